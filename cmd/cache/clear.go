@@ -0,0 +1,50 @@
+package cache
+
+import (
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	cacheSvc "github.com/cnopslabs/ocloud/internal/services/cache"
+	"github.com/spf13/cobra"
+)
+
+// Long description for the clear command
+var clearLong = `
+Remove cached resource listings from the local cache.
+
+By default the cache of every tenancy is removed. Use --tenancy-name to only remove the entries of one tenancy.
+The next list or search command fetches fresh results from OCI and repopulates the cache.
+`
+
+// Examples for the clear command
+var clearExamples = `
+  # Remove the whole cache
+  ocloud cache clear
+
+  # Remove the cache of a single tenancy
+  ocloud cache clear --tenancy-name mytenancy
+`
+
+// NewClearCmd creates the `cache clear` command.
+func NewClearCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "clear",
+		Aliases:       []string{"c"},
+		Short:         "Remove cached resource listings",
+		Long:          clearLong,
+		Example:       clearExamples,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runClearCommand(cmd)
+		},
+	}
+
+	return cmd
+}
+
+// runClearCommand handles the execution of the clear command
+func runClearCommand(cmd *cobra.Command) error {
+	tenancy := flags.GetStringFlag(cmd, flags.FlagNameTenancyName, "")
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running cache clear command", "tenancy", tenancy)
+	return cacheSvc.ClearCache(tenancy)
+}
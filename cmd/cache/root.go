@@ -0,0 +1,37 @@
+package cache
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Short description for the cache command
+var cacheShort = "Manage the local resource cache"
+
+// Long description for the cache command
+var cacheLong = `Manage the local on-disk cache of OCI resource listings.
+
+List and search commands cache their results under ~/.ocloud/cache/<tenancy>/<compartment>/<resource>.json.
+Cached results are reused until they are older than the cache TTL (--cache-ttl or OCLOUD_CACHE_TTL, default 5m).
+Use --refresh on any command to bypass the cache and store fresh results.`
+
+// Examples for the cache command
+var cacheExamples = `  ocloud cache status
+  ocloud cache clear
+  ocloud cache clear --tenancy-name mytenancy`
+
+// NewCacheCmd creates the `cache` command for inspecting and clearing the local resource cache.
+func NewCacheCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "cache",
+		Short:         cacheShort,
+		Long:          cacheLong,
+		Example:       cacheExamples,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	cmd.AddCommand(NewStatusCmd())
+	cmd.AddCommand(NewClearCmd())
+
+	return cmd
+}
@@ -0,0 +1,36 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewCacheCmd tests the NewCacheCmd function
+func TestNewCacheCmd(t *testing.T) {
+	cmd := NewCacheCmd()
+
+	assert.NotNil(t, cmd, "Command should not be nil")
+	assert.Equal(t, "cache", cmd.Use, "Command should have the correct use")
+	assert.NotEmpty(t, cmd.Short, "Command should have a short description")
+	assert.NotEmpty(t, cmd.Long, "Command should have a long description")
+	assert.NotEmpty(t, cmd.Example, "Command should have examples")
+	assert.True(t, cmd.SilenceUsage, "Command should silence usage")
+	assert.True(t, cmd.SilenceErrors, "Command should silence errors")
+
+	subcommands := map[string]bool{}
+	for _, subCmd := range cmd.Commands() {
+		subcommands[subCmd.Use] = true
+	}
+	assert.True(t, subcommands["status"], "Command should have the status subcommand")
+	assert.True(t, subcommands["clear"], "Command should have the clear subcommand")
+}
+
+// TestNewStatusCmd tests the NewStatusCmd function
+func TestNewStatusCmd(t *testing.T) {
+	cmd := NewStatusCmd()
+
+	assert.Equal(t, "status", cmd.Use)
+	assert.NotNil(t, cmd.RunE, "Command should have a RunE function")
+	assert.NotNil(t, cmd.Flags().Lookup("json"), "Command should have the json flag")
+}
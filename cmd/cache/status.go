@@ -0,0 +1,63 @@
+package cache
+
+import (
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	cacheSvc "github.com/cnopslabs/ocloud/internal/services/cache"
+	"github.com/spf13/cobra"
+)
+
+// Long description for the status command
+var statusLong = `
+Show the entries stored in the local resource cache.
+
+For each cached listing the tenancy, compartment, resource, number of items, size on disk and age are shown.
+Entries older than the cache TTL are marked as expired and will be refreshed on the next command that uses them.
+
+Additional Information:
+- Use --json (-j) or --output (-o) to output the results in another format
+- Use --cache-ttl to evaluate freshness against a different TTL
+`
+
+// Examples for the status command
+var statusExamples = `
+  # Show the cache contents
+  ocloud cache status
+
+  # Show the cache contents in JSON format
+  ocloud cache status --json
+`
+
+// NewStatusCmd creates the `cache status` command.
+func NewStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "status",
+		Aliases:       []string{"s"},
+		Short:         "Show cached resource listings",
+		Long:          statusLong,
+		Example:       statusExamples,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStatusCommand(cmd)
+		},
+	}
+
+	flags.JSONFlag.Add(cmd)
+
+	return cmd
+}
+
+// runStatusCommand handles the execution of the status command
+func runStatusCommand(cmd *cobra.Command) error {
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	ttl, err := flags.GetCacheTTL(cmd)
+	if err != nil {
+		return err
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running cache status command", "output", format.String(), "ttl", ttl)
+	return cacheSvc.ShowStatus(format, ttl)
+}
@@ -2,6 +2,7 @@ package image
 
 import (
 	imageFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
@@ -11,14 +12,17 @@ import (
 
 // Dedicated documentation for the get command
 var getLong = `
-Get images in the specified compartment with pagination support.
+Get images in the specified compartment with pagination support, or one image by
+name or OCID.
 
 This command retrieves available images in the current compartment.
 By default, it shows basic image information such as name, ID, operating system, and launch mode.
 
 The output is paginated, with a default limit of 20 images per page. You can navigate
 through pages using the --page flag and control the number of images per page with
-the --limit flag.
+the --limit flag. Given an image name or OCID, the command shows its details instead:
+the shapes the image can be launched on and the instances of the compartment that
+run it.
 
 Additional Information:
 - Use --json (-j) to output the results in JSON format
@@ -32,6 +36,9 @@ var getExamples = `
   # Get images with custom pagination (10 per page, page 2)
   ocloud compute image get --limit 10 --page 2
 
+  # Show one image with its compatible shapes and the instances running it
+  ocloud compute image get golden-web
+
   # Get images and output in JSON format
   ocloud compute image get --json
 
@@ -42,28 +49,46 @@ var getExamples = `
 // NewGetCmd creates a new command for listing images
 func NewGetCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:           "get",
+		Use:           "get [name-or-ocid]",
 		Short:         "Paginated Image Results",
 		Long:          getLong,
 		Example:       getExamples,
+		Args:          cobra.MaximumNArgs(1),
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runGetCommand(cmd, appCtx)
+			return runGetCommand(cmd, args, appCtx)
 		},
 	}
 
 	imageFlags.LimitFlag.Add(cmd)
 	imageFlags.PageFlag.Add(cmd)
+	imageFlags.RecursiveFlag.Add(cmd)
+	imageFlags.RegionsFlag.Add(cmd)
+	imageFlags.AllRegionsFlag.Add(cmd)
 
 	return cmd
 }
 
-// runGetCommand handles the execution of the list command
-func runGetCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
+// runGetCommand handles the execution of the get command
+func runGetCommand(cmd *cobra.Command, args []string, appCtx *app.ApplicationContext) error {
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if len(args) == 1 {
+		logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running image get command", "image", args[0], "compartment", appCtx.CompartmentName)
+		return image.GetImage(appCtx, args[0], format)
+	}
+
 	limit := flags.GetIntFlag(cmd, flags.FlagNameLimit, imageFlags.FlagDefaultLimit)
 	page := flags.GetIntFlag(cmd, flags.FlagNamePage, imageFlags.FlagDefaultPage)
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
-	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running image list command in", "compartment", appCtx.CompartmentName, "limit", limit, "page", page, "json", useJSON)
-	return image.GetImages(appCtx, limit, page, useJSON)
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running image list command in", "compartment", appCtx.CompartmentName, "limit", limit, "page", page, "output", format.String())
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	return image.GetImages(appCtx, limit, page, format)
 }
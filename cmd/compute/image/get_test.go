@@ -17,7 +17,7 @@ func TestGetCommand(t *testing.T) {
 	cmd := NewGetCmd(appCtx)
 
 	// Test that the list command is properly configured
-	assert.Equal(t, "get", cmd.Use)
+	assert.Equal(t, "get [name-or-ocid]", cmd.Use)
 	assert.Equal(t, "Paginated Image Results", cmd.Short)
 	assert.Equal(t, getLong, cmd.Long)
 	assert.Equal(t, getExamples, cmd.Example)
@@ -0,0 +1,68 @@
+package image
+
+import (
+	imageFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/services/compute/image"
+	"github.com/spf13/cobra"
+)
+
+var latestLong = `
+Resolve the newest platform image of an operating system version.
+
+Platform images are the Oracle-provided images available to every tenancy. The
+available images of --os and --os-version are checked newest first against the image
+shape compatibility API, and the first one that can be launched on --shape is shown
+together with the OCPU and memory range it supports on that shape. Without --shape
+the newest image is shown with all its compatible shapes.
+`
+
+var latestExamples = `
+  # Newest Oracle Linux 9 image for an AMD flexible shape
+  ocloud compute image latest --os "Oracle Linux" --os-version 9 --shape VM.Standard.E5.Flex
+
+  # Newest Ubuntu 24.04 image and every shape it runs on
+  ocloud compute image latest --os "Canonical Ubuntu" --os-version 24.04
+
+  # Output in JSON format, e.g. to pick up the OCID in a script
+  ocloud compute image latest --os "Oracle Linux" --os-version 9 --shape VM.Standard.A1.Flex --json
+`
+
+// NewLatestCmd creates a new command for resolving the newest platform image
+func NewLatestCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "latest",
+		Short:         "Resolve the newest platform image compatible with a shape",
+		Long:          latestLong,
+		Example:       latestExamples,
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLatestCommand(cmd, appCtx)
+		},
+	}
+
+	imageFlags.PlatformOSFlag.Add(cmd)
+	imageFlags.PlatformVersionFlag.Add(cmd)
+	imageFlags.ShapeFlag.Add(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagNameOS)
+	_ = cmd.MarkFlagRequired(flags.FlagNameOSVersion)
+
+	return cmd
+}
+
+// runLatestCommand handles the execution of the latest command
+func runLatestCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	operatingSystem := flags.GetStringFlag(cmd, flags.FlagNameOS, "")
+	version := flags.GetStringFlag(cmd, flags.FlagNameOSVersion, "")
+	shape := flags.GetStringFlag(cmd, flags.FlagNameShape, "")
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running image latest command in", "compartment", appCtx.CompartmentName, "os", operatingSystem, "version", version, "shape", shape)
+	return image.GetLatestImage(appCtx, operatingSystem, version, shape, format)
+}
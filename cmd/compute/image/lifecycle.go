@@ -0,0 +1,196 @@
+package image
+
+import (
+	imageFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/services/compute/image"
+	"github.com/spf13/cobra"
+)
+
+var createLong = `
+Create a custom image from the boot volume of an instance.
+
+The instance is given by its exact display name or OCID with --from-instance,
+as for the power actions; a partial name is rejected. The image is created in
+the current compartment. A running instance shuts down while the
+image is captured and restarts once it is done.
+
+The command waits, with a progress display, until the image is available.
+Use --no-wait to return as soon as OCI accepted the request.
+`
+
+var createExamples = `
+  # Bake a golden image from an instance
+  ocloud compute image create --from-instance web-1 --name golden-web-2026-10
+
+  # Send the request and return immediately
+  ocloud compute image create --from-instance ocid1.instance.oc1..aaaa... --no-wait
+`
+
+var exportLong = `
+Export an image, given by display name or OCID, to an Object Storage bucket.
+
+The bucket is looked up in the Object Storage namespace of the tenancy. The object
+defaults to the image name with the format as extension. The OCI format (default)
+keeps the image metadata, so that it can be imported again without extra options;
+QCOW2, VMDK, VHD and VDI suit other hypervisors.
+
+The command waits, with a progress display, until the export completed.
+Use --no-wait to return as soon as OCI accepted the request.
+`
+
+var exportExamples = `
+  # Export an image to a bucket as golden-web.oci
+  ocloud compute image export golden-web --bucket images
+
+  # Export as QCOW2 under a chosen object name
+  ocloud compute image export golden-web --bucket images --object exports/golden-web.qcow2 --format qcow2
+`
+
+var importLong = `
+Import a custom image from an object in an Object Storage bucket.
+
+The bucket is looked up in the Object Storage namespace of the tenancy and the
+image is created in the current compartment. The format is taken from --format or
+else from the object extension (.oci, .qcow2, .vmdk); images in the OCI format
+carry their own metadata. The image name defaults to the object name without its
+extension.
+
+The command waits, with a progress display, until the image is available.
+Use --no-wait to return as soon as OCI accepted the request.
+`
+
+var importExamples = `
+  # Import an image exported in the OCI format
+  ocloud compute image import --bucket images --object golden-web.oci
+
+  # Import a QCOW2 image with its operating system
+  ocloud compute image import --bucket images --object ubuntu.qcow2 --name ubuntu-custom --os "Canonical Ubuntu" --os-version 24.04
+`
+
+// NewCreateCmd creates a new command for creating an image from an instance
+func NewCreateCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "create",
+		Short:         "Create a custom image from an instance",
+		Long:          createLong,
+		Example:       createExamples,
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCreateCommand(cmd, appCtx)
+		},
+	}
+
+	imageFlags.FromInstanceFlag.Add(cmd)
+	imageFlags.NameFlag.Add(cmd)
+	imageFlags.NoWaitFlag.Add(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagNameFromInstance)
+
+	return cmd
+}
+
+// runCreateCommand handles the execution of the create command
+func runCreateCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	target := flags.GetStringFlag(cmd, flags.FlagNameFromInstance, "")
+	opts := image.LifecycleOptions{
+		Name:   flags.GetStringFlag(cmd, flags.FlagNameName, ""),
+		NoWait: flags.GetBoolFlag(cmd, flags.FlagNameNoWait, false),
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running image create command in", "compartment", appCtx.CompartmentName, "instance", target, "name", opts.Name, "noWait", opts.NoWait)
+	return image.CreateImage(cmd.Context(), appCtx, target, opts, format)
+}
+
+// NewExportCmd creates a new command for exporting an image to Object Storage
+func NewExportCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "export <name-or-ocid>",
+		Short:         "Export an image to Object Storage",
+		Long:          exportLong,
+		Example:       exportExamples,
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExportCommand(cmd, appCtx, args[0])
+		},
+	}
+
+	imageFlags.BucketFlag.Add(cmd)
+	imageFlags.ObjectFlag.Add(cmd)
+	imageFlags.ImageExportFormatFlag.Add(cmd)
+	imageFlags.NoWaitFlag.Add(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagNameBucket)
+
+	return cmd
+}
+
+// runExportCommand handles the execution of the export command
+func runExportCommand(cmd *cobra.Command, appCtx *app.ApplicationContext, target string) error {
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	bucket := flags.GetStringFlag(cmd, flags.FlagNameBucket, "")
+	object := flags.GetStringFlag(cmd, flags.FlagNameObject, "")
+	opts := image.LifecycleOptions{
+		Format: flags.GetStringFlag(cmd, flags.FlagNameFormat, image.FormatOCI),
+		NoWait: flags.GetBoolFlag(cmd, flags.FlagNameNoWait, false),
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running image export command", "image", target, "bucket", bucket, "object", object, "format", opts.Format, "noWait", opts.NoWait)
+	return image.ExportImage(cmd.Context(), appCtx, target, bucket, object, opts, format)
+}
+
+// NewImportCmd creates a new command for importing an image from Object Storage
+func NewImportCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "import",
+		Short:         "Import a custom image from Object Storage",
+		Long:          importLong,
+		Example:       importExamples,
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runImportCommand(cmd, appCtx)
+		},
+	}
+
+	imageFlags.BucketFlag.Add(cmd)
+	imageFlags.ObjectFlag.Add(cmd)
+	imageFlags.NameFlag.Add(cmd)
+	imageFlags.ImageImportFormatFlag.Add(cmd)
+	imageFlags.OSFlag.Add(cmd)
+	imageFlags.OSVersionFlag.Add(cmd)
+	imageFlags.NoWaitFlag.Add(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagNameBucket)
+	_ = cmd.MarkFlagRequired(flags.FlagNameObject)
+
+	return cmd
+}
+
+// runImportCommand handles the execution of the import command
+func runImportCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	bucket := flags.GetStringFlag(cmd, flags.FlagNameBucket, "")
+	object := flags.GetStringFlag(cmd, flags.FlagNameObject, "")
+	opts := image.LifecycleOptions{
+		Name:                   flags.GetStringFlag(cmd, flags.FlagNameName, ""),
+		Format:                 flags.GetStringFlag(cmd, flags.FlagNameFormat, ""),
+		OperatingSystem:        flags.GetStringFlag(cmd, flags.FlagNameOS, ""),
+		OperatingSystemVersion: flags.GetStringFlag(cmd, flags.FlagNameOSVersion, ""),
+		NoWait:                 flags.GetBoolFlag(cmd, flags.FlagNameNoWait, false),
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running image import command in", "compartment", appCtx.CompartmentName, "bucket", bucket, "object", object, "format", opts.Format, "noWait", opts.NoWait)
+	return image.ImportImage(cmd.Context(), appCtx, bucket, object, opts, format)
+}
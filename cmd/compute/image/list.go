@@ -1,6 +1,8 @@
 package image
 
 import (
+	imageFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
@@ -41,13 +43,26 @@ func NewListCmd(appCtx *app.ApplicationContext) *cobra.Command {
 		},
 	}
 
+	imageFlags.RecursiveFlag.Add(cmd)
+	imageFlags.RegionsFlag.Add(cmd)
+	imageFlags.AllRegionsFlag.Add(cmd)
+
 	return cmd
 }
 
 // runListCommand executes the interactive TUI image lister
 func runListCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
 	ctx := cmd.Context()
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
 	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running image list (TUI) command in", "compartment", appCtx.CompartmentName)
-	return image.ListImages(ctx, appCtx, useJSON)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	return image.ListImages(ctx, appCtx, format)
 }
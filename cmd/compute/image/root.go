@@ -10,9 +10,9 @@ func NewImageCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:           "image",
 		Aliases:       []string{"img"},
-		Short:         "Explore OCI Compute images — list, get, and search",
-		Long:          "List OCI Compute images in a compartment. Supports paging through large result sets and fuzzy search",
-		Example:       "  ocloud compute image get\n  ocloud compute image list\n  ocloud compute image search <value>",
+		Short:         "Explore and manage OCI Compute images — list, get, search, create, export, import and latest",
+		Long:          "List OCI Compute images in a compartment. Supports paging through large result sets and fuzzy search, and creates custom images from instances or moves them through Object Storage. Resolves the newest platform image compatible with a shape",
+		Example:       "  ocloud compute image get\n  ocloud compute image list\n  ocloud compute image search <value>\n  ocloud compute image create --from-instance <name>\n  ocloud compute image export <name> --bucket <bucket>\n  ocloud compute image latest --os <os> --os-version <version> --shape <shape>",
 		SilenceUsage:  true,
 		SilenceErrors: true,
 	}
@@ -20,6 +20,10 @@ func NewImageCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	cmd.AddCommand(NewGetCmd(appCtx))
 	cmd.AddCommand(NewListCmd(appCtx))
 	cmd.AddCommand(NewSearchCmd(appCtx))
+	cmd.AddCommand(NewCreateCmd(appCtx))
+	cmd.AddCommand(NewExportCmd(appCtx))
+	cmd.AddCommand(NewImportCmd(appCtx))
+	cmd.AddCommand(NewLatestCmd(appCtx))
 
 	return cmd
 }
@@ -20,9 +20,9 @@ func TestImageCommand(t *testing.T) {
 
 	// Test that the image command is properly configured
 	assert.Equal(t, "image", cmd.Use)
-	assert.Equal(t, "Explore OCI Compute images — list, get, and search", cmd.Short)
-	assert.Equal(t, "List OCI Compute images in a compartment. Supports paging through large result sets and fuzzy search", cmd.Long)
-	assert.Equal(t, "  ocloud compute image get\n  ocloud compute image list\n  ocloud compute image search <value>", cmd.Example)
+	assert.Equal(t, "Explore and manage OCI Compute images — list, get, search, create, export, import and latest", cmd.Short)
+	assert.Equal(t, "List OCI Compute images in a compartment. Supports paging through large result sets and fuzzy search, and creates custom images from instances or moves them through Object Storage. Resolves the newest platform image compatible with a shape", cmd.Long)
+	assert.Equal(t, "  ocloud compute image get\n  ocloud compute image list\n  ocloud compute image search <value>\n  ocloud compute image create --from-instance <name>\n  ocloud compute image export <name> --bucket <bucket>\n  ocloud compute image latest --os <os> --os-version <version> --shape <shape>", cmd.Example)
 	assert.True(t, cmd.SilenceUsage)
 	assert.True(t, cmd.SilenceErrors)
 	assert.Nil(t, cmd.RunE, "RunE should be nil since the root command now has subcommands")
@@ -74,6 +74,37 @@ func TestImageCommand(t *testing.T) {
 	// But we should still be able to get its value using flags.GetBoolFlag
 	useJSONFind := flags.GetBoolFlag(searchCmd, flags.FlagNameJSON, false)
 	assert.False(t, useJSONFind, "default value of json flag should be false")
+
+	// Test that the lifecycle subcommands are added with their required flags
+	createCmd := imageSubCommand(cmd, "create")
+	assert.NotNil(t, createCmd, "create subcommand should be added")
+	assert.NotNil(t, createCmd.Flags().Lookup(flags.FlagNameFromInstance))
+	assert.NotNil(t, createCmd.Flags().Lookup(flags.FlagNameNoWait))
+
+	exportCmd := imageSubCommand(cmd, "export")
+	assert.NotNil(t, exportCmd, "export subcommand should be added")
+	assert.Equal(t, "OCI", exportCmd.Flags().Lookup(flags.FlagNameFormat).DefValue)
+	assert.Error(t, exportCmd.Args(exportCmd, nil), "export needs an image")
+
+	importCmd := imageSubCommand(cmd, "import")
+	assert.NotNil(t, importCmd, "import subcommand should be added")
+	for _, name := range []string{flags.FlagNameBucket, flags.FlagNameObject} {
+		f := importCmd.Flags().Lookup(name)
+		if assert.NotNil(t, f, "%s flag should be added to import subcommand", name) {
+			assert.Equal(t, []string{"true"}, f.Annotations[cobra.BashCompOneRequiredFlag], "%s is required", name)
+		}
+	}
+
+	latestCmd := imageSubCommand(cmd, "latest")
+	assert.NotNil(t, latestCmd, "latest subcommand should be added")
+	for _, name := range []string{flags.FlagNameOS, flags.FlagNameOSVersion} {
+		f := latestCmd.Flags().Lookup(name)
+		if assert.NotNil(t, f, "%s flag should be added to latest subcommand", name) {
+			assert.Equal(t, []string{"true"}, f.Annotations[cobra.BashCompOneRequiredFlag], "%s is required", name)
+		}
+	}
+	assert.NotNil(t, latestCmd.Flags().Lookup(flags.FlagNameShape))
+	assert.Error(t, getCmd.Args(getCmd, []string{"a", "b"}), "get takes at most one image")
 }
 
 // findSubCommand is a helper function to find a subcommand by name
@@ -1,10 +1,13 @@
 package image
 
 import (
+	imageFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	cfgflags "github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
 	"github.com/cnopslabs/ocloud/internal/services/compute/image"
+	searchsvc "github.com/cnopslabs/ocloud/internal/services/search"
 	"github.com/spf13/cobra"
 )
 
@@ -23,7 +26,7 @@ Searchable fields:
 
 The search pattern is case-insensitive. For very specific inputs (like full OCID),
 the search first tries exact and substring matches; otherwise it falls back to broader fuzzy search.
-`
+` + searchsvc.QuerySyntaxHelp
 
 var searchExamples = `
   # Search by display name (substring)
@@ -58,13 +61,26 @@ func NewSearchCmd(appCtx *app.ApplicationContext) *cobra.Command {
 		},
 	}
 
+	imageFlags.RecursiveFlag.Add(cmd)
+	imageFlags.RegionsFlag.Add(cmd)
+	imageFlags.AllRegionsFlag.Add(cmd)
+
 	return cmd
 }
 
 // runSearchCommand handles the execution of the search command
 func runSearchCommand(cmd *cobra.Command, args []string, appCtx *app.ApplicationContext) error {
 	namePattern := args[0]
-	useJSON := cfgflags.GetBoolFlag(cmd, cfgflags.FlagNameJSON, false)
-	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running image search command", "pattern", namePattern, "in compartment", appCtx.CompartmentName, "json", useJSON)
-	return image.SearchImages(appCtx, namePattern, useJSON)
+	format, err := cfgflags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running image search command", "pattern", namePattern, "in compartment", appCtx.CompartmentName, "output", format.String())
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	return image.SearchImages(appCtx, namePattern, format)
 }
@@ -0,0 +1,122 @@
+package instance
+
+import (
+	"errors"
+
+	bastionCmd "github.com/cnopslabs/ocloud/cmd/identity/bastion"
+	instaceFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/services/compute/instance"
+	"github.com/spf13/cobra"
+)
+
+var consoleHistoryLong = `
+Capture and print the serial console output of an instance, given by its exact display
+name or OCID.
+
+This is the output you would otherwise fetch from the Console when an instance does not
+boot: kernel messages, cloud-init logs and login prompts. The capture is deleted once it
+has been read. Use --json to get the output wrapped with the instance name and OCID.
+`
+
+var consoleHistoryExamples = `
+  # Show why web-1 does not boot
+  ocloud compute instance console-history web-1
+
+  # Keep the output for later
+  ocloud compute instance console-history ocid1.instance.oc1..aaaa... > web-1-console.log
+`
+
+var consoleConnectLong = `
+Open a serial console connection to an instance, given by its exact display name or OCID.
+
+You pick the SSH key pair in the same browser as the bastion flow, or pass the private
+key with --identity-file (its public key is expected next to it with a .pub suffix).
+The command waits until the connection is ACTIVE and prints the ssh command to use;
+with --exec it runs the command right away. Type ~. to leave the console.
+
+OCI allows one console connection per instance. An active connection for the same key is
+reused; one for another key is reported, not replaced. A connection created for --exec is
+deleted when the session ends.
+`
+
+var consoleConnectExamples = `
+  # Pick a key pair and print the connection command
+  ocloud compute instance console connect web-1
+
+  # Connect right away with a given key
+  ocloud compute instance console connect web-1 --identity-file ~/.ssh/id_ed25519 --exec
+`
+
+// NewConsoleHistoryCmd creates a new command for printing the serial console output of an instance
+func NewConsoleHistoryCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	return &cobra.Command{
+		Use:           "console-history <name-or-ocid>",
+		Short:         "Print the serial console output of an instance",
+		Long:          consoleHistoryLong,
+		Example:       consoleHistoryExamples,
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running instance console-history command", "instance", args[0])
+			return instance.GetConsoleHistory(cmd.Context(), appCtx, args[0], format)
+		},
+	}
+}
+
+// NewConsoleCmd creates a new command for serial console operations
+func NewConsoleCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "console",
+		Short:         "Connect to the serial console of an instance",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	connect := &cobra.Command{
+		Use:           "connect <name-or-ocid>",
+		Short:         "Create a serial console connection and print or run its ssh command",
+		Long:          consoleConnectLong,
+		Example:       consoleConnectExamples,
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runConsoleConnectCommand(cmd, appCtx, args[0])
+		},
+	}
+	instaceFlags.IdentityFileFlag.Add(connect)
+	instaceFlags.ExecFlag.Add(connect)
+	cmd.AddCommand(connect)
+
+	return cmd
+}
+
+// runConsoleConnectCommand handles the execution of the console connect command
+func runConsoleConnectCommand(cmd *cobra.Command, appCtx *app.ApplicationContext, target string) error {
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	privKey := flags.GetStringFlag(cmd, flags.FlagNameIdentityFile, "")
+	pubKey := privKey + ".pub"
+	if privKey == "" {
+		pubKey, privKey, err = bastionCmd.SelectSSHKeyPair(cmd.Context())
+		if errors.Is(err, bastionCmd.ErrAborted) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	execute := flags.GetBoolFlag(cmd, flags.FlagNameExec, false)
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running instance console connect command", "instance", target, "publicKey", pubKey, "exec", execute)
+	return instance.ConnectConsole(cmd.Context(), appCtx, target, pubKey, privKey, execute, format)
+}
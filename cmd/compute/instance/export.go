@@ -0,0 +1,86 @@
+package instance
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	instaceFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/services/compute/instance"
+	"github.com/spf13/cobra"
+)
+
+var exportLong = `
+Export the instances of the specified compartment as an SSH config or an Ansible inventory.
+
+Each instance with a private IP becomes one host, named after its hostname label or,
+without one, its display name. The SSH user follows the image (ubuntu on Ubuntu
+images, opc otherwise) unless --user is given.
+
+Formats:
+- ssh-config:   Host blocks to include from ~/.ssh/config
+- ansible-ini:  an INI inventory with one group per freeform tag (e.g. env_prod)
+- ansible-yaml: the same inventory in YAML
+
+With --bastion-session, the instance targeted by that managed SSH or port
+forwarding session gets a ProxyCommand that tunnels through the OCI Bastion, so
+that it is reachable without a public IP. The other hosts connect directly.
+`
+
+var exportExamples = `
+  # Write an SSH config for the compartment
+  ocloud compute instance export > ~/.ssh/config.d/oci
+
+  # Ansible inventory grouped by freeform tags
+  ocloud compute instance export --format ansible-ini > inventory.ini
+  ocloud compute instance export --format ansible-yaml --recursive > inventory.yaml
+
+  # Route the session's target instance through a bastion session
+  ocloud compute instance export --identity-file ~/.ssh/oci --bastion-session ocid1.bastionsession.oc1.iad.aaaa...
+`
+
+// NewExportCmd creates a new command for exporting instances as SSH config or Ansible inventory
+func NewExportCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "export",
+		Short:         "Export instances as SSH config or Ansible inventory",
+		Long:          exportLong,
+		Example:       exportExamples,
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExportCommand(cmd, appCtx)
+		},
+	}
+
+	instaceFlags.ExportFormatFlag.Add(cmd)
+	instaceFlags.UserFlag.Add(cmd)
+	instaceFlags.IdentityFileFlag.Add(cmd)
+	instaceFlags.BastionSessionFlag.Add(cmd)
+	instaceFlags.RecursiveFlag.Add(cmd)
+
+	return cmd
+}
+
+// runExportCommand handles the execution of the export command
+func runExportCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
+	opts := instance.ExportOptions{
+		Format:         flags.GetStringFlag(cmd, flags.FlagNameFormat, instance.ExportSSHConfig),
+		User:           flags.GetStringFlag(cmd, flags.FlagNameUser, ""),
+		IdentityFile:   flags.GetStringFlag(cmd, flags.FlagNameIdentityFile, ""),
+		BastionSession: flags.GetStringFlag(cmd, flags.FlagNameBastionSession, ""),
+	}
+	if !slices.Contains(instance.ExportFormats, opts.Format) {
+		return fmt.Errorf("invalid --format %q (expected %s)", opts.Format, strings.Join(instance.ExportFormats, ", "))
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running instance export command in", "compartment", appCtx.CompartmentName, "format", opts.Format, "bastionSession", opts.BastionSession)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	return instance.ExportInstances(cmd.Context(), appCtx, opts)
+}
@@ -2,6 +2,7 @@ package instance
 
 import (
 	instaceFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
@@ -20,7 +21,10 @@ through pages using the --page flag and control the number of instances per page
 the --limit flag.
 
 Additional Information:
-- Use --all (-A) to include detailed information about the instance
+- Use --all (-A) to include detailed information about the instance, with its boot
+  volume and attached block volumes
+- Use --metadata to show the decoded cloud-init user data, the fingerprints of the
+  authorized SSH keys, the launch options and the Oracle Cloud Agent plugin states
 - Use --json (-j) to output the results in JSON format
 - The command only shows running instances by default
 `
@@ -43,6 +47,9 @@ var getExamples = `
 
   # Get instances with both instance details and JSON output
   ocloud compute instance get --all --json
+
+  # Get instances with their cloud-init, SSH keys, launch options and agent plugins
+  ocloud compute instance get --metadata
 `
 
 // NewGetCmd creates a new command for listing instances
@@ -62,6 +69,12 @@ func NewGetCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	instaceFlags.LimitFlag.Add(cmd)
 	instaceFlags.PageFlag.Add(cmd)
 	instaceFlags.AllInfoFlag.Add(cmd)
+	instaceFlags.MetadataFlag.Add(cmd)
+	instaceFlags.RecursiveFlag.Add(cmd)
+	instaceFlags.RegionsFlag.Add(cmd)
+	instaceFlags.AllRegionsFlag.Add(cmd)
+	instaceFlags.WatchFlag.Add(cmd)
+	instaceFlags.UntilStateFlag.Add(cmd)
 
 	return cmd
 }
@@ -70,8 +83,21 @@ func NewGetCmd(appCtx *app.ApplicationContext) *cobra.Command {
 func runGetCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
 	limit := flags.GetIntFlag(cmd, flags.FlagNameLimit, instaceFlags.FlagDefaultLimit)
 	page := flags.GetIntFlag(cmd, flags.FlagNamePage, instaceFlags.FlagDefaultPage)
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
 	imageDetails := flags.GetBoolFlag(cmd, flags.FlagNameAll, false)
-	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running instance get command in", "compartment", appCtx.CompartmentName, "limit", limit, "page", page, "json", useJSON, "imageDetails", imageDetails)
-	return instance.GetInstances(appCtx, useJSON, limit, page, imageDetails)
+	metadata := flags.GetBoolFlag(cmd, flags.FlagNameMetadata, false)
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running instance get command in", "compartment", appCtx.CompartmentName, "limit", limit, "page", page, "output", format.String(), "imageDetails", imageDetails, "metadata", metadata)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyWatch(cmd, appCtx); err != nil {
+		return err
+	}
+	return instance.GetInstances(appCtx, format, limit, page, imageDetails, metadata)
 }
@@ -40,4 +40,9 @@ func TestGetCommand(t *testing.T) {
 	assert.NotNil(t, imageDetailsFlag, "list command should have all flag (used for image details)")
 	assert.Equal(t, "all", imageDetailsFlag.Name)
 	assert.Equal(t, "A", imageDetailsFlag.Shorthand)
+
+	watchFlag := cmd.Flag("watch")
+	assert.NotNil(t, watchFlag, "get command should have watch flag")
+	assert.Equal(t, "10s", watchFlag.NoOptDefVal, "a bare --watch should use the default interval")
+	assert.NotNil(t, cmd.Flag("until-state"), "get command should have until-state flag")
 }
@@ -2,6 +2,7 @@ package instance
 
 import (
 	instaceFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
@@ -19,12 +20,17 @@ This command launches terminal UI that loads available instances and lets you:
 - Select a single instance to view its details
 
 After you pick an instance, the tool prints detailed information about the selected instance default table view or JSON format if specified with --json.
+With --all (-A), the boot volume and attached block volumes (device, attachment type,
+read-only, size and performance) are included as well.
 `
 
 var listExamples = `
   # Launch the interactive instance browser
   ocloud compute instance list
   ocloud compute instance list --json
+
+  # Include the boot volume and block volume attachments of the selected instance
+  ocloud compute instance list --all
 `
 
 // NewListCmd creates a new command for listing instances
@@ -43,13 +49,31 @@ func NewListCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	}
 
 	instaceFlags.AllInfoFlag.Add(cmd)
+	instaceFlags.RecursiveFlag.Add(cmd)
+	instaceFlags.RegionsFlag.Add(cmd)
+	instaceFlags.AllRegionsFlag.Add(cmd)
+	instaceFlags.WatchFlag.Add(cmd)
+	instaceFlags.UntilStateFlag.Add(cmd)
 
 	return cmd
 }
 
 // RunListCommand handles the execution of the list command
 func RunListCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
-	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running instance list command in", "compartment", appCtx.CompartmentName, useJSON)
-	return instance.ListInstances(appCtx, useJSON)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	showStorage := flags.GetBoolFlag(cmd, flags.FlagNameAll, false)
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running instance list command in", "compartment", appCtx.CompartmentName, "output", format.String(), "storage", showStorage)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyWatch(cmd, appCtx); err != nil {
+		return err
+	}
+	return instance.ListInstances(appCtx, format, showStorage)
 }
@@ -0,0 +1,89 @@
+package instance
+
+import (
+	"fmt"
+
+	instaceFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/services/compute/instance"
+	"github.com/spf13/cobra"
+)
+
+var powerLong = `
+%s one or more instances, given by display name or OCID.
+
+Names must match the display name of a single instance exactly (case-insensitive);
+unlike 'ocloud compute instance search', a partial or fuzzy match is never acted
+upon, only suggested. Use the OCID when several instances share a name. %s
+
+You are asked to confirm, with the name and current state of each instance, before
+the action is sent; use --yes (-y) to skip the prompt.
+The command then waits, with a progress display, until every instance is %s.
+Use --no-wait to return as soon as OCI accepted the request.
+`
+
+var powerExamples = `
+  # %[1]s a single instance by name
+  ocloud compute instance %[2]s web-1
+
+  # %[1]s several instances without confirmation
+  ocloud compute instance %[2]s web-1 web-2 ocid1.instance.oc1..aaaa... --yes
+
+  # Send the request and return immediately
+  ocloud compute instance %[2]s web-1 --yes --no-wait
+`
+
+// powerCommands lists the power actions exposed as instance subcommands.
+var powerCommands = []struct {
+	verb   string
+	short  string
+	action string
+	note   string
+	state  string
+}{
+	{verb: "start", short: "Start stopped instances", action: "Start", note: "Instances already RUNNING are left alone.", state: "RUNNING"},
+	{verb: "stop", short: "Stop instances immediately (hard stop)", action: "Stop", note: "Instances already STOPPED are left alone.", state: "STOPPED"},
+	{verb: "softstop", short: "Gracefully shut down instances", action: "Shut down", note: "The operating system gets time to shut down before the instance is stopped.", state: "STOPPED"},
+	{verb: "reboot", short: "Gracefully reboot instances", action: "Reboot", note: "The operating system gets time to shut down before the instance restarts.", state: "RUNNING again"},
+	{verb: "reset", short: "Power-cycle instances immediately (hard reboot)", action: "Reset", note: "The instance restarts without a graceful shutdown.", state: "RUNNING again"},
+}
+
+// NewPowerCmds creates the start, stop, softstop, reboot and reset commands.
+func NewPowerCmds(appCtx *app.ApplicationContext) []*cobra.Command {
+	cmds := make([]*cobra.Command, 0, len(powerCommands))
+	for _, pc := range powerCommands {
+		verb := pc.verb
+		cmd := &cobra.Command{
+			Use:           verb + " <name-or-ocid>...",
+			Short:         pc.short,
+			Long:          fmt.Sprintf(powerLong, pc.action, pc.note, pc.state),
+			Example:       fmt.Sprintf(powerExamples, pc.action, verb),
+			Args:          cobra.MinimumNArgs(1),
+			SilenceUsage:  true,
+			SilenceErrors: true,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return runPowerCommand(cmd, appCtx, verb, args)
+			},
+		}
+		instaceFlags.YesFlag.Add(cmd)
+		instaceFlags.NoWaitFlag.Add(cmd)
+		cmds = append(cmds, cmd)
+	}
+	return cmds
+}
+
+// runPowerCommand handles the execution of a power action command
+func runPowerCommand(cmd *cobra.Command, appCtx *app.ApplicationContext, verb string, targets []string) error {
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	opts := instance.ActionOptions{
+		Yes:    flags.GetBoolFlag(cmd, flags.FlagNameYes, false),
+		NoWait: flags.GetBoolFlag(cmd, flags.FlagNameNoWait, false),
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running instance power command", "action", verb, "targets", targets, "yes", opts.Yes, "noWait", opts.NoWait)
+	return instance.RunInstanceAction(cmd.Context(), appCtx, verb, targets, opts, format)
+}
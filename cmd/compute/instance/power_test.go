@@ -0,0 +1,29 @@
+package instance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+)
+
+func TestPowerCommands(t *testing.T) {
+	root := NewInstanceCmd(&app.ApplicationContext{})
+
+	for _, verb := range []string{"start", "stop", "softstop", "reboot", "reset"} {
+		cmd := instanceSubCommand(root, verb)
+		if !assert.NotNil(t, cmd, "%s subcommand should be added", verb) {
+			continue
+		}
+		assert.NotNil(t, cmd.RunE)
+		assert.Error(t, cmd.Args(cmd, nil), "%s needs at least one instance", verb)
+		assert.NoError(t, cmd.Args(cmd, []string{"web-1", "web-2"}))
+
+		yesFlag := cmd.Flag("yes")
+		if assert.NotNil(t, yesFlag, "%s should have the yes flag", verb) {
+			assert.Equal(t, "y", yesFlag.Shorthand)
+		}
+		assert.NotNil(t, cmd.Flag("no-wait"), "%s should have the no-wait flag", verb)
+	}
+}
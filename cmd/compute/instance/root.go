@@ -11,9 +11,9 @@ func NewInstanceCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:           "instance",
 		Aliases:       []string{"inst"},
-		Short:         "Explore OCI Compute instances — list, get, and search.",
+		Short:         "Explore and operate OCI Compute instances — list, get, search, start and stop.",
 		Long:          "List OCI Compute instances in a compartment. Supports paging through large result sets and fuzzy search",
-		Example:       "  ocloud compute instance get\n  ocloud compute instance list\n  ocloud compute instance search <value>",
+		Example:       "  ocloud compute instance get\n  ocloud compute instance list\n  ocloud compute instance search <value>\n  ocloud compute instance stop <name>\n  ocloud compute instance export --format ansible-ini",
 		SilenceUsage:  true,
 		SilenceErrors: true,
 	}
@@ -21,6 +21,10 @@ func NewInstanceCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	cmd.AddCommand(NewGetCmd(appCtx))
 	cmd.AddCommand(NewSearchCmd(appCtx))
 	cmd.AddCommand(NewListCmd(appCtx))
+	cmd.AddCommand(NewExportCmd(appCtx))
+	cmd.AddCommand(NewConsoleHistoryCmd(appCtx))
+	cmd.AddCommand(NewConsoleCmd(appCtx))
+	cmd.AddCommand(NewPowerCmds(appCtx)...)
 
 	return cmd
 }
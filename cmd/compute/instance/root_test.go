@@ -20,9 +20,9 @@ func TestInstanceCommand(t *testing.T) {
 
 	// Test that the instance command is properly configured
 	assert.Equal(t, "instance", cmd.Use)
-	assert.Equal(t, "Explore OCI Compute instances — list, get, and search.", cmd.Short)
+	assert.Equal(t, "Explore and operate OCI Compute instances — list, get, search, start and stop.", cmd.Short)
 	assert.Equal(t, "List OCI Compute instances in a compartment. Supports paging through large result sets and fuzzy search", cmd.Long)
-	assert.Equal(t, "  ocloud compute instance get\n  ocloud compute instance list\n  ocloud compute instance search <value>", cmd.Example)
+	assert.Equal(t, "  ocloud compute instance get\n  ocloud compute instance list\n  ocloud compute instance search <value>\n  ocloud compute instance stop <name>\n  ocloud compute instance export --format ansible-ini", cmd.Example)
 	assert.True(t, cmd.SilenceUsage)
 	assert.True(t, cmd.SilenceErrors)
 	assert.Nil(t, cmd.RunE, "RunE should be nil since the root command now has subcommands")
@@ -44,6 +44,10 @@ func TestInstanceCommand(t *testing.T) {
 	assert.Equal(t, flags.FlagShortPage, pageFlag.Shorthand)
 	assert.Equal(t, flags.FlagDescPage, pageFlag.Usage)
 
+	metadataFlag := listCmd.Flags().Lookup(flags.FlagNameMetadata)
+	assert.NotNil(t, metadataFlag, "metadata flag should be added to get subcommand")
+	assert.Equal(t, "false", metadataFlag.DefValue)
+
 	// JSON flag is now a global flag, so it should not be in the local flags
 	jsonFlag := listCmd.Flags().Lookup(flags.FlagNameJSON)
 	assert.Nil(t, jsonFlag, "json flag should not be added as a local flag to list subcommand")
@@ -71,6 +75,28 @@ func TestInstanceCommand(t *testing.T) {
 	// But we should still be able to get its value using flags.GetBoolFlag
 	useJSONSearch := flags.GetBoolFlag(searchCmd, flags.FlagNameJSON, false)
 	assert.False(t, useJSONSearch, "default value of json flag should be false")
+
+	// Test that the export subcommand is added with its flags
+	exportCmd := instanceSubCommand(cmd, "export")
+	assert.NotNil(t, exportCmd, "export subcommand should be added")
+	formatFlag := exportCmd.Flags().Lookup(flags.FlagNameFormat)
+	assert.NotNil(t, formatFlag, "format flag should be added to export subcommand")
+	assert.Equal(t, "ssh-config", formatFlag.DefValue)
+	assert.NotNil(t, exportCmd.Flags().Lookup(flags.FlagNameBastionSession))
+	assert.NotNil(t, exportCmd.Flags().Lookup(flags.FlagNameIdentityFile))
+	assert.NotNil(t, exportCmd.Flags().Lookup(flags.FlagNameUser))
+
+	// Test that the console commands are added
+	historyCmd := instanceSubCommand(cmd, "console-history")
+	assert.NotNil(t, historyCmd, "console-history subcommand should be added")
+	assert.Error(t, historyCmd.Args(historyCmd, nil), "console-history requires an instance")
+
+	consoleCmd := instanceSubCommand(cmd, "console")
+	assert.NotNil(t, consoleCmd, "console subcommand should be added")
+	connectCmd := instanceSubCommand(consoleCmd, "connect")
+	assert.NotNil(t, connectCmd, "console connect subcommand should be added")
+	assert.NotNil(t, connectCmd.Flags().Lookup(flags.FlagNameIdentityFile))
+	assert.NotNil(t, connectCmd.Flags().Lookup(flags.FlagNameExec))
 }
 
 // instanceSubCommand is a helper function to find a subcommand by name
@@ -2,10 +2,12 @@ package instance
 
 import (
 	instaceFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
 	"github.com/cnopslabs/ocloud/internal/services/compute/instance"
+	searchsvc "github.com/cnopslabs/ocloud/internal/services/search"
 	"github.com/spf13/cobra"
 )
 
@@ -22,6 +24,8 @@ Searchable fields:
 - ImageOS: Operating system of the image
 - Shape: Instance shape
 - PrimaryIP: Primary private IP address
+- PublicIP: Public IP of the primary private IP (ephemeral or reserved)
+- IPs: The private and public IP of every VNIC; with --all, secondary IPs too
 - OCID: Instance OCID
 - VcnName: Name of the VCN the instance is attached to
 - SubnetName: Name of the subnet the instance is attached to
@@ -34,7 +38,7 @@ Searchable fields:
 
 The search pattern is case-insensitive. For very specific inputs (like full OCID, IP, or exact hostname),
 the search first tries exact and substring matches; otherwise it falls back to broader fuzzy search.
-`
+` + searchsvc.QuerySyntaxHelp
 
 var searchExamples = `
   # Search by display name (substring)
@@ -54,6 +58,12 @@ var searchExamples = `
   ocloud compute instance search 10.0.1.15
   ocloud compute instance search 10.0.1.
 
+  # Search by public IP on any VNIC
+  ocloud compute instance search 129.146.10.20
+
+  # Search by a secondary private IP
+  ocloud compute instance search 10.0.1.25 --all
+
   # Search by OCID (exact)
   ocloud compute instance search ocid1.instance.oc1..aaaa...
 
@@ -72,11 +82,17 @@ var searchExamples = `
   # Search by tag value only (TagsVal)
   ocloud compute instance search 8.10
 
-  # Show more details in the output
+  # Running instances of a shape family tagged env=prod, excluding test servers
+  ocloud compute instance search "state:RUNNING shape:VM.Standard.E4* tag:env=prod -name:test"
+
+  # Show more details in the output, with the boot and block volumes
   ocloud compute instance search api --all
 
   # Output in JSON format
   ocloud compute instance search server --json
+
+  # Follow matching instances until they have stopped
+  ocloud compute instance search web --watch --until-state STOPPED
 `
 
 // NewSearchCmd creates a new command for finding instances by name pattern
@@ -96,6 +112,11 @@ func NewSearchCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	}
 
 	instaceFlags.AllInfoFlag.Add(cmd)
+	instaceFlags.RecursiveFlag.Add(cmd)
+	instaceFlags.RegionsFlag.Add(cmd)
+	instaceFlags.AllRegionsFlag.Add(cmd)
+	instaceFlags.WatchFlag.Add(cmd)
+	instaceFlags.UntilStateFlag.Add(cmd)
 
 	return cmd
 }
@@ -104,7 +125,19 @@ func NewSearchCmd(appCtx *app.ApplicationContext) *cobra.Command {
 func runSearchCommand(cmd *cobra.Command, args []string, appCtx *app.ApplicationContext) error {
 	search := args[0]
 	showDetails := flags.GetBoolFlag(cmd, flags.FlagNameAll, false)
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
-	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running instance search command", "search", search, "in compartment", appCtx.CompartmentName, "json", useJSON)
-	return instance.SearchInstances(appCtx, search, useJSON, showDetails)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running instance search command", "search", search, "in compartment", appCtx.CompartmentName, "output", format.String())
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyWatch(cmd, appCtx); err != nil {
+		return err
+	}
+	return instance.SearchInstances(appCtx, search, format, showDetails)
 }
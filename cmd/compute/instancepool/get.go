@@ -0,0 +1,84 @@
+package instancepool
+
+import (
+	poolFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/services/compute/instancepool"
+	"github.com/spf13/cobra"
+)
+
+// Dedicated documentation for the get command
+var getLong = `
+Get the instance pools in the specified compartment, or one pool by name or OCID.
+
+Each pool is listed with:
+- SIZE: the number of instances the pool maintains
+- SHAPE / IMAGE: taken from the instance configuration the pool launches from
+- LOAD BALANCERS: the load balancers the pool registers its instances with
+- MEMBERS: the instances of the pool and how many of them are RUNNING
+
+The output is paginated, with a default limit of 20 pools per page. You can navigate
+through pages using the --page flag and control the number of pools per page with
+the --limit flag. Given a pool name or OCID, the command shows its details instead:
+the instance configuration with its metadata, each load balancer backend set with
+its health, and each member instance.
+`
+
+var getExamples = `
+  # Get instance pools with default pagination (20 per page)
+  ocloud compute instance-pool get
+
+  # Show one pool with its configuration, load balancers and members
+  ocloud compute instance-pool get web-pool
+
+  # Include the pools of all sub-compartments
+  ocloud compute instance-pool get --recursive
+
+  # Output in JSON format
+  ocloud compute instance-pool get --json
+`
+
+// NewGetCmd creates a new command for getting instance pools
+func NewGetCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "get [name-or-ocid]",
+		Short:         "Paginated Instance Pool Results",
+		Long:          getLong,
+		Example:       getExamples,
+		Args:          cobra.MaximumNArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runGetCommand(cmd, args, appCtx)
+		},
+	}
+
+	poolFlags.LimitFlag.Add(cmd)
+	poolFlags.PageFlag.Add(cmd)
+	poolFlags.RecursiveFlag.Add(cmd)
+
+	return cmd
+}
+
+// runGetCommand handles the execution of the get command
+func runGetCommand(cmd *cobra.Command, args []string, appCtx *app.ApplicationContext) error {
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if len(args) == 1 {
+		logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running instance pool get command", "pool", args[0], "compartment", appCtx.CompartmentName)
+		return instancepool.GetInstancePool(appCtx, args[0], format)
+	}
+
+	limit := flags.GetIntFlag(cmd, flags.FlagNameLimit, poolFlags.FlagDefaultLimit)
+	page := flags.GetIntFlag(cmd, flags.FlagNamePage, poolFlags.FlagDefaultPage)
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running instance pool get command in", "compartment", appCtx.CompartmentName, "limit", limit, "page", page, "output", format.String())
+	return instancepool.GetInstancePools(appCtx, limit, page, format)
+}
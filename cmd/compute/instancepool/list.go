@@ -0,0 +1,63 @@
+package instancepool
+
+import (
+	poolFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/services/compute/instancepool"
+	"github.com/spf13/cobra"
+)
+
+// Dedicated documentation for the list command (separate from get)
+var listLong = `
+Interactively browse and search the instance pools of the specified compartment using a TUI.
+
+This command launches terminal UI that loads the instance pools and lets you:
+- Search/filter pools as you type
+- Navigate the list
+- Select a single pool to view its configuration, load balancers and members
+
+After you pick a pool, the tool prints its details in the default table view or JSON format if specified with --json.
+`
+
+var listExamples = `
+  # Launch the interactive instance pool browser
+  ocloud compute instance-pool list
+  ocloud compute instance-pool list --recursive
+  ocloud compute instance-pool list --json
+`
+
+// NewListCmd creates a new command for listing instance pools
+func NewListCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "list",
+		Short:         "List all instance pools",
+		Aliases:       []string{"l"},
+		Long:          listLong,
+		Example:       listExamples,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runListCommand(cmd, appCtx)
+		},
+	}
+
+	poolFlags.RecursiveFlag.Add(cmd)
+
+	return cmd
+}
+
+// runListCommand executes the interactive TUI instance pool lister
+func runListCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running instance pool list (TUI) command in", "compartment", appCtx.CompartmentName)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	return instancepool.ListInstancePools(cmd.Context(), appCtx, format)
+}
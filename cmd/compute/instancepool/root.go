@@ -0,0 +1,25 @@
+package instancepool
+
+import (
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/spf13/cobra"
+)
+
+// NewInstancePoolCmd creates a new command for instance pool-related operations
+func NewInstancePoolCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "instance-pool",
+		Aliases:       []string{"instance-pools", "pool", "pools"},
+		Short:         "Explore OCI Compute instance pools — list, get, and search",
+		Long:          "Show OCI Compute instance pools with their size and state, the instance configuration they launch from (shape, image, metadata), the load balancer backend sets they register with and their member instances.",
+		Example:       "  ocloud compute instance-pool get\n  ocloud compute instance-pool get web-pool\n  ocloud compute instance-pool list\n  ocloud compute instance-pool search <value>",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	cmd.AddCommand(NewGetCmd(appCtx))
+	cmd.AddCommand(NewListCmd(appCtx))
+	cmd.AddCommand(NewSearchCmd(appCtx))
+
+	return cmd
+}
@@ -0,0 +1,48 @@
+package instancepool
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+)
+
+// TestInstancePoolCommand tests the basic structure of the instance-pool command
+func TestInstancePoolCommand(t *testing.T) {
+	cmd := NewInstancePoolCmd(&app.ApplicationContext{})
+
+	assert.Equal(t, "instance-pool", cmd.Use)
+	assert.Contains(t, cmd.Aliases, "pool")
+	assert.True(t, cmd.SilenceUsage)
+	assert.True(t, cmd.SilenceErrors)
+	assert.Nil(t, cmd.RunE, "RunE should be nil since the root command has subcommands")
+
+	getCmd := poolSubCommand(cmd, "get")
+	assert.NotNil(t, getCmd, "get subcommand should be added")
+	assert.NotNil(t, getCmd.Flags().Lookup(flags.FlagNameLimit))
+	assert.NotNil(t, getCmd.Flags().Lookup(flags.FlagNamePage))
+	assert.NotNil(t, getCmd.Flags().Lookup(flags.FlagNameRecursive))
+	assert.NoError(t, getCmd.Args(getCmd, []string{"web-pool"}))
+	assert.Error(t, getCmd.Args(getCmd, []string{"a", "b"}), "get takes at most one pool")
+
+	listCmd := poolSubCommand(cmd, "list")
+	assert.NotNil(t, listCmd, "list subcommand should be added")
+	assert.NotNil(t, listCmd.Flags().Lookup(flags.FlagNameRecursive))
+
+	searchCmd := poolSubCommand(cmd, "search")
+	assert.NotNil(t, searchCmd, "search subcommand should be added")
+	assert.Error(t, searchCmd.Args(searchCmd, nil), "search requires a pattern")
+}
+
+// poolSubCommand is a helper function to find a subcommand by name
+func poolSubCommand(cmd *cobra.Command, name string) *cobra.Command {
+	for _, subCmd := range cmd.Commands() {
+		if subCmd.Name() == name {
+			return subCmd
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package instancepool
+
+import (
+	poolFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
+	"github.com/cnopslabs/ocloud/internal/app"
+	cfgflags "github.com/cnopslabs/ocloud/internal/config/flags"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/services/compute/instancepool"
+	searchsvc "github.com/cnopslabs/ocloud/internal/services/search"
+	"github.com/spf13/cobra"
+)
+
+var searchLong = `
+Search for instance pools in the specified compartment that match the given pattern.
+
+The search uses a fuzzy, prefix, and substring matching algorithm across many indexed fields.
+You can search using any of the following fields (partial matches are supported):
+
+Searchable fields:
+- Name: Pool display name
+- OCID: Pool OCID
+- State: Lifecycle state
+- Shape: Shape of the instance configuration
+- Image: Image name of the instance configuration
+- Configuration: Instance configuration name
+- LoadBalancers: Names and backend sets of the attached load balancers
+- Members: Display names of the member instances
+- AD: Availability domains the pool places instances in
+- TagsKV / TagsVal: Freeform and defined tags
+
+The search pattern is case-insensitive.
+` + searchsvc.QuerySyntaxHelp
+
+var searchExamples = `
+  # Search by pool name
+  ocloud compute instance-pool search web
+
+  # Find the pool an instance belongs to
+  ocloud compute instance-pool search inst-20240101-1234
+
+  # Find the pools behind a load balancer
+  ocloud compute instance-pool search public-lb
+
+  # Output in JSON format
+  ocloud compute instance-pool search E5.Flex --json
+`
+
+// NewSearchCmd creates a new command for finding instance pools by pattern
+func NewSearchCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "search [pattern]",
+		Aliases:       []string{"s"},
+		Short:         "Fuzzy search for Instance Pools",
+		Long:          searchLong,
+		Example:       searchExamples,
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSearchCommand(cmd, args, appCtx)
+		},
+	}
+
+	poolFlags.RecursiveFlag.Add(cmd)
+
+	return cmd
+}
+
+// runSearchCommand handles the execution of the search command
+func runSearchCommand(cmd *cobra.Command, args []string, appCtx *app.ApplicationContext) error {
+	pattern := args[0]
+	format, err := cfgflags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running instance pool search command", "pattern", pattern, "in compartment", appCtx.CompartmentName, "output", format.String())
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	return instancepool.SearchInstancePools(appCtx, pattern, format)
+}
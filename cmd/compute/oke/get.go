@@ -1,7 +1,10 @@
 package oke
 
 import (
+	"fmt"
+
 	paginationFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
@@ -10,11 +13,22 @@ import (
 )
 
 var getLong = `
-Get all Oracle Kubernetes Engine (OKE) clusters in the specified compartment.
+Get all Oracle Kubernetes Engine (OKE) clusters in the specified compartment, or one
+cluster by name or OCID.
 
 This command displays information about all OKE clusters in the current compartment,
-including their names, Kubernetes versions, endpoints, and associated node pools.
-By default, it shows basic cluster information in a tabular format.
+including their names, Kubernetes versions, endpoints, and associated node pools
+and virtual node pools. By default, it shows basic cluster information in a tabular format.
+
+Given a cluster, --nodes lists every node of each node pool: its instance OCID,
+private IP, availability and fault domain, lifecycle state, Kubernetes version, and
+the subnet and network security groups of its instance. Errors OCI reports for a
+node are listed below its pool. Virtual node pools list their virtual nodes the
+same way.
+
+With --all (-A), each cluster also shows its options (CNI type, pods and services
+CIDRs, admission controller and image policy settings) and its installed add-ons
+with their versions and states.
 
 Additional Information:
 - Use --json (-j) to output the results in JSON format
@@ -29,8 +43,20 @@ var getExamples = `
   # Get all OKE clusters and output in JSON format
   ocloud compute oke get --json
 
+  # Show one cluster with the nodes of each node pool
+  ocloud compute oke get prod-oke --nodes
+
+  # Show the options and add-ons of one cluster
+  ocloud compute oke get prod-oke --all
+
   # Get OKE clusters with pagination (10 per page, page 2)
   ocloud compute oke get --limit 10 --page 2
+
+  # Refresh every 30 seconds until every cluster is ACTIVE
+  ocloud compute oke get --watch=30s --until-state ACTIVE
+
+  # Follow the nodes of one cluster while a node pool scales
+  ocloud compute oke get prod-oke --nodes --watch
 `
 
 // NewGetCmd creates a new cobra.Command for listing all OKE clusters in a specified compartment.
@@ -38,28 +64,57 @@ var getExamples = `
 // The command supports JSON output through the --JSON flag.
 func NewGetCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:           "get",
+		Use:           "get [name-or-ocid]",
 		Short:         "Get all Oracle Kubernetes Engine (OKE) clusters",
 		Long:          getLong,
 		Example:       getExamples,
+		Args:          cobra.MaximumNArgs(1),
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return RunGetCommand(cmd, appCtx)
+			return RunGetCommand(cmd, args, appCtx)
 		},
 	}
 
 	paginationFlags.LimitFlag.Add(cmd)
 	paginationFlags.PageFlag.Add(cmd)
+	paginationFlags.RecursiveFlag.Add(cmd)
+	paginationFlags.RegionsFlag.Add(cmd)
+	paginationFlags.AllRegionsFlag.Add(cmd)
+	paginationFlags.WatchFlag.Add(cmd)
+	paginationFlags.UntilStateFlag.Add(cmd)
+	paginationFlags.NodesFlag.Add(cmd)
+	paginationFlags.AllInfoFlag.Add(cmd)
 
 	return cmd
 }
 
-// RunGetCommand handles the execution of the list command
-func RunGetCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
+// RunGetCommand handles the execution of the get command
+func RunGetCommand(cmd *cobra.Command, args []string, appCtx *app.ApplicationContext) error {
 	limit := flags.GetIntFlag(cmd, flags.FlagNameLimit, paginationFlags.FlagDefaultLimit)
 	page := flags.GetIntFlag(cmd, flags.FlagNamePage, paginationFlags.FlagDefaultPage)
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
-	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running oke get command in", "compartment", appCtx.CompartmentName, "json", useJSON)
-	return oke.GetClusters(appCtx, useJSON, limit, page)
+	showNodes := flags.GetBoolFlag(cmd, flags.FlagNameNodes, false)
+	showAll := flags.GetBoolFlag(cmd, flags.FlagNameAll, false)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	if showNodes && len(args) == 0 {
+		return fmt.Errorf("--%s needs a cluster name or OCID", flags.FlagNameNodes)
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running oke get command in", "compartment", appCtx.CompartmentName, "output", format.String())
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyWatch(cmd, appCtx); err != nil {
+		return err
+	}
+	if len(args) == 1 {
+		logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running oke get command", "cluster", args[0], "nodes", showNodes)
+		return oke.GetCluster(appCtx, args[0], showNodes, showAll, format)
+	}
+	return oke.GetClusters(appCtx, format, limit, page, showAll)
 }
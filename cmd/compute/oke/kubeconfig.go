@@ -0,0 +1,178 @@
+package oke
+
+import (
+	okeFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/services/compute/oke"
+	"github.com/spf13/cobra"
+)
+
+var kubeconfigLong = `
+Manage the kubeconfig contexts ocloud creates for OKE clusters.
+
+Contexts managed by ocloud are those whose user generates its token with
+'oci ce cluster generate-token'; other entries of the kubeconfig are never changed.
+Like kubectl, the commands read every file listed in KUBECONFIG, or ~/.kube/config
+when it is unset. Each file is replaced atomically, and its previous content is kept
+next to it with a .bak extension.
+`
+
+var kubeconfigExamples = `
+  # Add a context for a cluster, through its public endpoint when it has one
+  ocloud compute oke kubeconfig add prod-oke
+
+  # List the contexts managed by ocloud
+  ocloud compute oke kubeconfig list
+
+  # Remove the contexts of clusters that were deleted
+  ocloud compute oke kubeconfig prune
+`
+
+var kubeconfigAddLong = `
+Add a kubeconfig context reaching a cluster directly through its public or private
+endpoint, without a bastion.
+
+The cluster is given by name or OCID. The server and certificate authority come from
+the kubeconfig OCI generates for the endpoint; the token is generated on demand by the
+OCI CLI with the session of the current profile. Use --endpoint private from inside
+the VCN or over a VPN. Running the command again for the same context updates it.
+The new context becomes the current one when none is set. Contexts created by the
+bastion tunnel flow for the same cluster keep their own cluster entry and server.
+`
+
+var kubeconfigAddExamples = `
+  # Add a context through the public endpoint
+  ocloud compute oke kubeconfig add prod-oke
+
+  # Add a context through the private endpoint, with a custom name
+  ocloud compute oke kubeconfig add prod-oke --endpoint private --context prod
+`
+
+var kubeconfigPruneLong = `
+Remove the contexts managed by ocloud whose cluster no longer exists.
+
+Each cluster is looked up in the region its context names; a context is stale when
+OCI reports its cluster DELETED. A cluster OCI does not find is kept and reported,
+since OCI answers the same way for clusters of another tenancy or out of reach of
+the current profile; remove such contexts with 'kubeconfig remove'. You are asked
+to confirm before anything is removed; use --yes (-y) to skip the prompt.
+`
+
+// NewKubeconfigCmd creates the kubeconfig command and its add, list, remove and prune subcommands
+func NewKubeconfigCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "kubeconfig",
+		Short:         "Manage kubeconfig contexts of OKE clusters",
+		Long:          kubeconfigLong,
+		Example:       kubeconfigExamples,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	cmd.AddCommand(newKubeconfigAddCmd(appCtx))
+	cmd.AddCommand(newKubeconfigListCmd(appCtx))
+	cmd.AddCommand(newKubeconfigRemoveCmd(appCtx))
+	cmd.AddCommand(newKubeconfigPruneCmd(appCtx))
+
+	return cmd
+}
+
+func newKubeconfigAddCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "add <name-or-ocid>",
+		Short:         "Add a kubeconfig context for a cluster's public or private endpoint",
+		Long:          kubeconfigAddLong,
+		Example:       kubeconfigAddExamples,
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runKubeconfigAddCommand(cmd, args[0], appCtx)
+		},
+	}
+
+	okeFlags.EndpointFlag.Add(cmd)
+	okeFlags.KubeContextFlag.Add(cmd)
+	okeFlags.RecursiveFlag.Add(cmd)
+
+	return cmd
+}
+
+func newKubeconfigListCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	return &cobra.Command{
+		Use:           "list",
+		Short:         "List the kubeconfig contexts managed by ocloud",
+		Example:       "  ocloud compute oke kubeconfig list\n  ocloud compute oke kubeconfig list --json",
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			return oke.ListKubeconfigs(appCtx, format)
+		},
+	}
+}
+
+func newKubeconfigRemoveCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	return &cobra.Command{
+		Use:           "remove <context>",
+		Short:         "Remove a kubeconfig context managed by ocloud",
+		Long:          "Remove a kubeconfig context managed by ocloud, with the cluster and user entries no other context uses.",
+		Example:       "  ocloud compute oke kubeconfig remove context-c3dmnrqg4ba-public",
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running oke kubeconfig remove command", "context", args[0])
+			return oke.RemoveKubeconfig(appCtx, args[0])
+		},
+	}
+}
+
+func newKubeconfigPruneCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "prune",
+		Short:         "Remove kubeconfig contexts of deleted clusters",
+		Long:          kubeconfigPruneLong,
+		Example:       "  ocloud compute oke kubeconfig prune\n  ocloud compute oke kubeconfig prune --yes",
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			opts := oke.PruneOptions{Yes: flags.GetBoolFlag(cmd, flags.FlagNameYes, false)}
+			logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running oke kubeconfig prune command", "yes", opts.Yes)
+			return oke.PruneKubeconfigs(appCtx, opts, format)
+		},
+	}
+
+	okeFlags.YesFlag.Add(cmd)
+
+	return cmd
+}
+
+// runKubeconfigAddCommand handles the execution of the kubeconfig add command
+func runKubeconfigAddCommand(cmd *cobra.Command, cluster string, appCtx *app.ApplicationContext) error {
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	opts := oke.AddKubeconfigOptions{
+		Endpoint: flags.GetStringFlag(cmd, flags.FlagNameEndpoint, ""),
+		Context:  flags.GetStringFlag(cmd, flags.FlagNameKubeContext, ""),
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running oke kubeconfig add command in", "compartment", appCtx.CompartmentName, "cluster", cluster, "endpoint", opts.Endpoint, "context", opts.Context)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	return oke.AddKubeconfig(appCtx, cluster, opts, format)
+}
@@ -2,6 +2,7 @@ package oke
 
 import (
 	paginationFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
@@ -44,13 +45,30 @@ func NewListCmd(appCtx *app.ApplicationContext) *cobra.Command {
 
 	paginationFlags.LimitFlag.Add(cmd)
 	paginationFlags.PageFlag.Add(cmd)
+	paginationFlags.RecursiveFlag.Add(cmd)
+	paginationFlags.RegionsFlag.Add(cmd)
+	paginationFlags.AllRegionsFlag.Add(cmd)
+	paginationFlags.WatchFlag.Add(cmd)
+	paginationFlags.UntilStateFlag.Add(cmd)
 
 	return cmd
 }
 
 // RunListCommand handles the execution of the list command
 func RunListCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
-	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running oke list command in", "compartment", appCtx.CompartmentName, "json", useJSON)
-	return oke.ListClusters(appCtx, useJSON)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running oke list command in", "compartment", appCtx.CompartmentName, "output", format.String())
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyWatch(cmd, appCtx); err != nil {
+		return err
+	}
+	return oke.ListClusters(appCtx, format)
 }
@@ -10,8 +10,8 @@ func NewOKECmd(appCtx *app.ApplicationContext) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:           "oke",
 		Short:         "Explore OCI Kubernetes Engine (OKE)",
-		Long:          "Explore Oracle Cloud Infrastructure Kubernetes Engine (OKE) clusters and node pools.\nThis command allows you to list all clusters in a compartment or search specific clusters by search pattern. For each cluster, you can view detailed information including Kubernetes version, endpoint, and associated node pools.",
-		Example:       "  ocloud compute oke list\n  ocloud compute oke list --json\n  ocloud compute oke get\n  ocloud compute oke get --json\n  ocloud compute oke search myoke\n  ocloud compute oke search myoke --json",
+		Long:          "Explore Oracle Cloud Infrastructure Kubernetes Engine (OKE) clusters and node pools.\nThis command allows you to list all clusters in a compartment or search specific clusters by search pattern. For each cluster, you can view detailed information including Kubernetes version, endpoint, and associated node pools, and the upgrades available to it. Contexts for the clusters can be added to and pruned from your kubeconfig.",
+		Example:       "  ocloud compute oke list\n  ocloud compute oke list --json\n  ocloud compute oke get\n  ocloud compute oke get --json\n  ocloud compute oke search myoke\n  ocloud compute oke search myoke --json\n  ocloud compute oke upgrades myoke\n  ocloud compute oke kubeconfig add myoke",
 		SilenceUsage:  true,
 		SilenceErrors: true,
 	}
@@ -19,6 +19,8 @@ func NewOKECmd(appCtx *app.ApplicationContext) *cobra.Command {
 	cmd.AddCommand(NewGetCmd(appCtx))
 	cmd.AddCommand(NewSearchCmd(appCtx))
 	cmd.AddCommand(NewListCmd(appCtx))
+	cmd.AddCommand(NewUpgradesCmd(appCtx))
+	cmd.AddCommand(NewKubeconfigCmd(appCtx))
 
 	return cmd
 }
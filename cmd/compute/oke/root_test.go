@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/config/flags"
 )
 
 // TestOKECommand tests the basic structure of the OKE command
@@ -20,14 +21,14 @@ func TestOKECommand(t *testing.T) {
 	// Test that the OKE command is properly configured
 	assert.Equal(t, "oke", cmd.Use)
 	assert.Equal(t, "Explore OCI Kubernetes Engine (OKE)", cmd.Short)
-	assert.Equal(t, "Explore Oracle Cloud Infrastructure Kubernetes Engine (OKE) clusters and node pools.\nThis command allows you to list all clusters in a compartment or search specific clusters by search pattern. For each cluster, you can view detailed information including Kubernetes version, endpoint, and associated node pools.", cmd.Long)
-	assert.Equal(t, "  ocloud compute oke list\n  ocloud compute oke list --json\n  ocloud compute oke get\n  ocloud compute oke get --json\n  ocloud compute oke search myoke\n  ocloud compute oke search myoke --json", cmd.Example)
+	assert.Equal(t, "Explore Oracle Cloud Infrastructure Kubernetes Engine (OKE) clusters and node pools.\nThis command allows you to list all clusters in a compartment or search specific clusters by search pattern. For each cluster, you can view detailed information including Kubernetes version, endpoint, and associated node pools, and the upgrades available to it. Contexts for the clusters can be added to and pruned from your kubeconfig.", cmd.Long)
+	assert.Equal(t, "  ocloud compute oke list\n  ocloud compute oke list --json\n  ocloud compute oke get\n  ocloud compute oke get --json\n  ocloud compute oke search myoke\n  ocloud compute oke search myoke --json\n  ocloud compute oke upgrades myoke\n  ocloud compute oke kubeconfig add myoke", cmd.Example)
 	assert.True(t, cmd.SilenceUsage)
 	assert.True(t, cmd.SilenceErrors)
 
 	// Test that the subcommands are added
 	subCmds := cmd.Commands()
-	assert.Equal(t, 3, len(subCmds), "oke command should have 3 subcommands")
+	assert.Equal(t, 5, len(subCmds), "oke command should have 5 subcommands")
 
 	// Check that the list subcommand is present
 	listCmd := okeSubCommand(subCmds, "list")
@@ -36,6 +37,33 @@ func TestOKECommand(t *testing.T) {
 	// Check that the find subcommand is present
 	findCmd := okeSubCommand(subCmds, "search")
 	assert.NotNil(t, findCmd, "oke command should have find subcommand")
+
+	// Check that get takes an optional cluster and the nodes flag
+	getCmd := okeSubCommand(subCmds, "get")
+	assert.NotNil(t, getCmd, "oke command should have get subcommand")
+	assert.Equal(t, "get [name-or-ocid]", getCmd.Use)
+	assert.NoError(t, getCmd.Args(getCmd, []string{"prod-oke"}))
+	assert.Error(t, getCmd.Args(getCmd, []string{"a", "b"}))
+	assert.NotNil(t, getCmd.Flags().Lookup(flags.FlagNameNodes), "get should have the nodes flag")
+	assert.NotNil(t, getCmd.Flags().Lookup(flags.FlagNameAll), "get should have the all flag")
+	assert.NotNil(t, findCmd.Flags().Lookup(flags.FlagNameAll), "search should have the all flag")
+
+	// Check that upgrades takes exactly one cluster
+	upgradesCmd := okeSubCommand(subCmds, "upgrades")
+	assert.NotNil(t, upgradesCmd, "oke command should have upgrades subcommand")
+	assert.Error(t, upgradesCmd.Args(upgradesCmd, nil), "upgrades needs a cluster")
+
+	// Check that kubeconfig groups add, list, remove and prune
+	kubeconfigCmd := okeSubCommand(subCmds, "kubeconfig")
+	assert.NotNil(t, kubeconfigCmd, "oke command should have kubeconfig subcommand")
+	for _, name := range []string{"add", "list", "remove", "prune"} {
+		assert.NotNil(t, okeSubCommand(kubeconfigCmd.Commands(), name), "kubeconfig should have %s subcommand", name)
+	}
+	addCmd := okeSubCommand(kubeconfigCmd.Commands(), "add")
+	assert.NotNil(t, addCmd.Flags().Lookup(flags.FlagNameEndpoint), "add should have the endpoint flag")
+	assert.NotNil(t, addCmd.Flags().Lookup(flags.FlagNameKubeContext), "add should have the context flag")
+	pruneCmd := okeSubCommand(kubeconfigCmd.Commands(), "prune")
+	assert.NotNil(t, pruneCmd.Flags().Lookup(flags.FlagNameYes), "prune should have the yes flag")
 }
 
 // okeSubCommand is a helper function to find a subcommand by name
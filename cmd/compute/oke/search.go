@@ -1,10 +1,13 @@
 package oke
 
 import (
+	okeFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
 	"github.com/cnopslabs/ocloud/internal/services/compute/oke"
+	searchsvc "github.com/cnopslabs/ocloud/internal/services/search"
 	"github.com/spf13/cobra"
 )
 
@@ -14,17 +17,19 @@ FuzzySearch Oracle Kubernetes Engine (OKE) clusters in the specified compartment
 This command searches across both cluster-level and node-pool attributes. It matches on:
 - Cluster: name, OCID, Kubernetes version, state, VCN OCID, private/public endpoints, and tags
 - Node pools: display names and node shapes (aggregated per cluster)
+- Virtual node pools: display names and pod shapes (aggregated per cluster)
 
 By default, it shows detailed cluster information such as name, ID, Kubernetes version,
-endpoints, and associated node pools for all matching clusters.
+endpoints, and associated node pools and virtual node pools for all matching clusters.
 
 The search is performed using fuzzy matching, which means it will find clusters
 even if the pattern is only partially matched. The search is case-insensitive.
 
 Additional Information:
 - Use --json (-j) to output the results in JSON format
+- Use --all (-A) to also show the options and add-ons of each cluster
 - The command searches across all available clusters in the compartment
-`
+` + searchsvc.QuerySyntaxHelp
 
 var searchExamples = `
   # Fuzzy search clusters with names containing "prod"
@@ -36,6 +41,9 @@ var searchExamples = `
   # Fuzzy search clusters by node pool shape
   ocloud compute oke search "VM.Standard3"
 
+  # Fuzzy search clusters by virtual node pool pod shape
+  ocloud compute oke search "Pod.Standard"
+
   # Fuzzy search clusters by OCID fragment or exact OCID
   ocloud compute oke search ocid1.clusters
   ocloud compute oke search ocid1.cluster.oc1..exampleexactocid
@@ -61,13 +69,33 @@ func NewSearchCmd(appCtx *app.ApplicationContext) *cobra.Command {
 		},
 	}
 
+	okeFlags.RecursiveFlag.Add(cmd)
+	okeFlags.RegionsFlag.Add(cmd)
+	okeFlags.AllRegionsFlag.Add(cmd)
+	okeFlags.WatchFlag.Add(cmd)
+	okeFlags.UntilStateFlag.Add(cmd)
+	okeFlags.AllInfoFlag.Add(cmd)
+
 	return cmd
 }
 
 // RunFindCommand handles the execution of the search command
 func runSearchCommand(cmd *cobra.Command, args []string, appCtx *app.ApplicationContext) error {
 	search := args[0]
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
-	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running oke search command", "search", search, "in compartment", appCtx.CompartmentName, "json", useJSON)
-	return oke.SearchOKEClusters(appCtx, search, useJSON)
+	showAll := flags.GetBoolFlag(cmd, flags.FlagNameAll, false)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running oke search command", "search", search, "in compartment", appCtx.CompartmentName, "output", format.String())
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyWatch(cmd, appCtx); err != nil {
+		return err
+	}
+	return oke.SearchOKEClusters(appCtx, search, format, showAll)
 }
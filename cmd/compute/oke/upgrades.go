@@ -0,0 +1,65 @@
+package oke
+
+import (
+	paginationFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/services/compute/oke"
+	"github.com/spf13/cobra"
+)
+
+var upgradesLong = `
+Show the Kubernetes versions a cluster can be upgraded to and how to get there.
+
+The cluster is given by name or OCID. The command lists the versions OCI offers for
+the control plane and the version of each node pool, flagging node pools that lag
+the control plane by more than one minor version. It then suggests an upgrade
+order: lagging node pools catch up with the control plane first, then the control
+plane moves one minor version at a time, with the node pools following.
+
+The JSON output carries a Compliant flag, the lagging node pools and a CheckedAt
+timestamp, for dashboards and audits. Nothing is upgraded by this command.
+`
+
+var upgradesExamples = `
+  # Show the upgrade advice for a cluster
+  ocloud compute oke upgrades prod-oke
+
+  # Output in JSON format, e.g. for a compliance dashboard
+  ocloud compute oke upgrades prod-oke --json
+`
+
+// NewUpgradesCmd creates a new command for showing the available upgrades of an OKE cluster
+func NewUpgradesCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "upgrades <name-or-ocid>",
+		Short:         "Show available upgrades and a suggested upgrade order for a cluster",
+		Long:          upgradesLong,
+		Example:       upgradesExamples,
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runUpgradesCommand(cmd, args[0], appCtx)
+		},
+	}
+
+	paginationFlags.RecursiveFlag.Add(cmd)
+
+	return cmd
+}
+
+// runUpgradesCommand handles the execution of the upgrades command
+func runUpgradesCommand(cmd *cobra.Command, cluster string, appCtx *app.ApplicationContext) error {
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running oke upgrades command in", "compartment", appCtx.CompartmentName, "cluster", cluster, "output", format.String())
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	return oke.GetUpgrades(appCtx, cluster, format)
+}
@@ -3,7 +3,9 @@ package compute
 import (
 	"github.com/cnopslabs/ocloud/cmd/compute/image"
 	"github.com/cnopslabs/ocloud/cmd/compute/instance"
+	"github.com/cnopslabs/ocloud/cmd/compute/instancepool"
 	"github.com/cnopslabs/ocloud/cmd/compute/oke"
+	"github.com/cnopslabs/ocloud/cmd/compute/shape"
 	"github.com/spf13/cobra"
 
 	"github.com/cnopslabs/ocloud/internal/app"
@@ -15,13 +17,15 @@ func NewComputeCmd(appCtx *app.ApplicationContext) *cobra.Command {
 		Use:           "compute",
 		Aliases:       []string{"comp"},
 		Short:         "Explore OCI compute services",
-		Long:          "Explore Oracle Cloud Infrastructure Compute services such as instances, images, and oke.",
+		Long:          "Explore Oracle Cloud Infrastructure Compute services such as instances, instance pools, images, shapes, and oke.",
 		SilenceUsage:  true,
 		SilenceErrors: true,
 	}
 
 	cmd.AddCommand(instance.NewInstanceCmd(appCtx))
+	cmd.AddCommand(instancepool.NewInstancePoolCmd(appCtx))
 	cmd.AddCommand(image.NewImageCmd(appCtx))
+	cmd.AddCommand(shape.NewShapeCmd(appCtx))
 	cmd.AddCommand(oke.NewOKECmd(appCtx))
 
 	return cmd
@@ -20,22 +20,30 @@ func TestComputeCommand(t *testing.T) {
 	// Test that the compute command is properly configured
 	assert.Equal(t, "compute", cmd.Use)
 	assert.Equal(t, "Explore OCI compute services", cmd.Short)
-	assert.Equal(t, "Explore Oracle Cloud Infrastructure Compute services such as instances, images, and oke.", cmd.Long)
+	assert.Equal(t, "Explore Oracle Cloud Infrastructure Compute services such as instances, instance pools, images, shapes, and oke.", cmd.Long)
 	assert.True(t, cmd.SilenceUsage)
 	assert.True(t, cmd.SilenceErrors)
 
 	// Test that the subcommands are added
 	subCmds := cmd.Commands()
-	assert.Equal(t, 3, len(subCmds), "compute command should have 3 subcommands")
+	assert.Equal(t, 5, len(subCmds), "compute command should have 5 subcommands")
 
 	// Check that the instance subcommand is present
 	instanceCmd := computeSubCommand(subCmds, "instance")
 	assert.NotNil(t, instanceCmd, "compute command should have instance subcommand")
 
+	// Check that the instance-pool subcommand is present
+	instancePoolCmd := computeSubCommand(subCmds, "instance-pool")
+	assert.NotNil(t, instancePoolCmd, "compute command should have instance-pool subcommand")
+
 	// Check that the image subcommand is present
 	imageCmd := computeSubCommand(subCmds, "image")
 	assert.NotNil(t, imageCmd, "compute command should have image subcommand")
 
+	// Check that the shape subcommand is present
+	shapeCmd := computeSubCommand(subCmds, "shape")
+	assert.NotNil(t, shapeCmd, "compute command should have shape subcommand")
+
 	// Check that the oke subcommand is present
 	okeCmd := computeSubCommand(subCmds, "oke")
 	assert.NotNil(t, okeCmd, "compute command should have oke subcommand")
@@ -0,0 +1,78 @@
+package shape
+
+import (
+	shapeFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/services/compute/shape"
+	"github.com/spf13/cobra"
+)
+
+// Dedicated documentation for the get command
+var getLong = `
+Get the compute shapes offered in the specified compartment, or one shape by name.
+
+Each shape is listed once with:
+- TYPE: fixed, or flexible when OCPUs and memory are chosen at launch
+- OCPUS / MEMORY (GB): the size of a fixed shape or the range of a flexible one
+- GPUS and LOCAL DISKS: GPU model and local NVMe disks, when the shape has them
+- ADS: the number of availability domains offering the shape
+- RUNNING: the RUNNING instances in the compartment that use the shape
+
+The output is paginated, with a default limit of 20 shapes per page. You can navigate
+through pages using the --page flag and control the number of shapes per page with
+the --limit flag. Given a shape name, the command shows its details instead.
+`
+
+var getExamples = `
+  # Get shapes with default pagination (20 per page)
+  ocloud compute shape get
+
+  # Get shapes with custom pagination
+  ocloud compute shape get --limit 50 --page 2
+
+  # Show one shape, including the availability domains it is offered in
+  ocloud compute shape get VM.Standard.E5.Flex
+
+  # Output in JSON format
+  ocloud compute shape get --json
+`
+
+// NewGetCmd creates a new command for getting shapes
+func NewGetCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "get [shape-name]",
+		Short:         "Paginated Shape Results",
+		Long:          getLong,
+		Example:       getExamples,
+		Args:          cobra.MaximumNArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runGetCommand(cmd, args, appCtx)
+		},
+	}
+
+	shapeFlags.LimitFlag.Add(cmd)
+	shapeFlags.PageFlag.Add(cmd)
+
+	return cmd
+}
+
+// runGetCommand handles the execution of the get command
+func runGetCommand(cmd *cobra.Command, args []string, appCtx *app.ApplicationContext) error {
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	if len(args) == 1 {
+		logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running shape get command", "shape", args[0], "compartment", appCtx.CompartmentName)
+		return shape.GetShape(appCtx, args[0], format)
+	}
+
+	limit := flags.GetIntFlag(cmd, flags.FlagNameLimit, shapeFlags.FlagDefaultLimit)
+	page := flags.GetIntFlag(cmd, flags.FlagNamePage, shapeFlags.FlagDefaultPage)
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running shape get command in", "compartment", appCtx.CompartmentName, "limit", limit, "page", page, "output", format.String())
+	return shape.GetShapes(appCtx, limit, page, format)
+}
@@ -0,0 +1,55 @@
+package shape
+
+import (
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/services/compute/shape"
+	"github.com/spf13/cobra"
+)
+
+// Dedicated documentation for the list command (separate from get)
+var listLong = `
+Interactively browse and search the compute shapes of the specified compartment using a TUI.
+
+This command launches terminal UI that loads the available shapes and lets you:
+- Search/filter shapes as you type
+- Navigate the list
+- Select a single shape to view its details
+
+After you pick a shape, the tool prints its details in the default table view or JSON format if specified with --json.
+`
+
+var listExamples = `
+  # Launch the interactive shapes browser
+  ocloud compute shape list
+  ocloud compute shape list --json
+`
+
+// NewListCmd creates a new command for listing shapes
+func NewListCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "list",
+		Short:         "List all shapes",
+		Aliases:       []string{"l"},
+		Long:          listLong,
+		Example:       listExamples,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runListCommand(cmd, appCtx)
+		},
+	}
+
+	return cmd
+}
+
+// runListCommand executes the interactive TUI shape lister
+func runListCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running shape list (TUI) command in", "compartment", appCtx.CompartmentName)
+	return shape.ListShapes(cmd.Context(), appCtx, format)
+}
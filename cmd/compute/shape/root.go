@@ -0,0 +1,25 @@
+package shape
+
+import (
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/spf13/cobra"
+)
+
+// NewShapeCmd creates a new command for shape-related operations
+func NewShapeCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "shape",
+		Aliases:       []string{"shapes"},
+		Short:         "Explore OCI Compute shapes — list, get, and search",
+		Long:          "List the OCI Compute shapes offered in a compartment with their OCPU and memory ranges, GPUs, local NVMe disks, availability domains and the number of running instances using them.",
+		Example:       "  ocloud compute shape get\n  ocloud compute shape get VM.Standard.E5.Flex\n  ocloud compute shape list\n  ocloud compute shape search <value>",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	cmd.AddCommand(NewGetCmd(appCtx))
+	cmd.AddCommand(NewListCmd(appCtx))
+	cmd.AddCommand(NewSearchCmd(appCtx))
+
+	return cmd
+}
@@ -0,0 +1,45 @@
+package shape
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+)
+
+// TestShapeCommand tests the basic structure of the shape command
+func TestShapeCommand(t *testing.T) {
+	cmd := NewShapeCmd(&app.ApplicationContext{})
+
+	assert.Equal(t, "shape", cmd.Use)
+	assert.Equal(t, "Explore OCI Compute shapes — list, get, and search", cmd.Short)
+	assert.True(t, cmd.SilenceUsage)
+	assert.True(t, cmd.SilenceErrors)
+	assert.Nil(t, cmd.RunE, "RunE should be nil since the root command has subcommands")
+
+	getCmd := shapeSubCommand(cmd, "get")
+	assert.NotNil(t, getCmd, "get subcommand should be added")
+	assert.NotNil(t, getCmd.Flags().Lookup(flags.FlagNameLimit))
+	assert.NotNil(t, getCmd.Flags().Lookup(flags.FlagNamePage))
+	assert.NoError(t, getCmd.Args(getCmd, []string{"VM.Standard.E5.Flex"}))
+	assert.Error(t, getCmd.Args(getCmd, []string{"a", "b"}), "get takes at most one shape name")
+
+	assert.NotNil(t, shapeSubCommand(cmd, "list"), "list subcommand should be added")
+
+	searchCmd := shapeSubCommand(cmd, "search")
+	assert.NotNil(t, searchCmd, "search subcommand should be added")
+	assert.Error(t, searchCmd.Args(searchCmd, nil), "search requires a pattern")
+}
+
+// shapeSubCommand is a helper function to find a subcommand by name
+func shapeSubCommand(cmd *cobra.Command, name string) *cobra.Command {
+	for _, subCmd := range cmd.Commands() {
+		if subCmd.Name() == name {
+			return subCmd
+		}
+	}
+	return nil
+}
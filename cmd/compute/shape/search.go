@@ -0,0 +1,72 @@
+package shape
+
+import (
+	"github.com/cnopslabs/ocloud/internal/app"
+	cfgflags "github.com/cnopslabs/ocloud/internal/config/flags"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/services/compute/shape"
+	searchsvc "github.com/cnopslabs/ocloud/internal/services/search"
+	"github.com/spf13/cobra"
+)
+
+var searchLong = `
+Search for compute shapes in the specified compartment that match the given pattern.
+
+The search uses a fuzzy, prefix, and substring matching algorithm across many indexed fields.
+You can search using any of the following fields (partial matches are supported):
+
+Searchable fields:
+- Name: Shape name
+- Processor: Processor description
+- Type: "flexible" or "fixed"
+- GPU: GPU description
+- LocalDisk: Local disk description
+- BillingType: Billing type (e.g. PAID, ALWAYS_FREE)
+- AD: Availability domains offering the shape
+
+The search pattern is case-insensitive.
+` + searchsvc.QuerySyntaxHelp
+
+var searchExamples = `
+  # Search by shape family
+  ocloud compute shape search E5
+
+  # Find GPU shapes
+  ocloud compute shape search A10
+
+  # Find flexible shapes
+  ocloud compute shape search flexible
+
+  # Output in JSON format
+  ocloud compute shape search Ampere --json
+`
+
+// NewSearchCmd creates a new command for finding shapes by pattern
+func NewSearchCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "search [pattern]",
+		Aliases:       []string{"s"},
+		Short:         "Fuzzy search for Shapes",
+		Long:          searchLong,
+		Example:       searchExamples,
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSearchCommand(cmd, args, appCtx)
+		},
+	}
+
+	return cmd
+}
+
+// runSearchCommand handles the execution of the search command
+func runSearchCommand(cmd *cobra.Command, args []string, appCtx *app.ApplicationContext) error {
+	pattern := args[0]
+	format, err := cfgflags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running shape search command", "pattern", pattern, "in compartment", appCtx.CompartmentName, "output", format.String())
+	return shape.SearchShapes(appCtx, pattern, format)
+}
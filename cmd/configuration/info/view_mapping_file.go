@@ -59,8 +59,11 @@ func ViewMappingFile() *cobra.Command {
 
 // runViewFileMappingCommand handles the execution of the map-file command
 func runViewFileMappingCommand(cmd *cobra.Command) error {
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
 	realm := flags.GetStringFlag(cmd, flags.FlagNameRealm, "")
-	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running map-file command", "json", useJSON, "realm", realm)
-	return info.ViewConfiguration(useJSON, realm)
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running map-file command", "output", format.String(), "realm", realm)
+	return info.ViewConfiguration(format, realm)
 }
@@ -2,6 +2,7 @@ package autonomousdb
 
 import (
 	databaseFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
@@ -38,6 +39,12 @@ var getExamples = `
 
   # Get Autonomous Databases with custom pagination and JSON output
   ocloud database autonomous get --limit 5 --page 3 --json
+
+  # Refresh every 10 seconds and highlight lifecycle changes
+  ocloud database autonomous get --watch
+
+  # Wait for the databases to finish provisioning
+  ocloud database autonomous get --watch --until-state AVAILABLE
 `
 
 // NewGetCmd creates a "list" subcommand for listing all databases in the specified compartment with pagination support.
@@ -57,6 +64,11 @@ func NewGetCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	databaseFlags.LimitFlag.Add(cmd)
 	databaseFlags.PageFlag.Add(cmd)
 	databaseFlags.AllInfoFlag.Add(cmd)
+	databaseFlags.RecursiveFlag.Add(cmd)
+	databaseFlags.RegionsFlag.Add(cmd)
+	databaseFlags.AllRegionsFlag.Add(cmd)
+	databaseFlags.WatchFlag.Add(cmd)
+	databaseFlags.UntilStateFlag.Add(cmd)
 
 	return cmd
 
@@ -65,9 +77,21 @@ func NewGetCmd(appCtx *app.ApplicationContext) *cobra.Command {
 // runGetCommand handles the execution of the list command
 func runGetCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
 	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running autonomous database Get command")
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
 	limit := flags.GetIntFlag(cmd, flags.FlagNameLimit, databaseFlags.FlagDefaultLimit)
 	page := flags.GetIntFlag(cmd, flags.FlagNamePage, databaseFlags.FlagDefaultPage)
 	showAll := flags.GetBoolFlag(cmd, flags.FlagNameAll, false)
-	return autonomousdb.GetAutonomousDatabase(appCtx, useJSON, limit, page, showAll)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyWatch(cmd, appCtx); err != nil {
+		return err
+	}
+	return autonomousdb.GetAutonomousDatabase(appCtx, format, limit, page, showAll)
 }
@@ -2,10 +2,12 @@ package autonomousdb
 
 import (
 	databaseFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
 	"github.com/cnopslabs/ocloud/internal/services/database/autonomousdb"
+	searchsvc "github.com/cnopslabs/ocloud/internal/services/search"
 	"github.com/spf13/cobra"
 )
 
@@ -25,7 +27,7 @@ The search is case-insensitive and uses a fuzzy/prefix/wildcard strategy, simila
 Additional Information:
 - Use --json (-j) to output results in JSON format
 - Works with partial fragments (e.g., OCID parts, hostnames, IPs, tag values)
-`
+` + searchsvc.QuerySyntaxHelp
 
 // Examples for the search command
 var searchExamples = `
@@ -76,14 +78,31 @@ func NewSearchCmd(appCtx *app.ApplicationContext) *cobra.Command {
 		},
 	}
 	databaseFlags.AllInfoFlag.Add(cmd)
+	databaseFlags.RecursiveFlag.Add(cmd)
+	databaseFlags.RegionsFlag.Add(cmd)
+	databaseFlags.AllRegionsFlag.Add(cmd)
+	databaseFlags.WatchFlag.Add(cmd)
+	databaseFlags.UntilStateFlag.Add(cmd)
 	return cmd
 }
 
 // runSearchCommand handles the execution of the search command
 func runSearchCommand(cmd *cobra.Command, args []string, appCtx *app.ApplicationContext) error {
 	namePattern := args[0]
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
-	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running search command", "searchPattern", namePattern, "json", useJSON)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running search command", "searchPattern", namePattern, "output", format.String())
 	showAll := flags.GetBoolFlag(cmd, flags.FlagNameAll, false)
-	return autonomousdb.SearchAutonomousDatabases(appCtx, namePattern, useJSON, showAll)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyWatch(cmd, appCtx); err != nil {
+		return err
+	}
+	return autonomousdb.SearchAutonomousDatabases(appCtx, namePattern, format, showAll)
 }
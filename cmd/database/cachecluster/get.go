@@ -2,6 +2,7 @@ package cachecluster
 
 import (
 	cacheClusterFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
@@ -38,6 +39,9 @@ var getExamples = `
 
   # Get OCI Cache Clusters with custom pagination and JSON output
   ocloud database cache-cluster get --limit 5 --page 3 --json
+
+  # Wait for the cache clusters to become ACTIVE
+  ocloud database cache-cluster get --watch --until-state ACTIVE
 `
 
 // NewGetCmd creates a "list" subcommand for listing all in the specified compartment with pagination support.
@@ -57,6 +61,11 @@ func NewGetCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	cacheClusterFlags.LimitFlag.Add(cmd)
 	cacheClusterFlags.PageFlag.Add(cmd)
 	cacheClusterFlags.AllInfoFlag.Add(cmd)
+	cacheClusterFlags.RecursiveFlag.Add(cmd)
+	cacheClusterFlags.RegionsFlag.Add(cmd)
+	cacheClusterFlags.AllRegionsFlag.Add(cmd)
+	cacheClusterFlags.WatchFlag.Add(cmd)
+	cacheClusterFlags.UntilStateFlag.Add(cmd)
 
 	return cmd
 
@@ -64,9 +73,21 @@ func NewGetCmd(appCtx *app.ApplicationContext) *cobra.Command {
 
 func runGetCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
 	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running CacheClusters database Get command")
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
 	limit := flags.GetIntFlag(cmd, flags.FlagNameLimit, cacheClusterFlags.FlagDefaultLimit)
 	page := flags.GetIntFlag(cmd, flags.FlagNamePage, cacheClusterFlags.FlagDefaultPage)
 	showAll := flags.GetBoolFlag(cmd, flags.FlagNameAll, false)
-	return cacheclusterdb.GetCacheClusters(appCtx, useJSON, limit, page, showAll)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyWatch(cmd, appCtx); err != nil {
+		return err
+	}
+	return cacheclusterdb.GetCacheClusters(appCtx, format, limit, page, showAll)
 }
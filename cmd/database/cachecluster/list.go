@@ -1,6 +1,8 @@
 package cachecluster
 
 import (
+	cacheClusterFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
@@ -39,6 +41,12 @@ func NewListCmd(appCtx *app.ApplicationContext) *cobra.Command {
 			return runListCommand(cmd, appCtx)
 		},
 	}
+
+	cacheClusterFlags.RecursiveFlag.Add(cmd)
+	cacheClusterFlags.RegionsFlag.Add(cmd)
+	cacheClusterFlags.AllRegionsFlag.Add(cmd)
+	cacheClusterFlags.WatchFlag.Add(cmd)
+	cacheClusterFlags.UntilStateFlag.Add(cmd)
 	return cmd
 
 }
@@ -46,6 +54,18 @@ func NewListCmd(appCtx *app.ApplicationContext) *cobra.Command {
 // runListCommand handles the execution of the list command
 func runListCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
 	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running OCI Cache Cluster list command")
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
-	return cacheclusterdb.ListCacheClusters(appCtx, useJSON)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyWatch(cmd, appCtx); err != nil {
+		return err
+	}
+	return cacheclusterdb.ListCacheClusters(appCtx, format)
 }
@@ -2,10 +2,12 @@ package cachecluster
 
 import (
 	cacheClusterFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
 	"github.com/cnopslabs/ocloud/internal/services/database/cacheclusterdb"
+	searchsvc "github.com/cnopslabs/ocloud/internal/services/search"
 	"github.com/spf13/cobra"
 )
 
@@ -25,7 +27,7 @@ Searchable fields include:
   - Network Security Group Names/IDs
   - Primary/Replicas/Discovery Endpoints (FQDN and IP addresses)
   - Tags (both keys and values)
-`
+` + searchsvc.QuerySyntaxHelp
 
 var searchExamples = `
   # Search by cluster name
@@ -75,14 +77,31 @@ func NewSearchCmd(appCtx *app.ApplicationContext) *cobra.Command {
 		},
 	}
 	cacheClusterFlags.AllInfoFlag.Add(cmd)
+	cacheClusterFlags.RecursiveFlag.Add(cmd)
+	cacheClusterFlags.RegionsFlag.Add(cmd)
+	cacheClusterFlags.AllRegionsFlag.Add(cmd)
+	cacheClusterFlags.WatchFlag.Add(cmd)
+	cacheClusterFlags.UntilStateFlag.Add(cmd)
 	return cmd
 }
 
 // runSearchCommand handles the execution of the search command
 func runSearchCommand(cmd *cobra.Command, args []string, appCtx *app.ApplicationContext) error {
 	namePattern := args[0]
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
 	showAll := flags.GetBoolFlag(cmd, flags.FlagNameAll, false)
-	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running OCI Cache Clusters search command", "searchPattern", namePattern, "json", useJSON, "showAll", showAll)
-	return cacheclusterdb.SearchCacheClusters(appCtx, namePattern, useJSON, showAll)
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running OCI Cache Clusters search command", "searchPattern", namePattern, "output", format.String(), "showAll", showAll)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyWatch(cmd, appCtx); err != nil {
+		return err
+	}
+	return cacheclusterdb.SearchCacheClusters(appCtx, namePattern, format, showAll)
 }
@@ -2,6 +2,7 @@ package heatwave
 
 import (
 	databaseFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
@@ -38,6 +39,9 @@ var getExamples = `
 
   # Get HeatWave Databases with custom pagination and JSON output
   ocloud database heatwave get --limit 5 --page 3 --json
+
+  # Refresh every minute until the databases are ACTIVE
+  ocloud database heatwave get --watch=1m --until-state ACTIVE
 `
 
 // NewGetCmd creates a "list" subcommand for listing all databases in the specified compartment with pagination support.
@@ -57,6 +61,11 @@ func NewGetCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	databaseFlags.LimitFlag.Add(cmd)
 	databaseFlags.PageFlag.Add(cmd)
 	databaseFlags.AllInfoFlag.Add(cmd)
+	databaseFlags.RecursiveFlag.Add(cmd)
+	databaseFlags.RegionsFlag.Add(cmd)
+	databaseFlags.AllRegionsFlag.Add(cmd)
+	databaseFlags.WatchFlag.Add(cmd)
+	databaseFlags.UntilStateFlag.Add(cmd)
 
 	return cmd
 
@@ -64,9 +73,21 @@ func NewGetCmd(appCtx *app.ApplicationContext) *cobra.Command {
 
 func runGetCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
 	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running HeatWave database Get command")
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
 	limit := flags.GetIntFlag(cmd, flags.FlagNameLimit, databaseFlags.FlagDefaultLimit)
 	page := flags.GetIntFlag(cmd, flags.FlagNamePage, databaseFlags.FlagDefaultPage)
 	showAll := flags.GetBoolFlag(cmd, flags.FlagNameAll, false)
-	return heatwavedb.GetHeatWaveDatabase(appCtx, useJSON, limit, page, showAll)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyWatch(cmd, appCtx); err != nil {
+		return err
+	}
+	return heatwavedb.GetHeatWaveDatabase(appCtx, format, limit, page, showAll)
 }
@@ -1,6 +1,8 @@
 package heatwave
 
 import (
+	databaseFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
@@ -39,6 +41,12 @@ func NewListCmd(appCtx *app.ApplicationContext) *cobra.Command {
 			return runListCommand(cmd, appCtx)
 		},
 	}
+
+	databaseFlags.RecursiveFlag.Add(cmd)
+	databaseFlags.RegionsFlag.Add(cmd)
+	databaseFlags.AllRegionsFlag.Add(cmd)
+	databaseFlags.WatchFlag.Add(cmd)
+	databaseFlags.UntilStateFlag.Add(cmd)
 	return cmd
 
 }
@@ -46,6 +54,18 @@ func NewListCmd(appCtx *app.ApplicationContext) *cobra.Command {
 // runListCommand handles the execution of the list command
 func runListCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
 	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running HeatWave database list command")
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
-	return heatwavedb.ListHeatWaveDatabases(appCtx, useJSON)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyWatch(cmd, appCtx); err != nil {
+		return err
+	}
+	return heatwavedb.ListHeatWaveDatabases(appCtx, format)
 }
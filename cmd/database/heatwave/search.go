@@ -2,10 +2,12 @@ package heatwave
 
 import (
 	databaseFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
 	"github.com/cnopslabs/ocloud/internal/services/database/heatwavedb"
+	searchsvc "github.com/cnopslabs/ocloud/internal/services/search"
 	"github.com/spf13/cobra"
 )
 
@@ -24,7 +26,7 @@ Searchable fields include:
   - HeatWave Cluster Size
   - Availability Domain, Fault Domain
   - Tags (both keys and values)
-`
+` + searchsvc.QuerySyntaxHelp
 
 var searchExamples = `
   # Search by database name
@@ -65,14 +67,31 @@ func NewSearchCmd(appCtx *app.ApplicationContext) *cobra.Command {
 		},
 	}
 	databaseFlags.AllInfoFlag.Add(cmd)
+	databaseFlags.RecursiveFlag.Add(cmd)
+	databaseFlags.RegionsFlag.Add(cmd)
+	databaseFlags.AllRegionsFlag.Add(cmd)
+	databaseFlags.WatchFlag.Add(cmd)
+	databaseFlags.UntilStateFlag.Add(cmd)
 	return cmd
 }
 
 // runSearchCommand handles the execution of the search command
 func runSearchCommand(cmd *cobra.Command, args []string, appCtx *app.ApplicationContext) error {
 	namePattern := args[0]
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
 	showAll := flags.GetBoolFlag(cmd, flags.FlagNameAll, false)
-	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running HeatWave database search command", "searchPattern", namePattern, "json", useJSON, "showAll", showAll)
-	return heatwavedb.SearchHeatWaveDatabases(appCtx, namePattern, useJSON, showAll)
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running HeatWave database search command", "searchPattern", namePattern, "output", format.String(), "showAll", showAll)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyWatch(cmd, appCtx); err != nil {
+		return err
+	}
+	return heatwavedb.SearchHeatWaveDatabases(appCtx, namePattern, format, showAll)
 }
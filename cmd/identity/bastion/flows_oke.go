@@ -194,7 +194,7 @@ func connectOKE(ctx context.Context, appCtx *app.ApplicationContext, svc *bastio
 			return fmt.Errorf("check kubeconfig: %w", err)
 		}
 		if !exists {
-			question := "Kubeconfig for this OKE cluster was not found in ~/.kube/config. Create and merge it now?"
+			question := "Kubeconfig for this OKE cluster was not found in your kubeconfig. Create and merge it now?"
 			if util.PromptYesNo(question) {
 				if err := okeSvc.EnsureKubeconfigForOKE(cluster, region, port); err != nil {
 					return fmt.Errorf("ensure kubeconfig: %w", err)
@@ -26,6 +26,9 @@ func NewGetCmd(appCtx *app.ApplicationContext) *cobra.Command {
 func runGetCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
 	ctx := cmd.Context()
 	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running Get command")
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
-	return bastion.GetBastions(ctx, appCtx, useJSON)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	return bastion.GetBastions(ctx, appCtx, format)
 }
@@ -71,6 +71,7 @@ func NewGetCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	scopeFlags.PageFlag.Add(cmd)
 	scopeFlags.ScopeFlag.Add(cmd)
 	scopeFlags.TenancyScopeFlag.Add(cmd)
+	scopeFlags.RecursiveFlag.Add(cmd)
 
 	return cmd
 
@@ -80,14 +81,20 @@ func NewGetCmd(appCtx *app.ApplicationContext) *cobra.Command {
 func runGetCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
 	limit := flags.GetIntFlag(cmd, flags.FlagNameLimit, scopeFlags.FlagDefaultLimit)
 	page := flags.GetIntFlag(cmd, flags.FlagNamePage, scopeFlags.FlagDefaultPage)
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
 
 	scope := scopeUtil.ResolveScope(cmd)
 	parentID := scopeUtil.ResolveParentID(scope, appCtx)
 
 	logger.LogWithLevel(
 		logger.CmdLogger, logger.Debug, "Running compartment get",
-		"scope", scope, "parentID", parentID, "json", useJSON,
+		"scope", scope, "parentID", parentID, "output", format.String(),
 	)
-	return compartment.GetCompartments(appCtx, useJSON, limit, page, parentID)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, parentID); err != nil {
+		return err
+	}
+	return compartment.GetCompartments(appCtx, format, limit, page, parentID)
 }
@@ -60,6 +60,7 @@ func NewListCmd(appCtx *app.ApplicationContext) *cobra.Command {
 
 	scopeFlags.ScopeFlag.Add(cmd)
 	scopeFlags.TenancyScopeFlag.Add(cmd)
+	scopeFlags.RecursiveFlag.Add(cmd)
 
 	return cmd
 
@@ -67,12 +68,18 @@ func NewListCmd(appCtx *app.ApplicationContext) *cobra.Command {
 
 // runListCommand handles the execution of the get command
 func runListCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
 	scope := scopeUtil.ResolveScope(cmd)
 	parentID := scopeUtil.ResolveParentID(scope, appCtx)
 	logger.LogWithLevel(
 		logger.CmdLogger, logger.Debug, "Running compartment list",
-		"scope", scope, "parentID", parentID, "json", useJSON,
+		"scope", scope, "parentID", parentID, "output", format.String(),
 	)
-	return compartment.ListCompartments(appCtx, parentID, useJSON)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, parentID); err != nil {
+		return err
+	}
+	return compartment.ListCompartments(appCtx, parentID, format)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
 	"github.com/cnopslabs/ocloud/internal/services/identity/compartment"
+	searchsvc "github.com/cnopslabs/ocloud/internal/services/search"
 	"github.com/spf13/cobra"
 )
 
@@ -36,7 +37,7 @@ Scope control:
 
 Output control:
 - Use --json (-j) to output the results in JSON format
-`
+` + searchsvc.QuerySyntaxHelp
 
 // Examples for the search command
 var searchExamples = `
@@ -78,6 +79,7 @@ func NewSearchCmd(appCtx *app.ApplicationContext) *cobra.Command {
 
 	scopeFlags.ScopeFlag.Add(cmd)
 	scopeFlags.TenancyScopeFlag.Add(cmd)
+	scopeFlags.RecursiveFlag.Add(cmd)
 
 	return cmd
 }
@@ -85,12 +87,18 @@ func NewSearchCmd(appCtx *app.ApplicationContext) *cobra.Command {
 // RunFindCommand handles the execution of the find command
 func runSearchCommand(cmd *cobra.Command, args []string, appCtx *app.ApplicationContext) error {
 	namePattern := args[0]
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
 	scope := scopeUtil.ResolveScope(cmd)
 	parentID := scopeUtil.ResolveParentID(scope, appCtx)
 	logger.LogWithLevel(
 		logger.CmdLogger, logger.Debug, "Running compartment search",
-		"scope", scope, "parentID", parentID, "json", useJSON,
+		"scope", scope, "parentID", parentID, "output", format.String(),
 	)
-	return compartment.SearchCompartments(appCtx, namePattern, useJSON, parentID)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, parentID); err != nil {
+		return err
+	}
+	return compartment.SearchCompartments(appCtx, namePattern, format, parentID)
 }
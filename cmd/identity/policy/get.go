@@ -72,6 +72,7 @@ func NewGetCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	paginationFlags.PageFlag.Add(cmd)
 	scopeFlags.ScopeFlag.Add(cmd)
 	scopeFlags.TenancyScopeFlag.Add(cmd)
+	scopeFlags.RecursiveFlag.Add(cmd)
 
 	return cmd
 
@@ -81,14 +82,20 @@ func NewGetCmd(appCtx *app.ApplicationContext) *cobra.Command {
 func runGetCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
 	limit := flags.GetIntFlag(cmd, flags.FlagNameLimit, paginationFlags.FlagDefaultLimit)
 	page := flags.GetIntFlag(cmd, flags.FlagNamePage, paginationFlags.FlagDefaultPage)
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
 
 	scope := scopeUtil.ResolveScope(cmd)
 	parentID := scopeUtil.ResolveParentID(scope, appCtx)
 
 	logger.LogWithLevel(
 		logger.CmdLogger, logger.Debug, "Running policy get",
-		"scope", scope, "parentID", parentID, "json", useJSON,
+		"scope", scope, "parentID", parentID, "output", format.String(),
 	)
-	return policy.GetPolicies(appCtx, useJSON, limit, page, parentID)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, parentID); err != nil {
+		return err
+	}
+	return policy.GetPolicies(appCtx, format, limit, page, parentID)
 }
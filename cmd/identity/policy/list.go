@@ -58,20 +58,27 @@ func NewListCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	}
 	scopeFlags.ScopeFlag.Add(cmd)
 	scopeFlags.TenancyScopeFlag.Add(cmd)
+	scopeFlags.RecursiveFlag.Add(cmd)
 	return cmd
 
 }
 
 // runListCommand handles the execution of the list command
 func runListCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
 	scope := scopeUtil.ResolveScope(cmd)
 	parentID := scopeUtil.ResolveParentID(scope, appCtx)
 
 	logger.LogWithLevel(
 		logger.CmdLogger, logger.Debug, "Running policy list",
-		"scope", scope, "parentID", parentID, "json", useJSON,
+		"scope", scope, "parentID", parentID, "output", format.String(),
 	)
 
-	return policy.ListPolicies(appCtx, useJSON, parentID)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, parentID); err != nil {
+		return err
+	}
+	return policy.ListPolicies(appCtx, format, parentID)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
 	"github.com/cnopslabs/ocloud/internal/services/identity/policy"
+	searchsvc "github.com/cnopslabs/ocloud/internal/services/search"
 	"github.com/spf13/cobra"
 )
 
@@ -37,7 +38,7 @@ Scope control:
 
 Additional Information:
 - Use --json (-j) to output the results in JSON format
-`
+` + searchsvc.QuerySyntaxHelp
 
 // Examples for the search command
 var searchExamples = `
@@ -73,6 +74,7 @@ func NewSearchCmd(appCtx *app.ApplicationContext) *cobra.Command {
 
 	scopeFlags.ScopeFlag.Add(cmd)
 	scopeFlags.TenancyScopeFlag.Add(cmd)
+	scopeFlags.RecursiveFlag.Add(cmd)
 
 	return cmd
 }
@@ -80,15 +82,21 @@ func NewSearchCmd(appCtx *app.ApplicationContext) *cobra.Command {
 // RunFindCommand handles the execution of the find command
 func runSearchCommand(cmd *cobra.Command, args []string, appCtx *app.ApplicationContext) error {
 	search := args[0]
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
 	scope := scopeUtil.ResolveScope(cmd)
 	parentID := scopeUtil.ResolveParentID(scope, appCtx)
 
 	logger.LogWithLevel(
 		logger.CmdLogger, logger.Debug, "Running policy search",
-		"scope", scope, "parentID", parentID, "json", useJSON,
+		"scope", scope, "parentID", parentID, "output", format.String(),
 	)
 
-	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running policy search command", "search", search, "json", useJSON)
-	return policy.SearchPolicies(appCtx, search, useJSON, parentID)
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running policy search command", "search", search, "output", format.String())
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, parentID); err != nil {
+		return err
+	}
+	return policy.SearchPolicies(appCtx, search, format, parentID)
 }
@@ -2,6 +2,7 @@ package loadbalancer
 
 import (
 	lbFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	configflags "github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
@@ -25,7 +26,10 @@ var getExamples = `  # Get all load balancers with default pagination (20 per pa
   ocloud network loadbalancer get --all
 
   # Output in JSON format
-  ocloud net lb get --json`
+  ocloud net lb get --json
+
+  # Refresh every 10 seconds and highlight state changes
+  ocloud net lb get --watch`
 
 func NewGetCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	cmd := &cobra.Command{
@@ -43,14 +47,31 @@ func NewGetCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	lbFlags.LimitFlag.Add(cmd)
 	lbFlags.PageFlag.Add(cmd)
 	lbFlags.AllInfoFlag.Add(cmd)
+	lbFlags.RecursiveFlag.Add(cmd)
+	lbFlags.RegionsFlag.Add(cmd)
+	lbFlags.AllRegionsFlag.Add(cmd)
+	lbFlags.WatchFlag.Add(cmd)
+	lbFlags.UntilStateFlag.Add(cmd)
 	return cmd
 }
 
 func runGetCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
 	limit := configflags.GetIntFlag(cmd, configflags.FlagNameLimit, lbFlags.FlagDefaultLimit)
 	page := configflags.GetIntFlag(cmd, configflags.FlagNamePage, lbFlags.FlagDefaultPage)
-	useJSON := configflags.GetBoolFlag(cmd, configflags.FlagNameJSON, false)
+	format, err := configflags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
 	showAll := configflags.GetBoolFlag(cmd, configflags.FlagNameAll, false)
-	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running load balancer get command", "compartment", appCtx.CompartmentName, "limit", limit, "page", page, "json", useJSON, "all", showAll)
-	return lbservice.GetLoadBalancers(appCtx, useJSON, limit, page, showAll)
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running load balancer get command", "compartment", appCtx.CompartmentName, "limit", limit, "page", page, "output", format.String(), "all", showAll)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyWatch(cmd, appCtx); err != nil {
+		return err
+	}
+	return lbservice.GetLoadBalancers(appCtx, format, limit, page, showAll)
 }
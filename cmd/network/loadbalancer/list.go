@@ -2,6 +2,7 @@ package loadbalancer
 
 import (
 	lbFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	configflags "github.com/cnopslabs/ocloud/internal/config/flags"
 	lbdomain "github.com/cnopslabs/ocloud/internal/services/network/loadbalancer"
@@ -47,11 +48,28 @@ func NewListCmd(appCtx *app.ApplicationContext) *cobra.Command {
 		},
 	}
 	lbFlags.AllInfoFlag.Add(cmd)
+	lbFlags.RecursiveFlag.Add(cmd)
+	lbFlags.RegionsFlag.Add(cmd)
+	lbFlags.AllRegionsFlag.Add(cmd)
+	lbFlags.WatchFlag.Add(cmd)
+	lbFlags.UntilStateFlag.Add(cmd)
 	return cmd
 }
 
 func runListCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
-	useJSON := configflags.GetBoolFlag(cmd, configflags.FlagNameJSON, false)
+	format, err := configflags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
 	showAll := configflags.GetBoolFlag(cmd, configflags.FlagNameAll, false)
-	return lbdomain.ListLoadBalancers(appCtx, useJSON, showAll)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyWatch(cmd, appCtx); err != nil {
+		return err
+	}
+	return lbdomain.ListLoadBalancers(appCtx, format, showAll)
 }
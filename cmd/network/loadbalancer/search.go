@@ -2,10 +2,12 @@ package loadbalancer
 
 import (
 	lbFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	configflags "github.com/cnopslabs/ocloud/internal/config/flags"
 	lbservice "github.com/cnopslabs/ocloud/internal/services/network/loadbalancer"
+	searchsvc "github.com/cnopslabs/ocloud/internal/services/search"
 	"github.com/spf13/cobra"
 )
 
@@ -32,7 +34,7 @@ Additional information:
 - Use --json (-j) to output the results in JSON format
 - The search is case-insensitive. For highly specific inputs (like full OCIDs), exact and substring
   matches are attempted before broader fuzzy search.
-`
+` + searchsvc.QuerySyntaxHelp
 
 var searchExamples = `
   # Search load balancers whose name contains "prod"
@@ -67,13 +69,30 @@ func NewSearchCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	}
 
 	lbFlags.AllInfoFlag.Add(cmd)
+	lbFlags.RecursiveFlag.Add(cmd)
+	lbFlags.RegionsFlag.Add(cmd)
+	lbFlags.AllRegionsFlag.Add(cmd)
+	lbFlags.WatchFlag.Add(cmd)
+	lbFlags.UntilStateFlag.Add(cmd)
 
 	return cmd
 }
 
 func runSearchCommand(cmd *cobra.Command, args []string, appCtx *app.ApplicationContext) error {
 	namePattern := args[0]
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
 	showAll := configflags.GetBoolFlag(cmd, configflags.FlagNameAll, false)
-	return lbservice.SearchLoadBalancer(appCtx, namePattern, useJSON, showAll)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyWatch(cmd, appCtx); err != nil {
+		return err
+	}
+	return lbservice.SearchLoadBalancer(appCtx, namePattern, format, showAll)
 }
@@ -1,10 +1,13 @@
 package subnet
 
 import (
+	subnetFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
 	"github.com/cnopslabs/ocloud/internal/services/network/subnet"
+	searchsvc "github.com/cnopslabs/ocloud/internal/services/search"
 	"github.com/spf13/cobra"
 )
 
@@ -22,7 +25,7 @@ even if the pattern is only partially matched. The search is case-insensitive.
 Additional Information:
 - Use --json (-j) to output the results in JSON format
 - The command searches across all available subnets in the compartment
-`
+` + searchsvc.QuerySyntaxHelp
 
 // Examples for the find command
 var findExamples = `
@@ -52,13 +55,26 @@ func NewFindCmd(appCtx *app.ApplicationContext) *cobra.Command {
 		},
 	}
 
+	subnetFlags.RecursiveFlag.Add(cmd)
+	subnetFlags.RegionsFlag.Add(cmd)
+	subnetFlags.AllRegionsFlag.Add(cmd)
+
 	return cmd
 }
 
 // RunFindCommand handles the execution of the find command
 func RunFindCommand(cmd *cobra.Command, args []string, appCtx *app.ApplicationContext) error {
 	namePattern := args[0]
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
-	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running subnet find command", "pattern", namePattern, "json", useJSON)
-	return subnet.FindSubnets(appCtx, namePattern, useJSON)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running subnet find command", "pattern", namePattern, "output", format.String())
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	return subnet.FindSubnets(appCtx, namePattern, format)
 }
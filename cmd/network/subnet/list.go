@@ -2,6 +2,7 @@ package subnet
 
 import (
 	paginationFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
@@ -62,6 +63,9 @@ func NewListCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	paginationFlags.LimitFlag.Add(cmd)
 	paginationFlags.PageFlag.Add(cmd)
 	paginationFlags.SortFlag.Add(cmd)
+	paginationFlags.RecursiveFlag.Add(cmd)
+	paginationFlags.RegionsFlag.Add(cmd)
+	paginationFlags.AllRegionsFlag.Add(cmd)
 
 	return cmd
 
@@ -71,8 +75,17 @@ func NewListCmd(appCtx *app.ApplicationContext) *cobra.Command {
 func RunListCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
 	limit := flags.GetIntFlag(cmd, flags.FlagNameLimit, paginationFlags.FlagDefaultLimit)
 	page := flags.GetIntFlag(cmd, flags.FlagNamePage, paginationFlags.FlagDefaultPage)
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
 	sortBy := flags.GetStringFlag(cmd, flags.FlagNameSort, "")
-	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running subnet list command in", "compartment", appCtx.CompartmentName, "json", useJSON, "sort", sortBy)
-	return subnet.ListSubnets(appCtx, useJSON, limit, page, sortBy)
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running subnet list command in", "compartment", appCtx.CompartmentName, "output", format.String(), "sort", sortBy)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	return subnet.ListSubnets(appCtx, format, limit, page, sortBy)
 }
@@ -3,6 +3,7 @@ package vcn
 import (
 	networkFlags "github.com/cnopslabs/ocloud/cmd/network/flags"
 	vcnFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
@@ -65,6 +66,9 @@ func NewGetCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	vcnFlags.AllInfoFlag.Add(cmd)
 	vcnFlags.LimitFlag.Add(cmd)
 	vcnFlags.PageFlag.Add(cmd)
+	vcnFlags.RecursiveFlag.Add(cmd)
+	vcnFlags.RegionsFlag.Add(cmd)
+	vcnFlags.AllRegionsFlag.Add(cmd)
 
 	return cmd
 }
@@ -73,7 +77,10 @@ func NewGetCmd(appCtx *app.ApplicationContext) *cobra.Command {
 func runGetCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
 	limit := flags.GetIntFlag(cmd, flags.FlagNameLimit, vcnFlags.FlagDefaultLimit)
 	page := flags.GetIntFlag(cmd, flags.FlagNamePage, vcnFlags.FlagDefaultPage)
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
 	gateways := flags.GetBoolFlag(cmd, flags.FlagNameGateway, false)
 	subnets := flags.GetBoolFlag(cmd, flags.FlagNameSubnet, false)
 	nsgs := flags.GetBoolFlag(cmd, flags.FlagNameNsg, false)
@@ -83,6 +90,12 @@ func runGetCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
 	if showAll {
 		gateways, subnets, nsgs, routes, securityLists = true, true, true, true, true
 	}
-	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running network vcn get", "json", useJSON, "all", showAll)
-	return netvcn.GetVCNs(appCtx, limit, page, useJSON, gateways, subnets, nsgs, routes, securityLists)
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running network vcn get", "output", format.String(), "all", showAll)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	return netvcn.GetVCNs(appCtx, limit, page, format, gateways, subnets, nsgs, routes, securityLists)
 }
@@ -3,6 +3,7 @@ package vcn
 import (
 	networkFlags "github.com/cnopslabs/ocloud/cmd/network/flags"
 	vcnFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	cfgflags "github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
@@ -58,12 +59,18 @@ func NewListCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	networkFlags.RouteTable.Add(cmd)
 	networkFlags.SecurityList.Add(cmd)
 	vcnFlags.AllInfoFlag.Add(cmd)
+	vcnFlags.RecursiveFlag.Add(cmd)
+	vcnFlags.RegionsFlag.Add(cmd)
+	vcnFlags.AllRegionsFlag.Add(cmd)
 
 	return cmd
 }
 
 func runListCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
-	useJSON := cfgflags.GetBoolFlag(cmd, cfgflags.FlagNameJSON, false)
+	format, err := cfgflags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
 	gateways := cfgflags.GetBoolFlag(cmd, cfgflags.FlagNameGateway, false)
 	subnets := cfgflags.GetBoolFlag(cmd, cfgflags.FlagNameSubnet, false)
 	nsgs := cfgflags.GetBoolFlag(cmd, cfgflags.FlagNameNsg, false)
@@ -75,7 +82,13 @@ func runListCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
 		gateways, subnets, nsgs, routes, securityLists = true, true, true, true, true
 	}
 
-	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running network vcn list", "json", useJSON, "all", showAll)
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running network vcn list", "output", format.String(), "all", showAll)
 
-	return netvcn.ListVCNs(appCtx, useJSON, gateways, subnets, nsgs, routes, securityLists)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	return netvcn.ListVCNs(appCtx, format, gateways, subnets, nsgs, routes, securityLists)
 }
@@ -3,10 +3,12 @@ package vcn
 import (
 	networkFlags "github.com/cnopslabs/ocloud/cmd/network/flags"
 	vcnFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	cfgflags "github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
 	netvcn "github.com/cnopslabs/ocloud/internal/services/network/vcn"
+	searchsvc "github.com/cnopslabs/ocloud/internal/services/search"
 	"github.com/spf13/cobra"
 )
 
@@ -31,7 +33,7 @@ Additional information:
 - Use --json (-j) to output the results in JSON format
 - The search is case-insensitive. For highly specific inputs (like full OCIDs), exact and substring
   matches are attempted before broader fuzzy search.
-`
+` + searchsvc.QuerySyntaxHelp
 
 var searchExamples = `
   # Search VCNs whose name contains "prod"
@@ -70,12 +72,18 @@ func NewSearchCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	networkFlags.RouteTable.Add(cmd)
 	networkFlags.SecurityList.Add(cmd)
 	vcnFlags.AllInfoFlag.Add(cmd)
+	vcnFlags.RecursiveFlag.Add(cmd)
+	vcnFlags.RegionsFlag.Add(cmd)
+	vcnFlags.AllRegionsFlag.Add(cmd)
 	return cmd
 }
 
 func runSearchCommand(cmd *cobra.Command, args []string, appCtx *app.ApplicationContext) error {
 	pattern := args[0]
-	useJSON := cfgflags.GetBoolFlag(cmd, cfgflags.FlagNameJSON, false)
+	format, err := cfgflags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
 	gateways := cfgflags.GetBoolFlag(cmd, cfgflags.FlagNameGateway, false)
 	subnets := cfgflags.GetBoolFlag(cmd, cfgflags.FlagNameSubnet, false)
 	nsgs := cfgflags.GetBoolFlag(cmd, cfgflags.FlagNameNsg, false)
@@ -85,6 +93,12 @@ func runSearchCommand(cmd *cobra.Command, args []string, appCtx *app.Application
 	if showAll {
 		gateways, subnets, nsgs, routes, securityLists = true, true, true, true, true
 	}
-	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running network vcn search", "pattern", pattern, "json", useJSON, "all", showAll)
-	return netvcn.SearchVCNs(appCtx, pattern, useJSON, gateways, subnets, nsgs, routes, securityLists)
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running network vcn search", "pattern", pattern, "output", format.String(), "all", showAll)
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	return netvcn.SearchVCNs(appCtx, pattern, format, gateways, subnets, nsgs, routes, securityLists)
 }
@@ -0,0 +1,66 @@
+package search
+
+import (
+	"strings"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/services/search/global"
+	"github.com/spf13/cobra"
+)
+
+var reindexLong = `
+Rebuild the persisted search indexes of the current compartment.
+
+Search commands keep a Bleve index per tenancy, compartment and resource type next to the
+resource cache (~/.ocloud/cache). Indexes are updated incrementally whenever a listing changes;
+this command fetches fresh listings from OCI and rebuilds the indexes from scratch.
+
+Resource types: ` + strings.Join(global.TypeNames(), ", ") + `
+
+Additional Information:
+- Pass one or more resource types to only rebuild those indexes (all types by default)
+- Use --json (-j) or --output (-o) to output the results in another format
+`
+
+var reindexExamples = `
+  # Rebuild every search index of the current compartment
+  ocloud search reindex
+
+  # Rebuild only the instance and VCN indexes
+  ocloud search reindex instance vcn
+
+  # Rebuild the indexes of another compartment
+  ocloud search reindex -c my-compartment
+`
+
+// NewReindexCmd creates the `search reindex` command.
+func NewReindexCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "reindex [resource-type...]",
+		Short:         "Rebuild the persisted search indexes",
+		Long:          reindexLong,
+		Example:       reindexExamples,
+		ValidArgs:     global.TypeNames(),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runReindexCommand(cmd, args, appCtx)
+		},
+	}
+
+	flags.JSONFlag.Add(cmd)
+
+	return cmd
+}
+
+// runReindexCommand handles the execution of the reindex command
+func runReindexCommand(cmd *cobra.Command, args []string, appCtx *app.ApplicationContext) error {
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running search reindex command", "types", args, "output", format.String())
+	return global.Reindex(appCtx, args, format)
+}
@@ -0,0 +1,91 @@
+package search
+
+import (
+	"strings"
+
+	searchFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	searchsvc "github.com/cnopslabs/ocloud/internal/services/search"
+	"github.com/cnopslabs/ocloud/internal/services/search/global"
+	"github.com/spf13/cobra"
+)
+
+var searchLong = `
+Search every supported OCI resource type of the current compartment at once.
+
+The pattern is matched with the same fuzzy, prefix and substring matching used by each
+resource's own search command. Hits from all resource types are merged into one list
+with a column naming the resource type of each hit. The list is ranked by how each hit
+matched (exact name or value, whole word, substring, then fuzzy), and within that by
+relevance score relative to the best hit of its resource type.
+
+Resource types: ` + strings.Join(global.TypeNames(), ", ") + `
+
+Additional Information:
+- Resource types that cannot be searched (for example for lack of permissions) are skipped
+- Search indexes are persisted next to the resource cache; use 'ocloud search reindex' to rebuild them
+- Use --json (-j) or --output (-o) to output the results in another format
+` + searchsvc.QuerySyntaxHelp
+
+var searchExamples = `
+  # Search every resource type for "prod"
+  ocloud search prod
+
+  # Search for an IP address or CIDR block
+  ocloud search 10.0.1.
+
+  # Output the ranked hits as JSON
+  ocloud search payments --json
+
+  # Rebuild the persisted search indexes
+  ocloud search reindex
+`
+
+// NewSearchCmd creates the `search` command, which searches across every
+// searchable resource type and groups operations on the search indexes.
+func NewSearchCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "search <pattern>",
+		Short:         "Search across OCI resource types",
+		Long:          searchLong,
+		Example:       searchExamples,
+		Args:          cobra.ArbitraryArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSearchCommand(cmd, args, appCtx)
+		},
+	}
+
+	flags.JSONFlag.Add(cmd)
+	searchFlags.RecursiveFlag.Add(cmd)
+	searchFlags.RegionsFlag.Add(cmd)
+	searchFlags.AllRegionsFlag.Add(cmd)
+	cmd.AddCommand(NewReindexCmd(appCtx))
+
+	return cmd
+}
+
+// runSearchCommand handles the execution of the search command
+func runSearchCommand(cmd *cobra.Command, args []string, appCtx *app.ApplicationContext) error {
+	pattern := strings.TrimSpace(strings.Join(args, " "))
+	if pattern == "" {
+		return cmd.Help()
+	}
+
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running search command", "pattern", pattern, "output", format.String())
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	return global.Search(cmd.Context(), appCtx, pattern, format)
+}
@@ -0,0 +1,59 @@
+package search
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSearchCmd(t *testing.T) {
+	appCtx := &app.ApplicationContext{
+		Logger: testr.New(t),
+	}
+
+	cmd := NewSearchCmd(appCtx)
+
+	assert.NotNil(t, cmd)
+	assert.Equal(t, "search <pattern>", cmd.Use)
+	assert.True(t, cmd.SilenceUsage)
+	assert.True(t, cmd.SilenceErrors)
+
+	found := false
+	for _, c := range cmd.Commands() {
+		if c.Name() == "reindex" {
+			found = true
+			break
+		}
+	}
+	assert.True(t, found, "subcommand reindex not found")
+}
+
+func TestReindexCmd_CacheDisabled(t *testing.T) {
+	appCtx := &app.ApplicationContext{
+		Logger: testr.New(t),
+	}
+
+	cmd := NewReindexCmd(appCtx)
+	cmd.SetArgs([]string{})
+
+	err := cmd.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cache is disabled")
+}
+
+func TestSearchCmd_NoPatternShowsHelp(t *testing.T) {
+	appCtx := &app.ApplicationContext{
+		Logger: testr.New(t),
+	}
+
+	cmd := NewSearchCmd(appCtx)
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{})
+
+	assert.NoError(t, cmd.Execute())
+	assert.Contains(t, out.String(), "search <pattern> [flags]")
+}
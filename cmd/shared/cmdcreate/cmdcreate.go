@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/cnopslabs/ocloud/cmd/cache"
 	"github.com/cnopslabs/ocloud/cmd/compute"
 	"github.com/cnopslabs/ocloud/cmd/configuration"
 	"github.com/cnopslabs/ocloud/cmd/database"
 	"github.com/cnopslabs/ocloud/cmd/identity"
 	"github.com/cnopslabs/ocloud/cmd/network"
+	"github.com/cnopslabs/ocloud/cmd/search"
+	"github.com/cnopslabs/ocloud/cmd/snapshot"
 	"github.com/cnopslabs/ocloud/cmd/storage"
 	"github.com/cnopslabs/ocloud/cmd/version"
 	"github.com/cnopslabs/ocloud/internal/app"
@@ -34,6 +37,8 @@ func CreateRootCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	rootCmd.AddCommand(version.NewVersionCommand())
 	version.AddVersionFlag(rootCmd, os.Stdout)
 	rootCmd.AddCommand(configuration.NewConfigCmd())
+	rootCmd.AddCommand(cache.NewCacheCmd())
+	rootCmd.AddCommand(snapshot.NewSnapshotCmd(appCtx))
 
 	// If appCtx is not nil, add commands that need context
 	if appCtx != nil {
@@ -42,6 +47,7 @@ func CreateRootCmd(appCtx *app.ApplicationContext) *cobra.Command {
 		rootCmd.AddCommand(database.NewDatabaseCmd(appCtx))
 		rootCmd.AddCommand(network.NewNetworkCmd(appCtx))
 		rootCmd.AddCommand(storage.NewStorageCmd(appCtx))
+		rootCmd.AddCommand(search.NewSearchCmd(appCtx))
 	}
 
 	return rootCmd
@@ -73,6 +79,7 @@ func addPlaceholderCommands(rootCmd *cobra.Command) {
 		{"database", "Explore OCI Database services"},
 		{"network", "Explore OCI network services"},
 		{"storage", "Explore OCI Storage services"},
+		{"search", "Search across OCI resource types"},
 	}
 
 	for _, cmdType := range commandTypes {
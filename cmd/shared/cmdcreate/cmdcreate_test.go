@@ -25,6 +25,16 @@ func TestCreateRootCmd(t *testing.T) {
 	configCmd := findSubcommand(rootCmd, "config")
 	assert.NotNil(t, configCmd, "config command should be added as a subcommand")
 
+	// Verify that the cache command is added
+	cacheCmd := findSubcommand(rootCmd, "cache")
+	assert.NotNil(t, cacheCmd, "cache command should be added as a subcommand")
+
+	// Verify that the snapshot command is added, with diff usable without context
+	snapshotCmd := findSubcommand(rootCmd, "snapshot")
+	assert.NotNil(t, snapshotCmd, "snapshot command should be added as a subcommand")
+	assert.NotNil(t, findSubcommand(snapshotCmd, "diff"), "snapshot diff should be available without appCtx")
+	assert.Nil(t, findSubcommand(snapshotCmd, "save"), "snapshot save should not be added when appCtx is nil")
+
 	// Verify that the compute command is not added when appCtx is nil
 	computeCmd := findSubcommand(rootCmd, "compute")
 	assert.Nil(t, computeCmd, "compute command should not be added when appCtx is nil")
@@ -49,6 +59,10 @@ func TestCreateRootCmd(t *testing.T) {
 	// Verify that network command is added when appCtx is not nil
 	networkCmd := findSubcommand(rootCmdWithCtx, "network")
 	assert.NotNil(t, networkCmd, "network command should be added when appCtx is not nil")
+
+	// Verify that the search command is added when appCtx is not nil
+	searchCmd := findSubcommand(rootCmdWithCtx, "search")
+	assert.NotNil(t, searchCmd, "search command should be added when appCtx is not nil")
 }
 
 // TestCreateRootCmdWithoutContext tests the CreateRootCmdWithoutContext function
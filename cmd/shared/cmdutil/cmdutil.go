@@ -15,6 +15,7 @@ func IsNoContextCommand() bool {
 	noContextCommands := map[string]bool{
 		"version": true,
 		"config":  true,
+		"cache":   true,
 	}
 
 	// Flags that don't need context
@@ -27,6 +28,11 @@ func IsNoContextCommand() bool {
 		return true
 	}
 
+	// Comparing snapshots only reads local files
+	if args[1] == "snapshot" && len(args) > 2 && (args[2] == "diff" || args[2] == "d") {
+		return true
+	}
+
 	for _, arg := range args[1:] {
 		if noContextFlags[arg] {
 			return true
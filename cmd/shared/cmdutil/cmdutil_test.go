@@ -21,6 +21,18 @@ func TestIsNoContextCommand(t *testing.T) {
 	os.Args = []string{"ocloud", "config"}
 	assert.True(t, IsNoContextCommand(), "should return true for 'config' command")
 
+	// Test with cache command
+	os.Args = []string{"ocloud", "cache", "status"}
+	assert.True(t, IsNoContextCommand(), "should return true for 'cache' command")
+
+	// Test with snapshot diff, which only reads local files
+	os.Args = []string{"ocloud", "snapshot", "diff", "a", "b"}
+	assert.True(t, IsNoContextCommand(), "should return true for 'snapshot diff' command")
+
+	// Test with snapshot save, which lists resources
+	os.Args = []string{"ocloud", "snapshot", "save", "a"}
+	assert.False(t, IsNoContextCommand(), "should return false for 'snapshot save' command")
+
 	// Test with version flag (short)
 	os.Args = []string{"ocloud", "-v"}
 	assert.True(t, IsNoContextCommand(), "should return true for '-v' flag")
@@ -29,6 +41,10 @@ func TestIsNoContextCommand(t *testing.T) {
 	os.Args = []string{"ocloud", "--version"}
 	assert.True(t, IsNoContextCommand(), "should return true for '--version' flag")
 
+	// Test with version flag after a command
+	os.Args = []string{"ocloud", "compute", "--version"}
+	assert.True(t, IsNoContextCommand(), "should return true for a trailing '--version' flag")
+
 	// Test with other command
 	os.Args = []string{"ocloud", "compute", "instance", "list"}
 	assert.False(t, IsNoContextCommand(), "should return false for other commands")
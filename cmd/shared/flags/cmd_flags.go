@@ -47,4 +47,194 @@ var (
 		Default:   false,
 		Usage:     flags.FlagDescTenancyScope,
 	}
+
+	RecursiveFlag = flags.BoolFlag{
+		Name:      flags.FlagNameRecursive,
+		Shorthand: "",
+		Default:   false,
+		Usage:     flags.FlagDescRecursive,
+	}
+
+	RegionsFlag = flags.StringFlag{
+		Name:      flags.FlagNameRegions,
+		Shorthand: "",
+		Default:   "",
+		Usage:     flags.FlagDescRegions,
+	}
+
+	AllRegionsFlag = flags.BoolFlag{
+		Name:      flags.FlagNameAllRegions,
+		Shorthand: "",
+		Default:   false,
+		Usage:     flags.FlagDescAllRegions,
+	}
+
+	WatchFlag = flags.StringFlag{
+		Name:        flags.FlagNameWatch,
+		Shorthand:   "",
+		Default:     "",
+		NoOptDefVal: flags.DefaultWatchInterval,
+		Usage:       flags.FlagDescWatch,
+	}
+
+	UntilStateFlag = flags.StringFlag{
+		Name:      flags.FlagNameUntilState,
+		Shorthand: "",
+		Default:   "",
+		Usage:     flags.FlagDescUntilState,
+	}
+
+	YesFlag = flags.BoolFlag{
+		Name:      flags.FlagNameYes,
+		Shorthand: flags.FlagShortYes,
+		Default:   false,
+		Usage:     flags.FlagDescYes,
+	}
+
+	NoWaitFlag = flags.BoolFlag{
+		Name:      flags.FlagNameNoWait,
+		Shorthand: "",
+		Default:   false,
+		Usage:     flags.FlagDescNoWait,
+	}
+
+	ExportFormatFlag = flags.StringFlag{
+		Name:      flags.FlagNameFormat,
+		Shorthand: "",
+		Default:   "ssh-config",
+		Usage:     flags.FlagDescFormat,
+	}
+
+	UserFlag = flags.StringFlag{
+		Name:      flags.FlagNameUser,
+		Shorthand: "",
+		Default:   "",
+		Usage:     flags.FlagDescUser,
+	}
+
+	IdentityFileFlag = flags.StringFlag{
+		Name:      flags.FlagNameIdentityFile,
+		Shorthand: "",
+		Default:   "",
+		Usage:     flags.FlagDescIdentityFile,
+	}
+
+	BastionSessionFlag = flags.StringFlag{
+		Name:      flags.FlagNameBastionSession,
+		Shorthand: "",
+		Default:   "",
+		Usage:     flags.FlagDescBastionSession,
+	}
+
+	ExecFlag = flags.BoolFlag{
+		Name:      flags.FlagNameExec,
+		Shorthand: "",
+		Default:   false,
+		Usage:     flags.FlagDescExec,
+	}
+
+	MetadataFlag = flags.BoolFlag{
+		Name:      flags.FlagNameMetadata,
+		Shorthand: "",
+		Default:   false,
+		Usage:     flags.FlagDescMetadata,
+	}
+
+	NodesFlag = flags.BoolFlag{
+		Name:      flags.FlagNameNodes,
+		Shorthand: "",
+		Default:   false,
+		Usage:     flags.FlagDescNodes,
+	}
+
+	EndpointFlag = flags.StringFlag{
+		Name:      flags.FlagNameEndpoint,
+		Shorthand: "",
+		Default:   "",
+		Usage:     flags.FlagDescEndpoint,
+	}
+
+	KubeContextFlag = flags.StringFlag{
+		Name:      flags.FlagNameKubeContext,
+		Shorthand: "",
+		Default:   "",
+		Usage:     flags.FlagDescKubeContext,
+	}
+
+	FromInstanceFlag = flags.StringFlag{
+		Name:      flags.FlagNameFromInstance,
+		Shorthand: "",
+		Default:   "",
+		Usage:     flags.FlagDescFromInstance,
+	}
+
+	BucketFlag = flags.StringFlag{
+		Name:      flags.FlagNameBucket,
+		Shorthand: "",
+		Default:   "",
+		Usage:     flags.FlagDescBucket,
+	}
+
+	ObjectFlag = flags.StringFlag{
+		Name:      flags.FlagNameObject,
+		Shorthand: "",
+		Default:   "",
+		Usage:     flags.FlagDescObject,
+	}
+
+	NameFlag = flags.StringFlag{
+		Name:      flags.FlagNameName,
+		Shorthand: "",
+		Default:   "",
+		Usage:     flags.FlagDescName,
+	}
+
+	OSFlag = flags.StringFlag{
+		Name:      flags.FlagNameOS,
+		Shorthand: "",
+		Default:   "",
+		Usage:     flags.FlagDescOS,
+	}
+
+	OSVersionFlag = flags.StringFlag{
+		Name:      flags.FlagNameOSVersion,
+		Shorthand: "",
+		Default:   "",
+		Usage:     flags.FlagDescOSVersion,
+	}
+
+	PlatformOSFlag = flags.StringFlag{
+		Name:      flags.FlagNameOS,
+		Shorthand: "",
+		Default:   "",
+		Usage:     flags.FlagDescPlatformOS,
+	}
+
+	PlatformVersionFlag = flags.StringFlag{
+		Name:      flags.FlagNameOSVersion,
+		Shorthand: "",
+		Default:   "",
+		Usage:     flags.FlagDescPlatformVersion,
+	}
+
+	ShapeFlag = flags.StringFlag{
+		Name:      flags.FlagNameShape,
+		Shorthand: "",
+		Default:   "",
+		Usage:     flags.FlagDescShape,
+	}
+
+	ImageExportFormatFlag = flags.StringFlag{
+		Name:      flags.FlagNameFormat,
+		Shorthand: "",
+		Default:   "OCI",
+		Usage:     flags.FlagDescImageExportFormat,
+	}
+
+	ImageImportFormatFlag = flags.StringFlag{
+		Name:      flags.FlagNameFormat,
+		Shorthand: "",
+		Default:   "",
+		Usage:     flags.FlagDescImageImportFormat,
+	}
 )
@@ -0,0 +1,39 @@
+package scope
+
+import (
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+	"github.com/cnopslabs/ocloud/internal/services/identity/compartment"
+	"github.com/spf13/cobra"
+)
+
+// subtreeIndexScope keeps search indexes over a whole subtree apart from the
+// indexes of its root compartment alone.
+const subtreeIndexScope = "subtree"
+
+// ApplyRecursive honours --recursive: it walks the compartments below rootID
+// and sets appCtx.Subtree so that listing rootID covers all of them.
+func ApplyRecursive(cmd *cobra.Command, appCtx *app.ApplicationContext, rootID string) error {
+	if !flags.GetBoolFlag(cmd, flags.FlagNameRecursive, false) {
+		return nil
+	}
+	tree, err := compartment.LoadSubtree(cmd.Context(), appCtx, rootID, rootName(appCtx, rootID))
+	if err != nil {
+		return err
+	}
+	appCtx.Subtree = tree
+	appCtx.Cache = appCtx.Cache.WithIndexScope(subtreeIndexScope)
+	return nil
+}
+
+// rootName returns the display name used as the first element of compartment paths.
+func rootName(appCtx *app.ApplicationContext, rootID string) string {
+	switch {
+	case rootID == appCtx.TenancyID && appCtx.TenancyName != "":
+		return appCtx.TenancyName
+	case rootID == appCtx.CompartmentID && appCtx.CompartmentName != "":
+		return appCtx.CompartmentName
+	default:
+		return rootID
+	}
+}
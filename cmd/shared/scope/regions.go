@@ -0,0 +1,66 @@
+package scope
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+	ociregion "github.com/cnopslabs/ocloud/internal/oci/identity/region"
+	"github.com/cnopslabs/ocloud/internal/region"
+	"github.com/spf13/cobra"
+)
+
+// ApplyRegions honours --regions and --all-subscribed-regions: it sets
+// appCtx.Regions so that services query each selected region in parallel.
+func ApplyRegions(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
+	names := splitRegions(flags.GetStringFlag(cmd, flags.FlagNameRegions, ""))
+	if flags.GetBoolFlag(cmd, flags.FlagNameAllRegions, false) {
+		if len(names) > 0 {
+			return errors.New("--regions and --all-subscribed-regions cannot be used together")
+		}
+		subscribed, err := subscribedRegions(context.Background(), appCtx)
+		if err != nil {
+			return err
+		}
+		names = subscribed
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	appCtx.Regions = region.NewSet(names)
+	appCtx.Cache = appCtx.Cache.WithIndexScope("regions-" + strings.Join(appCtx.Regions.Regions(), "_"))
+	return nil
+}
+
+// subscribedRegions returns the active region subscriptions of the tenancy, home region first.
+func subscribedRegions(ctx context.Context, appCtx *app.ApplicationContext) ([]string, error) {
+	subscriptions, err := ociregion.NewAdapter(appCtx.IdentityClient).ListRegionSubscriptions(ctx, appCtx.TenancyID)
+	if err != nil {
+		return nil, fmt.Errorf("resolving subscribed regions: %w", err)
+	}
+	var names []string
+	for _, s := range subscriptions {
+		if s.IsActive {
+			names = append(names, s.Name)
+		}
+	}
+	if len(names) == 0 {
+		return nil, errors.New("the tenancy has no active region subscriptions")
+	}
+	return names, nil
+}
+
+// splitRegions parses a comma-separated region list, ignoring blanks.
+func splitRegions(value string) []string {
+	var names []string
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,35 @@
+package scope
+
+import (
+	"errors"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+	"github.com/cnopslabs/ocloud/internal/watch"
+	"github.com/spf13/cobra"
+)
+
+// ApplyWatch honours --watch and --until-state: it sets appCtx.Watch so that
+// services re-run their query until the command is interrupted or the target
+// state is reached. Polls bypass the resource cache so each one reflects OCI.
+func ApplyWatch(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
+	interval, watching, err := flags.GetWatchInterval(cmd)
+	if err != nil {
+		return err
+	}
+	until := flags.GetStringFlag(cmd, flags.FlagNameUntilState, "")
+	if !watching {
+		if until != "" {
+			return errors.New("--until-state requires --watch")
+		}
+		return nil
+	}
+
+	opts := &watch.Options{Interval: interval, UntilState: until, Out: appCtx.Stderr}
+	if ctx := cmd.Context(); ctx != nil {
+		opts.Done = ctx.Done()
+	}
+	appCtx.Watch = opts
+	appCtx.Cache = appCtx.Cache.WithRefresh()
+	return nil
+}
@@ -0,0 +1,65 @@
+package snapshot
+
+import (
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	snapshotSvc "github.com/cnopslabs/ocloud/internal/services/snapshot"
+	"github.com/spf13/cobra"
+)
+
+var diffLong = `
+Compare two snapshots and report the resources added, removed and modified in between.
+
+Snapshots are given by name (as saved with 'ocloud snapshot save') or by the path of a
+snapshot file. Modified resources are reported field by field with the old and new value;
+nested fields are named by their path, e.g. FreeformTags.env or SecurityListNames[0].
+Only resource types captured by both snapshots are compared.
+
+Additional Information:
+- Comparing snapshots only reads local files and does not contact OCI
+- Use --json (-j) or --output (-o) to output the changes in another format
+`
+
+var diffExamples = `
+  # What changed in prod since yesterday
+  ocloud snapshot diff prod-2026-10-15 prod-2026-10-16
+
+  # Compare snapshot files shared by a colleague
+  ocloud snapshot diff ./before.json ./after.json --json
+`
+
+// NewDiffCmd creates the `snapshot diff` command. appCtx may be nil, since
+// comparing snapshots only reads local files.
+func NewDiffCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "diff <a> <b>",
+		Aliases:       []string{"d"},
+		Short:         "Compare two snapshots",
+		Long:          diffLong,
+		Example:       diffExamples,
+		Args:          cobra.ExactArgs(2),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDiffCommand(cmd, args, appCtx)
+		},
+	}
+
+	flags.JSONFlag.Add(cmd)
+
+	return cmd
+}
+
+// runDiffCommand handles the execution of the diff command
+func runDiffCommand(cmd *cobra.Command, args []string, appCtx *app.ApplicationContext) error {
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running snapshot diff command", "from", args[0], "to", args[1], "output", format.String())
+	if appCtx == nil {
+		appCtx = &app.ApplicationContext{Stdout: cmd.OutOrStdout(), Stderr: cmd.ErrOrStderr()}
+	}
+	return snapshotSvc.Diff(appCtx, args[0], args[1], format)
+}
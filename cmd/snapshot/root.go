@@ -0,0 +1,40 @@
+package snapshot
+
+import (
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/spf13/cobra"
+)
+
+// Short description for the snapshot command
+var snapshotShort = "Save and compare snapshots of OCI resources"
+
+// Long description for the snapshot command
+var snapshotLong = `Save point-in-time snapshots of the resources of a compartment and compare them.
+
+Snapshots are stored as versioned JSON files under ~/.ocloud/snapshots/<name>.json and hold the
+enriched details of each resource, so that a diff reports every field that changed in between.`
+
+// Examples for the snapshot command
+var snapshotExamples = `  ocloud snapshot save prod-monday
+  ocloud snapshot diff prod-monday prod-tuesday`
+
+// NewSnapshotCmd creates the `snapshot` command. Comparing snapshots only reads
+// local files, so without an application context only the diff subcommand is added.
+func NewSnapshotCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "snapshot",
+		Aliases:       []string{"snap"},
+		Short:         snapshotShort,
+		Long:          snapshotLong,
+		Example:       snapshotExamples,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	if appCtx != nil {
+		cmd.AddCommand(NewSaveCmd(appCtx))
+	}
+	cmd.AddCommand(NewDiffCmd(appCtx))
+
+	return cmd
+}
@@ -0,0 +1,52 @@
+package snapshot
+
+import (
+	"testing"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewSnapshotCmd tests the NewSnapshotCmd function with and without application context
+func TestNewSnapshotCmd(t *testing.T) {
+	cmd := NewSnapshotCmd(&app.ApplicationContext{})
+
+	assert.NotNil(t, cmd, "Command should not be nil")
+	assert.Equal(t, "snapshot", cmd.Use, "Command should have the correct use")
+	assert.NotEmpty(t, cmd.Short, "Command should have a short description")
+	assert.NotEmpty(t, cmd.Long, "Command should have a long description")
+	assert.NotEmpty(t, cmd.Example, "Command should have examples")
+	assert.True(t, cmd.SilenceUsage, "Command should silence usage")
+	assert.True(t, cmd.SilenceErrors, "Command should silence errors")
+
+	subcommands := map[string]bool{}
+	for _, subCmd := range cmd.Commands() {
+		subcommands[subCmd.Name()] = true
+	}
+	assert.True(t, subcommands["save"], "Command should have the save subcommand")
+	assert.True(t, subcommands["diff"], "Command should have the diff subcommand")
+
+	withoutCtx := NewSnapshotCmd(nil)
+	assert.Len(t, withoutCtx.Commands(), 1, "only diff works without an application context")
+	assert.Equal(t, "diff", withoutCtx.Commands()[0].Name())
+}
+
+// TestNewSaveCmd tests the flags of the save command
+func TestNewSaveCmd(t *testing.T) {
+	cmd := NewSaveCmd(&app.ApplicationContext{})
+
+	assert.NotNil(t, cmd.RunE, "Command should have a RunE function")
+	assert.Error(t, cmd.Args(cmd, nil), "a snapshot name is required")
+	for _, name := range []string{"json", "recursive", "regions", "all-subscribed-regions"} {
+		assert.NotNil(t, cmd.Flags().Lookup(name), "Command should have the %s flag", name)
+	}
+}
+
+// TestNewDiffCmd tests that the diff command takes exactly two snapshots
+func TestNewDiffCmd(t *testing.T) {
+	cmd := NewDiffCmd(nil)
+
+	assert.Error(t, cmd.Args(cmd, []string{"a"}))
+	assert.NoError(t, cmd.Args(cmd, []string{"a", "b"}))
+	assert.NotNil(t, cmd.Flags().Lookup("json"), "Command should have the json flag")
+}
@@ -0,0 +1,82 @@
+package snapshot
+
+import (
+	"strings"
+
+	snapshotFlags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	snapshotSvc "github.com/cnopslabs/ocloud/internal/services/snapshot"
+	"github.com/spf13/cobra"
+)
+
+var saveLong = `
+Save a snapshot of the resources of the current compartment.
+
+Every resource type is listed live from OCI (bypassing the resource cache) with its enriched
+details and written to ~/.ocloud/snapshots/<name>.json. Saving under an existing name replaces
+that snapshot.
+
+Resource types: ` + strings.Join(snapshotSvc.TypeNames(), ", ") + `
+
+Additional Information:
+- Pass one or more resource types after the name to only capture those (all types by default)
+- Resource types that cannot be listed (for example for lack of permissions) are skipped and
+  left out of the snapshot, so a later diff does not report their resources as removed
+- Use --recursive and --regions to capture a whole compartment subtree or several regions
+- Use --json (-j) or --output (-o) to output the results in another format
+`
+
+var saveExamples = `
+  # Snapshot every resource type of the current compartment
+  ocloud snapshot save prod-2026-10-16
+
+  # Snapshot only instances and load balancers
+  ocloud snapshot save prod-lbs instance load-balancer
+
+  # Snapshot the whole compartment subtree in every subscribed region
+  ocloud snapshot save prod-all --recursive --all-subscribed-regions
+`
+
+// NewSaveCmd creates the `snapshot save` command.
+func NewSaveCmd(appCtx *app.ApplicationContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "save <name> [resource-type...]",
+		Aliases:       []string{"s"},
+		Short:         "Save a snapshot of the current compartment",
+		Long:          saveLong,
+		Example:       saveExamples,
+		Args:          cobra.MinimumNArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSaveCommand(cmd, args, appCtx)
+		},
+	}
+
+	flags.JSONFlag.Add(cmd)
+	snapshotFlags.RecursiveFlag.Add(cmd)
+	snapshotFlags.RegionsFlag.Add(cmd)
+	snapshotFlags.AllRegionsFlag.Add(cmd)
+
+	return cmd
+}
+
+// runSaveCommand handles the execution of the save command
+func runSaveCommand(cmd *cobra.Command, args []string, appCtx *app.ApplicationContext) error {
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	name, types := args[0], args[1:]
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running snapshot save command", "name", name, "types", types, "output", format.String())
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	return snapshotSvc.Save(appCtx, name, types, format)
+}
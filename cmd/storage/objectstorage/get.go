@@ -2,6 +2,7 @@ package objectstorage
 
 import (
 	osflags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
@@ -41,13 +42,25 @@ func NewGetCmd(appCtx *app.ApplicationContext) *cobra.Command {
 	}
 	osflags.LimitFlag.Add(cmd)
 	osflags.PageFlag.Add(cmd)
+	osflags.RecursiveFlag.Add(cmd)
+	osflags.RegionsFlag.Add(cmd)
+	osflags.AllRegionsFlag.Add(cmd)
 	return cmd
 }
 
 func runGetCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
 	limit := flags.GetIntFlag(cmd, flags.FlagNameLimit, osflags.FlagDefaultLimit)
 	page := flags.GetIntFlag(cmd, flags.FlagNamePage, osflags.FlagDefaultPage)
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
-	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running object storage get command", "compartment", appCtx.CompartmentName, "limit", limit, "page", page, "json", useJSON)
-	return osSvc.GetBuckets(appCtx, limit, page, useJSON)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running object storage get command", "compartment", appCtx.CompartmentName, "limit", limit, "page", page, "output", format.String())
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	return osSvc.GetBuckets(appCtx, limit, page, format)
 }
@@ -1,6 +1,8 @@
 package objectstorage
 
 import (
+	osflags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	configflags "github.com/cnopslabs/ocloud/internal/config/flags"
 	osSvc "github.com/cnopslabs/ocloud/internal/services/storage/objectstorage"
@@ -42,10 +44,23 @@ func NewListCmd(appCtx *app.ApplicationContext) *cobra.Command {
 		},
 	}
 
+	osflags.RecursiveFlag.Add(cmd)
+	osflags.RegionsFlag.Add(cmd)
+	osflags.AllRegionsFlag.Add(cmd)
+
 	return cmd
 }
 
 func runListCommand(cmd *cobra.Command, appCtx *app.ApplicationContext) error {
-	useJSON := configflags.GetBoolFlag(cmd, configflags.FlagNameJSON, false)
-	return osSvc.ListBuckets(appCtx, useJSON)
+	format, err := configflags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	return osSvc.ListBuckets(appCtx, format)
 }
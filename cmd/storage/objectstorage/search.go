@@ -1,9 +1,12 @@
 package objectstorage
 
 import (
+	osflags "github.com/cnopslabs/ocloud/cmd/shared/flags"
+	scopeUtil "github.com/cnopslabs/ocloud/cmd/shared/scope"
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	searchsvc "github.com/cnopslabs/ocloud/internal/services/search"
 	objsvc "github.com/cnopslabs/ocloud/internal/services/storage/objectstorage"
 	"github.com/spf13/cobra"
 )
@@ -29,7 +32,7 @@ Additional information:
 - Use --json (-j) to output the results in JSON format
 - The search is case-insensitive. For highly specific inputs (like full OCIDs), exact and substring
   matches are attempted before broader fuzzy search.
-`
+` + searchsvc.QuerySyntaxHelp
 
 var searchExamples = `
   # Buckets whose name contains "prod"
@@ -61,13 +64,26 @@ func NewSearchCmd(appCtx *app.ApplicationContext) *cobra.Command {
 		},
 	}
 
+	osflags.RecursiveFlag.Add(cmd)
+	osflags.RegionsFlag.Add(cmd)
+	osflags.AllRegionsFlag.Add(cmd)
+
 	return cmd
 }
 
 // RunSearchCommand handles the execution of the search command
 func runSearchCommand(cmd *cobra.Command, args []string, appCtx *app.ApplicationContext) error {
 	pattern := args[0]
-	useJSON := flags.GetBoolFlag(cmd, flags.FlagNameJSON, false)
-	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running object storage bucket search", "pattern", pattern, "json", useJSON)
-	return objsvc.SearchBuckets(appCtx, pattern, useJSON)
+	format, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Running object storage bucket search", "pattern", pattern, "output", format.String())
+	if err := scopeUtil.ApplyRecursive(cmd, appCtx, appCtx.CompartmentID); err != nil {
+		return err
+	}
+	if err := scopeUtil.ApplyRegions(cmd, appCtx); err != nil {
+		return err
+	}
+	return objsvc.SearchBuckets(appCtx, pattern, format)
 }
@@ -6,7 +6,11 @@ import (
 	"io"
 	"os"
 
+	"github.com/cnopslabs/ocloud/internal/cache"
 	"github.com/cnopslabs/ocloud/internal/oci"
+	"github.com/cnopslabs/ocloud/internal/region"
+	"github.com/cnopslabs/ocloud/internal/subtree"
+	"github.com/cnopslabs/ocloud/internal/watch"
 
 	"github.com/go-logr/logr"
 	"github.com/oracle/oci-go-sdk/v65/common"
@@ -31,6 +35,30 @@ type ApplicationContext struct {
 	Logger          logr.Logger
 	Stdout          io.Writer
 	Stderr          io.Writer
+	Cache           *cache.Store
+	// Subtree is set by --recursive; listings of its root then cover every compartment below it.
+	Subtree *subtree.Tree
+	// Regions is set by --regions or --all-subscribed-regions; listings then run in every region.
+	Regions *region.Set
+	// Region is the region a per-region copy made by ForRegion talks to; "" means the configured one.
+	Region string
+	// Watch is set by --watch; get and search commands then re-run their query until stopped.
+	Watch *watch.Options
+}
+
+// ForRegion returns a copy of the context whose clients and cache target region.
+// The copy lists a single region. An empty region returns the context itself.
+func (a *ApplicationContext) ForRegion(region string) *ApplicationContext {
+	if region == "" {
+		return a
+	}
+	c := *a
+	c.Provider = oci.WithRegion(a.Provider, region)
+	c.IdentityClient.SetRegion(region)
+	c.Cache = a.Cache.WithRegion(region)
+	c.Regions = nil
+	c.Region = region
+	return &c
 }
 
 // InitApp initializes the application context, setting up configuration, clients, logging, and determineConcurrencyStatus settings.
@@ -60,10 +88,44 @@ func InitApp(ctx context.Context, cmd *cobra.Command) (*ApplicationContext, erro
 		return nil, fmt.Errorf("resolving tenancy and compartment: %w", err)
 	}
 
+	store, err := newCacheStore(cmd, appCtx)
+	if err != nil {
+		return nil, fmt.Errorf("configuring cache: %w", err)
+	}
+	appCtx.Cache = store
+
 	logger.CmdLogger.V(logger.Debug).Info("Application context initialized successfully.")
 	return appCtx, nil
 }
 
+// newCacheStore creates the on-disk resource cache for the resolved tenancy.
+// It returns a nil store, which disables caching, when the TTL is not positive
+// or the cache directory cannot be determined.
+func newCacheStore(cmd *cobra.Command, appCtx *ApplicationContext) (*cache.Store, error) {
+	ttl, err := flags.GetCacheTTL(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if ttl <= 0 {
+		logger.LogWithLevel(logger.CmdLogger, logger.Trace, "cache disabled", "ttl", ttl)
+		return nil, nil
+	}
+
+	dir, err := cache.DefaultDir()
+	if err != nil {
+		logger.LogWithLevel(logger.CmdLogger, logger.Trace, "cache disabled, could not determine cache directory", "error", err)
+		return nil, nil
+	}
+
+	tenancy := appCtx.TenancyName
+	if tenancy == "" {
+		tenancy = appCtx.TenancyID
+	}
+	refresh := flags.GetBoolFlag(cmd, flags.FlagNameRefresh, false)
+	logger.LogWithLevel(logger.CmdLogger, logger.Trace, "cache enabled", "dir", dir, "ttl", ttl, "refresh", refresh)
+	return cache.NewStore(dir, tenancy, ttl, refresh), nil
+}
+
 // configureClientRegion checks the `OCI_REGION` environment variable and overrides the client's region if it is set.
 func configureClientRegion(client identity.IdentityClient) {
 	if region, ok := os.LookupEnv(flags.EnvKeyRegion); ok {
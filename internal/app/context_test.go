@@ -5,6 +5,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -12,6 +13,7 @@ import (
 	"github.com/cnopslabs/ocloud/internal/config"
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/region"
 )
 
 // setupTest prepares the test environment and returns a cleanup function
@@ -292,3 +294,25 @@ func TestInitAppComponents(t *testing.T) {
 	// The actual InitApp function is hard to test without extensive mocking
 	// of the OCI SDK, which is beyond the scope of this test
 }
+
+// TestForRegion tests that a per-region copy targets the region without touching the original
+func TestForRegion(t *testing.T) {
+	appCtx := &ApplicationContext{
+		Provider: common.NewRawConfigurationProvider("tenancy", "user", "us-ashburn-1", "fingerprint", "key", nil),
+		Regions:  region.NewSet([]string{"us-ashburn-1", "eu-frankfurt-1"}),
+	}
+
+	assert.Same(t, appCtx, appCtx.ForRegion(""))
+
+	frankfurt := appCtx.ForRegion("eu-frankfurt-1")
+	providerRegion, err := frankfurt.Provider.Region()
+	assert.NoError(t, err)
+	assert.Equal(t, "eu-frankfurt-1", providerRegion)
+	assert.Equal(t, "eu-frankfurt-1", frankfurt.Region)
+	assert.Nil(t, frankfurt.Regions, "a per-region copy lists a single region")
+
+	providerRegion, err = appCtx.Provider.Region()
+	assert.NoError(t, err)
+	assert.Equal(t, "us-ashburn-1", providerRegion)
+	assert.NotNil(t, appCtx.Regions)
+}
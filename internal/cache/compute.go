@@ -0,0 +1,153 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+)
+
+// Resource names used for compute cache entries.
+const (
+	ResourceInstances             = "instances"
+	ResourceEnrichedInstances     = "instances-enriched"
+	ResourceInstancesWithDetails  = "instances-detailed"
+	ResourceImages                = "images"
+	ResourceClusters              = "oke-clusters"
+	ResourceClustersWithAddons    = "oke-clusters-addons"
+	ResourceShapes                = "shapes"
+	ResourceInstancePools         = "instance-pools"
+	ResourceEnrichedInstancePools = "instance-pools-enriched"
+)
+
+// instanceRepository caches instance listings; lookups by OCID pass through.
+type instanceRepository struct {
+	compute.InstanceRepository
+	store    *Store
+	enriched string
+}
+
+// NewInstanceRepository wraps repo with the cache. A nil store returns repo unchanged.
+func NewInstanceRepository(repo compute.InstanceRepository, store *Store) compute.InstanceRepository {
+	if store == nil {
+		return repo
+	}
+	return &instanceRepository{InstanceRepository: repo, store: store, enriched: ResourceEnrichedInstances}
+}
+
+// NewInstanceRepositoryWithDetails is NewInstanceRepository for a repo listing
+// enriched instances with their volumes and VNIC details, whose listings are
+// cached apart.
+func NewInstanceRepositoryWithDetails(repo compute.InstanceRepository, store *Store) compute.InstanceRepository {
+	if store == nil {
+		return repo
+	}
+	return &instanceRepository{InstanceRepository: repo, store: store, enriched: ResourceInstancesWithDetails}
+}
+
+func (r *instanceRepository) ListInstances(ctx context.Context, compartmentID string) ([]compute.Instance, error) {
+	return Fetch(r.store, compartmentID, ResourceInstances, func() ([]compute.Instance, error) {
+		return r.InstanceRepository.ListInstances(ctx, compartmentID)
+	})
+}
+
+func (r *instanceRepository) ListEnrichedInstances(ctx context.Context, compartmentID string) ([]compute.Instance, error) {
+	return Fetch(r.store, compartmentID, r.enriched, func() ([]compute.Instance, error) {
+		return r.InstanceRepository.ListEnrichedInstances(ctx, compartmentID)
+	})
+}
+
+// imageRepository caches image listings; lookups by OCID pass through.
+type imageRepository struct {
+	compute.ImageRepository
+	store *Store
+}
+
+// NewImageRepository wraps repo with the cache. A nil store returns repo unchanged.
+func NewImageRepository(repo compute.ImageRepository, store *Store) compute.ImageRepository {
+	if store == nil {
+		return repo
+	}
+	return &imageRepository{ImageRepository: repo, store: store}
+}
+
+func (r *imageRepository) ListImages(ctx context.Context, compartmentID string) ([]compute.Image, error) {
+	return Fetch(r.store, compartmentID, ResourceImages, func() ([]compute.Image, error) {
+		return r.ImageRepository.ListImages(ctx, compartmentID)
+	})
+}
+
+// clusterRepository caches OKE cluster listings; lookups by OCID pass through.
+type clusterRepository struct {
+	compute.ClusterRepository
+	store    *Store
+	resource string
+}
+
+// NewClusterRepository wraps repo with the cache. A nil store returns repo unchanged.
+func NewClusterRepository(repo compute.ClusterRepository, store *Store) compute.ClusterRepository {
+	if store == nil {
+		return repo
+	}
+	return &clusterRepository{ClusterRepository: repo, store: store, resource: ResourceClusters}
+}
+
+// NewClusterRepositoryWithAddons is NewClusterRepository for a repo listing
+// clusters with their add-ons, whose listings are cached apart.
+func NewClusterRepositoryWithAddons(repo compute.ClusterRepository, store *Store) compute.ClusterRepository {
+	if store == nil {
+		return repo
+	}
+	return &clusterRepository{ClusterRepository: repo, store: store, resource: ResourceClustersWithAddons}
+}
+
+func (r *clusterRepository) ListClusters(ctx context.Context, compartmentID string) ([]compute.Cluster, error) {
+	return Fetch(r.store, compartmentID, r.resource, func() ([]compute.Cluster, error) {
+		return r.ClusterRepository.ListClusters(ctx, compartmentID)
+	})
+}
+
+// shapeRepository caches shape listings.
+type shapeRepository struct {
+	compute.ShapeRepository
+	store *Store
+}
+
+// NewShapeRepository wraps repo with the cache. A nil store returns repo unchanged.
+func NewShapeRepository(repo compute.ShapeRepository, store *Store) compute.ShapeRepository {
+	if store == nil {
+		return repo
+	}
+	return &shapeRepository{ShapeRepository: repo, store: store}
+}
+
+func (r *shapeRepository) ListShapes(ctx context.Context, compartmentID string) ([]compute.Shape, error) {
+	return Fetch(r.store, compartmentID, ResourceShapes, func() ([]compute.Shape, error) {
+		return r.ShapeRepository.ListShapes(ctx, compartmentID)
+	})
+}
+
+// instancePoolRepository caches instance pool listings; lookups by OCID pass through.
+type instancePoolRepository struct {
+	compute.InstancePoolRepository
+	store *Store
+}
+
+// NewInstancePoolRepository wraps repo with the cache. A nil store returns repo unchanged.
+func NewInstancePoolRepository(repo compute.InstancePoolRepository, store *Store) compute.InstancePoolRepository {
+	if store == nil {
+		return repo
+	}
+	return &instancePoolRepository{InstancePoolRepository: repo, store: store}
+}
+
+func (r *instancePoolRepository) ListInstancePools(ctx context.Context, compartmentID string) ([]compute.InstancePool, error) {
+	return Fetch(r.store, compartmentID, ResourceInstancePools, func() ([]compute.InstancePool, error) {
+		return r.InstancePoolRepository.ListInstancePools(ctx, compartmentID)
+	})
+}
+
+func (r *instancePoolRepository) ListEnrichedInstancePools(ctx context.Context, compartmentID string) ([]compute.InstancePool, error) {
+	return Fetch(r.store, compartmentID, ResourceEnrichedInstancePools, func() ([]compute.InstancePool, error) {
+		return r.InstancePoolRepository.ListEnrichedInstancePools(ctx, compartmentID)
+	})
+}
@@ -0,0 +1,171 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeInstanceRepository counts calls to the underlying repository.
+type fakeInstanceRepository struct {
+	listCalls     int
+	enrichedCalls int
+	getCalls      int
+}
+
+func (f *fakeInstanceRepository) GetEnrichedInstance(ctx context.Context, ocid string) (*compute.Instance, error) {
+	f.getCalls++
+	return &compute.Instance{OCID: ocid}, nil
+}
+
+func (f *fakeInstanceRepository) ListEnrichedInstances(ctx context.Context, compartmentID string) ([]compute.Instance, error) {
+	f.enrichedCalls++
+	return []compute.Instance{{OCID: "ocid1.instance.oc1..a", DisplayName: "enriched"}}, nil
+}
+
+func (f *fakeInstanceRepository) ListInstances(ctx context.Context, compartmentID string) ([]compute.Instance, error) {
+	f.listCalls++
+	return []compute.Instance{{OCID: "ocid1.instance.oc1..a", DisplayName: "plain"}}, nil
+}
+
+func TestNewInstanceRepository_NilStore(t *testing.T) {
+	fake := &fakeInstanceRepository{}
+	assert.Same(t, compute.InstanceRepository(fake), NewInstanceRepository(fake, nil))
+}
+
+func TestInstanceRepository_CachesListings(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeInstanceRepository{}
+	repo := NewInstanceRepository(fake, NewStore(t.TempDir(), "t", time.Hour, false))
+
+	for i := 0; i < 2; i++ {
+		plain, err := repo.ListInstances(ctx, "comp")
+		require.NoError(t, err)
+		assert.Equal(t, "plain", plain[0].DisplayName)
+
+		enriched, err := repo.ListEnrichedInstances(ctx, "comp")
+		require.NoError(t, err)
+		assert.Equal(t, "enriched", enriched[0].DisplayName)
+
+		_, err = repo.GetEnrichedInstance(ctx, "ocid1.instance.oc1..a")
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 1, fake.listCalls)
+	assert.Equal(t, 1, fake.enrichedCalls, "plain and enriched listings are cached separately")
+	assert.Equal(t, 2, fake.getCalls, "lookups by OCID are not cached")
+}
+
+func TestInstanceRepository_CachesListingsWithDetailsApart(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore(t.TempDir(), "t", time.Hour, false)
+	plain := &fakeInstanceRepository{}
+	withDetails := &fakeInstanceRepository{}
+
+	for i := 0; i < 2; i++ {
+		_, err := NewInstanceRepository(plain, store).ListEnrichedInstances(ctx, "comp")
+		require.NoError(t, err)
+		_, err = NewInstanceRepositoryWithDetails(withDetails, store).ListEnrichedInstances(ctx, "comp")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, plain.enrichedCalls)
+	assert.Equal(t, 1, withDetails.enrichedCalls, "a plain enriched listing is never served for details")
+}
+
+type fakeShapeRepository struct {
+	calls int
+}
+
+func (f *fakeShapeRepository) ListShapes(ctx context.Context, compartmentID string) ([]compute.Shape, error) {
+	f.calls++
+	return []compute.Shape{{Name: "VM.Standard.E5.Flex", AvailabilityDomains: []string{"AD-1", "AD-2"}}}, nil
+}
+
+func TestShapeRepository_CachesListings(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeShapeRepository{}
+	repo := NewShapeRepository(fake, NewStore(t.TempDir(), "t", time.Hour, false))
+
+	for i := 0; i < 2; i++ {
+		shapes, err := repo.ListShapes(ctx, "comp")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"AD-1", "AD-2"}, shapes[0].AvailabilityDomains)
+	}
+	assert.Equal(t, 1, fake.calls)
+}
+
+type fakeInstancePoolRepository struct {
+	listCalls     int
+	enrichedCalls int
+}
+
+func (f *fakeInstancePoolRepository) GetEnrichedInstancePool(ctx context.Context, ocid string) (*compute.InstancePool, error) {
+	return &compute.InstancePool{OCID: ocid}, nil
+}
+
+func (f *fakeInstancePoolRepository) ListInstancePools(ctx context.Context, compartmentID string) ([]compute.InstancePool, error) {
+	f.listCalls++
+	return []compute.InstancePool{{OCID: "ocid1.instancepool.oc1..a", Size: 2}}, nil
+}
+
+func (f *fakeInstancePoolRepository) ListEnrichedInstancePools(ctx context.Context, compartmentID string) ([]compute.InstancePool, error) {
+	f.enrichedCalls++
+	return []compute.InstancePool{{OCID: "ocid1.instancepool.oc1..a", Size: 2, Members: []compute.Instance{{OCID: "ocid1.instance.oc1..a"}}}}, nil
+}
+
+func TestInstancePoolRepository_CachesListings(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeInstancePoolRepository{}
+	repo := NewInstancePoolRepository(fake, NewStore(t.TempDir(), "t", time.Hour, false))
+
+	for i := 0; i < 2; i++ {
+		pools, err := repo.ListInstancePools(ctx, "comp")
+		require.NoError(t, err)
+		assert.Empty(t, pools[0].Members)
+
+		enriched, err := repo.ListEnrichedInstancePools(ctx, "comp")
+		require.NoError(t, err)
+		assert.Len(t, enriched[0].Members, 1)
+	}
+	assert.Equal(t, 1, fake.listCalls)
+	assert.Equal(t, 1, fake.enrichedCalls)
+}
+
+// fakeClusterRepository lists one cluster, with add-ons when addons is set.
+type fakeClusterRepository struct {
+	compute.ClusterRepository
+	addons bool
+	calls  int
+}
+
+func (f *fakeClusterRepository) ListClusters(ctx context.Context, compartmentID string) ([]compute.Cluster, error) {
+	f.calls++
+	c := compute.Cluster{OCID: "ocid1.cluster.oc1..a"}
+	if f.addons {
+		c.Addons = []compute.ClusterAddon{{Name: "CertManager"}}
+	}
+	return []compute.Cluster{c}, nil
+}
+
+func TestClusterRepository_CachesListingsWithAddonsApart(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore(t.TempDir(), "t", time.Hour, false)
+	plain := &fakeClusterRepository{}
+	withAddons := &fakeClusterRepository{addons: true}
+
+	for i := 0; i < 2; i++ {
+		clusters, err := NewClusterRepository(plain, store).ListClusters(ctx, "comp")
+		require.NoError(t, err)
+		assert.Nil(t, clusters[0].Addons)
+
+		clusters, err = NewClusterRepositoryWithAddons(withAddons, store).ListClusters(ctx, "comp")
+		require.NoError(t, err)
+		assert.Len(t, clusters[0].Addons, 1, "a plain listing is never served for add-ons")
+	}
+	assert.Equal(t, 1, plain.calls)
+	assert.Equal(t, 1, withAddons.calls)
+}
@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/cnopslabs/ocloud/internal/domain/database"
+)
+
+// Resource names used for database cache entries.
+const (
+	ResourceAutonomousDatabases         = "autonomous-databases"
+	ResourceEnrichedAutonomousDatabases = "autonomous-databases-enriched"
+	ResourceHeatWaveDatabases           = "heatwave-databases"
+	ResourceEnrichedHeatWaveDatabases   = "heatwave-databases-enriched"
+	ResourceCacheClusters               = "cache-clusters"
+	ResourceEnrichedCacheClusters       = "cache-clusters-enriched"
+)
+
+// autonomousDatabaseRepository caches Autonomous Database listings.
+type autonomousDatabaseRepository struct {
+	database.AutonomousDatabaseRepository
+	store *Store
+}
+
+// NewAutonomousDatabaseRepository wraps repo with the cache. A nil store returns repo unchanged.
+func NewAutonomousDatabaseRepository(repo database.AutonomousDatabaseRepository, store *Store) database.AutonomousDatabaseRepository {
+	if store == nil {
+		return repo
+	}
+	return &autonomousDatabaseRepository{AutonomousDatabaseRepository: repo, store: store}
+}
+
+func (r *autonomousDatabaseRepository) ListAutonomousDatabases(ctx context.Context, compartmentID string) ([]database.AutonomousDatabase, error) {
+	return Fetch(r.store, compartmentID, ResourceAutonomousDatabases, func() ([]database.AutonomousDatabase, error) {
+		return r.AutonomousDatabaseRepository.ListAutonomousDatabases(ctx, compartmentID)
+	})
+}
+
+func (r *autonomousDatabaseRepository) ListEnrichedAutonomousDatabase(ctx context.Context, compartmentID string) ([]database.AutonomousDatabase, error) {
+	return Fetch(r.store, compartmentID, ResourceEnrichedAutonomousDatabases, func() ([]database.AutonomousDatabase, error) {
+		return r.AutonomousDatabaseRepository.ListEnrichedAutonomousDatabase(ctx, compartmentID)
+	})
+}
+
+// heatWaveDatabaseRepository caches HeatWave database listings.
+type heatWaveDatabaseRepository struct {
+	database.HeatWaveDatabaseRepository
+	store *Store
+}
+
+// NewHeatWaveDatabaseRepository wraps repo with the cache. A nil store returns repo unchanged.
+func NewHeatWaveDatabaseRepository(repo database.HeatWaveDatabaseRepository, store *Store) database.HeatWaveDatabaseRepository {
+	if store == nil {
+		return repo
+	}
+	return &heatWaveDatabaseRepository{HeatWaveDatabaseRepository: repo, store: store}
+}
+
+func (r *heatWaveDatabaseRepository) ListHeatWaveDatabases(ctx context.Context, compartmentID string) ([]database.HeatWaveDatabase, error) {
+	return Fetch(r.store, compartmentID, ResourceHeatWaveDatabases, func() ([]database.HeatWaveDatabase, error) {
+		return r.HeatWaveDatabaseRepository.ListHeatWaveDatabases(ctx, compartmentID)
+	})
+}
+
+func (r *heatWaveDatabaseRepository) ListEnrichedHeatWaveDatabases(ctx context.Context, compartmentID string) ([]database.HeatWaveDatabase, error) {
+	return Fetch(r.store, compartmentID, ResourceEnrichedHeatWaveDatabases, func() ([]database.HeatWaveDatabase, error) {
+		return r.HeatWaveDatabaseRepository.ListEnrichedHeatWaveDatabases(ctx, compartmentID)
+	})
+}
+
+// cacheClusterRepository caches OCI Cache cluster listings.
+type cacheClusterRepository struct {
+	database.CacheClusterRepository
+	store *Store
+}
+
+// NewCacheClusterRepository wraps repo with the cache. A nil store returns repo unchanged.
+func NewCacheClusterRepository(repo database.CacheClusterRepository, store *Store) database.CacheClusterRepository {
+	if store == nil {
+		return repo
+	}
+	return &cacheClusterRepository{CacheClusterRepository: repo, store: store}
+}
+
+func (r *cacheClusterRepository) ListCacheClusters(ctx context.Context, compartmentID string) ([]database.CacheCluster, error) {
+	return Fetch(r.store, compartmentID, ResourceCacheClusters, func() ([]database.CacheCluster, error) {
+		return r.CacheClusterRepository.ListCacheClusters(ctx, compartmentID)
+	})
+}
+
+func (r *cacheClusterRepository) ListEnrichedCacheClusters(ctx context.Context, compartmentID string) ([]database.CacheCluster, error) {
+	return Fetch(r.store, compartmentID, ResourceEnrichedCacheClusters, func() ([]database.CacheCluster, error) {
+		return r.CacheClusterRepository.ListEnrichedCacheClusters(ctx, compartmentID)
+	})
+}
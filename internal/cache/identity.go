@@ -0,0 +1,53 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/cnopslabs/ocloud/internal/domain/identity"
+)
+
+// Resource names used for identity cache entries.
+const (
+	ResourcePolicies     = "policies"
+	ResourceCompartments = "compartments"
+)
+
+// policyRepository caches policy listings; lookups by OCID pass through.
+type policyRepository struct {
+	identity.PolicyRepository
+	store *Store
+}
+
+// NewPolicyRepository wraps repo with the cache. A nil store returns repo unchanged.
+func NewPolicyRepository(repo identity.PolicyRepository, store *Store) identity.PolicyRepository {
+	if store == nil {
+		return repo
+	}
+	return &policyRepository{PolicyRepository: repo, store: store}
+}
+
+func (r *policyRepository) ListPolicies(ctx context.Context, compartmentID string) ([]identity.Policy, error) {
+	return Fetch(r.store, compartmentID, ResourcePolicies, func() ([]identity.Policy, error) {
+		return r.PolicyRepository.ListPolicies(ctx, compartmentID)
+	})
+}
+
+// compartmentRepository caches compartment listings; lookups by OCID pass through.
+type compartmentRepository struct {
+	identity.CompartmentRepository
+	store *Store
+}
+
+// NewCompartmentRepository wraps repo with the cache. A nil store returns repo unchanged.
+func NewCompartmentRepository(repo identity.CompartmentRepository, store *Store) identity.CompartmentRepository {
+	if store == nil {
+		return repo
+	}
+	return &compartmentRepository{CompartmentRepository: repo, store: store}
+}
+
+func (r *compartmentRepository) ListCompartments(ctx context.Context, ocid string) ([]identity.Compartment, error) {
+	return Fetch(r.store, ocid, ResourceCompartments, func() ([]identity.Compartment, error) {
+		return r.CompartmentRepository.ListCompartments(ctx, ocid)
+	})
+}
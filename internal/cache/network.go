@@ -0,0 +1,90 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/cnopslabs/ocloud/internal/domain/network/loadbalancer"
+	"github.com/cnopslabs/ocloud/internal/domain/network/subnet"
+	"github.com/cnopslabs/ocloud/internal/domain/network/vcn"
+)
+
+// Resource names used for network cache entries.
+const (
+	ResourceVCNs                  = "vcns"
+	ResourceEnrichedVCNs          = "vcns-enriched"
+	ResourceSubnets               = "subnets"
+	ResourceLoadBalancers         = "load-balancers"
+	ResourceEnrichedLoadBalancers = "load-balancers-enriched"
+)
+
+// vcnRepository caches VCN listings; lookups by OCID pass through.
+type vcnRepository struct {
+	vcn.VCNRepository
+	store *Store
+}
+
+// NewVCNRepository wraps repo with the cache. A nil store returns repo unchanged.
+func NewVCNRepository(repo vcn.VCNRepository, store *Store) vcn.VCNRepository {
+	if store == nil {
+		return repo
+	}
+	return &vcnRepository{VCNRepository: repo, store: store}
+}
+
+func (r *vcnRepository) ListVcns(ctx context.Context, compartmentID string) ([]vcn.VCN, error) {
+	return Fetch(r.store, compartmentID, ResourceVCNs, func() ([]vcn.VCN, error) {
+		return r.VCNRepository.ListVcns(ctx, compartmentID)
+	})
+}
+
+func (r *vcnRepository) ListEnrichedVcns(ctx context.Context, compartmentID string) ([]vcn.VCN, error) {
+	return Fetch(r.store, compartmentID, ResourceEnrichedVCNs, func() ([]vcn.VCN, error) {
+		return r.VCNRepository.ListEnrichedVcns(ctx, compartmentID)
+	})
+}
+
+// subnetRepository caches subnet listings; lookups by OCID pass through.
+type subnetRepository struct {
+	subnet.SubnetRepository
+	store *Store
+}
+
+// NewSubnetRepository wraps repo with the cache. A nil store returns repo unchanged.
+func NewSubnetRepository(repo subnet.SubnetRepository, store *Store) subnet.SubnetRepository {
+	if store == nil {
+		return repo
+	}
+	return &subnetRepository{SubnetRepository: repo, store: store}
+}
+
+func (r *subnetRepository) ListSubnets(ctx context.Context, compartmentID string) ([]subnet.Subnet, error) {
+	return Fetch(r.store, compartmentID, ResourceSubnets, func() ([]subnet.Subnet, error) {
+		return r.SubnetRepository.ListSubnets(ctx, compartmentID)
+	})
+}
+
+// loadBalancerRepository caches load balancer listings; lookups by OCID pass through.
+type loadBalancerRepository struct {
+	loadbalancer.LoadBalancerRepository
+	store *Store
+}
+
+// NewLoadBalancerRepository wraps repo with the cache. A nil store returns repo unchanged.
+func NewLoadBalancerRepository(repo loadbalancer.LoadBalancerRepository, store *Store) loadbalancer.LoadBalancerRepository {
+	if store == nil {
+		return repo
+	}
+	return &loadBalancerRepository{LoadBalancerRepository: repo, store: store}
+}
+
+func (r *loadBalancerRepository) ListLoadBalancers(ctx context.Context, compartmentID string) ([]loadbalancer.LoadBalancer, error) {
+	return Fetch(r.store, compartmentID, ResourceLoadBalancers, func() ([]loadbalancer.LoadBalancer, error) {
+		return r.LoadBalancerRepository.ListLoadBalancers(ctx, compartmentID)
+	})
+}
+
+func (r *loadBalancerRepository) ListEnrichedLoadBalancers(ctx context.Context, compartmentID string) ([]loadbalancer.LoadBalancer, error) {
+	return Fetch(r.store, compartmentID, ResourceEnrichedLoadBalancers, func() ([]loadbalancer.LoadBalancer, error) {
+		return r.LoadBalancerRepository.ListEnrichedLoadBalancers(ctx, compartmentID)
+	})
+}
@@ -0,0 +1,7 @@
+package cache
+
+// Resource names used for object storage cache entries. Bucket listings are
+// not cached, but their search index is persisted under this name.
+const (
+	ResourceBuckets = "buckets"
+)
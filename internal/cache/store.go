@@ -0,0 +1,371 @@
+// Package cache provides a persistent on-disk cache for OCI resource listings.
+//
+// Entries live under ~/.ocloud/cache/<tenancy>/<compartment>/<resource>.json and
+// expire after a configurable TTL. Repository decorators in this package wrap the
+// domain repository interfaces so that services transparently read from the cache.
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+)
+
+// schemaVersion is bumped whenever the on-disk entry layout changes; entries
+// with a different version are treated as misses.
+const schemaVersion = 7
+
+// DefaultTTL is used when no TTL is configured.
+const DefaultTTL = 5 * time.Minute
+
+// indexExt is the suffix of persisted search index directories.
+const indexExt = ".bleve"
+
+// Store reads and writes cache entries for a single tenancy.
+// A nil *Store is valid and disables caching.
+type Store struct {
+	root    string
+	tenancy string
+	ttl     time.Duration
+	refresh bool
+	scope   string
+	region  string
+	now     func() time.Time
+}
+
+// Entry is the on-disk representation of a cached resource listing.
+type Entry[T any] struct {
+	Version   int       `json:"version"`
+	FetchedAt time.Time `json:"fetchedAt"`
+	Items     []T       `json:"items"`
+}
+
+// EntryInfo describes a cache entry found on disk.
+type EntryInfo struct {
+	Tenancy     string        `json:"Tenancy"`
+	Compartment string        `json:"Compartment"`
+	Resource    string        `json:"Resource"`
+	Path        string        `json:"Path"`
+	SizeBytes   int64         `json:"SizeBytes"`
+	Items       int           `json:"Items"`
+	FetchedAt   time.Time     `json:"FetchedAt"`
+	Age         time.Duration `json:"Age"`
+	Expired     bool          `json:"Expired"`
+}
+
+// NewStore creates a Store rooted at dir for the given tenancy. When refresh is
+// true, cached entries are ignored and overwritten by fresh results.
+func NewStore(dir, tenancy string, ttl time.Duration, refresh bool) *Store {
+	return &Store{
+		root:    dir,
+		tenancy: tenancy,
+		ttl:     ttl,
+		refresh: refresh,
+		now:     time.Now,
+	}
+}
+
+// DefaultDir returns ~/.ocloud/cache.
+func DefaultDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("getting user home directory: %w", err)
+	}
+	return filepath.Join(home, flags.OCloudDefaultDirName, flags.OCloudCacheDirName), nil
+}
+
+// Root returns the cache root directory.
+func (s *Store) Root() string {
+	return s.root
+}
+
+// TTL returns how long entries stay fresh.
+func (s *Store) TTL() time.Duration {
+	return s.ttl
+}
+
+// Refreshing reports whether cached data should be ignored and rebuilt.
+func (s *Store) Refreshing() bool {
+	return s != nil && s.refresh
+}
+
+// WithRefresh returns a copy of the store that bypasses and rewrites cached data.
+func (s *Store) WithRefresh() *Store {
+	if s == nil {
+		return nil
+	}
+	c := *s
+	c.refresh = true
+	return &c
+}
+
+// WithIndexScope returns a copy of the store whose search indexes are kept
+// apart from the default ones under the given scope, so that indexes over a
+// different item set for the same compartment do not overwrite each other.
+// Scopes accumulate: scoping an already scoped store narrows it further.
+func (s *Store) WithIndexScope(scope string) *Store {
+	if s == nil {
+		return nil
+	}
+	c := *s
+	if c.scope != "" {
+		scope = c.scope + "." + scope
+	}
+	c.scope = scope
+	return &c
+}
+
+// WithRegion returns a copy of the store whose entries and indexes are kept
+// per region, so that listings of the same compartment in different regions
+// do not overwrite each other.
+func (s *Store) WithRegion(region string) *Store {
+	if s == nil {
+		return nil
+	}
+	c := *s
+	c.region = region
+	return &c
+}
+
+// IndexPath returns the directory of the persisted search index for a
+// compartment and resource. It returns "" for a nil store.
+func (s *Store) IndexPath(compartmentID, resource string) string {
+	if s == nil {
+		return ""
+	}
+	if s.scope != "" {
+		resource += "." + s.scope
+	}
+	return filepath.Join(s.dir(compartmentID), sanitize(resource)+indexExt)
+}
+
+// path returns the entry file for a compartment and resource.
+func (s *Store) path(compartmentID, resource string) string {
+	return filepath.Join(s.dir(compartmentID), sanitize(resource)+".json")
+}
+
+// dir returns the directory holding the data of a compartment. Data of a
+// region other than the configured one lives in "<compartment>@<region>".
+func (s *Store) dir(compartmentID string) string {
+	name := sanitize(compartmentID)
+	if s.region != "" {
+		name += "@" + sanitize(s.region)
+	}
+	return filepath.Join(s.root, sanitize(s.tenancy), name)
+}
+
+// Fetch returns the cached items for compartmentID/resource when a fresh entry
+// exists, otherwise it calls load and stores its result. Cache read and write
+// failures never fail the call; they only cause load to be used.
+func Fetch[T any](s *Store, compartmentID, resource string, load func() ([]T, error)) ([]T, error) {
+	if s == nil {
+		return load()
+	}
+
+	p := s.path(compartmentID, resource)
+	if !s.refresh {
+		if items, ok := read[T](p, s.ttl, s.now()); ok {
+			return items, nil
+		}
+	}
+
+	items, err := load()
+	if err != nil {
+		return nil, err
+	}
+	_ = write(p, Entry[T]{Version: schemaVersion, FetchedAt: s.now(), Items: items})
+	return items, nil
+}
+
+// Invalidate removes the entry for compartmentID/resource, if any.
+func (s *Store) Invalidate(compartmentID, resource string) error {
+	if s == nil {
+		return nil
+	}
+	err := os.Remove(s.path(compartmentID, resource))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("removing cache entry: %w", err)
+	}
+	return nil
+}
+
+// read loads an entry and reports whether it is present and fresh.
+func read[T any](path string, ttl time.Duration, now time.Time) ([]T, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	var e Entry[T]
+	if err := json.Unmarshal(data, &e); err != nil || e.Version != schemaVersion {
+		return nil, false
+	}
+	if now.Sub(e.FetchedAt) > ttl {
+		return nil, false
+	}
+	return e.Items, true
+}
+
+// write stores an entry atomically (temp file + rename) with user-only permissions.
+func write(path string, entry any) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("creating cache directory: %w", err)
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshalling cache entry: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*.json")
+	if err != nil {
+		return fmt.Errorf("creating temp cache file: %w", err)
+	}
+	tmpName := tmp.Name()
+	defer func() { _ = os.Remove(tmpName) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("writing temp cache file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("closing temp cache file: %w", err)
+	}
+	if err := os.Chmod(tmpName, 0o600); err != nil {
+		return fmt.Errorf("setting cache file permissions: %w", err)
+	}
+	if err := os.Rename(tmpName, path); err != nil {
+		return fmt.Errorf("renaming cache file: %w", err)
+	}
+	return nil
+}
+
+// Status lists every entry under dir, sorted by tenancy, compartment and resource.
+// Entries older than ttl are reported as expired.
+func Status(dir string, ttl time.Duration) ([]EntryInfo, error) {
+	now := time.Now()
+	var entries []EntryInfo
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			if filepath.Ext(path) == indexExt {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(path) != ".json" || strings.HasPrefix(d.Name(), ".tmp-") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		parts := strings.Split(filepath.ToSlash(rel), "/")
+		if len(parts) != 3 {
+			return nil
+		}
+
+		info := EntryInfo{
+			Tenancy:     parts[0],
+			Compartment: parts[1],
+			Resource:    strings.TrimSuffix(parts[2], ".json"),
+			Path:        path,
+		}
+		if fi, err := d.Info(); err == nil {
+			info.SizeBytes = fi.Size()
+		}
+		if data, err := os.ReadFile(path); err == nil {
+			var e Entry[json.RawMessage]
+			if json.Unmarshal(data, &e) == nil {
+				info.Items = len(e.Items)
+				info.FetchedAt = e.FetchedAt
+				info.Age = now.Sub(e.FetchedAt).Truncate(time.Second)
+				info.Expired = e.Version != schemaVersion || info.Age > ttl
+			}
+		}
+		entries = append(entries, info)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walking cache directory: %w", err)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.Tenancy != b.Tenancy {
+			return a.Tenancy < b.Tenancy
+		}
+		if a.Compartment != b.Compartment {
+			return a.Compartment < b.Compartment
+		}
+		return a.Resource < b.Resource
+	})
+	return entries, nil
+}
+
+// Clear removes cached entries and search indexes under dir. When tenancy is
+// empty the whole cache is removed; otherwise only that tenancy's data is. It
+// returns the number of listing entries removed.
+func Clear(dir, tenancy string) (int, error) {
+	target := dir
+	if tenancy != "" {
+		target = filepath.Join(dir, sanitize(tenancy))
+	}
+
+	count := 0
+	err := filepath.WalkDir(target, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			if filepath.Ext(path) == indexExt {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(path) == ".json" && !strings.HasPrefix(d.Name(), ".tmp-") {
+			count++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("walking cache directory: %w", err)
+	}
+
+	if err := os.RemoveAll(target); err != nil {
+		return 0, fmt.Errorf("removing cache directory: %w", err)
+	}
+	return count, nil
+}
+
+// sanitize turns a tenancy, compartment or resource name into a safe path element.
+func sanitize(s string) string {
+	s = strings.TrimSpace(s)
+	if s == "" || s == "." || s == ".." {
+		return "_"
+	}
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, s)
+}
@@ -0,0 +1,185 @@
+package cache
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type item struct {
+	Name string
+	Size int
+}
+
+// newTestStore returns a store rooted in a temp dir with a controllable clock.
+func newTestStore(t *testing.T, ttl time.Duration, refresh bool) (*Store, *time.Time) {
+	t.Helper()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	s := NewStore(t.TempDir(), "my-tenancy", ttl, refresh)
+	s.now = func() time.Time { return now }
+	return s, &now
+}
+
+func countingLoader(calls *int, items []item) func() ([]item, error) {
+	return func() ([]item, error) {
+		*calls++
+		return items, nil
+	}
+}
+
+func TestFetch_CachesUntilTTL(t *testing.T) {
+	s, now := newTestStore(t, time.Minute, false)
+	calls := 0
+	load := countingLoader(&calls, []item{{Name: "a", Size: 1}})
+
+	got, err := Fetch(s, "ocid1.compartment.oc1..x", "instances", load)
+	require.NoError(t, err)
+	assert.Equal(t, []item{{Name: "a", Size: 1}}, got)
+	assert.Equal(t, 1, calls)
+
+	got, err = Fetch(s, "ocid1.compartment.oc1..x", "instances", load)
+	require.NoError(t, err)
+	assert.Equal(t, []item{{Name: "a", Size: 1}}, got)
+	assert.Equal(t, 1, calls, "fresh entry should be served from disk")
+
+	*now = now.Add(2 * time.Minute)
+	_, err = Fetch(s, "ocid1.compartment.oc1..x", "instances", load)
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls, "expired entry should be reloaded")
+
+	info, err := os.Stat(s.path("ocid1.compartment.oc1..x", "instances"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
+
+func TestFetch_Refresh(t *testing.T) {
+	s, _ := newTestStore(t, time.Hour, false)
+	calls := 0
+	_, err := Fetch(s, "c", "images", countingLoader(&calls, []item{{Name: "old"}}))
+	require.NoError(t, err)
+
+	s.refresh = true
+	got, err := Fetch(s, "c", "images", countingLoader(&calls, []item{{Name: "new"}}))
+	require.NoError(t, err)
+	assert.Equal(t, []item{{Name: "new"}}, got)
+	assert.Equal(t, 2, calls)
+
+	s.refresh = false
+	got, err = Fetch(s, "c", "images", countingLoader(&calls, nil))
+	require.NoError(t, err)
+	assert.Equal(t, []item{{Name: "new"}}, got, "refresh should rewrite the entry")
+	assert.Equal(t, 2, calls)
+}
+
+func TestFetch_LoadErrorIsNotCached(t *testing.T) {
+	s, _ := newTestStore(t, time.Hour, false)
+	boom := errors.New("boom")
+
+	_, err := Fetch(s, "c", "vcns", func() ([]item, error) { return nil, boom })
+	assert.ErrorIs(t, err, boom)
+
+	_, err = os.Stat(s.path("c", "vcns"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestFetch_NilStore(t *testing.T) {
+	calls := 0
+	for i := 0; i < 2; i++ {
+		_, err := Fetch(nil, "c", "vcns", countingLoader(&calls, []item{{Name: "a"}}))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, calls)
+}
+
+func TestFetch_CorruptEntryIsReloaded(t *testing.T) {
+	s, _ := newTestStore(t, time.Hour, false)
+	p := s.path("c", "subnets")
+	require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o700))
+	require.NoError(t, os.WriteFile(p, []byte("{not json"), 0o600))
+
+	calls := 0
+	got, err := Fetch(s, "c", "subnets", countingLoader(&calls, []item{{Name: "a"}}))
+	require.NoError(t, err)
+	assert.Equal(t, []item{{Name: "a"}}, got)
+	assert.Equal(t, 1, calls)
+}
+
+func TestStatusAndClear(t *testing.T) {
+	dir := t.TempDir()
+	a := NewStore(dir, "tenancy-a", time.Hour, false)
+	b := NewStore(dir, "tenancy-b", time.Hour, false)
+	old := time.Now().Add(-2 * time.Hour)
+	b.now = func() time.Time { return old }
+
+	_, err := Fetch(a, "comp-1", "instances", func() ([]item, error) { return []item{{Name: "x"}, {Name: "y"}}, nil })
+	require.NoError(t, err)
+	_, err = Fetch(b, "comp-2", "vcns", func() ([]item, error) { return []item{{Name: "z"}}, nil })
+	require.NoError(t, err)
+
+	entries, err := Status(dir, time.Hour)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "tenancy-a", entries[0].Tenancy)
+	assert.Equal(t, "comp-1", entries[0].Compartment)
+	assert.Equal(t, "instances", entries[0].Resource)
+	assert.Equal(t, 2, entries[0].Items)
+	assert.False(t, entries[0].Expired)
+	assert.Equal(t, "tenancy-b", entries[1].Tenancy)
+	assert.True(t, entries[1].Expired)
+
+	removed, err := Clear(dir, "tenancy-b")
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+
+	entries, err = Status(dir, time.Hour)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	removed, err = Clear(dir, "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+
+	entries, err = Status(dir, time.Hour)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestSanitize(t *testing.T) {
+	assert.Equal(t, "_", sanitize(""))
+	assert.Equal(t, "_", sanitize(".."))
+	assert.Equal(t, "ocid1.compartment.oc1..abc", sanitize("ocid1.compartment.oc1..abc"))
+	assert.Equal(t, "a_b_c", sanitize("a/b c"))
+	assert.Equal(t, "_.._x", sanitize("/../x"))
+}
+
+func TestIndexPath_Scope(t *testing.T) {
+	s := NewStore("/cache", "my-tenancy", time.Minute, false)
+	scoped := s.WithIndexScope("subtree")
+
+	assert.Equal(t, filepath.Join("/cache", "my-tenancy", "comp", "instances.bleve"), s.IndexPath("comp", "instances"))
+	assert.Equal(t, filepath.Join("/cache", "my-tenancy", "comp", "instances.subtree.bleve"), scoped.IndexPath("comp", "instances"))
+	assert.Equal(t, filepath.Join("/cache", "my-tenancy", "comp", "instances.subtree.regions-a_b.bleve"), scoped.WithIndexScope("regions-a_b").IndexPath("comp", "instances"))
+
+	var nilStore *Store
+	assert.Nil(t, nilStore.WithIndexScope("subtree"))
+}
+
+func TestWithRegion_SeparatesEntries(t *testing.T) {
+	s, _ := newTestStore(t, time.Hour, false)
+	phoenix := s.WithRegion("us-phoenix-1")
+
+	calls := 0
+	_, err := Fetch(s, "comp", "instances", countingLoader(&calls, []item{{Name: "iad"}}))
+	require.NoError(t, err)
+	items, err := Fetch(phoenix, "comp", "instances", countingLoader(&calls, []item{{Name: "phx"}}))
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, calls, "each region has its own entry")
+	assert.Equal(t, "phx", items[0].Name)
+	assert.Equal(t, filepath.Join(s.Root(), "my-tenancy", "comp@us-phoenix-1", "instances.bleve"), phoenix.IndexPath("comp", "instances"))
+}
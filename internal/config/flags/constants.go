@@ -15,6 +15,9 @@ const (
 	FlagNameLimit        = "limit"
 	FlagNamePage         = "page"
 	FlagNameJSON         = "json"
+	FlagNameOutput       = "output"
+	FlagNameQuery        = "query"
+	FlagNameFields       = "fields"
 	FlagNameVersion      = "version"
 	FlagNameAll          = "all"
 	FlagNameSort         = "sort"
@@ -22,6 +25,43 @@ const (
 	FlagNameFilter       = "filter"
 	FlagNameScope        = "scope"
 	FlagNameTenancyScope = "tenancy-scope"
+	FlagNameCacheTTL     = "cache-ttl"
+	FlagNameRefresh      = "refresh"
+	FlagNameRecursive    = "recursive"
+	FlagNameRegions      = "regions"
+	FlagNameAllRegions   = "all-subscribed-regions"
+	FlagNameWatch        = "watch"
+	FlagNameUntilState   = "until-state"
+	FlagNameYes          = "yes"
+	FlagNameNoWait       = "no-wait"
+)
+
+// Flag Names (instance export)
+const (
+	FlagNameFormat         = "format"
+	FlagNameUser           = "user"
+	FlagNameIdentityFile   = "identity-file"
+	FlagNameBastionSession = "bastion-session"
+	FlagNameExec           = "exec"
+	FlagNameMetadata       = "metadata"
+)
+
+// Flag Names (image lifecycle)
+const (
+	FlagNameFromInstance = "from-instance"
+	FlagNameBucket       = "bucket"
+	FlagNameObject       = "object"
+	FlagNameName         = "name"
+	FlagNameOS           = "os"
+	FlagNameOSVersion    = "os-version"
+	FlagNameShape        = "shape"
+)
+
+// Flag Names (OKE)
+const (
+	FlagNameNodes       = "nodes"
+	FlagNameEndpoint    = "endpoint"
+	FlagNameKubeContext = "context"
 )
 
 // Flag Names (network toggles)
@@ -45,12 +85,14 @@ const (
 	FlagShortLimit        = "m"
 	FlagShortPage         = "p"
 	FlagShortJSON         = "j"
+	FlagShortOutput       = "o"
 	FlagShortVersion      = "v"
 	FlagShortSort         = "s"
 	FlagShortRealm        = "r"
 	FlagShortFilter       = "f"
 	FlagShortAll          = "A"
 	FlagShortTenancyScope = "T"
+	FlagShortYes          = "y"
 
 	// Network toggles (avoid collisions with common flags)
 	FlagShortGateway  = "G"
@@ -73,7 +115,10 @@ const (
 	FlagDescHelp         = "Show help"
 	FlagDescLimit        = "Maximum number of records to display per page"
 	FlagDescPage         = "Page number to display"
-	FlagDescJSON         = "Output information in JSON format"
+	FlagDescJSON         = "Output information in JSON format (shorthand for --output json)"
+	FlagDescOutput       = "Output format: table, json, yaml, csv, tsv or template=<go-template>"
+	FlagDescQuery        = "JMESPath-style query applied to structured output (e.g., '[].Name')"
+	FlagDescFields       = "Comma-separated fields to keep in structured output (e.g., Name,IP)"
 	FlagDescVersion      = "Print the ocloud CLI version"
 	FlagDescSort         = "Sort results by field (e.g., name, cidr)"
 	FlagDescRealm        = "Filter by realm (e.g., OC1, OC2, OC3)"
@@ -81,6 +126,41 @@ const (
 	FlagDescAll          = "Show all information"
 	FlagDescScope        = "Listing scope: compartment or tenancy"
 	FlagDescTenancyScope = "Shortcut: list at tenancy level (overrides --scope)"
+	FlagDescCacheTTL     = "How long cached list results stay fresh (e.g., 30s, 10m); 0 disables the cache"
+	FlagDescRefresh      = "Bypass the local cache and refresh it with live results"
+	FlagDescRecursive    = "Include every compartment below the selected one, annotating rows with their compartment path"
+	FlagDescRegions      = "Comma-separated regions to query in parallel (e.g., us-ashburn-1,eu-frankfurt-1)"
+	FlagDescAllRegions   = "Query every region the tenancy is subscribed to"
+	FlagDescWatch        = "Re-run the query every interval (default 10s, e.g. --watch=30s) and highlight state changes"
+	FlagDescUntilState   = "With --watch, exit once every resource reaches this lifecycle state (e.g., AVAILABLE, STOPPED)"
+	FlagDescYes          = "Skip the confirmation prompt"
+	FlagDescNoWait       = "Return once OCI accepted the request instead of waiting for the new lifecycle state"
+
+	// Instance export
+	FlagDescFormat         = "Export format: ssh-config, ansible-ini or ansible-yaml"
+	FlagDescUser           = "SSH user for every host (default: opc, or ubuntu on Ubuntu images)"
+	FlagDescIdentityFile   = "Private key to connect with, also used for the bastion"
+	FlagDescBastionSession = "Bastion session OCID to route its target instance through with a ProxyCommand"
+	FlagDescExec           = "Run the ssh command instead of printing it"
+	FlagDescMetadata       = "Show the decoded user data, SSH key fingerprints, launch options and agent plugins"
+
+	// Image lifecycle
+	FlagDescFromInstance      = "Instance (name or OCID) whose boot volume the image is captured from"
+	FlagDescBucket            = "Object Storage bucket in the tenancy namespace"
+	FlagDescObject            = "Object name in the bucket"
+	FlagDescName              = "Display name of the image"
+	FlagDescOS                = "Operating system of the imported image (e.g., Oracle Linux)"
+	FlagDescOSVersion         = "Operating system version of the imported image (e.g., 9)"
+	FlagDescImageExportFormat = "Export format: OCI, QCOW2, VMDK, VHD or VDI"
+	FlagDescImageImportFormat = "Import format: OCI, QCOW2 or VMDK (default: from the object extension, else OCI)"
+	FlagDescPlatformOS        = "Operating system of the platform image (e.g., Oracle Linux)"
+	FlagDescPlatformVersion   = "Operating system version of the platform image (e.g., 9)"
+	FlagDescShape             = "Shape the image must be compatible with (e.g., VM.Standard.E5.Flex)"
+
+	// OKE
+	FlagDescNodes       = "List the nodes of every node pool with their instance, IP, placement, subnet and NSGs"
+	FlagDescEndpoint    = "Cluster endpoint to reach: public or private (default: public when the cluster has one)"
+	FlagDescKubeContext = "Name of the kube context (default: context-<cluster id suffix>-<endpoint>)"
 
 	// Network
 	FlagDescGateway  = "Display gateway information"
@@ -126,6 +206,7 @@ const (
 	EnvKeyRegion         = "OCI_REGION"
 	EnvKeyTenancyMapPath = "OCI_TENANCY_MAP_PATH"
 	EnvKeyPortForwarding = "PORT_FORWARDING"
+	EnvKeyCacheTTL       = "OCLOUD_CACHE_TTL"
 )
 
 // ============================================================================
@@ -134,11 +215,15 @@ const (
 const (
 	DefaultProfileName = "DEFAULT"
 
-	OCIConfigDirName     = ".oci"
-	OCIConfigFileName    = "config"
-	OCloudDefaultDirName = ".ocloud"
-	OCloudScriptsDirName = "scripts"
-	OCISessionsDirName   = "sessions"
-	TenancyMapFileName   = "tenancy-map.yaml"
-	OCIRefresherPIDFile  = "refresher.pid"
+	OCIConfigDirName       = ".oci"
+	OCIConfigFileName      = "config"
+	OCloudDefaultDirName   = ".ocloud"
+	OCloudCacheDirName     = "cache"
+	OCloudSnapshotsDirName = "snapshots"
+	DefaultCacheTTL        = "5m"
+	DefaultWatchInterval   = "10s"
+	OCloudScriptsDirName   = "scripts"
+	OCISessionsDirName     = "sessions"
+	TenancyMapFileName     = "tenancy-map.yaml"
+	OCIRefresherPIDFile    = "refresher.pid"
 )
@@ -25,6 +25,15 @@ func TestFlagNames(t *testing.T) {
 	assert.Equal(t, "filter", FlagNameFilter)
 	assert.Equal(t, "scope", FlagNameScope)
 	assert.Equal(t, "tenancy-scope", FlagNameTenancyScope)
+	assert.Equal(t, "cache-ttl", FlagNameCacheTTL)
+	assert.Equal(t, "refresh", FlagNameRefresh)
+	assert.Equal(t, "recursive", FlagNameRecursive)
+	assert.Equal(t, "regions", FlagNameRegions)
+	assert.Equal(t, "all-subscribed-regions", FlagNameAllRegions)
+	assert.Equal(t, "watch", FlagNameWatch)
+	assert.Equal(t, "until-state", FlagNameUntilState)
+	assert.Equal(t, "yes", FlagNameYes)
+	assert.Equal(t, "no-wait", FlagNameNoWait)
 
 	// Test network toggle flag names
 	assert.Equal(t, "gateway", FlagNameGateway)
@@ -49,6 +58,7 @@ func TestFlagShorthands(t *testing.T) {
 	assert.Equal(t, "f", FlagShortFilter)
 	assert.Equal(t, "A", FlagShortAll)
 	assert.Equal(t, "T", FlagShortTenancyScope)
+	assert.Equal(t, "y", FlagShortYes)
 
 	// Test network toggle flag shorthands
 	assert.Equal(t, "G", FlagShortGateway)
@@ -76,6 +86,13 @@ func TestFlagDescriptions(t *testing.T) {
 	assert.NotEmpty(t, FlagDescAll)
 	assert.NotEmpty(t, FlagDescScope)
 	assert.NotEmpty(t, FlagDescTenancyScope)
+	assert.NotEmpty(t, FlagDescRecursive)
+	assert.NotEmpty(t, FlagDescRegions)
+	assert.NotEmpty(t, FlagDescAllRegions)
+	assert.NotEmpty(t, FlagDescWatch)
+	assert.NotEmpty(t, FlagDescUntilState)
+	assert.NotEmpty(t, FlagDescYes)
+	assert.NotEmpty(t, FlagDescNoWait)
 
 	// Test network flag descriptions
 	assert.NotEmpty(t, FlagDescGateway)
@@ -117,6 +134,8 @@ func TestDefaults(t *testing.T) {
 	assert.Equal(t, ".oci", OCIConfigDirName)
 	assert.Equal(t, "config", OCIConfigFileName)
 	assert.Equal(t, ".ocloud", OCloudDefaultDirName)
+	assert.Equal(t, "snapshots", OCloudSnapshotsDirName)
+	assert.Equal(t, "10s", DefaultWatchInterval)
 	assert.Equal(t, "scripts", OCloudScriptsDirName)
 	assert.Equal(t, "sessions", OCISessionsDirName)
 	assert.Equal(t, "tenancy-map.yaml", TenancyMapFileName)
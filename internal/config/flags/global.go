@@ -60,6 +60,32 @@ var (
 		Default:   false,
 		Usage:     FlagDescJSON,
 	}
+	OutputFlag = StringFlag{
+		Name:      FlagNameOutput,
+		Shorthand: FlagShortOutput,
+		Default:   "",
+		Usage:     FlagDescOutput,
+	}
+	QueryFlag = StringFlag{
+		Name:    FlagNameQuery,
+		Default: "",
+		Usage:   FlagDescQuery,
+	}
+	FieldsFlag = StringFlag{
+		Name:    FlagNameFields,
+		Default: "",
+		Usage:   FlagDescFields,
+	}
+	CacheTTLFlag = StringFlag{
+		Name:    FlagNameCacheTTL,
+		Default: DefaultCacheTTL,
+		Usage:   FlagDescCacheTTL,
+	}
+	RefreshFlag = BoolFlag{
+		Name:    FlagNameRefresh,
+		Default: false,
+		Usage:   FlagDescRefresh,
+	}
 )
 
 // globalFlags is a slice of all global flags for batch registration
@@ -72,6 +98,11 @@ var globalFlags = []Flag{
 	CompartmentFlag,
 	HelpFlag,
 	JSONFlag,
+	OutputFlag,
+	QueryFlag,
+	FieldsFlag,
+	CacheTTLFlag,
+	RefreshFlag,
 }
 
 // AddGlobalFlags adds all global flags to the given command
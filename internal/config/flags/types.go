@@ -30,21 +30,27 @@ func (f BoolFlag) Apply(flags *pflag.FlagSet) {
 
 // StringFlag represents a string command flag configuration with a name, optional shorthand,
 // default value, and usage description. It implements the Flag interface for string flags.
+// When NoOptDefVal is set the flag may be given without a value, in which case it takes
+// NoOptDefVal; a value must then be attached with "=" (e.g. --watch=5s).
 type StringFlag struct {
-	Name      string
-	Shorthand string
-	Default   string
-	Usage     string
+	Name        string
+	Shorthand   string
+	Default     string
+	NoOptDefVal string
+	Usage       string
 }
 
 // Add adds the string flag to the command
 func (f StringFlag) Add(cmd *cobra.Command) {
-	cmd.Flags().StringP(f.Name, f.Shorthand, f.Default, f.Usage)
+	f.Apply(cmd.Flags())
 }
 
 // Apply adds the string flag to the given flag set
 func (f StringFlag) Apply(flags *pflag.FlagSet) {
 	flags.StringP(f.Name, f.Shorthand, f.Default, f.Usage)
+	if f.NoOptDefVal != "" {
+		flags.Lookup(f.Name).NoOptDefVal = f.NoOptDefVal
+	}
 }
 
 // IntFlag represents an integer command flag configuration with a name, optional shorthand,
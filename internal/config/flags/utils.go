@@ -15,6 +15,11 @@
 package flags
 
 import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/spf13/cobra"
 )
 
@@ -86,3 +91,66 @@ func GetDurationFlag(cmd *cobra.Command, flagName string, defaultValue int64) in
 	}
 	return value
 }
+
+// GetOutputFormat resolves the requested output format from the --output flag.
+// The --json flag is kept as a shorthand for --output json; when neither is set,
+// the table output is returned. An explicit --output value takes precedence.
+// A --query or --fields projection implies JSON unless another structured
+// format was requested.
+func GetOutputFormat(cmd *cobra.Command) (printer.OutputFormat, error) {
+	format := printer.TableOutput
+	if value := GetStringFlag(cmd, FlagNameOutput, ""); value != "" {
+		parsed, err := printer.ParseOutputFormat(value)
+		if err != nil {
+			return printer.OutputFormat{}, err
+		}
+		format = parsed
+	} else if GetBoolFlag(cmd, FlagNameJSON, false) {
+		format = printer.JSONOutput
+	}
+
+	format.Query = GetStringFlag(cmd, FlagNameQuery, "")
+	format.Fields = GetStringFlag(cmd, FlagNameFields, "")
+	if format.HasProjection() && format.IsTable() {
+		format.Format = printer.FormatJSON
+	}
+	return format, nil
+}
+
+// GetCacheTTL resolves how long cached list results stay fresh. An explicit
+// --cache-ttl flag takes precedence over the OCLOUD_CACHE_TTL environment
+// variable; otherwise DefaultCacheTTL is used. A zero or negative TTL disables the cache.
+func GetCacheTTL(cmd *cobra.Command) (time.Duration, error) {
+	value := DefaultCacheTTL
+	if f := cmd.Flags().Lookup(FlagNameCacheTTL); f != nil && f.Changed {
+		value = f.Value.String()
+	} else if env := os.Getenv(EnvKeyCacheTTL); env != "" {
+		value = env
+	}
+
+	ttl, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cache TTL %q: %w", value, err)
+	}
+	return ttl, nil
+}
+
+// GetWatchInterval reports whether --watch was given and at which interval.
+// A bare --watch polls every DefaultWatchInterval; intervals below one second
+// are rejected to keep the polling within OCI rate limits.
+func GetWatchInterval(cmd *cobra.Command) (time.Duration, bool, error) {
+	f := cmd.Flags().Lookup(FlagNameWatch)
+	if f == nil || !f.Changed {
+		return 0, false, nil
+	}
+
+	value := f.Value.String()
+	interval, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid watch interval %q: %w", value, err)
+	}
+	if interval < time.Second {
+		return 0, false, fmt.Errorf("watch interval %s is below the 1s minimum", interval)
+	}
+	return interval, true, nil
+}
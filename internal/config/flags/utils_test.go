@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 )
@@ -333,3 +334,106 @@ func TestFlagErrorHandling(t *testing.T) {
 		assert.Equal(t, 42, result) // Should return default value on error
 	})
 }
+
+func TestGetOutputFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected printer.OutputFormat
+		wantErr  bool
+	}{
+		{name: "default is table", args: nil, expected: printer.TableOutput},
+		{name: "json shorthand", args: []string{"--json"}, expected: printer.JSONOutput},
+		{name: "output yaml", args: []string{"--output", "yaml"}, expected: printer.OutputFormat{Format: printer.FormatYAML}},
+		{name: "output wins over json", args: []string{"--json", "-o", "csv"}, expected: printer.OutputFormat{Format: printer.FormatCSV}},
+		{name: "template", args: []string{"-o", "template={{.Name}}"}, expected: printer.OutputFormat{Format: printer.FormatTemplate, Template: "{{.Name}}"}},
+		{name: "invalid format", args: []string{"-o", "xml"}, wantErr: true},
+		{name: "query implies json", args: []string{"--query", "[].Name"}, expected: printer.OutputFormat{Format: printer.FormatJSON, Query: "[].Name"}},
+		{name: "fields keep yaml", args: []string{"-o", "yaml", "--fields", "Name,IP"}, expected: printer.OutputFormat{Format: printer.FormatYAML, Fields: "Name,IP"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{Use: "test"}
+			JSONFlag.Apply(cmd.Flags())
+			OutputFlag.Apply(cmd.Flags())
+			QueryFlag.Apply(cmd.Flags())
+			FieldsFlag.Apply(cmd.Flags())
+			assert.NoError(t, cmd.Flags().Parse(tt.args))
+
+			got, err := GetOutputFormat(cmd)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestGetCacheTTL(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		env      string
+		expected time.Duration
+		wantErr  bool
+	}{
+		{name: "default", expected: 5 * time.Minute},
+		{name: "env", env: "30s", expected: 30 * time.Second},
+		{name: "flag wins over env", args: []string{"--cache-ttl", "1h"}, env: "30s", expected: time.Hour},
+		{name: "zero disables", args: []string{"--cache-ttl", "0"}, expected: 0},
+		{name: "invalid", args: []string{"--cache-ttl", "soon"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvKeyCacheTTL, tt.env)
+			cmd := &cobra.Command{Use: "test"}
+			CacheTTLFlag.Apply(cmd.Flags())
+			assert.NoError(t, cmd.Flags().Parse(tt.args))
+
+			got, err := GetCacheTTL(cmd)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestGetWatchInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		watching bool
+		expected time.Duration
+		wantErr  bool
+	}{
+		{name: "not watching"},
+		{name: "bare flag", args: []string{"--watch"}, watching: true, expected: 10 * time.Second},
+		{name: "custom interval", args: []string{"--watch=1m"}, watching: true, expected: time.Minute},
+		{name: "too short", args: []string{"--watch=100ms"}, wantErr: true},
+		{name: "invalid", args: []string{"--watch=often"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{Use: "test"}
+			StringFlag{Name: FlagNameWatch, NoOptDefVal: DefaultWatchInterval, Usage: FlagDescWatch}.Apply(cmd.Flags())
+			assert.NoError(t, cmd.Flags().Parse(tt.args))
+
+			got, watching, err := GetWatchInterval(cmd)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.watching, watching)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
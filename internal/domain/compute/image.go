@@ -21,3 +21,61 @@ type ImageRepository interface {
 	ListImages(ctx context.Context, compartmentID string) ([]Image, error)
 	GetImage(ctx context.Context, ocid string) (*Image, error)
 }
+
+// ImageShapeCompatibility is a shape an image can be launched on. On flexible
+// shapes the image may limit the OCPUs and memory; 0 means no limit.
+type ImageShapeCompatibility struct {
+	Shape       string
+	MinOCPUs    int
+	MaxOCPUs    int
+	MinMemoryGB int
+	MaxMemoryGB int
+}
+
+// ImageCatalog defines the port for finding platform images and the shapes
+// images run on.
+type ImageCatalog interface {
+	// ListPlatformImages returns the available Oracle-provided images of an
+	// operating system version, newest first.
+	ListPlatformImages(ctx context.Context, compartmentID, operatingSystem, version string) ([]Image, error)
+	ListCompatibleShapes(ctx context.Context, imageID string) ([]ImageShapeCompatibility, error)
+}
+
+// ObjectLocation names an object in Object Storage.
+type ObjectLocation struct {
+	Namespace string
+	Bucket    string
+	Object    string
+}
+
+// ImageImport describes a custom image imported from Object Storage.
+type ImageImport struct {
+	CompartmentID string
+	DisplayName   string
+	Source        ObjectLocation
+	// SourceImageType is QCOW2 or VMDK; empty for images exported in the OCI
+	// format, which carry their own metadata.
+	SourceImageType        string
+	OperatingSystem        string
+	OperatingSystemVersion string
+}
+
+// WorkRequest tracks an asynchronous operation such as an image export.
+type WorkRequest struct {
+	ID              string
+	OperationType   string
+	Status          string
+	PercentComplete float32
+	TimeFinished    time.Time
+	// Errors holds the messages of a failed work request.
+	Errors []string
+}
+
+// ImageController defines the port for creating, exporting and importing custom
+// images. Each call returns the ID of the work request that tracks it.
+type ImageController interface {
+	CreateImageFromInstance(ctx context.Context, compartmentID, instanceID, displayName string) (*Image, string, error)
+	ExportImage(ctx context.Context, imageID string, dest ObjectLocation, format string) (string, error)
+	ImportImage(ctx context.Context, req ImageImport) (*Image, string, error)
+	GetWorkRequest(ctx context.Context, workRequestID string) (*WorkRequest, error)
+}
@@ -8,6 +8,7 @@ import (
 // Instance represents a compute instance in the cloud.
 type Instance struct {
 	OCID               string
+	CompartmentID      string
 	DisplayName        string
 	State              string
 	Shape              string
@@ -20,6 +21,14 @@ type Instance struct {
 	MemoryGB           float32
 	FreeformTags       map[string]string
 	DefinedTags        map[string]map[string]interface{}
+	// Metadata holds the launch metadata, e.g. ssh_authorized_keys and the
+	// base64-encoded user_data. Since user_data often holds secrets, Metadata
+	// and ExtendedMetadata are only loaded on request and never cached.
+	Metadata         map[string]string
+	ExtendedMetadata map[string]interface{}
+	LaunchMode       string
+	LaunchOptions    *LaunchOptions
+	AgentConfig      *AgentConfig
 	// Enriched fields
 	PrimaryIP         string
 	SubnetID          string
@@ -36,6 +45,96 @@ type Instance struct {
 	SecurityListNames []string
 	NsgIDs            []string
 	NsgNames          []string
+	// PublicIP is the public IP of the primary private IP, ephemeral or reserved.
+	PublicIP string
+	// Vnics lists every VNIC attachment, the primary one first.
+	Vnics []Vnic
+	// Storage fields, only set by lookups that ask for storage details
+	BootVolume   *BootVolume
+	BlockVolumes []VolumeAttachment
+	// AgentPlugins are the Oracle Cloud Agent plugins as the agent reports them,
+	// only set by lookups that ask for them.
+	AgentPlugins []AgentPlugin
+}
+
+// LaunchOptions are the emulation and paravirtualization options of an instance.
+type LaunchOptions struct {
+	BootVolumeType                  string
+	Firmware                        string
+	NetworkType                     string
+	RemoteDataVolumeType            string
+	IsPvEncryptionInTransitEnabled  bool
+	IsConsistentVolumeNamingEnabled bool
+}
+
+// AgentConfig is the Oracle Cloud Agent configuration of an instance.
+type AgentConfig struct {
+	IsMonitoringDisabled  bool
+	IsManagementDisabled  bool
+	AreAllPluginsDisabled bool
+	// PluginsConfig maps plugin names to their desired state, ENABLED or DISABLED.
+	PluginsConfig map[string]string
+}
+
+// AgentPlugin is the state of an Oracle Cloud Agent plugin on an instance.
+type AgentPlugin struct {
+	Name string
+	// Status is RUNNING, STOPPED, NOT_SUPPORTED or INVALID.
+	Status          string
+	TimeLastUpdated time.Time
+}
+
+// Vnic describes a VNIC attached to an instance with its addresses.
+type Vnic struct {
+	ID            string
+	Name          string
+	IsPrimary     bool
+	MacAddress    string
+	HostnameLabel string
+	SubnetID      string
+	SubnetName    string
+	NsgIDs        []string
+	NsgNames      []string
+	// PrivateIP is the primary private IP of the VNIC.
+	PrivateIP string
+	// PublicIP and PublicIPLifetime (EPHEMERAL or RESERVED) describe the
+	// public IP assigned to PrivateIP, if any.
+	PublicIP         string
+	PublicIPLifetime string
+	SecondaryIPs     []PrivateIP
+}
+
+// PrivateIP is a secondary private IP of a VNIC and its public IP, if any.
+type PrivateIP struct {
+	IP               string
+	HostnameLabel    string
+	PublicIP         string
+	PublicIPLifetime string
+}
+
+// BootVolume describes the boot volume of an instance.
+type BootVolume struct {
+	ID              string
+	Name            string
+	State           string
+	SizeGB          int64
+	VpusPerGB       int64
+	PerformanceTier string
+}
+
+// VolumeAttachment describes a block volume attached to an instance.
+type VolumeAttachment struct {
+	ID              string
+	VolumeID        string
+	VolumeName      string
+	State           string
+	Device          string
+	AttachmentType  string
+	ReadOnly        bool
+	Shareable       bool
+	SizeGB          int64
+	VpusPerGB       int64
+	PerformanceTier string
 }
 
 // InstanceRepository defines the port for interacting with instance storage.
@@ -45,3 +144,66 @@ type InstanceRepository interface {
 	ListEnrichedInstances(ctx context.Context, compartmentID string) ([]Instance, error)
 	ListInstances(ctx context.Context, compartmentID string) ([]Instance, error)
 }
+
+// InstanceAction is a power action on an instance, named as in the OCI API.
+type InstanceAction string
+
+// Power actions supported by InstanceController.
+const (
+	InstanceActionStart     InstanceAction = "START"
+	InstanceActionStop      InstanceAction = "STOP"
+	InstanceActionSoftStop  InstanceAction = "SOFTSTOP"
+	InstanceActionReset     InstanceAction = "RESET"
+	InstanceActionSoftReset InstanceAction = "SOFTRESET"
+)
+
+// InstanceController defines the port for changing the power state of instances.
+// It is kept apart from InstanceRepository so that the read-only decorators
+// (cache, subtree) never see mutating calls; the region decorator only routes them.
+type InstanceController interface {
+	// GetInstance fetches the current, unenriched state of an instance.
+	GetInstance(ctx context.Context, ocid string) (*Instance, error)
+	// InstanceAction sends a power action and returns the instance as OCI reports it afterwards.
+	InstanceAction(ctx context.Context, ocid string, action InstanceAction) (*Instance, error)
+}
+
+// ConsoleHistory is a capture of the serial console output of an instance.
+type ConsoleHistory struct {
+	ID         string
+	InstanceID string
+	// State is REQUESTED, GETTING-HISTORY, SUCCEEDED or FAILED.
+	State string
+}
+
+// ConsoleConnection is an SSH connection to the serial console of an instance.
+type ConsoleConnection struct {
+	ID         string
+	InstanceID string
+	// State is CREATING, ACTIVE, FAILED, DELETING or DELETED.
+	State string
+	// ConnectionString is the ssh command line OCI generated for the connection.
+	ConnectionString    string
+	VncConnectionString string
+	Fingerprint         string
+}
+
+// InstanceAgent defines the port for reading the Oracle Cloud Agent plugins of instances.
+type InstanceAgent interface {
+	ListAgentPlugins(ctx context.Context, compartmentID, instanceID string) ([]AgentPlugin, error)
+}
+
+// InstanceConsole defines the port for the serial console of instances.
+type InstanceConsole interface {
+	// CaptureConsoleHistory starts a capture of the console output of an instance.
+	CaptureConsoleHistory(ctx context.Context, instanceID string) (*ConsoleHistory, error)
+	GetConsoleHistory(ctx context.Context, id string) (*ConsoleHistory, error)
+	// GetConsoleHistoryContent returns the captured output of a SUCCEEDED capture.
+	GetConsoleHistoryContent(ctx context.Context, id string) (string, error)
+	DeleteConsoleHistory(ctx context.Context, id string) error
+	// CreateConsoleConnection registers publicKey for SSH access to the console of an instance.
+	CreateConsoleConnection(ctx context.Context, instanceID, publicKey string) (*ConsoleConnection, error)
+	GetConsoleConnection(ctx context.Context, id string) (*ConsoleConnection, error)
+	// ListConsoleConnections returns the serial console connections of an instance.
+	ListConsoleConnections(ctx context.Context, instanceID string) ([]ConsoleConnection, error)
+	DeleteConsoleConnection(ctx context.Context, id string) error
+}
@@ -0,0 +1,58 @@
+package compute
+
+import (
+	"context"
+	"time"
+)
+
+// InstancePool is a group of instances launched from one instance configuration.
+type InstancePool struct {
+	OCID                    string
+	DisplayName             string
+	State                   string
+	Size                    int
+	TimeCreated             time.Time
+	AvailabilityDomains     []string
+	InstanceConfigurationID string
+	FreeformTags            map[string]string
+	DefinedTags             map[string]map[string]interface{}
+	// Enriched fields
+	Configuration *InstanceConfiguration
+	LoadBalancers []InstancePoolLoadBalancer
+	// Members are the instances of the pool. Lookups map them from the pool
+	// membership, which only carries the basic instance fields.
+	Members []Instance
+}
+
+// InstanceConfiguration is the template the instances of a pool are launched from.
+type InstanceConfiguration struct {
+	OCID      string
+	Name      string
+	Shape     string
+	OCPUs     float32
+	MemoryGB  float32
+	ImageID   string
+	ImageName string
+	// Metadata holds the launch metadata, e.g. ssh_authorized_keys and user_data.
+	Metadata map[string]string
+}
+
+// InstancePoolLoadBalancer is a load balancer backend set the pool registers its instances with.
+type InstancePoolLoadBalancer struct {
+	LoadBalancerID   string
+	LoadBalancerName string
+	BackendSetName   string
+	// BackendSetHealth is the health of the backend set as the load balancer reports it.
+	BackendSetHealth string
+	Port             int
+	VnicSelection    string
+	State            string
+}
+
+// InstancePoolRepository defines the port for reading instance pools.
+type InstancePoolRepository interface {
+	// GetEnrichedInstancePool fetches a pool with its configuration, load balancer attachments and members.
+	GetEnrichedInstancePool(ctx context.Context, ocid string) (*InstancePool, error)
+	ListInstancePools(ctx context.Context, compartmentID string) ([]InstancePool, error)
+	ListEnrichedInstancePools(ctx context.Context, compartmentID string) ([]InstancePool, error)
+}
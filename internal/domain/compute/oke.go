@@ -17,7 +17,37 @@ type Cluster struct {
 	TimeCreated       time.Time
 	FreeformTags      map[string]string
 	DefinedTags       map[string]map[string]interface{}
-	NodePools         []NodePool
+	// AvailableUpgrades are the Kubernetes versions the control plane can be upgraded to.
+	AvailableUpgrades []string
+	Options           ClusterOptions
+	// Addons are the add-ons installed on the cluster; nil when they were not listed.
+	Addons    []ClusterAddon
+	NodePools []NodePool
+	// VirtualNodePools are the node pools whose pods run on serverless virtual
+	// nodes; nil when they could not be listed.
+	VirtualNodePools []VirtualNodePool
+}
+
+// ClusterOptions are the pod networking, admission and image policy options of a cluster.
+type ClusterOptions struct {
+	// CNIType is FLANNEL_OVERLAY or OCI_VCN_IP_NATIVE.
+	CNIType                  string
+	PodsCIDR                 string
+	ServicesCIDR             string
+	PodSecurityPolicyEnabled bool
+	ImagePolicyEnabled       bool
+	// ImageSigningKeyIDs are the KMS keys images must be signed with when the image policy is enabled.
+	ImageSigningKeyIDs []string
+}
+
+// ClusterAddon is an add-on installed on a cluster.
+type ClusterAddon struct {
+	Name string
+	// Version is the installed version, or the configured one while it is being installed.
+	Version string
+	State   string
+	// Error is the last error OCI reported for the add-on, as "code: message".
+	Error string
 }
 
 // NodePool represents a node pool within an OKE cluster.
@@ -29,6 +59,59 @@ type NodePool struct {
 	NodeCount         int
 	FreeformTags      map[string]string
 	DefinedTags       map[string]map[string]interface{}
+	// Nodes are the worker nodes of the pool, only set by lookups that ask for them.
+	Nodes []Node
+}
+
+// Node is a worker node of a node pool.
+type Node struct {
+	// OCID is the OCID of the compute instance backing the node.
+	OCID               string
+	DisplayName        string
+	NodePoolID         string
+	KubernetesVersion  string
+	State              string
+	LifecycleDetails   string
+	PrivateIP          string
+	PublicIP           string
+	AvailabilityDomain string
+	FaultDomain        string
+	SubnetID           string
+	// Error is the last error OCI reported for the node, as "code: message".
+	Error string
+	// Instance is the enriched instance record of the node, when it was found.
+	Instance *Instance
+}
+
+// VirtualNodePool represents a virtual node pool within an OKE cluster.
+type VirtualNodePool struct {
+	OCID              string
+	DisplayName       string
+	KubernetesVersion string
+	State             string
+	// PodShape is the shape the pods of the pool run on.
+	PodShape     string
+	Size         int
+	FreeformTags map[string]string
+	DefinedTags  map[string]map[string]interface{}
+	// VirtualNodes are the virtual nodes of the pool, only set by lookups that ask for them.
+	VirtualNodes []VirtualNode
+}
+
+// VirtualNode is a virtual node of a virtual node pool.
+type VirtualNode struct {
+	OCID               string
+	DisplayName        string
+	VirtualNodePoolID  string
+	KubernetesVersion  string
+	State              string
+	LifecycleDetails   string
+	PrivateIP          string
+	AvailabilityDomain string
+	FaultDomain        string
+	SubnetID           string
+	// Error is the last error OCI reported for the virtual node.
+	Error string
 }
 
 // ClusterRepository defines the port for interacting with OKE cluster storage.
@@ -36,3 +119,22 @@ type ClusterRepository interface {
 	GetCluster(ctx context.Context, ocid string) (*Cluster, error)
 	ListClusters(ctx context.Context, compartmentID string) ([]Cluster, error)
 }
+
+// NodePoolInventory defines the port for listing the worker nodes of a node pool.
+type NodePoolInventory interface {
+	ListNodes(ctx context.Context, clusterID, nodePoolID string) ([]Node, error)
+	ListVirtualNodes(ctx context.Context, clusterID, virtualNodePoolID string) ([]VirtualNode, error)
+}
+
+// Cluster endpoints a kubeconfig can target.
+const (
+	EndpointPublic  = "public"
+	EndpointPrivate = "private"
+)
+
+// ClusterKubeconfig defines the port for generating the kubeconfig of a cluster.
+type ClusterKubeconfig interface {
+	// CreateKubeconfig returns the kubeconfig OCI generates for reaching the
+	// cluster through endpoint, one of EndpointPublic or EndpointPrivate.
+	CreateKubeconfig(ctx context.Context, clusterID, endpoint string) ([]byte, error)
+}
@@ -0,0 +1,36 @@
+package compute
+
+import "context"
+
+// Shape describes a compute shape that can be used to launch instances.
+// Fixed shapes report their size in OCPUs and MemoryGB; flexible shapes report
+// the ranges an instance can be sized in.
+type Shape struct {
+	Name                 string
+	ProcessorDescription string
+	IsFlexible           bool
+	OCPUs                float32
+	MemoryGB             float32
+	OCPUsMin             float32
+	OCPUsMax             float32
+	MemoryMinGB          float32
+	MemoryMaxGB          float32
+	NetworkBandwidthGbps float32
+	MaxVnicAttachments   int
+	GPUs                 int
+	GPUDescription       string
+	LocalDisks           int
+	LocalDisksTotalGB    float32
+	LocalDiskDescription string
+	BillingType          string
+	// AvailabilityDomains lists the availability domains the shape can be launched in.
+	AvailabilityDomains []string
+	// RunningInstances counts the RUNNING instances of the compartment using the shape.
+	RunningInstances int
+}
+
+// ShapeRepository defines the port for listing the compute shapes of a compartment.
+type ShapeRepository interface {
+	// ListShapes returns each shape once, with every availability domain it is offered in.
+	ListShapes(ctx context.Context, compartmentID string) ([]Shape, error)
+}
@@ -6,6 +6,7 @@ import "context"
 // This is our application's internal representation, decoupled from the OCI SDK.
 type Compartment struct {
 	OCID           string
+	ParentID       string
 	DisplayName    string
 	Description    string
 	LifecycleState string
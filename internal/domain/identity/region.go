@@ -0,0 +1,16 @@
+package identity
+
+import "context"
+
+// RegionSubscription is a region the tenancy is subscribed to.
+type RegionSubscription struct {
+	Name     string
+	Key      string
+	IsHome   bool
+	IsActive bool
+}
+
+// RegionRepository defines the port for looking up the regions of a tenancy.
+type RegionRepository interface {
+	ListRegionSubscriptions(ctx context.Context, tenancyID string) ([]RegionSubscription, error)
+}
@@ -124,3 +124,19 @@ func int64Value(v *int64) int64 {
 	}
 	return *v
 }
+
+// Helper to dereference *int safely.
+func intValue(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+// Helper to dereference *float32 safely.
+func float32Value(v *float32) float32 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
@@ -7,6 +7,7 @@ import (
 
 type CompartmentAttributes struct {
 	OCID           *string
+	ParentID       *string
 	Name           *string
 	Description    *string
 	LifecycleState identity.CompartmentLifecycleStateEnum
@@ -17,6 +18,7 @@ type CompartmentAttributes struct {
 func NewCompartmentAttributesFromOCICompartment(c identity.Compartment) *CompartmentAttributes {
 	return &CompartmentAttributes{
 		OCID:           c.Id,
+		ParentID:       c.CompartmentId,
 		Name:           c.Name,
 		Description:    c.Description,
 		LifecycleState: c.LifecycleState,
@@ -26,10 +28,13 @@ func NewCompartmentAttributesFromOCICompartment(c identity.Compartment) *Compart
 }
 
 func NewDomainCompartmentFromAttrs(c *CompartmentAttributes) *domain.Compartment {
-	var ocid, name, description, lifecycleState string
+	var ocid, parentID, name, description, lifecycleState string
 	if c.OCID != nil {
 		ocid = *c.OCID
 	}
+	if c.ParentID != nil {
+		parentID = *c.ParentID
+	}
 	if c.Name != nil {
 		name = *c.Name
 	}
@@ -42,6 +47,7 @@ func NewDomainCompartmentFromAttrs(c *CompartmentAttributes) *domain.Compartment
 
 	return &domain.Compartment{
 		OCID:           ocid,
+		ParentID:       parentID,
 		DisplayName:    name,
 		Description:    description,
 		LifecycleState: lifecycleState,
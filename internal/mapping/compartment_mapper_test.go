@@ -12,11 +12,13 @@ import (
 func TestNewCompartmentAttributesFromOCICompartment_And_ToDomain(t *testing.T) {
 	name := "Dev"
 	id := "ocid1.compartment.oc1..abcd"
+	parent := "ocid1.tenancy.oc1..root"
 	desc := "Development compartment"
 	state := identity.CompartmentLifecycleStateActive
 
 	ocic := identity.Compartment{
 		Id:             &id,
+		CompartmentId:  &parent,
 		Name:           &name,
 		Description:    &desc,
 		LifecycleState: state,
@@ -27,6 +29,7 @@ func TestNewCompartmentAttributesFromOCICompartment_And_ToDomain(t *testing.T) {
 	attrs := mapping.NewCompartmentAttributesFromOCICompartment(ocic)
 	require.NotNil(t, attrs)
 	require.Equal(t, &id, attrs.OCID)
+	require.Equal(t, &parent, attrs.ParentID)
 	require.Equal(t, &name, attrs.Name)
 	require.Equal(t, &desc, attrs.Description)
 	require.Equal(t, state, attrs.LifecycleState)
@@ -36,6 +39,7 @@ func TestNewCompartmentAttributesFromOCICompartment_And_ToDomain(t *testing.T) {
 	dom := mapping.NewDomainCompartmentFromAttrs(attrs)
 	require.IsType(t, &domain.Compartment{}, dom)
 	require.Equal(t, id, dom.OCID)
+	require.Equal(t, parent, dom.ParentID)
 	require.Equal(t, name, dom.DisplayName)
 	require.Equal(t, desc, dom.Description)
 	require.Equal(t, string(state), dom.LifecycleState)
@@ -49,6 +53,7 @@ func TestNewDomainCompartmentFromAttrs_NilValues(t *testing.T) {
 
 	dom := mapping.NewDomainCompartmentFromAttrs(attrs)
 	require.Equal(t, "", dom.OCID)
+	require.Equal(t, "", dom.ParentID)
 	require.Equal(t, "", dom.DisplayName)
 	require.Equal(t, "", dom.Description)
 	require.Equal(t, "", dom.LifecycleState)
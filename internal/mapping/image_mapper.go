@@ -2,6 +2,8 @@ package mapping
 
 import (
 	compute "github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/workrequests"
 )
 
 // NewDomainImageFromAttrs builds a domain Image from provider-agnostic attributes.
@@ -18,3 +20,37 @@ func NewDomainImageFromAttrs(attrs ImageAttributes) compute.Image {
 	}
 	return img
 }
+
+// NewDomainImageShapeCompatibility maps an OCI image shape compatibility entry to the domain model.
+func NewDomainImageShapeCompatibility(e core.ImageShapeCompatibilitySummary) compute.ImageShapeCompatibility {
+	c := compute.ImageShapeCompatibility{Shape: stringValue(e.Shape)}
+	if e.OcpuConstraints != nil {
+		c.MinOCPUs = intValue(e.OcpuConstraints.Min)
+		c.MaxOCPUs = intValue(e.OcpuConstraints.Max)
+	}
+	if e.MemoryConstraints != nil {
+		c.MinMemoryGB = intValue(e.MemoryConstraints.MinInGBs)
+		c.MaxMemoryGB = intValue(e.MemoryConstraints.MaxInGBs)
+	}
+	return c
+}
+
+// NewDomainWorkRequest maps an OCI work request, and the errors reported for
+// it, to the domain model.
+func NewDomainWorkRequest(wr workrequests.WorkRequest, errs []workrequests.WorkRequestError) *compute.WorkRequest {
+	out := &compute.WorkRequest{
+		ID:            stringValue(wr.Id),
+		OperationType: stringValue(wr.OperationType),
+		Status:        string(wr.Status),
+	}
+	if wr.PercentComplete != nil {
+		out.PercentComplete = *wr.PercentComplete
+	}
+	if wr.TimeFinished != nil {
+		out.TimeFinished = wr.TimeFinished.Time
+	}
+	for _, e := range errs {
+		out.Errors = append(out.Errors, stringValue(e.Message))
+	}
+	return out
+}
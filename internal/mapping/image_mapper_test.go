@@ -8,6 +8,7 @@ import (
 	"github.com/cnopslabs/ocloud/internal/mapping"
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/workrequests"
 	"github.com/stretchr/testify/require"
 )
 
@@ -60,3 +61,38 @@ func TestNewDomainImageFromAttrs_ZeroValues(t *testing.T) {
 	require.Equal(t, "", img.LaunchMode)
 	require.True(t, img.TimeCreated.IsZero())
 }
+
+func TestNewDomainWorkRequest(t *testing.T) {
+	finished := time.Date(2026, 10, 1, 8, 30, 0, 0, time.UTC)
+	wr := workrequests.WorkRequest{
+		Id:              common.String("ocid1.coreservicesworkrequest.oc1..a"),
+		OperationType:   common.String("ExportImage"),
+		Status:          workrequests.WorkRequestStatusFailed,
+		PercentComplete: common.Float32(40),
+		TimeFinished:    &common.SDKTime{Time: finished},
+	}
+	errs := []workrequests.WorkRequestError{{Message: common.String("bucket not found")}}
+
+	got := mapping.NewDomainWorkRequest(wr, errs)
+	require.Equal(t, "ocid1.coreservicesworkrequest.oc1..a", got.ID)
+	require.Equal(t, "ExportImage", got.OperationType)
+	require.Equal(t, "FAILED", got.Status)
+	require.InDelta(t, 40, float64(got.PercentComplete), 0.001)
+	require.True(t, finished.Equal(got.TimeFinished))
+	require.Equal(t, []string{"bucket not found"}, got.Errors)
+}
+
+func TestNewDomainImageShapeCompatibility(t *testing.T) {
+	e := core.ImageShapeCompatibilitySummary{
+		ImageId:           common.String("ocid1.image.oc1..abc"),
+		Shape:             common.String("VM.Standard.E5.Flex"),
+		OcpuConstraints:   &core.ImageOcpuConstraints{Min: common.Int(1), Max: common.Int(64)},
+		MemoryConstraints: &core.ImageMemoryConstraints{MaxInGBs: common.Int(1024)},
+	}
+
+	got := mapping.NewDomainImageShapeCompatibility(e)
+	require.Equal(t, domain.ImageShapeCompatibility{Shape: "VM.Standard.E5.Flex", MinOCPUs: 1, MaxOCPUs: 64, MaxMemoryGB: 1024}, got)
+
+	got = mapping.NewDomainImageShapeCompatibility(core.ImageShapeCompatibilitySummary{Shape: common.String("VM.Standard2.1")})
+	require.Equal(t, domain.ImageShapeCompatibility{Shape: "VM.Standard2.1"}, got)
+}
@@ -5,11 +5,13 @@ import (
 
 	domain "github.com/cnopslabs/ocloud/internal/domain/compute"
 	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/computeinstanceagent"
 	"github.com/oracle/oci-go-sdk/v65/core"
 )
 
 type InstanceAttributes struct {
 	OCID               *string
+	CompartmentId      *string
 	DisplayName        *string
 	State              core.InstanceLifecycleStateEnum
 	Shape              *string
@@ -22,11 +24,17 @@ type InstanceAttributes struct {
 	MemoryInGBs        *float32
 	FreeformTags       map[string]string
 	DefinedTags        map[string]map[string]interface{}
+	Metadata           map[string]string
+	ExtendedMetadata   map[string]interface{}
+	LaunchMode         core.InstanceLaunchModeEnum
+	LaunchOptions      *core.LaunchOptions
+	AgentConfig        *core.InstanceAgentConfig
 }
 
 func NewInstanceAttributesFromOCIInstance(i core.Instance) *InstanceAttributes {
 	return &InstanceAttributes{
 		OCID:               i.Id,
+		CompartmentId:      i.CompartmentId,
 		DisplayName:        i.DisplayName,
 		State:              i.LifecycleState,
 		Shape:              i.Shape,
@@ -39,6 +47,11 @@ func NewInstanceAttributesFromOCIInstance(i core.Instance) *InstanceAttributes {
 		MemoryInGBs:        i.ShapeConfig.MemoryInGBs,
 		FreeformTags:       i.FreeformTags,
 		DefinedTags:        i.DefinedTags,
+		Metadata:           i.Metadata,
+		ExtendedMetadata:   i.ExtendedMetadata,
+		LaunchMode:         i.LaunchMode,
+		LaunchOptions:      i.LaunchOptions,
+		AgentConfig:        i.AgentConfig,
 	}
 }
 
@@ -84,6 +97,7 @@ func NewDomainInstanceFromAttrs(i *InstanceAttributes) *domain.Instance {
 
 	return &domain.Instance{
 		OCID:               ocid,
+		CompartmentID:      stringValue(i.CompartmentId),
 		DisplayName:        displayName,
 		State:              state,
 		Shape:              shape,
@@ -96,9 +110,58 @@ func NewDomainInstanceFromAttrs(i *InstanceAttributes) *domain.Instance {
 		MemoryGB:           memoryGB,
 		FreeformTags:       i.FreeformTags,
 		DefinedTags:        i.DefinedTags,
+		Metadata:           i.Metadata,
+		ExtendedMetadata:   i.ExtendedMetadata,
+		LaunchMode:         string(i.LaunchMode),
+		LaunchOptions:      newDomainLaunchOptions(i.LaunchOptions),
+		AgentConfig:        newDomainAgentConfig(i.AgentConfig),
 	}
 }
 
+func newDomainLaunchOptions(o *core.LaunchOptions) *domain.LaunchOptions {
+	if o == nil {
+		return nil
+	}
+	return &domain.LaunchOptions{
+		BootVolumeType:                  string(o.BootVolumeType),
+		Firmware:                        string(o.Firmware),
+		NetworkType:                     string(o.NetworkType),
+		RemoteDataVolumeType:            string(o.RemoteDataVolumeType),
+		IsPvEncryptionInTransitEnabled:  boolValue(o.IsPvEncryptionInTransitEnabled),
+		IsConsistentVolumeNamingEnabled: boolValue(o.IsConsistentVolumeNamingEnabled),
+	}
+}
+
+func newDomainAgentConfig(c *core.InstanceAgentConfig) *domain.AgentConfig {
+	if c == nil {
+		return nil
+	}
+	cfg := &domain.AgentConfig{
+		IsMonitoringDisabled:  boolValue(c.IsMonitoringDisabled),
+		IsManagementDisabled:  boolValue(c.IsManagementDisabled),
+		AreAllPluginsDisabled: boolValue(c.AreAllPluginsDisabled),
+	}
+	if len(c.PluginsConfig) > 0 {
+		cfg.PluginsConfig = make(map[string]string, len(c.PluginsConfig))
+		for _, p := range c.PluginsConfig {
+			cfg.PluginsConfig[stringValue(p.Name)] = string(p.DesiredState)
+		}
+	}
+	return cfg
+}
+
+// NewDomainAgentPlugin maps an Oracle Cloud Agent plugin summary to the domain model.
+func NewDomainAgentPlugin(p computeinstanceagent.InstanceAgentPluginSummary) domain.AgentPlugin {
+	plugin := domain.AgentPlugin{
+		Name:   stringValue(p.Name),
+		Status: string(p.Status),
+	}
+	if p.TimeLastUpdatedUtc != nil {
+		plugin.TimeLastUpdated = p.TimeLastUpdatedUtc.Time
+	}
+	return plugin
+}
+
 type VnicAttributes struct {
 	PrivateIp           *string
 	SubnetId            *string
@@ -116,3 +179,54 @@ func NewVnicAttributesFromOCIVnic(v core.Vnic) *VnicAttributes {
 		NsgIds:              v.NsgIds,
 	}
 }
+
+// NewDomainVnic maps an OCI VNIC to the domain model. Subnet and NSG names,
+// the lifetime of its public IP and its secondary IPs are resolved by the caller.
+func NewDomainVnic(v core.Vnic) domain.Vnic {
+	return domain.Vnic{
+		ID:            stringValue(v.Id),
+		Name:          stringValue(v.DisplayName),
+		IsPrimary:     boolValue(v.IsPrimary),
+		MacAddress:    stringValue(v.MacAddress),
+		HostnameLabel: stringValue(v.HostnameLabel),
+		SubnetID:      stringValue(v.SubnetId),
+		NsgIDs:        v.NsgIds,
+		PrivateIP:     stringValue(v.PrivateIp),
+		PublicIP:      stringValue(v.PublicIp),
+	}
+}
+
+// NewDomainPrivateIP maps a secondary OCI private IP, and the public IP
+// assigned to it when there is one, to the domain model.
+func NewDomainPrivateIP(p core.PrivateIp, public *core.PublicIp) domain.PrivateIP {
+	ip := domain.PrivateIP{
+		IP:            stringValue(p.IpAddress),
+		HostnameLabel: stringValue(p.HostnameLabel),
+	}
+	if public != nil {
+		ip.PublicIP = stringValue(public.IpAddress)
+		ip.PublicIPLifetime = string(public.Lifetime)
+	}
+	return ip
+}
+
+// NewDomainConsoleHistory maps an OCI console history to the domain model.
+func NewDomainConsoleHistory(h core.ConsoleHistory) *domain.ConsoleHistory {
+	return &domain.ConsoleHistory{
+		ID:         stringValue(h.Id),
+		InstanceID: stringValue(h.InstanceId),
+		State:      string(h.LifecycleState),
+	}
+}
+
+// NewDomainConsoleConnection maps an OCI instance console connection to the domain model.
+func NewDomainConsoleConnection(c core.InstanceConsoleConnection) *domain.ConsoleConnection {
+	return &domain.ConsoleConnection{
+		ID:                  stringValue(c.Id),
+		InstanceID:          stringValue(c.InstanceId),
+		State:               string(c.LifecycleState),
+		ConnectionString:    stringValue(c.ConnectionString),
+		VncConnectionString: stringValue(c.VncConnectionString),
+		Fingerprint:         stringValue(c.Fingerprint),
+	}
+}
@@ -7,6 +7,7 @@ import (
 	domain "github.com/cnopslabs/ocloud/internal/domain/compute"
 	"github.com/cnopslabs/ocloud/internal/mapping"
 	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/computeinstanceagent"
 	"github.com/oracle/oci-go-sdk/v65/core"
 	"github.com/stretchr/testify/require"
 )
@@ -39,6 +40,18 @@ func TestNewInstanceAttributesFromOCIInstance_And_ToDomain(t *testing.T) {
 		},
 		FreeformTags: map[string]string{"role": "web"},
 		DefinedTags:  map[string]map[string]interface{}{"ns": {"k": "v"}},
+		Metadata:     map[string]string{"user_data": "I2Nsb3VkLWNvbmZpZw=="},
+		LaunchMode:   core.InstanceLaunchModeParavirtualized,
+		LaunchOptions: &core.LaunchOptions{
+			Firmware:                       core.LaunchOptionsFirmwareUefi64,
+			IsPvEncryptionInTransitEnabled: common.Bool(true),
+		},
+		AgentConfig: &core.InstanceAgentConfig{
+			IsMonitoringDisabled: common.Bool(false),
+			PluginsConfig: []core.InstanceAgentPluginConfigDetails{
+				{Name: common.String("Bastion"), DesiredState: core.InstanceAgentPluginConfigDetailsDesiredStateEnabled},
+			},
+		},
 	}
 
 	attrs := mapping.NewInstanceAttributesFromOCIInstance(inst)
@@ -73,6 +86,10 @@ func TestNewInstanceAttributesFromOCIInstance_And_ToDomain(t *testing.T) {
 	require.InDelta(t, 16, float64(dom.MemoryGB), 0.001)
 	require.Equal(t, map[string]string{"role": "web"}, dom.FreeformTags)
 	require.Equal(t, map[string]map[string]interface{}{"ns": {"k": "v"}}, dom.DefinedTags)
+	require.Equal(t, "I2Nsb3VkLWNvbmZpZw==", dom.Metadata["user_data"])
+	require.Equal(t, "PARAVIRTUALIZED", dom.LaunchMode)
+	require.Equal(t, &domain.LaunchOptions{Firmware: "UEFI_64", IsPvEncryptionInTransitEnabled: true}, dom.LaunchOptions)
+	require.Equal(t, map[string]string{"Bastion": "ENABLED"}, dom.AgentConfig.PluginsConfig)
 }
 
 func TestNewDomainInstanceFromAttrs_NilValues(t *testing.T) {
@@ -91,6 +108,22 @@ func TestNewDomainInstanceFromAttrs_NilValues(t *testing.T) {
 	require.InDelta(t, 0, float64(dom.MemoryGB), 0.001)
 	require.Nil(t, dom.FreeformTags)
 	require.Nil(t, dom.DefinedTags)
+	require.Nil(t, dom.LaunchOptions)
+	require.Nil(t, dom.AgentConfig)
+}
+
+func TestNewDomainAgentPlugin(t *testing.T) {
+	updated := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	p := computeinstanceagent.InstanceAgentPluginSummary{
+		Name:               common.String("Compute Instance Monitoring"),
+		Status:             computeinstanceagent.InstanceAgentPluginSummaryStatusRunning,
+		TimeLastUpdatedUtc: &common.SDKTime{Time: updated},
+	}
+
+	got := mapping.NewDomainAgentPlugin(p)
+	require.Equal(t, "Compute Instance Monitoring", got.Name)
+	require.Equal(t, "RUNNING", got.Status)
+	require.True(t, updated.Equal(got.TimeLastUpdated))
 }
 
 func TestNewVnicAttributesFromOCIVnic(t *testing.T) {
@@ -148,3 +181,59 @@ func TestNewVnicAttributesFromOCIVnic_NilNsgIds(t *testing.T) {
 	require.Equal(t, &subnet, got.SubnetId)
 	require.Nil(t, got.NsgIds)
 }
+
+func TestNewDomainVnic(t *testing.T) {
+	v := core.Vnic{
+		Id:            common.String("ocid1.vnic.oc1..a"),
+		DisplayName:   common.String("web-1-vnic-2"),
+		IsPrimary:     common.Bool(false),
+		MacAddress:    common.String("02:00:17:00:00:01"),
+		SubnetId:      common.String("ocid1.subnet.oc1..b"),
+		PrivateIp:     common.String("10.0.2.15"),
+		PublicIp:      common.String("129.146.1.2"),
+		NsgIds:        []string{"ocid1.nsg.oc1..c"},
+		HostnameLabel: common.String("web-1-b"),
+	}
+
+	got := mapping.NewDomainVnic(v)
+	require.Equal(t, "ocid1.vnic.oc1..a", got.ID)
+	require.Equal(t, "web-1-vnic-2", got.Name)
+	require.False(t, got.IsPrimary)
+	require.Equal(t, "10.0.2.15", got.PrivateIP)
+	require.Equal(t, "129.146.1.2", got.PublicIP)
+	require.Equal(t, "web-1-b", got.HostnameLabel)
+	require.Equal(t, []string{"ocid1.nsg.oc1..c"}, got.NsgIDs)
+	require.Empty(t, got.PublicIPLifetime)
+}
+
+func TestNewDomainPrivateIP(t *testing.T) {
+	p := core.PrivateIp{IpAddress: common.String("10.0.2.16")}
+
+	got := mapping.NewDomainPrivateIP(p, nil)
+	require.Equal(t, "10.0.2.16", got.IP)
+	require.Empty(t, got.PublicIP)
+
+	public := &core.PublicIp{IpAddress: common.String("150.1.2.3"), Lifetime: core.PublicIpLifetimeReserved}
+	got = mapping.NewDomainPrivateIP(p, public)
+	require.Equal(t, "150.1.2.3", got.PublicIP)
+	require.Equal(t, "RESERVED", got.PublicIPLifetime)
+}
+
+func TestNewDomainConsoleConnection(t *testing.T) {
+	c := core.InstanceConsoleConnection{
+		Id:               common.String("ocid1.instanceconsoleconnection.oc1..a"),
+		InstanceId:       common.String("ocid1.instance.oc1..b"),
+		LifecycleState:   core.InstanceConsoleConnectionLifecycleStateActive,
+		ConnectionString: common.String("ssh -o ProxyCommand='ssh -W %h:%p -p 443 x@instance-console' y"),
+	}
+
+	got := mapping.NewDomainConsoleConnection(c)
+	require.Equal(t, "ocid1.instanceconsoleconnection.oc1..a", got.ID)
+	require.Equal(t, "ocid1.instance.oc1..b", got.InstanceID)
+	require.Equal(t, "ACTIVE", got.State)
+	require.Contains(t, got.ConnectionString, "instance-console")
+	require.Empty(t, got.VncConnectionString)
+
+	h := mapping.NewDomainConsoleHistory(core.ConsoleHistory{Id: common.String("h"), LifecycleState: core.ConsoleHistoryLifecycleStateGettingHistory})
+	require.Equal(t, "GETTING-HISTORY", h.State)
+}
@@ -0,0 +1,113 @@
+package mapping
+
+import (
+	"time"
+
+	domain "github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+)
+
+// NewDomainInstancePoolFromSummary maps an OCI instance pool summary, as
+// returned by listings, to the domain model.
+func NewDomainInstancePoolFromSummary(p core.InstancePoolSummary) domain.InstancePool {
+	return domain.InstancePool{
+		OCID:                    stringValue(p.Id),
+		DisplayName:             stringValue(p.DisplayName),
+		State:                   string(p.LifecycleState),
+		Size:                    intValue(p.Size),
+		TimeCreated:             sdkTime(p.TimeCreated),
+		AvailabilityDomains:     p.AvailabilityDomains,
+		InstanceConfigurationID: stringValue(p.InstanceConfigurationId),
+		FreeformTags:            p.FreeformTags,
+		DefinedTags:             p.DefinedTags,
+	}
+}
+
+// NewDomainInstancePool maps an OCI instance pool to the domain model, with its
+// load balancer attachments. Load balancer names are resolved by the caller.
+func NewDomainInstancePool(p core.InstancePool) domain.InstancePool {
+	pool := domain.InstancePool{
+		OCID:                    stringValue(p.Id),
+		DisplayName:             stringValue(p.DisplayName),
+		State:                   string(p.LifecycleState),
+		Size:                    intValue(p.Size),
+		TimeCreated:             sdkTime(p.TimeCreated),
+		InstanceConfigurationID: stringValue(p.InstanceConfigurationId),
+		FreeformTags:            p.FreeformTags,
+		DefinedTags:             p.DefinedTags,
+	}
+	for _, pc := range p.PlacementConfigurations {
+		if pc.AvailabilityDomain != nil {
+			pool.AvailabilityDomains = append(pool.AvailabilityDomains, *pc.AvailabilityDomain)
+		}
+	}
+	for _, lb := range p.LoadBalancers {
+		pool.LoadBalancers = append(pool.LoadBalancers, domain.InstancePoolLoadBalancer{
+			LoadBalancerID: stringValue(lb.LoadBalancerId),
+			BackendSetName: stringValue(lb.BackendSetName),
+			Port:           intValue(lb.Port),
+			VnicSelection:  stringValue(lb.VnicSelection),
+			State:          string(lb.LifecycleState),
+		})
+	}
+	return pool
+}
+
+// NewDomainInstanceFromPoolMember maps a member of an instance pool to the
+// instance domain model; only the fields the membership carries are set.
+func NewDomainInstanceFromPoolMember(m core.InstanceSummary) domain.Instance {
+	return domain.Instance{
+		OCID:               stringValue(m.Id),
+		DisplayName:        stringValue(m.DisplayName),
+		State:              stringValue(m.State),
+		Shape:              stringValue(m.Shape),
+		Region:             stringValue(m.Region),
+		AvailabilityDomain: stringValue(m.AvailabilityDomain),
+		FaultDomain:        stringValue(m.FaultDomain),
+		TimeCreated:        sdkTime(m.TimeCreated),
+	}
+}
+
+// NewDomainInstanceConfiguration maps an OCI instance configuration to the
+// domain model. Only compute launch details are read; the image name is
+// resolved by the caller.
+func NewDomainInstanceConfiguration(c core.InstanceConfiguration) *domain.InstanceConfiguration {
+	cfg := &domain.InstanceConfiguration{
+		OCID: stringValue(c.Id),
+		Name: stringValue(c.DisplayName),
+	}
+
+	var launch *core.InstanceConfigurationLaunchInstanceDetails
+	switch d := c.InstanceDetails.(type) {
+	case core.ComputeInstanceDetails:
+		launch = d.LaunchDetails
+	case *core.ComputeInstanceDetails:
+		launch = d.LaunchDetails
+	}
+	if launch == nil {
+		return cfg
+	}
+
+	cfg.Shape = stringValue(launch.Shape)
+	cfg.Metadata = launch.Metadata
+	if launch.ShapeConfig != nil {
+		cfg.OCPUs = float32Value(launch.ShapeConfig.Ocpus)
+		cfg.MemoryGB = float32Value(launch.ShapeConfig.MemoryInGBs)
+	}
+	switch src := launch.SourceDetails.(type) {
+	case core.InstanceConfigurationInstanceSourceViaImageDetails:
+		cfg.ImageID = stringValue(src.ImageId)
+	case *core.InstanceConfigurationInstanceSourceViaImageDetails:
+		cfg.ImageID = stringValue(src.ImageId)
+	}
+	return cfg
+}
+
+// sdkTime returns the time of t, or the zero time when t is nil.
+func sdkTime(t *common.SDKTime) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.Time
+}
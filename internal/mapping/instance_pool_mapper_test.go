@@ -0,0 +1,87 @@
+package mapping_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cnopslabs/ocloud/internal/mapping"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDomainInstancePool(t *testing.T) {
+	created := time.Now().UTC().Truncate(time.Second)
+	p := core.InstancePool{
+		Id:                      common.String("ocid1.instancepool.oc1..a"),
+		DisplayName:             common.String("web-pool"),
+		LifecycleState:          core.InstancePoolLifecycleStateRunning,
+		Size:                    common.Int(3),
+		TimeCreated:             &common.SDKTime{Time: created},
+		InstanceConfigurationId: common.String("ocid1.instanceconfiguration.oc1..b"),
+		PlacementConfigurations: []core.InstancePoolPlacementConfiguration{
+			{AvailabilityDomain: common.String("AD-1")},
+			{AvailabilityDomain: common.String("AD-2")},
+		},
+		LoadBalancers: []core.InstancePoolLoadBalancerAttachment{{
+			LoadBalancerId: common.String("ocid1.loadbalancer.oc1..c"),
+			BackendSetName: common.String("web"),
+			Port:           common.Int(8080),
+			VnicSelection:  common.String("PrimaryVnic"),
+			LifecycleState: core.InstancePoolLoadBalancerAttachmentLifecycleStateAttached,
+		}},
+	}
+
+	got := mapping.NewDomainInstancePool(p)
+	require.Equal(t, "web-pool", got.DisplayName)
+	require.Equal(t, "RUNNING", got.State)
+	require.Equal(t, 3, got.Size)
+	require.Equal(t, created, got.TimeCreated)
+	require.Equal(t, []string{"AD-1", "AD-2"}, got.AvailabilityDomains)
+	require.Len(t, got.LoadBalancers, 1)
+	require.Equal(t, "web", got.LoadBalancers[0].BackendSetName)
+	require.Equal(t, 8080, got.LoadBalancers[0].Port)
+	require.Equal(t, "ATTACHED", got.LoadBalancers[0].State)
+	require.Empty(t, got.LoadBalancers[0].LoadBalancerName)
+}
+
+func TestNewDomainInstanceFromPoolMember(t *testing.T) {
+	m := core.InstanceSummary{
+		Id:                 common.String("ocid1.instance.oc1..m"),
+		DisplayName:        common.String("inst-abc"),
+		State:              common.String("Running"),
+		Shape:              common.String("VM.Standard.E5.Flex"),
+		AvailabilityDomain: common.String("AD-1"),
+		FaultDomain:        common.String("FAULT-DOMAIN-2"),
+	}
+
+	got := mapping.NewDomainInstanceFromPoolMember(m)
+	require.Equal(t, "ocid1.instance.oc1..m", got.OCID)
+	require.Equal(t, "inst-abc", got.DisplayName)
+	require.Equal(t, "Running", got.State)
+	require.Equal(t, "FAULT-DOMAIN-2", got.FaultDomain)
+}
+
+func TestNewDomainInstanceConfiguration(t *testing.T) {
+	c := core.InstanceConfiguration{
+		Id:          common.String("ocid1.instanceconfiguration.oc1..b"),
+		DisplayName: common.String("web-config"),
+		InstanceDetails: core.ComputeInstanceDetails{LaunchDetails: &core.InstanceConfigurationLaunchInstanceDetails{
+			Shape:         common.String("VM.Standard.E5.Flex"),
+			ShapeConfig:   &core.InstanceConfigurationLaunchInstanceShapeConfigDetails{Ocpus: common.Float32(2), MemoryInGBs: common.Float32(16)},
+			SourceDetails: core.InstanceConfigurationInstanceSourceViaImageDetails{ImageId: common.String("ocid1.image.oc1..i")},
+			Metadata:      map[string]string{"ssh_authorized_keys": "ssh-ed25519 AAAA"},
+		}},
+	}
+
+	got := mapping.NewDomainInstanceConfiguration(c)
+	require.Equal(t, "web-config", got.Name)
+	require.Equal(t, "VM.Standard.E5.Flex", got.Shape)
+	require.Equal(t, float32(2), got.OCPUs)
+	require.Equal(t, float32(16), got.MemoryGB)
+	require.Equal(t, "ocid1.image.oc1..i", got.ImageID)
+	require.Contains(t, got.Metadata, "ssh_authorized_keys")
+
+	empty := mapping.NewDomainInstanceConfiguration(core.InstanceConfiguration{Id: common.String("x")})
+	require.Empty(t, empty.Shape)
+}
@@ -18,6 +18,8 @@ type ClusterAttributes struct {
 	TimeCreated       *time.Time
 	FreeformTags      map[string]string
 	DefinedTags       map[string]map[string]interface{}
+	AvailableUpgrades []string
+	Options           domain.ClusterOptions
 }
 
 func NewClusterAttributesFromOCICluster(c containerengine.Cluster) *ClusterAttributes {
@@ -43,6 +45,8 @@ func NewClusterAttributesFromOCICluster(c containerengine.Cluster) *ClusterAttri
 		TimeCreated:       timeCreated,
 		FreeformTags:      c.FreeformTags,
 		DefinedTags:       c.DefinedTags,
+		AvailableUpgrades: c.AvailableKubernetesUpgrades,
+		Options:           newClusterOptions(c.Options, c.ImagePolicyConfig, c.ClusterPodNetworkOptions),
 	}
 }
 
@@ -69,6 +73,8 @@ func NewClusterAttributesFromOCIClusterSummary(c containerengine.ClusterSummary)
 		TimeCreated:       timeCreated,
 		FreeformTags:      c.FreeformTags,
 		DefinedTags:       c.DefinedTags,
+		AvailableUpgrades: c.AvailableKubernetesUpgrades,
+		Options:           newClusterOptions(c.Options, c.ImagePolicyConfig, c.ClusterPodNetworkOptions),
 	}
 }
 
@@ -112,9 +118,44 @@ func NewDomainClusterFromAttrs(c *ClusterAttributes) *domain.Cluster {
 		TimeCreated:       timeCreated,
 		FreeformTags:      c.FreeformTags,
 		DefinedTags:       c.DefinedTags,
+		AvailableUpgrades: c.AvailableUpgrades,
+		Options:           c.Options,
 	}
 }
 
+// newClusterOptions collects the options of a cluster spread over its OCI model.
+func newClusterOptions(opts *containerengine.ClusterCreateOptions, policy *containerengine.ImagePolicyConfig, podNetwork []containerengine.ClusterPodNetworkOptionDetails) domain.ClusterOptions {
+	var o domain.ClusterOptions
+	for _, pn := range podNetwork {
+		switch pn.(type) {
+		case containerengine.FlannelOverlayClusterPodNetworkOptionDetails:
+			o.CNIType = string(containerengine.ClusterPodNetworkOptionDetailsCniTypeFlannelOverlay)
+		case containerengine.OciVcnIpNativeClusterPodNetworkOptionDetails:
+			o.CNIType = string(containerengine.ClusterPodNetworkOptionDetailsCniTypeOciVcnIpNative)
+		}
+	}
+	if opts != nil {
+		if opts.KubernetesNetworkConfig != nil {
+			o.PodsCIDR = stringValue(opts.KubernetesNetworkConfig.PodsCidr)
+			o.ServicesCIDR = stringValue(opts.KubernetesNetworkConfig.ServicesCidr)
+		}
+		if opts.AdmissionControllerOptions != nil && opts.AdmissionControllerOptions.IsPodSecurityPolicyEnabled != nil {
+			o.PodSecurityPolicyEnabled = *opts.AdmissionControllerOptions.IsPodSecurityPolicyEnabled
+		}
+	}
+	if policy != nil {
+		if policy.IsPolicyEnabled != nil {
+			o.ImagePolicyEnabled = *policy.IsPolicyEnabled
+		}
+		for _, k := range policy.KeyDetails {
+			if id := stringValue(k.KmsKeyId); id != "" {
+				o.ImageSigningKeyIDs = append(o.ImageSigningKeyIDs, id)
+			}
+		}
+	}
+	return o
+}
+
 type NodePoolAttributes struct {
 	OCID              *string
 	DisplayName       *string
@@ -187,3 +228,82 @@ func NewDomainNodePoolFromAttrs(np *NodePoolAttributes) *domain.NodePool {
 		DefinedTags:       np.DefinedTags,
 	}
 }
+
+// NewDomainNode maps an OCI node pool node to the domain model.
+func NewDomainNode(n containerengine.Node) domain.Node {
+	node := domain.Node{
+		OCID:               stringValue(n.Id),
+		DisplayName:        stringValue(n.Name),
+		NodePoolID:         stringValue(n.NodePoolId),
+		KubernetesVersion:  stringValue(n.KubernetesVersion),
+		State:              string(n.LifecycleState),
+		LifecycleDetails:   stringValue(n.LifecycleDetails),
+		PrivateIP:          stringValue(n.PrivateIp),
+		PublicIP:           stringValue(n.PublicIp),
+		AvailabilityDomain: stringValue(n.AvailabilityDomain),
+		FaultDomain:        stringValue(n.FaultDomain),
+		SubnetID:           stringValue(n.SubnetId),
+	}
+	if n.NodeError != nil {
+		node.Error = stringValue(n.NodeError.Message)
+		if code := stringValue(n.NodeError.Code); code != "" {
+			node.Error = code + ": " + node.Error
+		}
+	}
+	return node
+}
+
+// NewDomainClusterAddon maps an OCI add-on summary to the domain model.
+func NewDomainClusterAddon(a containerengine.AddonSummary) domain.ClusterAddon {
+	addon := domain.ClusterAddon{
+		Name:    stringValue(a.Name),
+		Version: stringValue(a.CurrentInstalledVersion),
+		State:   string(a.LifecycleState),
+	}
+	if addon.Version == "" {
+		addon.Version = stringValue(a.Version)
+	}
+	if a.AddonError != nil {
+		addon.Error = stringValue(a.AddonError.Message)
+		if code := stringValue(a.AddonError.Code); code != "" {
+			addon.Error = code + ": " + addon.Error
+		}
+	}
+	return addon
+}
+
+// NewDomainVirtualNodePool maps an OCI virtual node pool summary to the domain model.
+func NewDomainVirtualNodePool(vnp containerengine.VirtualNodePoolSummary) domain.VirtualNodePool {
+	pool := domain.VirtualNodePool{
+		OCID:              stringValue(vnp.Id),
+		DisplayName:       stringValue(vnp.DisplayName),
+		KubernetesVersion: stringValue(vnp.KubernetesVersion),
+		State:             string(vnp.LifecycleState),
+		FreeformTags:      vnp.FreeformTags,
+		DefinedTags:       vnp.DefinedTags,
+	}
+	if vnp.PodConfiguration != nil {
+		pool.PodShape = stringValue(vnp.PodConfiguration.Shape)
+	}
+	if vnp.Size != nil {
+		pool.Size = *vnp.Size
+	}
+	return pool
+}
+
+// NewDomainVirtualNode maps an OCI virtual node summary to the domain model.
+func NewDomainVirtualNode(vn containerengine.VirtualNodeSummary) domain.VirtualNode {
+	return domain.VirtualNode{
+		OCID:               stringValue(vn.Id),
+		DisplayName:        stringValue(vn.DisplayName),
+		VirtualNodePoolID:  stringValue(vn.VirtualNodePoolId),
+		KubernetesVersion:  stringValue(vn.KubernetesVersion),
+		State:              string(vn.LifecycleState),
+		LifecycleDetails:   stringValue(vn.LifecycleDetails),
+		PrivateIP:          stringValue(vn.PrivateIp),
+		AvailabilityDomain: stringValue(vn.AvailabilityDomain),
+		FaultDomain:        stringValue(vn.FaultDomain),
+		SubnetID:           stringValue(vn.SubnetId),
+		Error:              stringValue(vn.VirtualNodeError),
+	}
+}
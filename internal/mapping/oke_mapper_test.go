@@ -33,8 +33,9 @@ func TestCluster_Attributes_From_OCI_And_Domain(t *testing.T) {
 		Metadata: &containerengine.ClusterMetadata{
 			TimeCreated: &common.SDKTime{Time: created},
 		},
-		FreeformTags: map[string]string{"team": "platform"},
-		DefinedTags:  map[string]map[string]interface{}{"ns": {"k": "v"}},
+		FreeformTags:                map[string]string{"team": "platform"},
+		DefinedTags:                 map[string]map[string]interface{}{"ns": {"k": "v"}},
+		AvailableKubernetesUpgrades: []string{"v1.29.10", "v1.30.1"},
 	}
 
 	attrs := mapping.NewClusterAttributesFromOCICluster(ociCluster)
@@ -61,6 +62,7 @@ func TestCluster_Attributes_From_OCI_And_Domain(t *testing.T) {
 	require.True(t, created.Equal(dom.TimeCreated))
 	require.Equal(t, map[string]string{"team": "platform"}, dom.FreeformTags)
 	require.Equal(t, map[string]map[string]interface{}{"ns": {"k": "v"}}, dom.DefinedTags)
+	require.Equal(t, []string{"v1.29.10", "v1.30.1"}, dom.AvailableUpgrades)
 }
 
 func TestClusterSummary_Attributes_From_OCI(t *testing.T) {
@@ -161,3 +163,111 @@ func TestNodePoolSummary_Attributes_From_OCI(t *testing.T) {
 	require.Equal(t, &shape, attrs.NodeShape)
 	require.Equal(t, &count, attrs.NodeCount)
 }
+
+func TestNewDomainNode(t *testing.T) {
+	node := mapping.NewDomainNode(containerengine.Node{
+		Id:                 common.String("ocid1.instance.oc1..n1"),
+		Name:               common.String("oke-c1-n1"),
+		NodePoolId:         common.String("ocid1.nodepool.oc1..np1"),
+		KubernetesVersion:  common.String("v1.31.1"),
+		AvailabilityDomain: common.String("Uocm:PHX-AD-1"),
+		FaultDomain:        common.String("FAULT-DOMAIN-2"),
+		PrivateIp:          common.String("10.0.10.7"),
+		SubnetId:           common.String("ocid1.subnet.oc1..workers"),
+		LifecycleState:     containerengine.NodeLifecycleStateFailing,
+		LifecycleDetails:   common.String("node registration failed"),
+		NodeError: &containerengine.NodeError{
+			Code:    common.String("LimitExceeded"),
+			Message: common.String("service limit reached"),
+		},
+	})
+
+	require.Equal(t, "ocid1.instance.oc1..n1", node.OCID)
+	require.Equal(t, "oke-c1-n1", node.DisplayName)
+	require.Equal(t, "ocid1.nodepool.oc1..np1", node.NodePoolID)
+	require.Equal(t, "v1.31.1", node.KubernetesVersion)
+	require.Equal(t, "Uocm:PHX-AD-1", node.AvailabilityDomain)
+	require.Equal(t, "FAULT-DOMAIN-2", node.FaultDomain)
+	require.Equal(t, "10.0.10.7", node.PrivateIP)
+	require.Equal(t, "ocid1.subnet.oc1..workers", node.SubnetID)
+	require.Equal(t, "FAILING", node.State)
+	require.Equal(t, "node registration failed", node.LifecycleDetails)
+	require.Equal(t, "LimitExceeded: service limit reached", node.Error)
+	require.Nil(t, node.Instance)
+
+	require.Empty(t, mapping.NewDomainNode(containerengine.Node{}).Error)
+}
+
+func TestClusterOptionsAndAddons_From_OCI(t *testing.T) {
+	pods, services, key := "10.244.0.0/16", "10.96.0.0/16", "ocid1.key.oc1..k"
+	ociCluster := containerengine.ClusterSummary{
+		Options: &containerengine.ClusterCreateOptions{
+			KubernetesNetworkConfig:    &containerengine.KubernetesNetworkConfig{PodsCidr: &pods, ServicesCidr: &services},
+			AdmissionControllerOptions: &containerengine.AdmissionControllerOptions{IsPodSecurityPolicyEnabled: common.Bool(true)},
+		},
+		ImagePolicyConfig: &containerengine.ImagePolicyConfig{
+			IsPolicyEnabled: common.Bool(true),
+			KeyDetails:      []containerengine.KeyDetails{{KmsKeyId: &key}, {}},
+		},
+		ClusterPodNetworkOptions: []containerengine.ClusterPodNetworkOptionDetails{containerengine.OciVcnIpNativeClusterPodNetworkOptionDetails{}},
+	}
+
+	dom := mapping.NewDomainClusterFromAttrs(mapping.NewClusterAttributesFromOCIClusterSummary(ociCluster))
+	require.Equal(t, domain.ClusterOptions{
+		CNIType:                  "OCI_VCN_IP_NATIVE",
+		PodsCIDR:                 pods,
+		ServicesCIDR:             services,
+		PodSecurityPolicyEnabled: true,
+		ImagePolicyEnabled:       true,
+		ImageSigningKeyIDs:       []string{key},
+	}, dom.Options)
+
+	addon := mapping.NewDomainClusterAddon(containerengine.AddonSummary{
+		Name:           common.String("CertManager"),
+		Version:        common.String("v1.14.4"),
+		LifecycleState: containerengine.AddonLifecycleStateNeedsAttention,
+		AddonError:     &containerengine.AddonError{Code: common.String("InstallFailed"), Message: common.String("timed out")},
+	})
+	require.Equal(t, domain.ClusterAddon{Name: "CertManager", Version: "v1.14.4", State: "NEEDS_ATTENTION", Error: "InstallFailed: timed out"}, addon)
+
+	addon = mapping.NewDomainClusterAddon(containerengine.AddonSummary{
+		Name:                    common.String("KubernetesDashboard"),
+		Version:                 common.String("v2.7.0"),
+		CurrentInstalledVersion: common.String("v2.6.1"),
+		LifecycleState:          containerengine.AddonLifecycleStateActive,
+	})
+	require.Equal(t, "v2.6.1", addon.Version, "the installed version wins over the configured one")
+}
+
+func TestVirtualNodePoolAndVirtualNode_From_OCI(t *testing.T) {
+	pool := mapping.NewDomainVirtualNodePool(containerengine.VirtualNodePoolSummary{
+		Id:                common.String("ocid1.virtualnodepool.oc1..v"),
+		DisplayName:       common.String("virtual-a"),
+		KubernetesVersion: common.String("v1.31.1"),
+		LifecycleState:    containerengine.VirtualNodePoolLifecycleStateActive,
+		PodConfiguration:  &containerengine.PodConfiguration{Shape: common.String("Pod.Standard.E4.Flex")},
+		Size:              common.Int(3),
+	})
+	require.Equal(t, domain.VirtualNodePool{
+		OCID:              "ocid1.virtualnodepool.oc1..v",
+		DisplayName:       "virtual-a",
+		KubernetesVersion: "v1.31.1",
+		State:             "ACTIVE",
+		PodShape:          "Pod.Standard.E4.Flex",
+		Size:              3,
+	}, pool)
+
+	node := mapping.NewDomainVirtualNode(containerengine.VirtualNodeSummary{
+		Id:                common.String("ocid1.virtualnode.oc1..n"),
+		DisplayName:       common.String("vnode-1"),
+		VirtualNodePoolId: common.String("ocid1.virtualnodepool.oc1..v"),
+		LifecycleState:    containerengine.VirtualNodeLifecycleStateFailed,
+		PrivateIp:         common.String("10.0.20.4"),
+		FaultDomain:       common.String("FAULT-DOMAIN-1"),
+		VirtualNodeError:  common.String("insufficient capacity"),
+	})
+	require.Equal(t, "ocid1.virtualnodepool.oc1..v", node.VirtualNodePoolID)
+	require.Equal(t, "FAILED", node.State)
+	require.Equal(t, "10.0.20.4", node.PrivateIP)
+	require.Equal(t, "insufficient capacity", node.Error)
+}
@@ -0,0 +1,16 @@
+package mapping
+
+import (
+	domain "github.com/cnopslabs/ocloud/internal/domain/identity"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+)
+
+// NewDomainRegionSubscription maps an OCI region subscription to the domain model.
+func NewDomainRegionSubscription(s identity.RegionSubscription) domain.RegionSubscription {
+	return domain.RegionSubscription{
+		Name:     stringValue(s.RegionName),
+		Key:      stringValue(s.RegionKey),
+		IsHome:   boolValue(s.IsHomeRegion),
+		IsActive: s.Status == identity.RegionSubscriptionStatusReady,
+	}
+}
@@ -0,0 +1,28 @@
+package mapping
+
+import (
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDomainRegionSubscription(t *testing.T) {
+	sub := NewDomainRegionSubscription(identity.RegionSubscription{
+		RegionName:   common.String("us-ashburn-1"),
+		RegionKey:    common.String("IAD"),
+		IsHomeRegion: common.Bool(true),
+		Status:       identity.RegionSubscriptionStatusReady,
+	})
+
+	assert.Equal(t, "us-ashburn-1", sub.Name)
+	assert.Equal(t, "IAD", sub.Key)
+	assert.True(t, sub.IsHome)
+	assert.True(t, sub.IsActive)
+
+	empty := NewDomainRegionSubscription(identity.RegionSubscription{Status: identity.RegionSubscriptionStatusInProgress})
+	assert.Empty(t, empty.Name)
+	assert.False(t, empty.IsHome)
+	assert.False(t, empty.IsActive)
+}
@@ -0,0 +1,35 @@
+package mapping
+
+import (
+	domain "github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/oracle/oci-go-sdk/v65/core"
+)
+
+// NewDomainShape maps an OCI shape to the domain model. The availability
+// domains and the running instance count are filled in by the caller.
+func NewDomainShape(s core.Shape) domain.Shape {
+	shape := domain.Shape{
+		Name:                 stringValue(s.Shape),
+		ProcessorDescription: stringValue(s.ProcessorDescription),
+		IsFlexible:           boolValue(s.IsFlexible),
+		OCPUs:                float32Value(s.Ocpus),
+		MemoryGB:             float32Value(s.MemoryInGBs),
+		NetworkBandwidthGbps: float32Value(s.NetworkingBandwidthInGbps),
+		MaxVnicAttachments:   intValue(s.MaxVnicAttachments),
+		GPUs:                 intValue(s.Gpus),
+		GPUDescription:       stringValue(s.GpuDescription),
+		LocalDisks:           intValue(s.LocalDisks),
+		LocalDisksTotalGB:    float32Value(s.LocalDisksTotalSizeInGBs),
+		LocalDiskDescription: stringValue(s.LocalDiskDescription),
+		BillingType:          string(s.BillingType),
+	}
+	if s.OcpuOptions != nil {
+		shape.OCPUsMin = float32Value(s.OcpuOptions.Min)
+		shape.OCPUsMax = float32Value(s.OcpuOptions.Max)
+	}
+	if s.MemoryOptions != nil {
+		shape.MemoryMinGB = float32Value(s.MemoryOptions.MinInGBs)
+		shape.MemoryMaxGB = float32Value(s.MemoryOptions.MaxInGBs)
+	}
+	return shape
+}
@@ -0,0 +1,52 @@
+package mapping_test
+
+import (
+	"testing"
+
+	"github.com/cnopslabs/ocloud/internal/mapping"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDomainShape_Flexible(t *testing.T) {
+	s := core.Shape{
+		Shape:                common.String("VM.Standard.E5.Flex"),
+		ProcessorDescription: common.String("2.4 GHz AMD EPYC"),
+		IsFlexible:           common.Bool(true),
+		Ocpus:                common.Float32(1),
+		MemoryInGBs:          common.Float32(16),
+		OcpuOptions:          &core.ShapeOcpuOptions{Min: common.Float32(1), Max: common.Float32(94)},
+		MemoryOptions:        &core.ShapeMemoryOptions{MinInGBs: common.Float32(1), MaxInGBs: common.Float32(1049)},
+		BillingType:          core.ShapeBillingTypePaid,
+	}
+
+	got := mapping.NewDomainShape(s)
+	require.Equal(t, "VM.Standard.E5.Flex", got.Name)
+	require.True(t, got.IsFlexible)
+	require.Equal(t, float32(94), got.OCPUsMax)
+	require.Equal(t, float32(1049), got.MemoryMaxGB)
+	require.Equal(t, "PAID", got.BillingType)
+	require.Empty(t, got.AvailabilityDomains)
+}
+
+func TestNewDomainShape_FixedWithGPUAndNVMe(t *testing.T) {
+	s := core.Shape{
+		Shape:                    common.String("BM.GPU.A10.4"),
+		Ocpus:                    common.Float32(64),
+		MemoryInGBs:              common.Float32(1024),
+		Gpus:                     common.Int(4),
+		GpuDescription:           common.String("NVIDIA A10"),
+		LocalDisks:               common.Int(1),
+		LocalDisksTotalSizeInGBs: common.Float32(7680),
+		LocalDiskDescription:     common.String("NVMe"),
+	}
+
+	got := mapping.NewDomainShape(s)
+	require.False(t, got.IsFlexible)
+	require.Equal(t, float32(64), got.OCPUs)
+	require.Zero(t, got.OCPUsMax)
+	require.Equal(t, 4, got.GPUs)
+	require.Equal(t, "NVIDIA A10", got.GPUDescription)
+	require.Equal(t, float32(7680), got.LocalDisksTotalGB)
+}
@@ -0,0 +1,71 @@
+package mapping
+
+import (
+	domain "github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/oracle/oci-go-sdk/v65/core"
+)
+
+// VolumePerformanceTier names the performance level of a volume from its
+// VPUs per GB, as the OCI console does.
+func VolumePerformanceTier(vpusPerGB int64) string {
+	switch {
+	case vpusPerGB <= 0:
+		return "Lower Cost"
+	case vpusPerGB < 20:
+		return "Balanced"
+	case vpusPerGB < 30:
+		return "Higher Performance"
+	default:
+		return "Ultra High Performance"
+	}
+}
+
+// NewDomainBootVolume maps an OCI boot volume to the domain model.
+func NewDomainBootVolume(v core.BootVolume) *domain.BootVolume {
+	return &domain.BootVolume{
+		ID:              stringValue(v.Id),
+		Name:            stringValue(v.DisplayName),
+		State:           string(v.LifecycleState),
+		SizeGB:          int64Value(v.SizeInGBs),
+		VpusPerGB:       int64Value(v.VpusPerGB),
+		PerformanceTier: VolumePerformanceTier(int64Value(v.VpusPerGB)),
+	}
+}
+
+// NewDomainVolumeAttachment maps an OCI volume attachment, and the volume it
+// attaches when known, to the domain model.
+func NewDomainVolumeAttachment(a core.VolumeAttachment, v *core.Volume) domain.VolumeAttachment {
+	va := domain.VolumeAttachment{
+		ID:             stringValue(a.GetId()),
+		VolumeID:       stringValue(a.GetVolumeId()),
+		VolumeName:     stringValue(a.GetDisplayName()),
+		State:          string(a.GetLifecycleState()),
+		Device:         stringValue(a.GetDevice()),
+		AttachmentType: volumeAttachmentType(a),
+		ReadOnly:       boolValue(a.GetIsReadOnly()),
+		Shareable:      boolValue(a.GetIsShareable()),
+	}
+	if v != nil {
+		if v.DisplayName != nil {
+			va.VolumeName = *v.DisplayName
+		}
+		va.SizeGB = int64Value(v.SizeInGBs)
+		va.VpusPerGB = int64Value(v.VpusPerGB)
+		va.PerformanceTier = VolumePerformanceTier(va.VpusPerGB)
+	}
+	return va
+}
+
+// volumeAttachmentType names the attachment type of a polymorphic OCI volume attachment.
+func volumeAttachmentType(a core.VolumeAttachment) string {
+	switch a.(type) {
+	case core.IScsiVolumeAttachment, *core.IScsiVolumeAttachment:
+		return "iscsi"
+	case core.ParavirtualizedVolumeAttachment, *core.ParavirtualizedVolumeAttachment:
+		return "paravirtualized"
+	case core.EmulatedVolumeAttachment, *core.EmulatedVolumeAttachment:
+		return "emulated"
+	default:
+		return ""
+	}
+}
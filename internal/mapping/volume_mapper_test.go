@@ -0,0 +1,63 @@
+package mapping_test
+
+import (
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cnopslabs/ocloud/internal/mapping"
+)
+
+func TestVolumePerformanceTier(t *testing.T) {
+	assert.Equal(t, "Lower Cost", mapping.VolumePerformanceTier(0))
+	assert.Equal(t, "Balanced", mapping.VolumePerformanceTier(10))
+	assert.Equal(t, "Higher Performance", mapping.VolumePerformanceTier(20))
+	assert.Equal(t, "Ultra High Performance", mapping.VolumePerformanceTier(120))
+}
+
+func TestNewDomainBootVolume(t *testing.T) {
+	bv := mapping.NewDomainBootVolume(core.BootVolume{
+		Id:             common.String("ocid1.bootvolume.oc1..a"),
+		DisplayName:    common.String("web-1 (Boot Volume)"),
+		LifecycleState: core.BootVolumeLifecycleStateAvailable,
+		SizeInGBs:      common.Int64(50),
+		VpusPerGB:      common.Int64(10),
+	})
+	assert.Equal(t, "ocid1.bootvolume.oc1..a", bv.ID)
+	assert.Equal(t, "web-1 (Boot Volume)", bv.Name)
+	assert.Equal(t, "AVAILABLE", bv.State)
+	assert.Equal(t, int64(50), bv.SizeGB)
+	assert.Equal(t, "Balanced", bv.PerformanceTier)
+}
+
+func TestNewDomainVolumeAttachment(t *testing.T) {
+	attachment := core.ParavirtualizedVolumeAttachment{
+		Id:             common.String("ocid1.volumeattachment.oc1..a"),
+		VolumeId:       common.String("ocid1.volume.oc1..v"),
+		DisplayName:    common.String("attachment-1"),
+		Device:         common.String("/dev/oracleoci/oraclevdb"),
+		IsReadOnly:     common.Bool(true),
+		LifecycleState: core.VolumeAttachmentLifecycleStateAttached,
+	}
+
+	va := mapping.NewDomainVolumeAttachment(attachment, &core.Volume{
+		DisplayName: common.String("data"),
+		SizeInGBs:   common.Int64(1024),
+		VpusPerGB:   common.Int64(20),
+	})
+	assert.Equal(t, "ocid1.volume.oc1..v", va.VolumeID)
+	assert.Equal(t, "data", va.VolumeName)
+	assert.Equal(t, "/dev/oracleoci/oraclevdb", va.Device)
+	assert.Equal(t, "paravirtualized", va.AttachmentType)
+	assert.Equal(t, "ATTACHED", va.State)
+	assert.True(t, va.ReadOnly)
+	assert.Equal(t, int64(1024), va.SizeGB)
+	assert.Equal(t, "Higher Performance", va.PerformanceTier)
+
+	iscsi := mapping.NewDomainVolumeAttachment(core.IScsiVolumeAttachment{DisplayName: common.String("attachment-2")}, nil)
+	assert.Equal(t, "iscsi", iscsi.AttachmentType)
+	assert.Equal(t, "attachment-2", iscsi.VolumeName, "the attachment name stands in when the volume is unknown")
+	assert.Empty(t, iscsi.PerformanceTier)
+}
@@ -7,11 +7,16 @@ import (
 	domain "github.com/cnopslabs/ocloud/internal/domain/compute"
 	"github.com/cnopslabs/ocloud/internal/mapping"
 	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/workrequests"
 )
 
-// Adapter is an infrastructure-layer adapter that implements the domain.ImageRepository interface.
+// Adapter is an infrastructure-layer adapter that implements the domain.ImageRepository
+// and domain.ImageCatalog interfaces and, when built WithWorkRequests, the
+// domain.ImageController interface.
 type Adapter struct {
 	client core.ComputeClient
+	// workRequestClient is set by WithWorkRequests; GetWorkRequest needs it.
+	workRequestClient *workrequests.WorkRequestClient
 }
 
 // NewAdapter creates a new adapter for interacting with OCI images.
@@ -19,6 +24,13 @@ func NewAdapter(client core.ComputeClient) *Adapter {
 	return &Adapter{client: client}
 }
 
+// WithWorkRequests lets the adapter follow the work requests of image creation,
+// export and import.
+func (a *Adapter) WithWorkRequests(workRequestClient workrequests.WorkRequestClient) *Adapter {
+	a.workRequestClient = &workRequestClient
+	return a
+}
+
 // GetImage retrieves a single image by its OCID.
 func (a *Adapter) GetImage(ctx context.Context, ocid string) (*domain.Image, error) {
 	resp, err := a.client.GetImage(ctx, core.GetImageRequest{
@@ -58,3 +70,149 @@ func (a *Adapter) ListImages(ctx context.Context, compartmentID string) ([]domai
 
 	return images, nil
 }
+
+// ListPlatformImages returns the available platform images of an operating
+// system version, newest first. Platform images are the ones without a compartment.
+func (a *Adapter) ListPlatformImages(ctx context.Context, compartmentID, operatingSystem, version string) ([]domain.Image, error) {
+	var images []domain.Image
+	var page *string
+	for {
+		resp, err := a.client.ListImages(ctx, core.ListImagesRequest{
+			CompartmentId:          &compartmentID,
+			OperatingSystem:        &operatingSystem,
+			OperatingSystemVersion: &version,
+			LifecycleState:         core.ImageLifecycleStateAvailable,
+			SortBy:                 core.ListImagesSortByTimecreated,
+			SortOrder:              core.ListImagesSortOrderDesc,
+			Page:                   page,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing platform images from OCI: %w", err)
+		}
+		for _, item := range resp.Items {
+			if stringValue(item.CompartmentId) != "" {
+				continue
+			}
+			images = append(images, mapping.NewDomainImageFromAttrs(*mapping.NewImageAttributesFromOCIImage(item)))
+		}
+		if resp.OpcNextPage == nil {
+			break
+		}
+		page = resp.OpcNextPage
+	}
+	return images, nil
+}
+
+// ListCompatibleShapes returns the shapes an image can be launched on.
+func (a *Adapter) ListCompatibleShapes(ctx context.Context, imageID string) ([]domain.ImageShapeCompatibility, error) {
+	var shapes []domain.ImageShapeCompatibility
+	var page *string
+	for {
+		resp, err := a.client.ListImageShapeCompatibilityEntries(ctx, core.ListImageShapeCompatibilityEntriesRequest{
+			ImageId: &imageID,
+			Page:    page,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing image shape compatibility from OCI: %w", err)
+		}
+		for _, item := range resp.Items {
+			shapes = append(shapes, mapping.NewDomainImageShapeCompatibility(item))
+		}
+		if resp.OpcNextPage == nil {
+			break
+		}
+		page = resp.OpcNextPage
+	}
+	return shapes, nil
+}
+
+// CreateImageFromInstance starts capturing a custom image of the boot volume of an instance.
+func (a *Adapter) CreateImageFromInstance(ctx context.Context, compartmentID, instanceID, displayName string) (*domain.Image, string, error) {
+	details := core.CreateImageDetails{
+		CompartmentId: &compartmentID,
+		InstanceId:    &instanceID,
+	}
+	if displayName != "" {
+		details.DisplayName = &displayName
+	}
+	resp, err := a.client.CreateImage(ctx, core.CreateImageRequest{CreateImageDetails: details})
+	if err != nil {
+		return nil, "", fmt.Errorf("creating image in OCI: %w", err)
+	}
+	img := mapping.NewDomainImageFromAttrs(*mapping.NewImageAttributesFromOCIImage(resp.Image))
+	return &img, stringValue(resp.OpcWorkRequestId), nil
+}
+
+// ExportImage starts exporting an image to an Object Storage object in the given format.
+func (a *Adapter) ExportImage(ctx context.Context, imageID string, dest domain.ObjectLocation, format string) (string, error) {
+	resp, err := a.client.ExportImage(ctx, core.ExportImageRequest{
+		ImageId: &imageID,
+		ExportImageDetails: core.ExportImageViaObjectStorageTupleDetails{
+			NamespaceName: &dest.Namespace,
+			BucketName:    &dest.Bucket,
+			ObjectName:    &dest.Object,
+			ExportFormat:  core.ExportImageDetailsExportFormatEnum(format),
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("exporting image in OCI: %w", err)
+	}
+	return stringValue(resp.OpcWorkRequestId), nil
+}
+
+// ImportImage starts importing a custom image from an Object Storage object.
+func (a *Adapter) ImportImage(ctx context.Context, req domain.ImageImport) (*domain.Image, string, error) {
+	source := core.ImageSourceViaObjectStorageTupleDetails{
+		NamespaceName:   &req.Source.Namespace,
+		BucketName:      &req.Source.Bucket,
+		ObjectName:      &req.Source.Object,
+		SourceImageType: core.ImageSourceDetailsSourceImageTypeEnum(req.SourceImageType),
+	}
+	if req.OperatingSystem != "" {
+		source.OperatingSystem = &req.OperatingSystem
+	}
+	if req.OperatingSystemVersion != "" {
+		source.OperatingSystemVersion = &req.OperatingSystemVersion
+	}
+	details := core.CreateImageDetails{
+		CompartmentId:      &req.CompartmentID,
+		ImageSourceDetails: source,
+	}
+	if req.DisplayName != "" {
+		details.DisplayName = &req.DisplayName
+	}
+	resp, err := a.client.CreateImage(ctx, core.CreateImageRequest{CreateImageDetails: details})
+	if err != nil {
+		return nil, "", fmt.Errorf("importing image in OCI: %w", err)
+	}
+	img := mapping.NewDomainImageFromAttrs(*mapping.NewImageAttributesFromOCIImage(resp.Image))
+	return &img, stringValue(resp.OpcWorkRequestId), nil
+}
+
+// GetWorkRequest returns the progress of a work request, with its error
+// messages once it failed.
+func (a *Adapter) GetWorkRequest(ctx context.Context, workRequestID string) (*domain.WorkRequest, error) {
+	if a.workRequestClient == nil {
+		return nil, fmt.Errorf("image adapter was built without a work request client")
+	}
+	resp, err := a.workRequestClient.GetWorkRequest(ctx, workrequests.GetWorkRequestRequest{WorkRequestId: &workRequestID})
+	if err != nil {
+		return nil, fmt.Errorf("getting work request from OCI: %w", err)
+	}
+	var errs []workrequests.WorkRequestError
+	if resp.Status == workrequests.WorkRequestStatusFailed {
+		errResp, err := a.workRequestClient.ListWorkRequestErrors(ctx, workrequests.ListWorkRequestErrorsRequest{WorkRequestId: &workRequestID})
+		if err != nil {
+			return nil, fmt.Errorf("listing work request errors from OCI: %w", err)
+		}
+		errs = errResp.Items
+	}
+	return mapping.NewDomainWorkRequest(resp.WorkRequest, errs), nil
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
 	domain "github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/logger"
 	"github.com/cnopslabs/ocloud/internal/mapping"
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/core"
@@ -20,10 +22,17 @@ const (
 )
 
 // Adapter is an infrastructure-layer adapter for compute instances.
-// It implements the domain.InstanceRepository interface.
+// It implements the domain.InstanceRepository, domain.InstanceController and
+// domain.InstanceConsole interfaces.
 type Adapter struct {
 	computeClient core.ComputeClient
 	networkClient core.VirtualNetworkClient
+	// storageClient is set by WithStorage; lookups and listings then report volumes.
+	storageClient *core.BlockstorageClient
+	// vnicDetails is set by WithVnicDetails; listings then describe every VNIC.
+	vnicDetails bool
+	// metadata is set by WithMetadata; instances then keep their launch metadata.
+	metadata bool
 }
 
 // NewAdapter creates a new instance adapter.
@@ -34,19 +43,92 @@ func NewAdapter(computeClient core.ComputeClient, networkClient core.VirtualNetw
 	}
 }
 
-// GetEnrichedInstance fetches a single instance by OCID and enriches it with network and image details.
+// WithStorage makes GetEnrichedInstance and ListEnrichedInstances also report
+// the boot volume and the block volume attachments of each instance, which costs
+// a few extra calls per instance.
+func (a *Adapter) WithStorage(storageClient core.BlockstorageClient) *Adapter {
+	a.storageClient = &storageClient
+	return a
+}
+
+// WithVnicDetails makes ListEnrichedInstances also report the subnet and NSG
+// names of secondary VNICs and the secondary private IPs of every VNIC, with
+// their public IPs, which costs a few extra calls per VNIC. GetEnrichedInstance
+// always reports them.
+func (a *Adapter) WithVnicDetails() *Adapter {
+	a.vnicDetails = true
+	return a
+}
+
+// WithMetadata makes every lookup and listing report the launch metadata and
+// extended metadata of instances. Both are left out otherwise, since user_data
+// often holds secrets that must not end up in the resource cache.
+func (a *Adapter) WithMetadata() *Adapter {
+	a.metadata = true
+	return a
+}
+
+// newDomainInstance maps an OCI instance to the domain model, without its
+// metadata unless the adapter was built WithMetadata.
+func (a *Adapter) newDomainInstance(ociInstance core.Instance) *domain.Instance {
+	dm := mapping.NewDomainInstanceFromAttrs(mapping.NewInstanceAttributesFromOCIInstance(ociInstance))
+	if !a.metadata {
+		dm.Metadata = nil
+		dm.ExtendedMetadata = nil
+	}
+	return dm
+}
+
+// GetEnrichedInstance fetches a single instance by OCID and enriches it with network and image details,
+// and with storage details when the adapter was built WithStorage.
 func (a *Adapter) GetEnrichedInstance(ctx context.Context, instanceID string) (*domain.Instance, error) {
 	resp, err := a.computeClient.GetInstance(ctx, core.GetInstanceRequest{InstanceId: &instanceID})
 	if err != nil {
 		return nil, fmt.Errorf("getting instance from OCI: %w", err)
 	}
-	dm, err := a.enrichAndMapInstance(ctx, resp.Instance)
-	if err != nil {
+	dm := a.newDomainInstance(resp.Instance)
+	if err := a.enrichDomainInstance(ctx, dm, resp.Instance, true); err != nil {
 		return nil, err
 	}
+	if a.storageClient != nil {
+		if err := a.enrichStorage(ctx, dm, resp.Instance); err != nil {
+			return nil, err
+		}
+	}
 	return dm, nil
 }
 
+// GetInstance fetches a single instance by OCID without enrichment, which keeps
+// polling its lifecycle state to one API call.
+func (a *Adapter) GetInstance(ctx context.Context, instanceID string) (*domain.Instance, error) {
+	var resp core.GetInstanceResponse
+	err := retryOnRateLimit(ctx, defaultMaxRetries, defaultInitialBackoff, defaultMaxBackoff, func() error {
+		var e error
+		resp, e = a.computeClient.GetInstance(ctx, core.GetInstanceRequest{InstanceId: &instanceID})
+		return e
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting instance from OCI: %w", err)
+	}
+	return a.newDomainInstance(resp.Instance), nil
+}
+
+// InstanceAction sends a power action (START, STOP, SOFTSTOP, RESET, SOFTRESET) to an instance.
+func (a *Adapter) InstanceAction(ctx context.Context, instanceID string, action domain.InstanceAction) (*domain.Instance, error) {
+	ociAction, ok := core.GetMappingInstanceActionActionEnum(string(action))
+	if !ok {
+		return nil, fmt.Errorf("unsupported instance action %q", action)
+	}
+	resp, err := a.computeClient.InstanceAction(ctx, core.InstanceActionRequest{
+		InstanceId: &instanceID,
+		Action:     ociAction,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("sending %s to instance: %w", action, err)
+	}
+	return a.newDomainInstance(resp.Instance), nil
+}
+
 // ListInstances fetches all instances in a compartment.
 func (a *Adapter) ListInstances(ctx context.Context, compartmentID string) ([]domain.Instance, error) {
 	var allInstances []domain.Instance
@@ -61,7 +143,7 @@ func (a *Adapter) ListInstances(ctx context.Context, compartmentID string) ([]do
 			return nil, fmt.Errorf("listing instances from OCI: %w", err)
 		}
 		for _, item := range resp.Items {
-			allInstances = append(allInstances, *mapping.NewDomainInstanceFromAttrs(mapping.NewInstanceAttributesFromOCIInstance(item)))
+			allInstances = append(allInstances, *a.newDomainInstance(item))
 		}
 
 		if resp.OpcNextPage == nil {
@@ -73,7 +155,8 @@ func (a *Adapter) ListInstances(ctx context.Context, compartmentID string) ([]do
 	return allInstances, nil
 }
 
-// ListEnrichedInstances fetches all instances in a compartment and enriches them with network and image details.
+// ListEnrichedInstances fetches all instances in a compartment and enriches them with network and image details,
+// and with storage details when the adapter was built WithStorage.
 func (a *Adapter) ListEnrichedInstances(ctx context.Context, compartmentID string) ([]domain.Instance, error) {
 	var allInstances []core.Instance
 	var page *string
@@ -108,12 +191,18 @@ func (a *Adapter) enrichAndMapInstances(ctx context.Context, ociInstances []core
 		go func(i int, ociInstance core.Instance) {
 			defer wg.Done()
 
-			dm := mapping.NewDomainInstanceFromAttrs(mapping.NewInstanceAttributesFromOCIInstance(ociInstance))
+			dm := a.newDomainInstance(ociInstance)
 
-			if err := a.enrichDomainInstance(ctx, dm, ociInstance); err != nil {
+			if err := a.enrichDomainInstance(ctx, dm, ociInstance, a.vnicDetails); err != nil {
 				errChan <- err
 				return
 			}
+			if a.storageClient != nil {
+				if err := a.enrichStorage(ctx, dm, ociInstance); err != nil {
+					errChan <- err
+					return
+				}
+			}
 
 			domainInstances[i] = *dm
 		}(i, ociInstance)
@@ -129,22 +218,18 @@ func (a *Adapter) enrichAndMapInstances(ctx context.Context, ociInstances []core
 	return domainInstances, nil
 }
 
-// enrichAndMapInstance converts a single OCI instance to a domain model and enriches it with details.
-func (a *Adapter) enrichAndMapInstance(ctx context.Context, ociInstance core.Instance) (*domain.Instance, error) {
-	dm := mapping.NewDomainInstanceFromAttrs(mapping.NewInstanceAttributesFromOCIInstance(ociInstance))
-	if err := a.enrichDomainInstance(ctx, dm, ociInstance); err != nil {
-		return dm, err
-	}
-
-	return dm, nil
-}
-
-// enrichDomainInstance enriches the given domain instance with network, subnet, VCN, route table and image details.
-func (a *Adapter) enrichDomainInstance(ctx context.Context, dm *domain.Instance, ociInstance core.Instance) error {
-	vnic, err := a.getPrimaryVnic(ctx, *ociInstance.Id, *ociInstance.CompartmentId)
+// enrichDomainInstance enriches the given domain instance with network, subnet, VCN, route table and image details,
+// and with every VNIC and its primary private and public IPs. With vnicDetails set, each VNIC is described by
+// describeVnic as well.
+func (a *Adapter) enrichDomainInstance(ctx context.Context, dm *domain.Instance, ociInstance core.Instance, vnicDetails bool) error {
+	vnics, err := a.getVnics(ctx, *ociInstance.Id, *ociInstance.CompartmentId)
 	if err != nil {
 		return fmt.Errorf("enriching instance %s with network: %w", dm.OCID, err)
 	}
+	var vnic *core.Vnic
+	if len(vnics) > 0 && vnics[0].IsPrimary != nil && *vnics[0].IsPrimary {
+		vnic = &vnics[0]
+	}
 	if vnic != nil {
 		vnicAttrs := mapping.NewVnicAttributesFromOCIVnic(*vnic)
 		dm.PrimaryIP = *vnicAttrs.PrivateIp
@@ -211,6 +296,21 @@ func (a *Adapter) enrichDomainInstance(ctx context.Context, dm *domain.Instance,
 		}
 	}
 
+	for _, v := range vnics {
+		dv := mapping.NewDomainVnic(v)
+		if dv.IsPrimary {
+			dv.SubnetName = dm.SubnetName
+			dv.NsgNames = dm.NsgNames
+		}
+		if vnicDetails {
+			a.describeVnic(ctx, v, &dv)
+		}
+		if dv.IsPrimary {
+			dm.PublicIP = dv.PublicIP
+		}
+		dm.Vnics = append(dm.Vnics, dv)
+	}
+
 	image, err := a.getImage(ctx, *ociInstance.ImageId)
 	if err != nil {
 		return fmt.Errorf("enriching instance %s with image: %w", dm.OCID, err)
@@ -223,8 +323,65 @@ func (a *Adapter) enrichDomainInstance(ctx context.Context, dm *domain.Instance,
 	return nil
 }
 
-// getPrimaryVnic finds the primary VNIC for a given instance.
-func (a *Adapter) getPrimaryVnic(ctx context.Context, instanceID, compartmentID string) (*core.Vnic, error) {
+// enrichStorage adds the boot volume and the attached block volumes of the instance.
+// Detached attachments are skipped.
+func (a *Adapter) enrichStorage(ctx context.Context, dm *domain.Instance, ociInstance core.Instance) error {
+	bootAttachments, err := a.computeClient.ListBootVolumeAttachments(ctx, core.ListBootVolumeAttachmentsRequest{
+		AvailabilityDomain: ociInstance.AvailabilityDomain,
+		CompartmentId:      ociInstance.CompartmentId,
+		InstanceId:         ociInstance.Id,
+	})
+	if err != nil {
+		return fmt.Errorf("enriching instance %s with boot volume attachments: %w", dm.OCID, err)
+	}
+	for _, attachment := range bootAttachments.Items {
+		if attachment.LifecycleState != core.BootVolumeAttachmentLifecycleStateAttached || attachment.BootVolumeId == nil {
+			continue
+		}
+		resp, err := a.storageClient.GetBootVolume(ctx, core.GetBootVolumeRequest{BootVolumeId: attachment.BootVolumeId})
+		if err != nil {
+			return fmt.Errorf("enriching instance %s with boot volume: %w", dm.OCID, err)
+		}
+		dm.BootVolume = mapping.NewDomainBootVolume(resp.BootVolume)
+		break
+	}
+
+	var page *string
+	for {
+		resp, err := a.computeClient.ListVolumeAttachments(ctx, core.ListVolumeAttachmentsRequest{
+			CompartmentId: ociInstance.CompartmentId,
+			InstanceId:    ociInstance.Id,
+			Page:          page,
+		})
+		if err != nil {
+			return fmt.Errorf("enriching instance %s with volume attachments: %w", dm.OCID, err)
+		}
+		for _, attachment := range resp.Items {
+			state := attachment.GetLifecycleState()
+			if state == core.VolumeAttachmentLifecycleStateDetached || state == core.VolumeAttachmentLifecycleStateDetaching {
+				continue
+			}
+			var volume *core.Volume
+			if volumeID := attachment.GetVolumeId(); volumeID != nil {
+				v, err := a.storageClient.GetVolume(ctx, core.GetVolumeRequest{VolumeId: volumeID})
+				if err != nil {
+					return fmt.Errorf("enriching instance %s with volume %s: %w", dm.OCID, *volumeID, err)
+				}
+				volume = &v.Volume
+			}
+			dm.BlockVolumes = append(dm.BlockVolumes, mapping.NewDomainVolumeAttachment(attachment, volume))
+		}
+		if resp.OpcNextPage == nil {
+			break
+		}
+		page = resp.OpcNextPage
+	}
+	return nil
+}
+
+// getVnics fetches the VNICs attached to an instance, the primary one first.
+// VNICs that are detached or can no longer be read are skipped.
+func (a *Adapter) getVnics(ctx context.Context, instanceID, compartmentID string) ([]core.Vnic, error) {
 	var attachments core.ListVnicAttachmentsResponse
 	var err error
 	err = retryOnRateLimit(ctx, defaultMaxRetries, defaultInitialBackoff, defaultMaxBackoff, func() error {
@@ -239,24 +396,78 @@ func (a *Adapter) getPrimaryVnic(ctx context.Context, instanceID, compartmentID
 		return nil, err
 	}
 
+	var vnics []core.Vnic
 	for _, attach := range attachments.Items {
-		if attach.VnicId != nil {
-			var resp core.GetVnicResponse
-			var vnicErr error
-
-			vnicErr = retryOnRateLimit(ctx, defaultMaxRetries, defaultInitialBackoff, defaultMaxBackoff, func() error {
-				var e error
-				resp, e = a.networkClient.GetVnic(ctx, core.GetVnicRequest{VnicId: attach.VnicId})
-				return e
-			})
-			if vnicErr == nil {
-				if resp.Vnic.IsPrimary != nil && *resp.Vnic.IsPrimary {
-					return &resp.Vnic, nil
-				}
+		if attach.VnicId == nil || attach.LifecycleState == core.VnicAttachmentLifecycleStateDetached {
+			continue
+		}
+		var resp core.GetVnicResponse
+		vnicErr := retryOnRateLimit(ctx, defaultMaxRetries, defaultInitialBackoff, defaultMaxBackoff, func() error {
+			var e error
+			resp, e = a.networkClient.GetVnic(ctx, core.GetVnicRequest{VnicId: attach.VnicId})
+			return e
+		})
+		if vnicErr != nil {
+			continue
+		}
+		if resp.Vnic.IsPrimary != nil && *resp.Vnic.IsPrimary {
+			vnics = append([]core.Vnic{resp.Vnic}, vnics...)
+		} else {
+			vnics = append(vnics, resp.Vnic)
+		}
+	}
+	return vnics, nil
+}
+
+// describeVnic adds to dv the subnet and NSG names of a secondary VNIC, its
+// secondary private IPs and the public IPs assigned to each of them. The primary
+// VNIC already has its names from the instance. A lookup that fails is logged
+// and leaves its part of dv empty, so that one unreadable VNIC does not fail the
+// listing.
+func (a *Adapter) describeVnic(ctx context.Context, vnic core.Vnic, dv *domain.Vnic) {
+	if !dv.IsPrimary {
+		if vnic.SubnetId != nil {
+			subnet, err := a.getSubnet(ctx, *vnic.SubnetId)
+			if err != nil {
+				logger.LogWithLevel(logger.CmdLogger, logger.Info, "skipping subnet of vnic", "vnic", dv.ID, "error", err)
+			} else if subnet.DisplayName != nil {
+				dv.SubnetName = *subnet.DisplayName
+			}
+		}
+		for _, nsgID := range vnic.NsgIds {
+			nsgName, err := a.getNsgName(ctx, nsgID)
+			if err != nil {
+				logger.LogWithLevel(logger.CmdLogger, logger.Info, "skipping NSG of vnic", "vnic", dv.ID, "nsg", nsgID, "error", err)
+				continue
+			}
+			dv.NsgNames = append(dv.NsgNames, nsgName)
+		}
+	}
+
+	privateIPs, err := a.getPrivateIps(ctx, dv.ID)
+	if err != nil {
+		logger.LogWithLevel(logger.CmdLogger, logger.Info, "skipping private IPs of vnic", "vnic", dv.ID, "error", err)
+		return
+	}
+	for _, privateIP := range privateIPs {
+		isPrimary := privateIP.IsPrimary != nil && *privateIP.IsPrimary
+		// The VNIC already reports the public IP of its primary private IP,
+		// so it is only looked up to learn whether it is reserved.
+		if isPrimary && dv.PublicIP == "" {
+			continue
+		}
+		publicIP, err := a.getPublicIpByPrivateIp(ctx, *privateIP.Id)
+		if err != nil {
+			logger.LogWithLevel(logger.CmdLogger, logger.Info, "skipping public IP of private IP", "vnic", dv.ID, "privateIp", *privateIP.Id, "error", err)
+		}
+		if isPrimary {
+			if publicIP != nil {
+				dv.PublicIPLifetime = string(publicIP.Lifetime)
 			}
+			continue
 		}
+		dv.SecondaryIPs = append(dv.SecondaryIPs, mapping.NewDomainPrivateIP(privateIP, publicIP))
 	}
-	return nil, nil
 }
 
 // getSubnet fetches subnet details.
@@ -361,6 +572,48 @@ func (a *Adapter) getNsgName(ctx context.Context, nsgID string) (string, error)
 	return "", nil
 }
 
+// getPrivateIps lists the private IPs of a VNIC.
+func (a *Adapter) getPrivateIps(ctx context.Context, vnicID string) ([]core.PrivateIp, error) {
+	var privateIPs []core.PrivateIp
+	var page *string
+	for {
+		var resp core.ListPrivateIpsResponse
+		err := retryOnRateLimit(ctx, defaultMaxRetries, defaultInitialBackoff, defaultMaxBackoff, func() error {
+			var e error
+			resp, e = a.networkClient.ListPrivateIps(ctx, core.ListPrivateIpsRequest{VnicId: &vnicID, Page: page})
+			return e
+		})
+		if err != nil {
+			return nil, err
+		}
+		privateIPs = append(privateIPs, resp.Items...)
+		if resp.OpcNextPage == nil {
+			return privateIPs, nil
+		}
+		page = resp.OpcNextPage
+	}
+}
+
+// getPublicIpByPrivateIp fetches the public IP assigned to a private IP, or
+// nil when there is none.
+func (a *Adapter) getPublicIpByPrivateIp(ctx context.Context, privateIPID string) (*core.PublicIp, error) {
+	var resp core.GetPublicIpByPrivateIpIdResponse
+	err := retryOnRateLimit(ctx, defaultMaxRetries, defaultInitialBackoff, defaultMaxBackoff, func() error {
+		var e error
+		resp, e = a.networkClient.GetPublicIpByPrivateIpId(ctx, core.GetPublicIpByPrivateIpIdRequest{
+			GetPublicIpByPrivateIpIdDetails: core.GetPublicIpByPrivateIpIdDetails{PrivateIpId: &privateIPID},
+		})
+		return e
+	})
+	if err != nil {
+		if serviceErr, ok := common.IsServiceError(err); ok && serviceErr.GetHTTPStatusCode() == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &resp.PublicIp, nil
+}
+
 // retryOnRateLimit retries the provided operation when OCI responds with HTTP 429 rate limited.
 // It applies exponential backoff between retries and preserves the original behavior and error messages.
 func retryOnRateLimit(ctx context.Context, maxRetries int, initialBackoff, maxBackoff time.Duration, op func() error) error {
@@ -387,3 +640,127 @@ func retryOnRateLimit(ctx context.Context, maxRetries int, initialBackoff, maxBa
 	}
 	return nil
 }
+
+// consoleHistoryChunk is the number of bytes read per GetConsoleHistoryContent call.
+const consoleHistoryChunk = 1024 * 1024
+
+// CaptureConsoleHistory starts a capture of the serial console output of an instance.
+func (a *Adapter) CaptureConsoleHistory(ctx context.Context, instanceID string) (*domain.ConsoleHistory, error) {
+	resp, err := a.computeClient.CaptureConsoleHistory(ctx, core.CaptureConsoleHistoryRequest{
+		CaptureConsoleHistoryDetails: core.CaptureConsoleHistoryDetails{InstanceId: &instanceID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("capturing console history: %w", err)
+	}
+	return mapping.NewDomainConsoleHistory(resp.ConsoleHistory), nil
+}
+
+// GetConsoleHistory fetches the state of a console history capture.
+func (a *Adapter) GetConsoleHistory(ctx context.Context, id string) (*domain.ConsoleHistory, error) {
+	var resp core.GetConsoleHistoryResponse
+	err := retryOnRateLimit(ctx, defaultMaxRetries, defaultInitialBackoff, defaultMaxBackoff, func() error {
+		var e error
+		resp, e = a.computeClient.GetConsoleHistory(ctx, core.GetConsoleHistoryRequest{InstanceConsoleHistoryId: &id})
+		return e
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting console history: %w", err)
+	}
+	return mapping.NewDomainConsoleHistory(resp.ConsoleHistory), nil
+}
+
+// GetConsoleHistoryContent reads the whole output of a console history capture.
+func (a *Adapter) GetConsoleHistoryContent(ctx context.Context, id string) (string, error) {
+	var content strings.Builder
+	for offset := 0; ; {
+		resp, err := a.computeClient.GetConsoleHistoryContent(ctx, core.GetConsoleHistoryContentRequest{
+			InstanceConsoleHistoryId: &id,
+			Offset:                   common.Int(offset),
+			Length:                   common.Int(consoleHistoryChunk),
+		})
+		if err != nil {
+			return "", fmt.Errorf("getting console history content: %w", err)
+		}
+		n := 0
+		if resp.Value != nil {
+			content.WriteString(*resp.Value)
+			n = len(*resp.Value)
+		}
+		if n == 0 || resp.OpcBytesRemaining == nil || *resp.OpcBytesRemaining <= 0 {
+			return content.String(), nil
+		}
+		offset += n
+	}
+}
+
+// DeleteConsoleHistory deletes a console history capture.
+func (a *Adapter) DeleteConsoleHistory(ctx context.Context, id string) error {
+	if _, err := a.computeClient.DeleteConsoleHistory(ctx, core.DeleteConsoleHistoryRequest{InstanceConsoleHistoryId: &id}); err != nil {
+		return fmt.Errorf("deleting console history: %w", err)
+	}
+	return nil
+}
+
+// CreateConsoleConnection creates a serial console connection that accepts publicKey.
+func (a *Adapter) CreateConsoleConnection(ctx context.Context, instanceID, publicKey string) (*domain.ConsoleConnection, error) {
+	resp, err := a.computeClient.CreateInstanceConsoleConnection(ctx, core.CreateInstanceConsoleConnectionRequest{
+		CreateInstanceConsoleConnectionDetails: core.CreateInstanceConsoleConnectionDetails{
+			InstanceId: &instanceID,
+			PublicKey:  &publicKey,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating console connection: %w", err)
+	}
+	return mapping.NewDomainConsoleConnection(resp.InstanceConsoleConnection), nil
+}
+
+// GetConsoleConnection fetches a serial console connection.
+func (a *Adapter) GetConsoleConnection(ctx context.Context, id string) (*domain.ConsoleConnection, error) {
+	var resp core.GetInstanceConsoleConnectionResponse
+	err := retryOnRateLimit(ctx, defaultMaxRetries, defaultInitialBackoff, defaultMaxBackoff, func() error {
+		var e error
+		resp, e = a.computeClient.GetInstanceConsoleConnection(ctx, core.GetInstanceConsoleConnectionRequest{InstanceConsoleConnectionId: &id})
+		return e
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting console connection: %w", err)
+	}
+	return mapping.NewDomainConsoleConnection(resp.InstanceConsoleConnection), nil
+}
+
+// ListConsoleConnections fetches the serial console connections of an instance
+// from the compartment the instance is in.
+func (a *Adapter) ListConsoleConnections(ctx context.Context, instanceID string) ([]domain.ConsoleConnection, error) {
+	inst, err := a.GetInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	var conns []domain.ConsoleConnection
+	var page *string
+	for {
+		resp, err := a.computeClient.ListInstanceConsoleConnections(ctx, core.ListInstanceConsoleConnectionsRequest{
+			CompartmentId: &inst.CompartmentID,
+			InstanceId:    &instanceID,
+			Page:          page,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing console connections: %w", err)
+		}
+		for _, item := range resp.Items {
+			conns = append(conns, *mapping.NewDomainConsoleConnection(item))
+		}
+		if resp.OpcNextPage == nil {
+			return conns, nil
+		}
+		page = resp.OpcNextPage
+	}
+}
+
+// DeleteConsoleConnection deletes a serial console connection.
+func (a *Adapter) DeleteConsoleConnection(ctx context.Context, id string) error {
+	if _, err := a.computeClient.DeleteInstanceConsoleConnection(ctx, core.DeleteInstanceConsoleConnectionRequest{InstanceConsoleConnectionId: &id}); err != nil {
+		return fmt.Errorf("deleting console connection: %w", err)
+	}
+	return nil
+}
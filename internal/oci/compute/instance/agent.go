@@ -0,0 +1,45 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+
+	domain "github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/mapping"
+	"github.com/oracle/oci-go-sdk/v65/computeinstanceagent"
+)
+
+// AgentAdapter reads the Oracle Cloud Agent plugins of instances.
+// It implements the domain.InstanceAgent interface.
+type AgentAdapter struct {
+	pluginClient computeinstanceagent.PluginClient
+}
+
+// NewAgentAdapter creates a new instance agent adapter.
+func NewAgentAdapter(pluginClient computeinstanceagent.PluginClient) *AgentAdapter {
+	return &AgentAdapter{pluginClient: pluginClient}
+}
+
+// ListAgentPlugins returns the plugins the agent of the instance reports, with their status.
+func (a *AgentAdapter) ListAgentPlugins(ctx context.Context, compartmentID, instanceID string) ([]domain.AgentPlugin, error) {
+	var plugins []domain.AgentPlugin
+	var page *string
+	for {
+		resp, err := a.pluginClient.ListInstanceAgentPlugins(ctx, computeinstanceagent.ListInstanceAgentPluginsRequest{
+			CompartmentId:   &compartmentID,
+			InstanceagentId: &instanceID,
+			Page:            page,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing agent plugins from OCI: %w", err)
+		}
+		for _, p := range resp.Items {
+			plugins = append(plugins, mapping.NewDomainAgentPlugin(p))
+		}
+		if resp.OpcNextPage == nil {
+			break
+		}
+		page = resp.OpcNextPage
+	}
+	return plugins, nil
+}
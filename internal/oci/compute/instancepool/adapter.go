@@ -0,0 +1,156 @@
+package instancepool
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	domain "github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/mapping"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"golang.org/x/sync/errgroup"
+)
+
+// maxConcurrentEnrichments bounds how many pools are enriched at the same time.
+const maxConcurrentEnrichments = 8
+
+// Adapter is an infrastructure-layer adapter that implements the domain.InstancePoolRepository interface.
+type Adapter struct {
+	managementClient core.ComputeManagementClient
+	computeClient    core.ComputeClient
+}
+
+// NewAdapter creates a new adapter for reading OCI instance pools.
+func NewAdapter(managementClient core.ComputeManagementClient, computeClient core.ComputeClient) *Adapter {
+	return &Adapter{managementClient: managementClient, computeClient: computeClient}
+}
+
+// GetEnrichedInstancePool fetches a pool by OCID with its instance configuration,
+// load balancer attachments and members.
+func (a *Adapter) GetEnrichedInstancePool(ctx context.Context, ocid string) (*domain.InstancePool, error) {
+	resp, err := a.managementClient.GetInstancePool(ctx, core.GetInstancePoolRequest{InstancePoolId: &ocid})
+	if err != nil {
+		return nil, fmt.Errorf("getting instance pool from OCI: %w", err)
+	}
+	pool := mapping.NewDomainInstancePool(resp.InstancePool)
+	if err := a.enrich(ctx, &pool, *resp.CompartmentId); err != nil {
+		return nil, err
+	}
+	return &pool, nil
+}
+
+// ListInstancePools lists the instance pools of a compartment without enrichment.
+func (a *Adapter) ListInstancePools(ctx context.Context, compartmentID string) ([]domain.InstancePool, error) {
+	var pools []domain.InstancePool
+	var page *string
+	for {
+		resp, err := a.managementClient.ListInstancePools(ctx, core.ListInstancePoolsRequest{
+			CompartmentId: &compartmentID,
+			Page:          page,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing instance pools from OCI: %w", err)
+		}
+		for _, item := range resp.Items {
+			pools = append(pools, mapping.NewDomainInstancePoolFromSummary(item))
+		}
+		if resp.OpcNextPage == nil {
+			return pools, nil
+		}
+		page = resp.OpcNextPage
+	}
+}
+
+// ListEnrichedInstancePools lists the instance pools of a compartment, each
+// with its instance configuration, load balancer attachments and members.
+// Pools are enriched concurrently.
+func (a *Adapter) ListEnrichedInstancePools(ctx context.Context, compartmentID string) ([]domain.InstancePool, error) {
+	summaries, err := a.ListInstancePools(ctx, compartmentID)
+	if err != nil {
+		return nil, err
+	}
+	pools := make([]domain.InstancePool, len(summaries))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentEnrichments)
+	for i, s := range summaries {
+		g.Go(func() error {
+			pool, err := a.GetEnrichedInstancePool(gctx, s.OCID)
+			if err != nil {
+				return err
+			}
+			pools[i] = *pool
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return pools, nil
+}
+
+// enrich adds the instance configuration and the members to pool.
+func (a *Adapter) enrich(ctx context.Context, pool *domain.InstancePool, compartmentID string) error {
+	if pool.InstanceConfigurationID != "" {
+		cfg, err := a.getInstanceConfiguration(ctx, pool.InstanceConfigurationID)
+		if err != nil {
+			return fmt.Errorf("enriching instance pool %s with instance configuration: %w", pool.OCID, err)
+		}
+		pool.Configuration = cfg
+	}
+
+	members, err := a.listMembers(ctx, pool.OCID, compartmentID)
+	if err != nil {
+		return fmt.Errorf("enriching instance pool %s with members: %w", pool.OCID, err)
+	}
+	pool.Members = members
+	return nil
+}
+
+// getInstanceConfiguration fetches an instance configuration and the name of its image.
+func (a *Adapter) getInstanceConfiguration(ctx context.Context, ocid string) (*domain.InstanceConfiguration, error) {
+	resp, err := a.managementClient.GetInstanceConfiguration(ctx, core.GetInstanceConfigurationRequest{InstanceConfigurationId: &ocid})
+	if err != nil {
+		return nil, err
+	}
+	cfg := mapping.NewDomainInstanceConfiguration(resp.InstanceConfiguration)
+	if cfg.ImageID == "" {
+		return cfg, nil
+	}
+
+	image, err := a.computeClient.GetImage(ctx, core.GetImageRequest{ImageId: &cfg.ImageID})
+	if err != nil {
+		// Pools keep running after their image was deleted; the name is then unknown.
+		if serviceErr, ok := common.IsServiceError(err); ok && serviceErr.GetHTTPStatusCode() == http.StatusNotFound {
+			return cfg, nil
+		}
+		return nil, fmt.Errorf("getting image %s: %w", cfg.ImageID, err)
+	}
+	if image.DisplayName != nil {
+		cfg.ImageName = *image.DisplayName
+	}
+	return cfg, nil
+}
+
+// listMembers fetches all pages of the instances of a pool.
+func (a *Adapter) listMembers(ctx context.Context, poolID, compartmentID string) ([]domain.Instance, error) {
+	var members []domain.Instance
+	var page *string
+	for {
+		resp, err := a.managementClient.ListInstancePoolInstances(ctx, core.ListInstancePoolInstancesRequest{
+			CompartmentId:  &compartmentID,
+			InstancePoolId: &poolID,
+			Page:           page,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range resp.Items {
+			members = append(members, mapping.NewDomainInstanceFromPoolMember(item))
+		}
+		if resp.OpcNextPage == nil {
+			return members, nil
+		}
+		page = resp.OpcNextPage
+	}
+}
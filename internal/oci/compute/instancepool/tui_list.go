@@ -0,0 +1,19 @@
+package instancepool
+
+import (
+	"fmt"
+
+	domain "github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/tui"
+)
+
+// NewInstancePoolListModel builds a TUI list for instance pools.
+func NewInstancePoolListModel(pools []domain.InstancePool) tui.Model {
+	return tui.NewModel("Instance Pools", pools, func(pool domain.InstancePool) tui.ResourceItemData {
+		return tui.ResourceItemData{
+			ID:          pool.OCID,
+			Title:       pool.DisplayName,
+			Description: fmt.Sprintf("State: %s • Size: %d • Created: %s", pool.State, pool.Size, pool.TimeCreated.Format("2006-01-02")),
+		}
+	})
+}
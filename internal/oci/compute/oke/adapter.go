@@ -3,15 +3,27 @@ package oke
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
 
+	domainerrors "github.com/cnopslabs/ocloud/internal/domain"
 	domain "github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/logger"
 	"github.com/cnopslabs/ocloud/internal/mapping"
+	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/containerengine"
+	"golang.org/x/sync/errgroup"
 )
 
-// Adapter is an infrastructure-layer adapter for OKE clusters.
+// maxConcurrentEnrichments bounds how many clusters are enriched at the same time.
+const maxConcurrentEnrichments = 8
+
+// Adapter is an infrastructure-layer adapter for OKE clusters. It also lists
+// the worker nodes of node pools.
 type Adapter struct {
 	client containerengine.ContainerEngineClient
+	// addons is set by WithAddons; clusters then also report their add-ons.
+	addons bool
 }
 
 // NewAdapter creates a new OKE adapter.
@@ -19,12 +31,23 @@ func NewAdapter(client containerengine.ContainerEngineClient) *Adapter {
 	return &Adapter{client: client}
 }
 
-// GetCluster retrieves a single cluster by its OCID and enriches it with node pools.
+// WithAddons makes cluster lookups and listings also report the add-ons
+// installed on each cluster.
+func (a *Adapter) WithAddons() *Adapter {
+	a.addons = true
+	return a
+}
+
+// GetCluster retrieves a single cluster by its OCID and enriches it with node
+// pools. A cluster OCI does not know returns an error wrapping domainerrors.ErrNotFound.
 func (a *Adapter) GetCluster(ctx context.Context, clusterOCID string) (*domain.Cluster, error) {
 	resp, err := a.client.GetCluster(ctx, containerengine.GetClusterRequest{
 		ClusterId: &clusterOCID,
 	})
 	if err != nil {
+		if serviceErr, ok := common.IsServiceError(err); ok && serviceErr.GetHTTPStatusCode() == http.StatusNotFound {
+			return nil, domainerrors.NewNotFoundError("cluster", clusterOCID)
+		}
 		return nil, fmt.Errorf("getting cluster from OCI: %w", err)
 	}
 
@@ -59,40 +82,69 @@ func (a *Adapter) ListClusters(ctx context.Context, compartmentID string) ([]dom
 	return a.mapAndEnrichClusters(ctx, ociClusters)
 }
 
-// mapAndEnrichClusters maps OCI clusters (summaries) to domain models and enriches them with node pools.
+// mapAndEnrichClusters maps OCI clusters (summaries) to domain models and enriches them concurrently.
 func (a *Adapter) mapAndEnrichClusters(ctx context.Context, ociClusters []containerengine.ClusterSummary) ([]domain.Cluster, error) {
-	var domainClusters []domain.Cluster
-	for _, ociCluster := range ociClusters {
-		dc := mapping.NewDomainClusterFromAttrs(mapping.NewClusterAttributesFromOCIClusterSummary(ociCluster))
-
+	domainClusters := make([]domain.Cluster, len(ociClusters))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentEnrichments)
+	for i, ociCluster := range ociClusters {
+		domainClusters[i] = *mapping.NewDomainClusterFromAttrs(mapping.NewClusterAttributesFromOCIClusterSummary(ociCluster))
 		if ociCluster.CompartmentId == nil || ociCluster.Id == nil {
-			domainClusters = append(domainClusters, *dc)
 			continue
 		}
-
-		nodePools, err := a.listNodePools(ctx, *ociCluster.CompartmentId, *ociCluster.Id)
-		if err != nil {
-			return nil, fmt.Errorf("enriching cluster %s with node pools: %w", dc.OCID, err)
-		}
-		dc.NodePools = nodePools
-		domainClusters = append(domainClusters, *dc)
+		g.Go(func() error {
+			return a.enrichCluster(gctx, &domainClusters[i], *ociCluster.CompartmentId, ociCluster.Type)
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
 	}
 	return domainClusters, nil
 }
 
-// enrichAndMapCluster maps a single full OCI cluster object to a domain model and enriches it with node pools.
+// enrichAndMapCluster maps a single full OCI cluster object to a domain model and enriches it.
 func (a *Adapter) enrichAndMapCluster(ctx context.Context, c containerengine.Cluster) (*domain.Cluster, error) {
 	dc := mapping.NewDomainClusterFromAttrs(mapping.NewClusterAttributesFromOCICluster(c))
 	if c.CompartmentId != nil && c.Id != nil {
-		nps, err := a.listNodePools(ctx, *c.CompartmentId, *c.Id)
-		if err != nil {
-			return dc, fmt.Errorf("enriching cluster %s with node pools: %w", dc.OCID, err)
+		if err := a.enrichCluster(ctx, dc, *c.CompartmentId, c.Type); err != nil {
+			return dc, err
 		}
-		dc.NodePools = nps
 	}
 	return dc, nil
 }
 
+// enrichCluster adds the node pools and virtual node pools of a cluster and,
+// when the adapter was built WithAddons, its add-ons. Only failing to list
+// node pools is an error: virtual node pools and add-ons that cannot be listed
+// are logged and left nil.
+func (a *Adapter) enrichCluster(ctx context.Context, dc *domain.Cluster, compartmentID string, clusterType containerengine.ClusterTypeEnum) error {
+	nodePools, err := a.listNodePools(ctx, compartmentID, dc.OCID)
+	if err != nil {
+		return fmt.Errorf("enriching cluster %s with node pools: %w", dc.OCID, err)
+	}
+	dc.NodePools = nodePools
+
+	if vnps, err := a.listVirtualNodePools(ctx, compartmentID, dc.OCID); err != nil {
+		logger.LogWithLevel(logger.CmdLogger, logger.Info, "skipping virtual node pools", "cluster", dc.DisplayName, "error", err)
+	} else {
+		dc.VirtualNodePools = vnps
+	}
+
+	switch {
+	case !a.addons:
+	case clusterType == containerengine.ClusterTypeBasicCluster:
+		// Basic clusters do not support add-ons.
+		dc.Addons = []domain.ClusterAddon{}
+	default:
+		if addons, err := a.listAddons(ctx, dc.OCID); err != nil {
+			logger.LogWithLevel(logger.CmdLogger, logger.Info, "skipping add-ons", "cluster", dc.DisplayName, "error", err)
+		} else {
+			dc.Addons = addons
+		}
+	}
+	return nil
+}
+
 // listNodePools fetches all node pools in a cluster.
 func (a *Adapter) listNodePools(ctx context.Context, compartmentID, clusterID string) ([]domain.NodePool, error) {
 	var domainNodePools []domain.NodePool
@@ -119,3 +171,132 @@ func (a *Adapter) listNodePools(ctx context.Context, compartmentID, clusterID st
 	}
 	return domainNodePools, nil
 }
+
+// listVirtualNodePools fetches all virtual node pools in a cluster.
+func (a *Adapter) listVirtualNodePools(ctx context.Context, compartmentID, clusterID string) ([]domain.VirtualNodePool, error) {
+	var pools []domain.VirtualNodePool
+	var page *string
+
+	for {
+		resp, err := a.client.ListVirtualNodePools(ctx, containerengine.ListVirtualNodePoolsRequest{
+			CompartmentId: &compartmentID,
+			ClusterId:     &clusterID,
+			Page:          page,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing virtual node pools from OCI: %w", err)
+		}
+
+		for _, vnp := range resp.Items {
+			pools = append(pools, mapping.NewDomainVirtualNodePool(vnp))
+		}
+
+		if resp.OpcNextPage == nil {
+			break
+		}
+		page = resp.OpcNextPage
+	}
+	return pools, nil
+}
+
+// listAddons fetches the add-ons installed on a cluster.
+func (a *Adapter) listAddons(ctx context.Context, clusterID string) ([]domain.ClusterAddon, error) {
+	addons := []domain.ClusterAddon{}
+	var page *string
+
+	for {
+		resp, err := a.client.ListAddons(ctx, containerengine.ListAddonsRequest{
+			ClusterId: &clusterID,
+			Page:      page,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing add-ons from OCI: %w", err)
+		}
+
+		for _, addon := range resp.Items {
+			addons = append(addons, mapping.NewDomainClusterAddon(addon))
+		}
+
+		if resp.OpcNextPage == nil {
+			break
+		}
+		page = resp.OpcNextPage
+	}
+	return addons, nil
+}
+
+// ListNodes fetches the worker nodes of a node pool. The cluster OCID is not
+// needed by OCI; it lets region decorators route the call.
+func (a *Adapter) ListNodes(ctx context.Context, clusterID, nodePoolID string) ([]domain.Node, error) {
+	resp, err := a.client.GetNodePool(ctx, containerengine.GetNodePoolRequest{
+		NodePoolId: &nodePoolID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting node pool from OCI: %w", err)
+	}
+
+	nodes := make([]domain.Node, 0, len(resp.Nodes))
+	for _, n := range resp.Nodes {
+		nodes = append(nodes, mapping.NewDomainNode(n))
+	}
+	return nodes, nil
+}
+
+// kubeconfigEndpoints maps the endpoints of the domain model to those of the OCI API.
+var kubeconfigEndpoints = map[string]containerengine.CreateClusterKubeconfigContentDetailsEndpointEnum{
+	domain.EndpointPublic:  containerengine.CreateClusterKubeconfigContentDetailsEndpointPublicEndpoint,
+	domain.EndpointPrivate: containerengine.CreateClusterKubeconfigContentDetailsEndpointPrivateEndpoint,
+}
+
+// CreateKubeconfig generates the kubeconfig of a cluster for the given endpoint,
+// using v2 tokens.
+func (a *Adapter) CreateKubeconfig(ctx context.Context, clusterID, endpoint string) ([]byte, error) {
+	ociEndpoint, ok := kubeconfigEndpoints[endpoint]
+	if !ok {
+		return nil, fmt.Errorf("unknown kubeconfig endpoint %q", endpoint)
+	}
+	resp, err := a.client.CreateKubeconfig(ctx, containerengine.CreateKubeconfigRequest{
+		ClusterId: &clusterID,
+		CreateClusterKubeconfigContentDetails: containerengine.CreateClusterKubeconfigContentDetails{
+			TokenVersion: common.String("2.0.0"),
+			Endpoint:     ociEndpoint,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating kubeconfig in OCI: %w", err)
+	}
+	defer resp.Content.Close()
+
+	content, err := io.ReadAll(resp.Content)
+	if err != nil {
+		return nil, fmt.Errorf("reading kubeconfig: %w", err)
+	}
+	return content, nil
+}
+
+// ListVirtualNodes fetches the virtual nodes of a virtual node pool. Like
+// ListNodes, the cluster OCID only serves routing.
+func (a *Adapter) ListVirtualNodes(ctx context.Context, clusterID, virtualNodePoolID string) ([]domain.VirtualNode, error) {
+	nodes := []domain.VirtualNode{}
+	var page *string
+
+	for {
+		resp, err := a.client.ListVirtualNodes(ctx, containerengine.ListVirtualNodesRequest{
+			VirtualNodePoolId: &virtualNodePoolID,
+			Page:              page,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing virtual nodes from OCI: %w", err)
+		}
+
+		for _, vn := range resp.Items {
+			nodes = append(nodes, mapping.NewDomainVirtualNode(vn))
+		}
+
+		if resp.OpcNextPage == nil {
+			break
+		}
+		page = resp.OpcNextPage
+	}
+	return nodes, nil
+}
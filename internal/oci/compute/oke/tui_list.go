@@ -20,7 +20,7 @@ func NewImageListModel(cluster []domain.Cluster) tui.Model {
 }
 
 func description(c domain.Cluster) string {
-	parts := make([]string, 0, 4)
+	parts := make([]string, 0, 5)
 
 	if c.State != "" {
 		parts = append(parts, c.State)
@@ -31,6 +31,9 @@ func description(c domain.Cluster) string {
 
 	np := len(c.NodePools)
 	parts = append(parts, fmt.Sprintf("%d node pool%s", np, plural(np)))
+	if vnp := len(c.VirtualNodePools); vnp > 0 {
+		parts = append(parts, fmt.Sprintf("%d virtual node pool%s", vnp, plural(vnp)))
+	}
 
 	if !c.TimeCreated.IsZero() {
 		parts = append(parts, c.TimeCreated.Format("2006-01-02"))
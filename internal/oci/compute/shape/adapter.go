@@ -0,0 +1,80 @@
+package shape
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	domain "github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/mapping"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+)
+
+// Adapter is an infrastructure-layer adapter that implements the domain.ShapeRepository interface.
+type Adapter struct {
+	computeClient  core.ComputeClient
+	identityClient identity.IdentityClient
+}
+
+// NewAdapter creates a new adapter for listing OCI compute shapes.
+func NewAdapter(computeClient core.ComputeClient, identityClient identity.IdentityClient) *Adapter {
+	return &Adapter{computeClient: computeClient, identityClient: identityClient}
+}
+
+// ListShapes lists the shapes of every availability domain of the compartment
+// and merges them, so that each shape appears once with the domains offering it.
+func (a *Adapter) ListShapes(ctx context.Context, compartmentID string) ([]domain.Shape, error) {
+	ads, err := a.identityClient.ListAvailabilityDomains(ctx, identity.ListAvailabilityDomainsRequest{
+		CompartmentId: &compartmentID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing availability domains from OCI: %w", err)
+	}
+
+	var shapes []domain.Shape
+	byName := map[string]int{}
+	for _, ad := range ads.Items {
+		if ad.Name == nil {
+			continue
+		}
+		items, err := a.listShapesInAD(ctx, compartmentID, *ad.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			shape := mapping.NewDomainShape(item)
+			i, ok := byName[shape.Name]
+			if !ok {
+				i = len(shapes)
+				byName[shape.Name] = i
+				shapes = append(shapes, shape)
+			}
+			shapes[i].AvailabilityDomains = append(shapes[i].AvailabilityDomains, *ad.Name)
+		}
+	}
+
+	sort.Slice(shapes, func(i, j int) bool { return shapes[i].Name < shapes[j].Name })
+	return shapes, nil
+}
+
+// listShapesInAD fetches all pages of shapes offered in one availability domain.
+func (a *Adapter) listShapesInAD(ctx context.Context, compartmentID, ad string) ([]core.Shape, error) {
+	var shapes []core.Shape
+	var page *string
+	for {
+		resp, err := a.computeClient.ListShapes(ctx, core.ListShapesRequest{
+			CompartmentId:      &compartmentID,
+			AvailabilityDomain: &ad,
+			Page:               page,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing shapes in %s from OCI: %w", ad, err)
+		}
+		shapes = append(shapes, resp.Items...)
+		if resp.OpcNextPage == nil {
+			return shapes, nil
+		}
+		page = resp.OpcNextPage
+	}
+}
@@ -0,0 +1,24 @@
+package shape
+
+import (
+	"fmt"
+
+	domain "github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/tui"
+)
+
+// NewShapeListModel builds a TUI list for shapes. Shapes have no OCID, so the
+// shape name is the item ID.
+func NewShapeListModel(shapes []domain.Shape) tui.Model {
+	return tui.NewModel("Shapes", shapes, func(shape domain.Shape) tui.ResourceItemData {
+		kind := "Fixed"
+		if shape.IsFlexible {
+			kind = "Flexible"
+		}
+		return tui.ResourceItemData{
+			ID:          shape.Name,
+			Title:       shape.Name,
+			Description: fmt.Sprintf("%s • %s • Running: %d", kind, shape.ProcessorDescription, shape.RunningInstances),
+		}
+	})
+}
@@ -0,0 +1,42 @@
+package region
+
+import (
+	"context"
+	"fmt"
+
+	domain "github.com/cnopslabs/ocloud/internal/domain/identity"
+	"github.com/cnopslabs/ocloud/internal/mapping"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+)
+
+// Adapter is an infrastructure-layer adapter that implements the domain.RegionRepository interface.
+type Adapter struct {
+	client identity.IdentityClient
+}
+
+// NewAdapter creates a new adapter for looking up region subscriptions.
+func NewAdapter(client identity.IdentityClient) *Adapter {
+	return &Adapter{client: client}
+}
+
+// ListRegionSubscriptions returns the regions the tenancy is subscribed to, home region first.
+func (a *Adapter) ListRegionSubscriptions(ctx context.Context, tenancyID string) ([]domain.RegionSubscription, error) {
+	resp, err := a.client.ListRegionSubscriptions(ctx, identity.ListRegionSubscriptionsRequest{
+		TenancyId: &tenancyID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing region subscriptions from OCI: %w", err)
+	}
+
+	var home []domain.RegionSubscription
+	var others []domain.RegionSubscription
+	for _, item := range resp.Items {
+		sub := mapping.NewDomainRegionSubscription(item)
+		if sub.IsHome {
+			home = append(home, sub)
+		} else {
+			others = append(others, sub)
+		}
+	}
+	return append(home, others...), nil
+}
@@ -11,8 +11,10 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/identity"
 	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
 	"github.com/oracle/oci-go-sdk/v65/objectstorage"
+	"github.com/oracle/oci-go-sdk/v65/workrequests"
 
 	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/computeinstanceagent"
 	"github.com/oracle/oci-go-sdk/v65/containerengine"
 	"github.com/oracle/oci-go-sdk/v65/core"
 	"github.com/oracle/oci-go-sdk/v65/database"
@@ -63,6 +65,36 @@ func NewComputeClient(provider common.ConfigurationProvider) (core.ComputeClient
 	return client, nil
 }
 
+// NewComputeManagementClient creates a new OCI compute management client, which
+// serves instance pools and instance configurations, using the provided configuration provider.
+func NewComputeManagementClient(provider common.ConfigurationProvider) (core.ComputeManagementClient, error) {
+	client, err := core.NewComputeManagementClientWithConfigurationProvider(provider)
+	if err != nil {
+		return client, fmt.Errorf("creating compute management client: %w", err)
+	}
+	return client, nil
+}
+
+// NewPluginClient creates a new OCI compute instance agent plugin client, which
+// reports the Oracle Cloud Agent plugins running on instances.
+func NewPluginClient(provider common.ConfigurationProvider) (computeinstanceagent.PluginClient, error) {
+	client, err := computeinstanceagent.NewPluginClientWithConfigurationProvider(provider)
+	if err != nil {
+		return client, fmt.Errorf("creating instance agent plugin client: %w", err)
+	}
+	return client, nil
+}
+
+// NewWorkRequestClient creates a new OCI work request client, which tracks
+// asynchronous compute operations such as image exports.
+func NewWorkRequestClient(provider common.ConfigurationProvider) (workrequests.WorkRequestClient, error) {
+	client, err := workrequests.NewWorkRequestClientWithConfigurationProvider(provider)
+	if err != nil {
+		return client, fmt.Errorf("creating work request client: %w", err)
+	}
+	return client, nil
+}
+
 // NewNetworkClient creates a new OCI virtual network client using the provided configuration provider.
 func NewNetworkClient(provider common.ConfigurationProvider) (core.VirtualNetworkClient, error) {
 	client, err := core.NewVirtualNetworkClientWithConfigurationProvider(provider)
@@ -72,6 +104,15 @@ func NewNetworkClient(provider common.ConfigurationProvider) (core.VirtualNetwor
 	return client, nil
 }
 
+// NewBlockstorageClient creates a new OCI block storage client using the provided configuration provider.
+func NewBlockstorageClient(provider common.ConfigurationProvider) (core.BlockstorageClient, error) {
+	client, err := core.NewBlockstorageClientWithConfigurationProvider(provider)
+	if err != nil {
+		return client, fmt.Errorf("creating block storage client: %w", err)
+	}
+	return client, nil
+}
+
 // NewContainerEngineClient creates a new instance of ContainerEngineClient using the provided configuration provider.
 func NewContainerEngineClient(provider common.ConfigurationProvider) (containerengine.ContainerEngineClient, error) {
 	client, err := containerengine.NewContainerEngineClientWithConfigurationProvider(provider)
@@ -125,3 +166,23 @@ func NewObjectStorageClient(provider common.ConfigurationProvider) (objectstorag
 	}
 	return client, nil
 }
+
+// regionProvider overrides the region reported by a configuration provider.
+type regionProvider struct {
+	common.ConfigurationProvider
+	region string
+}
+
+// Region returns the overriding region.
+func (p regionProvider) Region() (string, error) {
+	return p.region, nil
+}
+
+// WithRegion returns a provider that authenticates like provider but makes the
+// clients created from it talk to region. An empty region returns provider unchanged.
+func WithRegion(provider common.ConfigurationProvider, region string) common.ConfigurationProvider {
+	if region == "" {
+		return provider
+	}
+	return regionProvider{ConfigurationProvider: provider, region: region}
+}
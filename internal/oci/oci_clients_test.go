@@ -19,6 +19,38 @@ func TestNewComputeClient(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+// TestNewComputeManagementClient tests the NewComputeManagementClient function
+func TestNewComputeManagementClient(t *testing.T) {
+	client, err := NewComputeManagementClient(NewMockConfigurationProvider())
+
+	assert.NotNil(t, client)
+	assert.NoError(t, err)
+}
+
+// TestNewPluginClient tests the NewPluginClient function
+func TestNewPluginClient(t *testing.T) {
+	client, err := NewPluginClient(NewMockConfigurationProvider())
+
+	assert.NotNil(t, client)
+	assert.NoError(t, err)
+}
+
+// TestNewWorkRequestClient tests the NewWorkRequestClient function
+func TestNewWorkRequestClient(t *testing.T) {
+	client, err := NewWorkRequestClient(NewMockConfigurationProvider())
+
+	assert.NotNil(t, client)
+	assert.NoError(t, err)
+}
+
+// TestNewBlockstorageClient tests the NewBlockstorageClient function
+func TestNewBlockstorageClient(t *testing.T) {
+	client, err := NewBlockstorageClient(NewMockConfigurationProvider())
+
+	assert.NotNil(t, client)
+	assert.NoError(t, err)
+}
+
 // TestNewNetworkClient tests the NewNetworkClient function
 func TestNewNetworkClient(t *testing.T) {
 	// Use our mock configuration provider instead of the real one
@@ -0,0 +1,487 @@
+package printer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// -----------------------------------------------------------------------------
+// Structured output helpers
+// -----------------------------------------------------------------------------
+
+// Marshal writes data using the requested structured format, applying any
+// --query/--fields projection first. The table format has no structured
+// encoding and falls back to JSON.
+func (p *Printer) Marshal(format OutputFormat, data interface{}) error {
+	if format.HasProjection() {
+		projected, err := Project(data, format.Query, format.Fields)
+		if err != nil {
+			return err
+		}
+		data = projected
+	}
+
+	switch format.Format {
+	case FormatYAML:
+		return p.MarshalToYAML(data)
+	case FormatCSV:
+		return p.MarshalToDelimited(data, ',')
+	case FormatTSV:
+		return p.MarshalToDelimited(data, '\t')
+	case FormatTemplate:
+		return p.ExecuteTemplate(format.Template, data)
+	default:
+		return p.MarshalToJSON(data)
+	}
+}
+
+// MarshalToYAML marshals data to YAML and writes it to the printer's output.
+// Keys follow the JSON field names and ordering of the data so that YAML and
+// JSON outputs describe resources identically.
+func (p *Printer) MarshalToYAML(data interface{}) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal data to YAML: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	node, err := decodeYAMLNode(dec)
+	if err != nil {
+		return fmt.Errorf("failed to marshal data to YAML: %w", err)
+	}
+
+	enc := yaml.NewEncoder(p.out)
+	enc.SetIndent(2)
+	if err := enc.Encode(node); err != nil {
+		return fmt.Errorf("failed to marshal data to YAML: %w", err)
+	}
+	return enc.Close()
+}
+
+// ExecuteTemplate renders data with a Go text/template. The template sees the
+// same field names as the JSON output (e.g. {{range .items}}{{.Name}}{{end}}).
+func (p *Printer) ExecuteTemplate(source string, data interface{}) error {
+	tmpl, err := template.New("output").Parse(source)
+	if err != nil {
+		return fmt.Errorf("parsing output template: %w", err)
+	}
+	generic, err := ToGeneric(data)
+	if err != nil {
+		return err
+	}
+	if err := tmpl.Execute(p.out, generic); err != nil {
+		return fmt.Errorf("executing output template: %w", err)
+	}
+	return nil
+}
+
+// MarshalToDelimited writes data as delimiter-separated rows preceded by a header
+// line. Slices produce one row per element, anything else a single row. Column
+// names come from the element type (JSON tags, nested structs joined with "."),
+// so every resource type always yields the same columns in the same order.
+func (p *Printer) MarshalToDelimited(data interface{}, comma rune) error {
+	elemType, rows := delimitedRows(reflect.ValueOf(data))
+	columns := delimitedColumns(elemType, rows)
+	if len(columns) == 0 {
+		return nil
+	}
+
+	w := csv.NewWriter(p.out)
+	w.Comma = comma
+
+	header := make([]string, len(columns))
+	for i, c := range columns {
+		header[i] = c.name
+	}
+	if err := w.Write(header); err != nil {
+		return fmt.Errorf("writing header: %w", err)
+	}
+
+	for _, row := range rows {
+		record := make([]string, len(columns))
+		for i, c := range columns {
+			record[i] = c.value(row)
+		}
+		if err := w.Write(record); err != nil {
+			return fmt.Errorf("writing row: %w", err)
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+// ToGeneric converts data into its JSON representation made of maps, slices and
+// scalars, so it can be walked independently of the Go types that produced it.
+func ToGeneric(data interface{}) (interface{}, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var out interface{}
+	if err := dec.Decode(&out); err != nil {
+		return nil, fmt.Errorf("failed to decode data: %w", err)
+	}
+	return out, nil
+}
+
+// decodeYAMLNode converts the next JSON value in dec into a YAML node, keeping
+// object keys in their original order.
+func decodeYAMLNode(dec *json.Decoder) (*yaml.Node, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t := tok.(type) {
+	case json.Delim:
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		if t == '{' {
+			node = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		}
+		for dec.More() {
+			if node.Kind == yaml.MappingNode {
+				keyTok, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				key, _ := keyTok.(string)
+				node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key})
+			}
+			child, err := decodeYAMLNode(dec)
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, child)
+		}
+		// consume the closing delimiter
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return node, nil
+	case string:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: t}, nil
+	case json.Number:
+		tag := "!!int"
+		if strings.ContainsAny(t.String(), ".eE") {
+			tag = "!!float"
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: t.String()}, nil
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(t)}, nil
+	default:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}, nil
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Delimited (CSV/TSV) helpers
+// -----------------------------------------------------------------------------
+
+var (
+	timeType   = reflect.TypeOf(time.Time{})
+	recordType = reflect.TypeOf(Record{})
+)
+
+// column is a single CSV/TSV column and the way to extract its value from a row.
+type column struct {
+	name  string
+	value func(row reflect.Value) string
+}
+
+// delimitedRows unwraps data into its element type and the rows to write.
+func delimitedRows(v reflect.Value) (reflect.Type, []reflect.Value) {
+	v = indirect(v)
+	if !v.IsValid() {
+		return nil, nil
+	}
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return v.Type(), []reflect.Value{v}
+	}
+
+	elemType := v.Type().Elem()
+	for elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+	rows := make([]reflect.Value, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		rows = append(rows, indirect(v.Index(i)))
+	}
+	return elemType, rows
+}
+
+// delimitedColumns derives the columns for the given element type. Struct types
+// use their fields; maps (e.g. projected data) use the union of their keys.
+func delimitedColumns(elemType reflect.Type, rows []reflect.Value) []column {
+	if elemType == nil {
+		return nil
+	}
+	if elemType.Kind() == reflect.Interface {
+		for _, row := range rows {
+			if row.IsValid() {
+				elemType = row.Type()
+				break
+			}
+		}
+	}
+
+	switch {
+	case elemType == recordType:
+		return recordColumns(rows)
+	case elemType.Kind() == reflect.Struct && elemType != timeType:
+		return structColumns(elemType, nil, "")
+	case elemType.Kind() == reflect.Map:
+		return mapColumns(rows)
+	default:
+		return []column{{name: "Value", value: formatCell}}
+	}
+}
+
+// structColumns flattens the exported fields of t into columns. Nested structs
+// are expanded using "Parent.Child" names; embedded structs and fields tagged
+// `json:",inline"` are inlined.
+func structColumns(t reflect.Type, index []int, prefix string) []column {
+	var cols []column
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, skip := jsonFieldName(f)
+		if skip {
+			continue
+		}
+		path := append(append([]int{}, index...), i)
+
+		ft := f.Type
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if ft.Kind() == reflect.Struct && ft != timeType {
+			if (f.Anonymous && !hasJSONName(f)) || isInline(f) {
+				cols = append(cols, structColumns(ft, path, prefix)...)
+			} else {
+				cols = append(cols, structColumns(ft, path, prefix+name+".")...)
+			}
+			continue
+		}
+
+		cols = append(cols, column{
+			name: prefix + name,
+			value: func(row reflect.Value) string {
+				return formatCell(fieldByPath(row, path))
+			},
+		})
+	}
+	return cols
+}
+
+// mapColumns builds one column per distinct key found across rows, sorted by name.
+func mapColumns(rows []reflect.Value) []column {
+	seen := map[string]bool{}
+	var keys []string
+	for _, row := range rows {
+		row = indirect(row)
+		if !row.IsValid() || row.Kind() != reflect.Map {
+			continue
+		}
+		for _, k := range row.MapKeys() {
+			key := fmt.Sprint(k.Interface())
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+
+	cols := make([]column, len(keys))
+	for i, key := range keys {
+		key := key
+		cols[i] = column{
+			name: key,
+			value: func(row reflect.Value) string {
+				row = indirect(row)
+				if !row.IsValid() || row.Kind() != reflect.Map || row.Type().Key().Kind() != reflect.String {
+					return ""
+				}
+				return formatCell(row.MapIndex(reflect.ValueOf(key).Convert(row.Type().Key())))
+			},
+		}
+	}
+	return cols
+}
+
+// recordColumns builds one column per record key, in first-seen order.
+func recordColumns(rows []reflect.Value) []column {
+	seen := map[string]bool{}
+	var keys []string
+	for _, row := range rows {
+		if !row.IsValid() || row.Type() != recordType {
+			continue
+		}
+		for _, k := range row.Interface().(Record).Keys() {
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+
+	cols := make([]column, len(keys))
+	for i, key := range keys {
+		key := key
+		cols[i] = column{
+			name: key,
+			value: func(row reflect.Value) string {
+				if !row.IsValid() || row.Type() != recordType {
+					return ""
+				}
+				v, _ := row.Interface().(Record).Get(key)
+				return formatCell(reflect.ValueOf(v))
+			},
+		}
+	}
+	return cols
+}
+
+// jsonFieldName returns the column name of a struct field and whether to skip it.
+func jsonFieldName(f reflect.StructField) (string, bool) {
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", true
+	}
+	if name, _, _ := strings.Cut(tag, ","); name != "" {
+		return name, false
+	}
+	return f.Name, false
+}
+
+func hasJSONName(f reflect.StructField) bool {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	return name != ""
+}
+
+// isInline reports whether a struct field is tagged `json:",inline"`, which
+// encoding/json ignores but a custom MarshalJSON of its parent may honor.
+func isInline(f reflect.StructField) bool {
+	_, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+	for _, opt := range strings.Split(opts, ",") {
+		if opt == "inline" {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldByPath walks a field index path, returning an invalid value when a nil
+// pointer is encountered along the way.
+func fieldByPath(v reflect.Value, path []int) reflect.Value {
+	for _, i := range path {
+		v = indirect(v)
+		if !v.IsValid() || v.Kind() != reflect.Struct {
+			return reflect.Value{}
+		}
+		v = v.Field(i)
+	}
+	return v
+}
+
+// indirect dereferences pointers and interfaces, returning an invalid value for nil.
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// formatCell renders a single value for a CSV/TSV cell. Lists of scalars are
+// joined with commas; maps and lists of objects are encoded as compact JSON.
+func formatCell(v reflect.Value) string {
+	v = indirect(v)
+	if !v.IsValid() {
+		return ""
+	}
+
+	if v.Type() == timeType {
+		t := v.Interface().(time.Time)
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format(time.RFC3339)
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 32)
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	case reflect.Slice, reflect.Array:
+		if v.Len() == 0 {
+			return ""
+		}
+		if scalarElements(v) {
+			parts := make([]string, v.Len())
+			for i := 0; i < v.Len(); i++ {
+				parts[i] = formatCell(v.Index(i))
+			}
+			return strings.Join(parts, ",")
+		}
+	case reflect.Map:
+		if v.Len() == 0 {
+			return ""
+		}
+	}
+
+	raw, err := json.Marshal(v.Interface())
+	if err != nil {
+		return fmt.Sprint(v.Interface())
+	}
+	return string(raw)
+}
+
+// scalarElements reports whether every element of a slice renders as a single
+// plain token.
+func scalarElements(v reflect.Value) bool {
+	for i := 0; i < v.Len(); i++ {
+		e := indirect(v.Index(i))
+		if !e.IsValid() {
+			continue
+		}
+		if e.Type() == timeType {
+			continue
+		}
+		switch e.Kind() {
+		case reflect.String, reflect.Bool,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+		default:
+			return false
+		}
+	}
+	return true
+}
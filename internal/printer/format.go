@@ -0,0 +1,101 @@
+package printer
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Format names an output encoding supported by the printer.
+type Format string
+
+const (
+	FormatTable    Format = "table"
+	FormatJSON     Format = "json"
+	FormatYAML     Format = "yaml"
+	FormatCSV      Format = "csv"
+	FormatTSV      Format = "tsv"
+	FormatTemplate Format = "template"
+)
+
+// OutputFormat describes how command results are rendered.
+// Template holds the Go text/template source when Format is FormatTemplate.
+// Query and Fields optionally project the data before it is encoded (see Project).
+type OutputFormat struct {
+	Format   Format
+	Template string
+	Query    string
+	Fields   string
+}
+
+var (
+	// TableOutput is the default human-readable output.
+	TableOutput = OutputFormat{Format: FormatTable}
+	// JSONOutput renders results as indented JSON.
+	JSONOutput = OutputFormat{Format: FormatJSON}
+)
+
+// SupportedFormats lists the accepted values for the --output flag.
+func SupportedFormats() []string {
+	return []string{
+		string(FormatTable),
+		string(FormatJSON),
+		string(FormatYAML),
+		string(FormatCSV),
+		string(FormatTSV),
+		string(FormatTemplate) + "=<go-template>",
+	}
+}
+
+// ParseOutputFormat parses an --output value such as "yaml", "csv" or
+// "template={{range .items}}{{.Name}}{{end}}". An empty value selects the table output.
+func ParseOutputFormat(value string) (OutputFormat, error) {
+	v := strings.TrimSpace(value)
+	if v == "" {
+		return TableOutput, nil
+	}
+
+	name, tmpl, hasValue := strings.Cut(v, "=")
+	switch f := Format(strings.ToLower(strings.TrimSpace(name))); f {
+	case FormatTable, FormatJSON, FormatYAML, FormatCSV, FormatTSV, "yml":
+		if hasValue {
+			return OutputFormat{}, fmt.Errorf("output format %q does not accept a value", name)
+		}
+		if f == "yml" {
+			f = FormatYAML
+		}
+		return OutputFormat{Format: f}, nil
+	case FormatTemplate:
+		if strings.TrimSpace(tmpl) == "" {
+			return OutputFormat{}, fmt.Errorf("template output requires a template, e.g. template='{{range .items}}{{.Name}}{{\"\\n\"}}{{end}}'")
+		}
+		return OutputFormat{Format: FormatTemplate, Template: tmpl}, nil
+	default:
+		return OutputFormat{}, fmt.Errorf("unsupported output format %q (valid: %s)", value, strings.Join(SupportedFormats(), ", "))
+	}
+}
+
+// IsTable reports whether the format is the human-readable table output.
+func (o OutputFormat) IsTable() bool {
+	return o.Format == "" || o.Format == FormatTable
+}
+
+// HasProjection reports whether a --query or --fields projection was requested.
+func (o OutputFormat) HasProjection() bool {
+	return strings.TrimSpace(o.Query) != "" || strings.TrimSpace(o.Fields) != ""
+}
+
+// IsDelimited reports whether the format is a row-oriented CSV or TSV output.
+func (o OutputFormat) IsDelimited() bool {
+	return o.Format == FormatCSV || o.Format == FormatTSV
+}
+
+// String returns the flag representation of the format.
+func (o OutputFormat) String() string {
+	if o.IsTable() {
+		return string(FormatTable)
+	}
+	if o.Format == FormatTemplate {
+		return string(FormatTemplate) + "=" + o.Template
+	}
+	return string(o.Format)
+}
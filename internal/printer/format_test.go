@@ -0,0 +1,128 @@
+package printer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testPlacement struct {
+	Region string `json:"Region"`
+	AD     string `json:"AvailabilityDomain"`
+}
+
+type testResource struct {
+	Name      string            `json:"Name"`
+	ID        string            `json:"ID"`
+	Placement testPlacement     `json:"Placement"`
+	Created   time.Time         `json:"CreatedAt"`
+	NsgNames  []string          `json:"NsgNames,omitempty"`
+	Tags      map[string]string `json:"Tags"`
+	internal  string
+}
+
+func TestParseOutputFormat(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected OutputFormat
+		wantErr  bool
+	}{
+		{input: "", expected: TableOutput},
+		{input: "table", expected: TableOutput},
+		{input: "JSON", expected: JSONOutput},
+		{input: "yaml", expected: OutputFormat{Format: FormatYAML}},
+		{input: "yml", expected: OutputFormat{Format: FormatYAML}},
+		{input: "csv", expected: OutputFormat{Format: FormatCSV}},
+		{input: "tsv", expected: OutputFormat{Format: FormatTSV}},
+		{input: "template={{.Name}}={{.ID}}", expected: OutputFormat{Format: FormatTemplate, Template: "{{.Name}}={{.ID}}"}},
+		{input: "template=", wantErr: true},
+		{input: "json=x", wantErr: true},
+		{input: "xml", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseOutputFormat(tt.input)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseOutputFormat(%q): expected error", tt.input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseOutputFormat(%q): unexpected error %v", tt.input, err)
+			continue
+		}
+		if got != tt.expected {
+			t.Errorf("ParseOutputFormat(%q) = %+v, want %+v", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestMarshalToDelimited_StableColumns(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	items := []testResource{
+		{Name: "a", ID: "ocid1", Placement: testPlacement{Region: "us-ashburn-1", AD: "AD-1"}, Created: created, NsgNames: []string{"n1", "n2"}},
+		{Name: "b", ID: "ocid2", Tags: map[string]string{"env": "prod"}},
+	}
+
+	var buf bytes.Buffer
+	if err := New(&buf).MarshalToDelimited(items, ','); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header and 2 rows, got %d lines: %q", len(lines), buf.String())
+	}
+	if lines[0] != "Name,ID,Placement.Region,Placement.AvailabilityDomain,CreatedAt,NsgNames,Tags" {
+		t.Errorf("unexpected header: %s", lines[0])
+	}
+	if lines[1] != `a,ocid1,us-ashburn-1,AD-1,2024-01-02T03:04:05Z,"n1,n2",` {
+		t.Errorf("unexpected first row: %s", lines[1])
+	}
+	if lines[2] != `b,ocid2,,,,,"{""env"":""prod""}"` {
+		t.Errorf("unexpected second row: %s", lines[2])
+	}
+
+	// An empty slice still yields the header so columns stay stable.
+	buf.Reset()
+	if err := New(&buf).MarshalToDelimited([]testResource{}, '\t'); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.TrimSpace(buf.String()) != "Name\tID\tPlacement.Region\tPlacement.AvailabilityDomain\tCreatedAt\tNsgNames\tTags" {
+		t.Errorf("unexpected TSV header: %q", buf.String())
+	}
+}
+
+func TestMarshalToYAML_PreservesJSONNames(t *testing.T) {
+	var buf bytes.Buffer
+	data := testResource{Name: "a", ID: "ocid1", Placement: testPlacement{Region: "r"}}
+	if err := New(&buf).Marshal(OutputFormat{Format: FormatYAML}, data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.HasPrefix(out, "Name: a\nID: ocid1\nPlacement:\n  Region: r\n") {
+		t.Errorf("unexpected YAML output:\n%s", out)
+	}
+}
+
+func TestExecuteTemplate(t *testing.T) {
+	var buf bytes.Buffer
+	data := struct {
+		Items []testResource `json:"items"`
+	}{Items: []testResource{{Name: "a"}, {Name: "b"}}}
+
+	format := OutputFormat{Format: FormatTemplate, Template: `{{range .items}}{{.Name}};{{end}}`}
+	if err := New(&buf).Marshal(format, data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.String() != "a;b;" {
+		t.Errorf("unexpected template output: %q", buf.String())
+	}
+
+	if err := New(&buf).ExecuteTemplate("{{.Name", data); err == nil {
+		t.Error("expected parse error for invalid template")
+	}
+}
@@ -0,0 +1,859 @@
+package printer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// -----------------------------------------------------------------------------
+// Projection (--query / --fields)
+// -----------------------------------------------------------------------------
+//
+// The query language is a practical subset of JMESPath, with a few JSONPath
+// spellings accepted for convenience:
+//
+//	Name                      field access (falls back to a case-insensitive match)
+//	Placement.Region          nested field access
+//	[0], [-1]                 list index
+//	[] / [*]                  list projection ([] also flattens nested lists)
+//	*                         object value projection
+//	[?State=='RUNNING']       filter projection (==, !=, <, <=, >, >=, &&, ||, !)
+//	{Name: Name, IP: IP}      multiselect hash (keys keep their order)
+//	[Name, IP]                multiselect list
+//	expr | expr               pipe, stops an active projection
+//	$.items[*].Name           JSONPath-style root and bracket notation
+//
+// Literals are written as 'raw strings', `json` values or bare numbers.
+
+// Project applies a query expression and then a comma-separated field list to
+// data. Data is first converted to its JSON representation, so paths use the
+// JSON field names. Fields are applied to each element when the (queried)
+// value is a list, or to the value itself otherwise.
+func Project(data interface{}, query, fields string) (interface{}, error) {
+	value, err := ToGeneric(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(query) != "" {
+		e, err := parseQuery(query)
+		if err != nil {
+			return nil, fmt.Errorf("invalid query %q: %w", query, err)
+		}
+		value = e.eval(value)
+	}
+
+	if strings.TrimSpace(fields) != "" {
+		value, err = selectFields(value, fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return value, nil
+}
+
+// selectFields keeps only the given comma-separated paths, in the given order.
+func selectFields(value interface{}, fields string) (interface{}, error) {
+	var paths []string
+	var exprs []expr
+	for _, f := range strings.Split(fields, ",") {
+		f = strings.TrimSpace(f)
+		if f == "" {
+			continue
+		}
+		e, err := parseQuery(f)
+		if err != nil {
+			return nil, fmt.Errorf("invalid field %q: %w", f, err)
+		}
+		paths = append(paths, f)
+		exprs = append(exprs, e)
+	}
+
+	pick := func(v interface{}) interface{} {
+		r := NewRecord()
+		for i, e := range exprs {
+			r.Set(paths[i], e.eval(v))
+		}
+		return r
+	}
+
+	list, ok := value.([]interface{})
+	if !ok {
+		return pick(value), nil
+	}
+	out := make([]interface{}, len(list))
+	for i, v := range list {
+		out[i] = pick(v)
+	}
+	return out, nil
+}
+
+// Record is a JSON object whose keys keep their insertion order. Projections
+// build records so that selected fields are printed in the requested order.
+type Record struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+// NewRecord creates an empty Record.
+func NewRecord() *Record {
+	return &Record{values: map[string]interface{}{}}
+}
+
+// Set adds or replaces a key, keeping the position of existing keys.
+func (r *Record) Set(key string, value interface{}) {
+	if _, ok := r.values[key]; !ok {
+		r.keys = append(r.keys, key)
+	}
+	r.values[key] = value
+}
+
+// Get returns the value stored under key.
+func (r Record) Get(key string) (interface{}, bool) {
+	v, ok := r.values[key]
+	return v, ok
+}
+
+// Keys returns the keys in insertion order.
+func (r Record) Keys() []string {
+	return r.keys
+}
+
+// MarshalJSON encodes the record as a JSON object preserving key order.
+func (r Record) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, k := range r.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		kb, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		vb, err := json.Marshal(r.values[k])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(kb)
+		buf.WriteByte(':')
+		buf.Write(vb)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// -----------------------------------------------------------------------------
+// Evaluation
+// -----------------------------------------------------------------------------
+
+type expr interface {
+	eval(v interface{}) interface{}
+}
+
+// chainExpr evaluates steps left to right; a projection step applies the
+// remaining steps to every element it projects.
+type chainExpr struct {
+	steps []step
+}
+
+type stepKind int
+
+const (
+	stepField stepKind = iota
+	stepIndex
+	stepProject
+	stepValues
+	stepHash
+	stepList
+)
+
+type step struct {
+	kind    stepKind
+	name    string
+	index   int
+	flatten bool
+	filter  expr
+	keys    []string
+	exprs   []expr
+}
+
+func (c chainExpr) eval(v interface{}) interface{} {
+	return evalSteps(c.steps, v)
+}
+
+func evalSteps(steps []step, v interface{}) interface{} {
+	for i, s := range steps {
+		switch s.kind {
+		case stepField:
+			v = field(v, s.name)
+		case stepIndex:
+			list, ok := v.([]interface{})
+			if !ok {
+				return nil
+			}
+			idx := s.index
+			if idx < 0 {
+				idx += len(list)
+			}
+			if idx < 0 || idx >= len(list) {
+				return nil
+			}
+			v = list[idx]
+		case stepProject:
+			list, ok := v.([]interface{})
+			if !ok {
+				return nil
+			}
+			if s.flatten {
+				list = flatten(list)
+			}
+			return project(list, s.filter, steps[i+1:])
+		case stepValues:
+			values, ok := objectValues(v)
+			if !ok {
+				return nil
+			}
+			return project(values, nil, steps[i+1:])
+		case stepHash:
+			if v == nil {
+				return nil
+			}
+			r := NewRecord()
+			for j, k := range s.keys {
+				r.Set(k, s.exprs[j].eval(v))
+			}
+			v = r
+		case stepList:
+			if v == nil {
+				return nil
+			}
+			out := make([]interface{}, len(s.exprs))
+			for j, e := range s.exprs {
+				out[j] = e.eval(v)
+			}
+			v = out
+		}
+	}
+	return v
+}
+
+// project applies rest to every element of list. As in JMESPath, a flatten
+// ([]) further down the chain ends the projection and flattens its results.
+func project(list []interface{}, filter expr, rest []step) interface{} {
+	for j, s := range rest {
+		if s.kind == stepProject && s.flatten {
+			return evalSteps(rest[j:], project(list, filter, rest[:j]))
+		}
+	}
+
+	out := make([]interface{}, 0, len(list))
+	for _, e := range list {
+		if filter != nil && !truthy(filter.eval(e)) {
+			continue
+		}
+		if r := evalSteps(rest, e); r != nil {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+func flatten(list []interface{}) []interface{} {
+	out := make([]interface{}, 0, len(list))
+	for _, e := range list {
+		if inner, ok := e.([]interface{}); ok {
+			out = append(out, inner...)
+			continue
+		}
+		out = append(out, e)
+	}
+	return out
+}
+
+// field looks up name in an object, falling back to a case-insensitive match.
+func field(v interface{}, name string) interface{} {
+	switch obj := v.(type) {
+	case map[string]interface{}:
+		if val, ok := obj[name]; ok {
+			return val
+		}
+		for k, val := range obj {
+			if strings.EqualFold(k, name) {
+				return val
+			}
+		}
+	case *Record:
+		if val, ok := obj.Get(name); ok {
+			return val
+		}
+		for _, k := range obj.Keys() {
+			if strings.EqualFold(k, name) {
+				val, _ := obj.Get(k)
+				return val
+			}
+		}
+	}
+	return nil
+}
+
+func objectValues(v interface{}) ([]interface{}, bool) {
+	switch obj := v.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(obj))
+		for k := range obj {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		out := make([]interface{}, len(keys))
+		for i, k := range keys {
+			out[i] = obj[k]
+		}
+		return out, true
+	case *Record:
+		out := make([]interface{}, 0, len(obj.Keys()))
+		for _, k := range obj.Keys() {
+			val, _ := obj.Get(k)
+			out = append(out, val)
+		}
+		return out, true
+	}
+	return nil, false
+}
+
+// pipeExpr feeds the result of left into right.
+type pipeExpr struct {
+	left, right expr
+}
+
+func (p pipeExpr) eval(v interface{}) interface{} {
+	return p.right.eval(p.left.eval(v))
+}
+
+// literalExpr always evaluates to its value.
+type literalExpr struct {
+	value interface{}
+}
+
+func (l literalExpr) eval(interface{}) interface{} {
+	return l.value
+}
+
+// compareExpr compares two operands inside a filter.
+type compareExpr struct {
+	op          string
+	left, right expr
+}
+
+func (c compareExpr) eval(v interface{}) interface{} {
+	l, r := normalize(c.left.eval(v)), normalize(c.right.eval(v))
+	switch c.op {
+	case "==":
+		return reflect.DeepEqual(l, r)
+	case "!=":
+		return !reflect.DeepEqual(l, r)
+	}
+
+	if lf, ok := l.(float64); ok {
+		if rf, ok := r.(float64); ok {
+			return compareOrdered(c.op, lf, rf)
+		}
+	}
+	if ls, ok := l.(string); ok {
+		if rs, ok := r.(string); ok {
+			return compareOrdered(c.op, ls, rs)
+		}
+	}
+	return nil
+}
+
+func compareOrdered[T float64 | string](op string, l, r T) bool {
+	switch op {
+	case "<":
+		return l < r
+	case "<=":
+		return l <= r
+	case ">":
+		return l > r
+	default:
+		return l >= r
+	}
+}
+
+// logicalExpr implements &&, || and ! inside filters.
+type logicalExpr struct {
+	op          string
+	left, right expr
+}
+
+func (l logicalExpr) eval(v interface{}) interface{} {
+	switch l.op {
+	case "!":
+		return !truthy(l.left.eval(v))
+	case "&&":
+		left := l.left.eval(v)
+		if !truthy(left) {
+			return left
+		}
+		return l.right.eval(v)
+	default:
+		left := l.left.eval(v)
+		if truthy(left) {
+			return left
+		}
+		return l.right.eval(v)
+	}
+}
+
+func normalize(v interface{}) interface{} {
+	if n, ok := v.(json.Number); ok {
+		if f, err := n.Float64(); err == nil {
+			return f
+		}
+	}
+	return v
+}
+
+func truthy(v interface{}) bool {
+	switch t := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return t
+	case string:
+		return t != ""
+	case []interface{}:
+		return len(t) > 0
+	case map[string]interface{}:
+		return len(t) > 0
+	case *Record:
+		return len(t.Keys()) > 0
+	default:
+		return true
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Parsing
+// -----------------------------------------------------------------------------
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokRawString
+	tokLiteral
+	tokNumber
+	tokPunct
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+func tokenize(input string) ([]token, error) {
+	var tokens []token
+	twoChar := []string{"==", "!=", "<=", ">=", "&&", "||"}
+
+	for i := 0; i < len(input); {
+		c := rune(input[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '_' || unicode.IsLetter(c):
+			start := i
+			for i < len(input) && (input[i] == '_' || unicode.IsLetter(rune(input[i])) || unicode.IsDigit(rune(input[i]))) {
+				i++
+			}
+			tokens = append(tokens, token{tokIdent, input[start:i], start})
+		case c == '-' && i+1 < len(input) && unicode.IsDigit(rune(input[i+1])), unicode.IsDigit(c):
+			start := i
+			i++
+			for i < len(input) && unicode.IsDigit(rune(input[i])) {
+				i++
+			}
+			tokens = append(tokens, token{tokNumber, input[start:i], start})
+		case c == '"' || c == '\'' || c == '`':
+			end := strings.IndexByte(input[i+1:], byte(c))
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated %c at position %d", c, i)
+			}
+			value := input[i+1 : i+1+end]
+			kind := tokIdent
+			switch c {
+			case '\'':
+				kind = tokRawString
+			case '`':
+				kind = tokLiteral
+			}
+			tokens = append(tokens, token{kind, value, i})
+			i += end + 2
+		default:
+			matched := false
+			for _, op := range twoChar {
+				if strings.HasPrefix(input[i:], op) {
+					tokens = append(tokens, token{tokPunct, op, i})
+					i += 2
+					matched = true
+					break
+				}
+			}
+			if matched {
+				continue
+			}
+			if !strings.ContainsRune(".[]{}(),:|*@?!<>$", c) {
+				return nil, fmt.Errorf("unexpected character %q at position %d", c, i)
+			}
+			tokens = append(tokens, token{tokPunct, string(c), i})
+			i++
+		}
+	}
+	return append(tokens, token{kind: tokEOF, pos: len(input)}), nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func parseQuery(input string) (expr, error) {
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	e, err := p.parsePipe()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, fmt.Errorf("unexpected %q at position %d", t.value, t.pos)
+	}
+	return e, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) isPunct(value string) bool {
+	t := p.peek()
+	return t.kind == tokPunct && t.value == value
+}
+
+func (p *parser) expect(value string) error {
+	if t := p.next(); t.kind != tokPunct || t.value != value {
+		return fmt.Errorf("expected %q at position %d", value, t.pos)
+	}
+	return nil
+}
+
+func (p *parser) parsePipe() (expr, error) {
+	left, err := p.parseChain()
+	if err != nil {
+		return nil, err
+	}
+	for p.isPunct("|") {
+		p.next()
+		right, err := p.parseChain()
+		if err != nil {
+			return nil, err
+		}
+		left = pipeExpr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseChain() (expr, error) {
+	var steps []step
+
+	// optional JSONPath root
+	if p.isPunct("$") {
+		p.next()
+		if p.isPunct(".") {
+			p.next()
+		}
+		if t := p.peek(); t.kind == tokEOF || t.value == "|" {
+			return chainExpr{}, nil
+		}
+	}
+
+	t := p.peek()
+	switch {
+	case t.kind == tokIdent:
+		p.next()
+		steps = append(steps, step{kind: stepField, name: t.value})
+	case t.kind == tokPunct && t.value == "@":
+		p.next()
+	case t.kind == tokPunct && t.value == "*":
+		p.next()
+		steps = append(steps, step{kind: stepValues})
+	case t.kind == tokPunct && t.value == "[":
+		s, err := p.parseBracket()
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, s)
+	case t.kind == tokPunct && t.value == "{":
+		s, err := p.parseHash()
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, s)
+	default:
+		return nil, fmt.Errorf("unexpected %q at position %d", t.value, t.pos)
+	}
+
+	for {
+		switch {
+		case p.isPunct("."):
+			p.next()
+			t := p.peek()
+			switch {
+			case t.kind == tokIdent:
+				p.next()
+				steps = append(steps, step{kind: stepField, name: t.value})
+			case t.kind == tokPunct && t.value == "*":
+				p.next()
+				steps = append(steps, step{kind: stepValues})
+			case t.kind == tokPunct && t.value == "{":
+				s, err := p.parseHash()
+				if err != nil {
+					return nil, err
+				}
+				steps = append(steps, s)
+			case t.kind == tokPunct && t.value == "[":
+				p.next()
+				exprs, err := p.parseExprList()
+				if err != nil {
+					return nil, err
+				}
+				steps = append(steps, step{kind: stepList, exprs: exprs})
+			default:
+				return nil, fmt.Errorf("expected field name at position %d", t.pos)
+			}
+		case p.isPunct("["):
+			s, err := p.parseBracket()
+			if err != nil {
+				return nil, err
+			}
+			steps = append(steps, s)
+		default:
+			return chainExpr{steps: steps}, nil
+		}
+	}
+}
+
+// parseBracket parses [], [*], [N], [?cond], ['name'] and [expr, ...].
+func (p *parser) parseBracket() (step, error) {
+	if err := p.expect("["); err != nil {
+		return step{}, err
+	}
+
+	t := p.peek()
+	after := p.tokens[min(p.pos+1, len(p.tokens)-1)]
+	closes := after.kind == tokPunct && after.value == "]"
+
+	switch {
+	case t.kind == tokPunct && t.value == "]":
+		p.next()
+		return step{kind: stepProject, flatten: true}, nil
+	case t.kind == tokPunct && t.value == "*" && closes:
+		p.next()
+		p.next()
+		return step{kind: stepProject}, nil
+	case t.kind == tokNumber && closes:
+		p.next()
+		p.next()
+		var idx int
+		if _, err := fmt.Sscan(t.value, &idx); err != nil {
+			return step{}, fmt.Errorf("invalid index %q", t.value)
+		}
+		return step{kind: stepIndex, index: idx}, nil
+	case t.kind == tokRawString && closes:
+		p.next()
+		p.next()
+		return step{kind: stepField, name: t.value}, nil
+	case t.kind == tokPunct && t.value == "?":
+		p.next()
+		cond, err := p.parseOr()
+		if err != nil {
+			return step{}, err
+		}
+		if err := p.expect("]"); err != nil {
+			return step{}, err
+		}
+		return step{kind: stepProject, filter: cond}, nil
+	default:
+		exprs, err := p.parseExprList()
+		if err != nil {
+			return step{}, err
+		}
+		return step{kind: stepList, exprs: exprs}, nil
+	}
+}
+
+// parseExprList parses "expr, expr]" after an opening bracket.
+func (p *parser) parseExprList() ([]expr, error) {
+	var exprs []expr
+	for {
+		e, err := p.parsePipe()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, e)
+		if p.isPunct(",") {
+			p.next()
+			continue
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+		return exprs, nil
+	}
+}
+
+// parseHash parses {key: expr, ...}.
+func (p *parser) parseHash() (step, error) {
+	if err := p.expect("{"); err != nil {
+		return step{}, err
+	}
+	s := step{kind: stepHash}
+	for {
+		k := p.next()
+		if k.kind != tokIdent && k.kind != tokRawString {
+			return step{}, fmt.Errorf("expected key at position %d", k.pos)
+		}
+		if err := p.expect(":"); err != nil {
+			return step{}, err
+		}
+		e, err := p.parsePipe()
+		if err != nil {
+			return step{}, err
+		}
+		s.keys = append(s.keys, k.value)
+		s.exprs = append(s.exprs, e)
+		if p.isPunct(",") {
+			p.next()
+			continue
+		}
+		if err := p.expect("}"); err != nil {
+			return step{}, err
+		}
+		return s, nil
+	}
+}
+
+func (p *parser) parseOr() (expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isPunct("||") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = logicalExpr{op: "||", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (expr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.isPunct("&&") {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = logicalExpr{op: "&&", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (expr, error) {
+	if p.isPunct("!") {
+		p.next()
+		e, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return logicalExpr{op: "!", left: e}, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (expr, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind == tokPunct {
+		switch t.value {
+		case "==", "!=", "<", "<=", ">", ">=":
+			p.next()
+			right, err := p.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+			return compareExpr{op: t.value, left: left, right: right}, nil
+		}
+	}
+	return left, nil
+}
+
+func (p *parser) parseOperand() (expr, error) {
+	t := p.peek()
+	switch {
+	case t.kind == tokPunct && t.value == "(":
+		p.next()
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case t.kind == tokRawString:
+		p.next()
+		return literalExpr{value: t.value}, nil
+	case t.kind == tokNumber:
+		p.next()
+		return literalExpr{value: json.Number(t.value)}, nil
+	case t.kind == tokLiteral:
+		p.next()
+		dec := json.NewDecoder(strings.NewReader(t.value))
+		dec.UseNumber()
+		var v interface{}
+		if err := dec.Decode(&v); err != nil {
+			// JMESPath allows unquoted strings inside backticks
+			v = strings.TrimSpace(t.value)
+		}
+		return literalExpr{value: v}, nil
+	default:
+		return p.parseChain()
+	}
+}
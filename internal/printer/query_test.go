@@ -0,0 +1,112 @@
+package printer
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+type queryDB struct {
+	Name              string            `json:"Name"`
+	State             string            `json:"State"`
+	CPUs              int               `json:"CPUs"`
+	ConnectionStrings map[string]string `json:"ConnectionStrings"`
+	Subnets           []string          `json:"Subnets"`
+}
+
+var queryData = []queryDB{
+	{Name: "prod-a", State: "AVAILABLE", CPUs: 4, ConnectionStrings: map[string]string{"HIGH": "a_high"}, Subnets: []string{"s1", "s2"}},
+	{Name: "prod-b", State: "STOPPED", CPUs: 2, ConnectionStrings: map[string]string{"HIGH": "b_high"}, Subnets: []string{"s3"}},
+}
+
+func projectJSON(t *testing.T, query, fields string) string {
+	t.Helper()
+	out, err := Project(queryData, query, fields)
+	if err != nil {
+		t.Fatalf("Project(%q, %q) returned error: %v", query, fields, err)
+	}
+	raw, err := json.Marshal(out)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	return string(raw)
+}
+
+func TestProject_Queries(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{"[].ConnectionStrings.HIGH", `["a_high","b_high"]`},
+		{"[*].name", `["prod-a","prod-b"]`},
+		{"$[*].Name", `["prod-a","prod-b"]`},
+		{"[0].Name", `"prod-a"`},
+		{"[-1].Name", `"prod-b"`},
+		{"[].Subnets[]", `["s1","s2","s3"]`},
+		{"[?State=='AVAILABLE'].Name", `["prod-a"]`},
+		{"[?(@.CPUs > 2)].Name", `["prod-a"]`},
+		{"[?State!='AVAILABLE' && CPUs == `2`].Name", `["prod-b"]`},
+		{"[].{DB: Name, Conn: ConnectionStrings.HIGH}", `[{"DB":"prod-a","Conn":"a_high"},{"DB":"prod-b","Conn":"b_high"}]`},
+		{"[].[Name, CPUs]", `[["prod-a",4],["prod-b",2]]`},
+		{"[].Name | [0]", `"prod-a"`},
+		{"[0].ConnectionStrings.*", `["a_high"]`},
+		{"[].Missing", `[]`},
+	}
+
+	for _, tt := range tests {
+		if got := projectJSON(t, tt.query, ""); got != tt.expected {
+			t.Errorf("query %q = %s, want %s", tt.query, got, tt.expected)
+		}
+	}
+}
+
+func TestProject_Fields(t *testing.T) {
+	got := projectJSON(t, "", "State, Name, ConnectionStrings.HIGH")
+	want := `[{"State":"AVAILABLE","Name":"prod-a","ConnectionStrings.HIGH":"a_high"},{"State":"STOPPED","Name":"prod-b","ConnectionStrings.HIGH":"b_high"}]`
+	if got != want {
+		t.Errorf("fields = %s, want %s", got, want)
+	}
+
+	got = projectJSON(t, "[?CPUs < `3`]", "Name")
+	if got != `[{"Name":"prod-b"}]` {
+		t.Errorf("query+fields = %s", got)
+	}
+}
+
+func TestProject_InvalidQuery(t *testing.T) {
+	for _, q := range []string{"[", "Name.", "[?Name==]", "{Name Name}", "'unterminated"} {
+		if _, err := Project(queryData, q, ""); err == nil {
+			t.Errorf("expected error for query %q", q)
+		}
+	}
+}
+
+func TestMarshal_ProjectionFormats(t *testing.T) {
+	var buf bytes.Buffer
+	format := OutputFormat{Format: FormatCSV, Fields: "Name,CPUs"}
+	if err := New(&buf).Marshal(format, queryData); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.TrimSpace(buf.String()) != "Name,CPUs\nprod-a,4\nprod-b,2" {
+		t.Errorf("unexpected CSV output: %q", buf.String())
+	}
+
+	buf.Reset()
+	format = OutputFormat{Format: FormatYAML, Query: "[].{DB: Name, State: State}"}
+	if err := New(&buf).Marshal(format, queryData); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(buf.String(), "- DB: prod-a\n  State: AVAILABLE\n") {
+		t.Errorf("unexpected YAML output:\n%s", buf.String())
+	}
+
+	buf.Reset()
+	format = OutputFormat{Format: FormatTemplate, Template: "{{range .}}{{.Name}} {{end}}", Fields: "Name"}
+	if err := New(&buf).Marshal(format, queryData); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.String() != "prod-a prod-b " {
+		t.Errorf("unexpected template output: %q", buf.String())
+	}
+}
@@ -0,0 +1,253 @@
+package region
+
+import (
+	"context"
+
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+)
+
+// instanceRepository lists instances in every region and looks them up in the region they came from.
+type instanceRepository struct {
+	compute.InstanceRepository
+	set   *Set
+	repos []compute.InstanceRepository
+}
+
+// NewInstanceRepository builds one repository per region of set. A nil set
+// builds a single repository for the configured region.
+func NewInstanceRepository(set *Set, build func(region string) (compute.InstanceRepository, error)) (compute.InstanceRepository, error) {
+	if set == nil {
+		return build("")
+	}
+	repos, err := buildAll(set, build)
+	if err != nil {
+		return nil, err
+	}
+	return &instanceRepository{InstanceRepository: repos[0], set: set, repos: repos}, nil
+}
+
+func (r *instanceRepository) GetEnrichedInstance(ctx context.Context, ocid string) (*compute.Instance, error) {
+	repo, err := pick(r.set, r.repos, ocid)
+	if err != nil {
+		return nil, err
+	}
+	return repo.GetEnrichedInstance(ctx, ocid)
+}
+
+func (r *instanceRepository) ListInstances(ctx context.Context, compartmentID string) ([]compute.Instance, error) {
+	return list(ctx, r.set, r.repos, func(ctx context.Context, repo compute.InstanceRepository) ([]compute.Instance, error) {
+		return repo.ListInstances(ctx, compartmentID)
+	}, instanceID)
+}
+
+func (r *instanceRepository) ListEnrichedInstances(ctx context.Context, compartmentID string) ([]compute.Instance, error) {
+	return list(ctx, r.set, r.repos, func(ctx context.Context, repo compute.InstanceRepository) ([]compute.Instance, error) {
+		return repo.ListEnrichedInstances(ctx, compartmentID)
+	}, instanceID)
+}
+
+func instanceID(i compute.Instance) string { return i.OCID }
+
+// instanceAgent reads agent plugins in the region the instance was listed from.
+type instanceAgent struct {
+	set    *Set
+	agents []compute.InstanceAgent
+}
+
+// NewInstanceAgent builds one agent reader per region of set. A nil set builds
+// a single reader for the configured region.
+func NewInstanceAgent(set *Set, build func(region string) (compute.InstanceAgent, error)) (compute.InstanceAgent, error) {
+	if set == nil {
+		return build("")
+	}
+	agents, err := buildAll(set, build)
+	if err != nil {
+		return nil, err
+	}
+	return &instanceAgent{set: set, agents: agents}, nil
+}
+
+func (a *instanceAgent) ListAgentPlugins(ctx context.Context, compartmentID, instanceID string) ([]compute.AgentPlugin, error) {
+	repo, err := pick(a.set, a.agents, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	return repo.ListAgentPlugins(ctx, compartmentID, instanceID)
+}
+
+// imageRepository lists images in every region and looks them up in the region they came from.
+type imageRepository struct {
+	compute.ImageRepository
+	set   *Set
+	repos []compute.ImageRepository
+}
+
+// NewImageRepository builds one repository per region of set. A nil set
+// builds a single repository for the configured region.
+func NewImageRepository(set *Set, build func(region string) (compute.ImageRepository, error)) (compute.ImageRepository, error) {
+	if set == nil {
+		return build("")
+	}
+	repos, err := buildAll(set, build)
+	if err != nil {
+		return nil, err
+	}
+	return &imageRepository{ImageRepository: repos[0], set: set, repos: repos}, nil
+}
+
+func (r *imageRepository) GetImage(ctx context.Context, ocid string) (*compute.Image, error) {
+	repo, err := pick(r.set, r.repos, ocid)
+	if err != nil {
+		return nil, err
+	}
+	return repo.GetImage(ctx, ocid)
+}
+
+func (r *imageRepository) ListImages(ctx context.Context, compartmentID string) ([]compute.Image, error) {
+	return list(ctx, r.set, r.repos, func(ctx context.Context, repo compute.ImageRepository) ([]compute.Image, error) {
+		return repo.ListImages(ctx, compartmentID)
+	}, func(i compute.Image) string { return i.OCID })
+}
+
+// clusterRepository lists OKE clusters in every region and looks them up in the region they came from.
+type clusterRepository struct {
+	compute.ClusterRepository
+	set   *Set
+	repos []compute.ClusterRepository
+}
+
+// NewClusterRepository builds one repository per region of set. A nil set
+// builds a single repository for the configured region.
+func NewClusterRepository(set *Set, build func(region string) (compute.ClusterRepository, error)) (compute.ClusterRepository, error) {
+	if set == nil {
+		return build("")
+	}
+	repos, err := buildAll(set, build)
+	if err != nil {
+		return nil, err
+	}
+	return &clusterRepository{ClusterRepository: repos[0], set: set, repos: repos}, nil
+}
+
+func (r *clusterRepository) GetCluster(ctx context.Context, ocid string) (*compute.Cluster, error) {
+	repo, err := pick(r.set, r.repos, ocid)
+	if err != nil {
+		return nil, err
+	}
+	return repo.GetCluster(ctx, ocid)
+}
+
+func (r *clusterRepository) ListClusters(ctx context.Context, compartmentID string) ([]compute.Cluster, error) {
+	return list(ctx, r.set, r.repos, func(ctx context.Context, repo compute.ClusterRepository) ([]compute.Cluster, error) {
+		return repo.ListClusters(ctx, compartmentID)
+	}, func(c compute.Cluster) string { return c.OCID })
+}
+
+// nodePoolInventory lists node pool nodes in the region their cluster was listed
+// from and records that region for the node instances.
+type nodePoolInventory struct {
+	set         *Set
+	inventories []compute.NodePoolInventory
+}
+
+// NewNodePoolInventory builds one node lister per region of set. A nil set
+// builds a single lister for the configured region.
+func NewNodePoolInventory(set *Set, build func(region string) (compute.NodePoolInventory, error)) (compute.NodePoolInventory, error) {
+	if set == nil {
+		return build("")
+	}
+	inventories, err := buildAll(set, build)
+	if err != nil {
+		return nil, err
+	}
+	return &nodePoolInventory{set: set, inventories: inventories}, nil
+}
+
+func (n *nodePoolInventory) ListNodes(ctx context.Context, clusterID, nodePoolID string) ([]compute.Node, error) {
+	inventory, err := pick(n.set, n.inventories, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := inventory.ListNodes(ctx, clusterID, nodePoolID)
+	if err != nil {
+		return nil, err
+	}
+	if r := n.set.RegionOf(clusterID); r != "" {
+		ids := make([]string, len(nodes))
+		for i, node := range nodes {
+			ids[i] = node.OCID
+		}
+		n.set.record(r, ids)
+	}
+	return nodes, nil
+}
+
+func (n *nodePoolInventory) ListVirtualNodes(ctx context.Context, clusterID, virtualNodePoolID string) ([]compute.VirtualNode, error) {
+	repo, err := pick(n.set, n.inventories, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return repo.ListVirtualNodes(ctx, clusterID, virtualNodePoolID)
+}
+
+// clusterKubeconfig generates kubeconfigs in the region their cluster was listed from.
+type clusterKubeconfig struct {
+	set        *Set
+	generators []compute.ClusterKubeconfig
+}
+
+// NewClusterKubeconfig builds one kubeconfig generator per region of set. A nil
+// set builds a single generator for the configured region.
+func NewClusterKubeconfig(set *Set, build func(region string) (compute.ClusterKubeconfig, error)) (compute.ClusterKubeconfig, error) {
+	if set == nil {
+		return build("")
+	}
+	generators, err := buildAll(set, build)
+	if err != nil {
+		return nil, err
+	}
+	return &clusterKubeconfig{set: set, generators: generators}, nil
+}
+
+func (k *clusterKubeconfig) CreateKubeconfig(ctx context.Context, clusterID, endpoint string) ([]byte, error) {
+	repo, err := pick(k.set, k.generators, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return repo.CreateKubeconfig(ctx, clusterID, endpoint)
+}
+
+// instanceController sends power actions in the region the instance was listed from.
+type instanceController struct {
+	set         *Set
+	controllers []compute.InstanceController
+}
+
+// NewInstanceController builds one controller per region of set. A nil set
+// builds a single controller for the configured region.
+func NewInstanceController(set *Set, build func(region string) (compute.InstanceController, error)) (compute.InstanceController, error) {
+	if set == nil {
+		return build("")
+	}
+	controllers, err := buildAll(set, build)
+	if err != nil {
+		return nil, err
+	}
+	return &instanceController{set: set, controllers: controllers}, nil
+}
+
+func (c *instanceController) GetInstance(ctx context.Context, ocid string) (*compute.Instance, error) {
+	repo, err := pick(c.set, c.controllers, ocid)
+	if err != nil {
+		return nil, err
+	}
+	return repo.GetInstance(ctx, ocid)
+}
+
+func (c *instanceController) InstanceAction(ctx context.Context, ocid string, action compute.InstanceAction) (*compute.Instance, error) {
+	repo, err := pick(c.set, c.controllers, ocid)
+	if err != nil {
+		return nil, err
+	}
+	return repo.InstanceAction(ctx, ocid, action)
+}
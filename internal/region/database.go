@@ -0,0 +1,133 @@
+package region
+
+import (
+	"context"
+
+	"github.com/cnopslabs/ocloud/internal/domain/database"
+)
+
+// autonomousDatabaseRepository lists Autonomous Databases in every region and looks them up in the region they came from.
+type autonomousDatabaseRepository struct {
+	database.AutonomousDatabaseRepository
+	set   *Set
+	repos []database.AutonomousDatabaseRepository
+}
+
+// NewAutonomousDatabaseRepository builds one repository per region of set. A nil set
+// builds a single repository for the configured region.
+func NewAutonomousDatabaseRepository(set *Set, build func(region string) (database.AutonomousDatabaseRepository, error)) (database.AutonomousDatabaseRepository, error) {
+	if set == nil {
+		return build("")
+	}
+	repos, err := buildAll(set, build)
+	if err != nil {
+		return nil, err
+	}
+	return &autonomousDatabaseRepository{AutonomousDatabaseRepository: repos[0], set: set, repos: repos}, nil
+}
+
+func (r *autonomousDatabaseRepository) GetAutonomousDatabase(ctx context.Context, ocid string) (*database.AutonomousDatabase, error) {
+	repo, err := pick(r.set, r.repos, ocid)
+	if err != nil {
+		return nil, err
+	}
+	return repo.GetAutonomousDatabase(ctx, ocid)
+}
+
+func (r *autonomousDatabaseRepository) ListAutonomousDatabases(ctx context.Context, compartmentID string) ([]database.AutonomousDatabase, error) {
+	return list(ctx, r.set, r.repos, func(ctx context.Context, repo database.AutonomousDatabaseRepository) ([]database.AutonomousDatabase, error) {
+		return repo.ListAutonomousDatabases(ctx, compartmentID)
+	}, autonomousDatabaseID)
+}
+
+func (r *autonomousDatabaseRepository) ListEnrichedAutonomousDatabase(ctx context.Context, compartmentID string) ([]database.AutonomousDatabase, error) {
+	return list(ctx, r.set, r.repos, func(ctx context.Context, repo database.AutonomousDatabaseRepository) ([]database.AutonomousDatabase, error) {
+		return repo.ListEnrichedAutonomousDatabase(ctx, compartmentID)
+	}, autonomousDatabaseID)
+}
+
+func autonomousDatabaseID(d database.AutonomousDatabase) string { return d.ID }
+
+// heatWaveDatabaseRepository lists HeatWave databases in every region and looks them up in the region they came from.
+type heatWaveDatabaseRepository struct {
+	database.HeatWaveDatabaseRepository
+	set   *Set
+	repos []database.HeatWaveDatabaseRepository
+}
+
+// NewHeatWaveDatabaseRepository builds one repository per region of set. A nil set
+// builds a single repository for the configured region.
+func NewHeatWaveDatabaseRepository(set *Set, build func(region string) (database.HeatWaveDatabaseRepository, error)) (database.HeatWaveDatabaseRepository, error) {
+	if set == nil {
+		return build("")
+	}
+	repos, err := buildAll(set, build)
+	if err != nil {
+		return nil, err
+	}
+	return &heatWaveDatabaseRepository{HeatWaveDatabaseRepository: repos[0], set: set, repos: repos}, nil
+}
+
+func (r *heatWaveDatabaseRepository) GetHeatWaveDatabase(ctx context.Context, ocid string) (*database.HeatWaveDatabase, error) {
+	repo, err := pick(r.set, r.repos, ocid)
+	if err != nil {
+		return nil, err
+	}
+	return repo.GetHeatWaveDatabase(ctx, ocid)
+}
+
+func (r *heatWaveDatabaseRepository) ListHeatWaveDatabases(ctx context.Context, compartmentID string) ([]database.HeatWaveDatabase, error) {
+	return list(ctx, r.set, r.repos, func(ctx context.Context, repo database.HeatWaveDatabaseRepository) ([]database.HeatWaveDatabase, error) {
+		return repo.ListHeatWaveDatabases(ctx, compartmentID)
+	}, heatWaveDatabaseID)
+}
+
+func (r *heatWaveDatabaseRepository) ListEnrichedHeatWaveDatabases(ctx context.Context, compartmentID string) ([]database.HeatWaveDatabase, error) {
+	return list(ctx, r.set, r.repos, func(ctx context.Context, repo database.HeatWaveDatabaseRepository) ([]database.HeatWaveDatabase, error) {
+		return repo.ListEnrichedHeatWaveDatabases(ctx, compartmentID)
+	}, heatWaveDatabaseID)
+}
+
+func heatWaveDatabaseID(d database.HeatWaveDatabase) string { return d.ID }
+
+// cacheClusterRepository lists OCI Cache clusters in every region and looks them up in the region they came from.
+type cacheClusterRepository struct {
+	database.CacheClusterRepository
+	set   *Set
+	repos []database.CacheClusterRepository
+}
+
+// NewCacheClusterRepository builds one repository per region of set. A nil set
+// builds a single repository for the configured region.
+func NewCacheClusterRepository(set *Set, build func(region string) (database.CacheClusterRepository, error)) (database.CacheClusterRepository, error) {
+	if set == nil {
+		return build("")
+	}
+	repos, err := buildAll(set, build)
+	if err != nil {
+		return nil, err
+	}
+	return &cacheClusterRepository{CacheClusterRepository: repos[0], set: set, repos: repos}, nil
+}
+
+func (r *cacheClusterRepository) GetCacheCluster(ctx context.Context, clusterID string) (*database.CacheCluster, error) {
+	repo, err := pick(r.set, r.repos, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return repo.GetCacheCluster(ctx, clusterID)
+}
+
+func (r *cacheClusterRepository) ListCacheClusters(ctx context.Context, compartmentID string) ([]database.CacheCluster, error) {
+	return list(ctx, r.set, r.repos, func(ctx context.Context, repo database.CacheClusterRepository) ([]database.CacheCluster, error) {
+		return repo.ListCacheClusters(ctx, compartmentID)
+	}, cacheClusterID)
+}
+
+func (r *cacheClusterRepository) ListEnrichedCacheClusters(ctx context.Context, compartmentID string) ([]database.CacheCluster, error) {
+	return list(ctx, r.set, r.repos, func(ctx context.Context, repo database.CacheClusterRepository) ([]database.CacheCluster, error) {
+		return repo.ListEnrichedCacheClusters(ctx, compartmentID)
+	}, cacheClusterID)
+}
+
+func cacheClusterID(c database.CacheCluster) string { return c.ID }
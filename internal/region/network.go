@@ -0,0 +1,135 @@
+package region
+
+import (
+	"context"
+
+	"github.com/cnopslabs/ocloud/internal/domain/network/loadbalancer"
+	"github.com/cnopslabs/ocloud/internal/domain/network/subnet"
+	"github.com/cnopslabs/ocloud/internal/domain/network/vcn"
+)
+
+// vcnRepository lists VCNs in every region and looks them up in the region they came from.
+type vcnRepository struct {
+	vcn.VCNRepository
+	set   *Set
+	repos []vcn.VCNRepository
+}
+
+// NewVCNRepository builds one repository per region of set. A nil set
+// builds a single repository for the configured region.
+func NewVCNRepository(set *Set, build func(region string) (vcn.VCNRepository, error)) (vcn.VCNRepository, error) {
+	if set == nil {
+		return build("")
+	}
+	repos, err := buildAll(set, build)
+	if err != nil {
+		return nil, err
+	}
+	return &vcnRepository{VCNRepository: repos[0], set: set, repos: repos}, nil
+}
+
+func (r *vcnRepository) GetEnrichedVcn(ctx context.Context, ocid string) (vcn.VCN, error) {
+	repo, err := pick(r.set, r.repos, ocid)
+	if err != nil {
+		return vcn.VCN{}, err
+	}
+	return repo.GetEnrichedVcn(ctx, ocid)
+}
+
+func (r *vcnRepository) ListVcns(ctx context.Context, compartmentID string) ([]vcn.VCN, error) {
+	return list(ctx, r.set, r.repos, func(ctx context.Context, repo vcn.VCNRepository) ([]vcn.VCN, error) {
+		return repo.ListVcns(ctx, compartmentID)
+	}, vcnID)
+}
+
+func (r *vcnRepository) ListEnrichedVcns(ctx context.Context, compartmentID string) ([]vcn.VCN, error) {
+	return list(ctx, r.set, r.repos, func(ctx context.Context, repo vcn.VCNRepository) ([]vcn.VCN, error) {
+		return repo.ListEnrichedVcns(ctx, compartmentID)
+	}, vcnID)
+}
+
+func vcnID(v vcn.VCN) string { return v.OCID }
+
+// subnetRepository lists subnets in every region and looks them up in the region they came from.
+type subnetRepository struct {
+	subnet.SubnetRepository
+	set   *Set
+	repos []subnet.SubnetRepository
+}
+
+// NewSubnetRepository builds one repository per region of set. A nil set
+// builds a single repository for the configured region.
+func NewSubnetRepository(set *Set, build func(region string) (subnet.SubnetRepository, error)) (subnet.SubnetRepository, error) {
+	if set == nil {
+		return build("")
+	}
+	repos, err := buildAll(set, build)
+	if err != nil {
+		return nil, err
+	}
+	return &subnetRepository{SubnetRepository: repos[0], set: set, repos: repos}, nil
+}
+
+func (r *subnetRepository) GetSubnet(ctx context.Context, ocid string) (*subnet.Subnet, error) {
+	repo, err := pick(r.set, r.repos, ocid)
+	if err != nil {
+		return nil, err
+	}
+	return repo.GetSubnet(ctx, ocid)
+}
+
+func (r *subnetRepository) ListSubnets(ctx context.Context, compartmentID string) ([]subnet.Subnet, error) {
+	return list(ctx, r.set, r.repos, func(ctx context.Context, repo subnet.SubnetRepository) ([]subnet.Subnet, error) {
+		return repo.ListSubnets(ctx, compartmentID)
+	}, func(s subnet.Subnet) string { return s.OCID })
+}
+
+// loadBalancerRepository lists load balancers in every region and looks them up in the region they came from.
+type loadBalancerRepository struct {
+	loadbalancer.LoadBalancerRepository
+	set   *Set
+	repos []loadbalancer.LoadBalancerRepository
+}
+
+// NewLoadBalancerRepository builds one repository per region of set. A nil set
+// builds a single repository for the configured region.
+func NewLoadBalancerRepository(set *Set, build func(region string) (loadbalancer.LoadBalancerRepository, error)) (loadbalancer.LoadBalancerRepository, error) {
+	if set == nil {
+		return build("")
+	}
+	repos, err := buildAll(set, build)
+	if err != nil {
+		return nil, err
+	}
+	return &loadBalancerRepository{LoadBalancerRepository: repos[0], set: set, repos: repos}, nil
+}
+
+func (r *loadBalancerRepository) GetLoadBalancer(ctx context.Context, ocid string) (*loadbalancer.LoadBalancer, error) {
+	repo, err := pick(r.set, r.repos, ocid)
+	if err != nil {
+		return nil, err
+	}
+	return repo.GetLoadBalancer(ctx, ocid)
+}
+
+func (r *loadBalancerRepository) GetEnrichedLoadBalancer(ctx context.Context, ocid string) (*loadbalancer.LoadBalancer, error) {
+	repo, err := pick(r.set, r.repos, ocid)
+	if err != nil {
+		return nil, err
+	}
+	return repo.GetEnrichedLoadBalancer(ctx, ocid)
+}
+
+func (r *loadBalancerRepository) ListLoadBalancers(ctx context.Context, compartmentID string) ([]loadbalancer.LoadBalancer, error) {
+	return list(ctx, r.set, r.repos, func(ctx context.Context, repo loadbalancer.LoadBalancerRepository) ([]loadbalancer.LoadBalancer, error) {
+		return repo.ListLoadBalancers(ctx, compartmentID)
+	}, loadBalancerID)
+}
+
+func (r *loadBalancerRepository) ListEnrichedLoadBalancers(ctx context.Context, compartmentID string) ([]loadbalancer.LoadBalancer, error) {
+	return list(ctx, r.set, r.repos, func(ctx context.Context, repo loadbalancer.LoadBalancerRepository) ([]loadbalancer.LoadBalancer, error) {
+		return repo.ListEnrichedLoadBalancers(ctx, compartmentID)
+	}, loadBalancerID)
+}
+
+func loadBalancerID(lb loadbalancer.LoadBalancer) string { return lb.OCID }
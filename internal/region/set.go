@@ -0,0 +1,157 @@
+// Package region fans resource listings out over several OCI regions.
+//
+// A Set holds the selected regions. Repository decorators in this package hold
+// one repository per region, list every region in parallel and remember the
+// region each resource came from so that later lookups go to the right region.
+//
+// Services build their repositories through these decorators with a function
+// that builds the adapter of one region: with a nil Set, as when neither
+// --regions nor --all-subscribed-regions is given, it is called once for the
+// configured region; otherwise once per selected region.
+package region
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"golang.org/x/sync/errgroup"
+)
+
+// Set is the list of regions a command runs against. A nil *Set is valid and
+// disables fan-out.
+type Set struct {
+	regions []string
+
+	mu sync.RWMutex
+	of map[string]string
+}
+
+// NewSet creates a Set of the given regions, dropping duplicates and empty names.
+func NewSet(regions []string) *Set {
+	seen := map[string]bool{}
+	var unique []string
+	for _, r := range regions {
+		if r == "" || seen[r] {
+			continue
+		}
+		seen[r] = true
+		unique = append(unique, r)
+	}
+	return &Set{regions: unique, of: map[string]string{}}
+}
+
+// Regions returns the regions of the set in the order they were given.
+func (s *Set) Regions() []string {
+	if s == nil {
+		return nil
+	}
+	return s.regions
+}
+
+// RegionOf returns the region the resource with the given ID was listed from,
+// or "" when it was not listed through the set.
+func (s *Set) RegionOf(id string) string {
+	if s == nil {
+		return ""
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.of[id]
+}
+
+// record remembers the region of the given resources.
+func (s *Set) record(region string, ids []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, id := range ids {
+		if id != "" {
+			s.of[id] = region
+		}
+	}
+}
+
+// buildAll creates one repository per region of s.
+func buildAll[R any](s *Set, build func(region string) (R, error)) ([]R, error) {
+	if len(s.regions) == 0 {
+		return nil, fmt.Errorf("no regions selected")
+	}
+	repos := make([]R, len(s.regions))
+	for i, r := range s.regions {
+		repo, err := build(r)
+		if err != nil {
+			return nil, fmt.Errorf("region %s: %w", r, err)
+		}
+		repos[i] = repo
+	}
+	return repos, nil
+}
+
+// list calls call with the repository of every region in parallel and
+// concatenates the results in region order; id identifies each resource for RegionOf.
+func list[R, T any](ctx context.Context, s *Set, repos []R, call func(context.Context, R) ([]T, error), id func(T) string) ([]T, error) {
+	results := make([][]T, len(repos))
+	g, gctx := errgroup.WithContext(ctx)
+	for i, repo := range repos {
+		g.Go(func() error {
+			items, err := call(gctx, repo)
+			if err != nil {
+				return fmt.Errorf("region %s: %w", s.regions[i], err)
+			}
+			ids := make([]string, len(items))
+			for j, item := range items {
+				ids[j] = id(item)
+			}
+			s.record(s.regions[i], ids)
+			results[i] = items
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	var all []T
+	for _, items := range results {
+		all = append(all, items...)
+	}
+	return all, nil
+}
+
+// pick returns the repository of the region the resource id lives in: the
+// region it was listed from, or else the region named in its OCID. An OCID that
+// names no region, like those of compartments and policies, is valid in every
+// region and goes to the first one. Any other id is an error, since a lookup in
+// an arbitrary region would only report the resource as not found.
+func pick[R any](s *Set, repos []R, id string) (R, error) {
+	var zero R
+	name := s.RegionOf(id)
+	if name == "" {
+		segment, ok := ocidRegion(id)
+		if !ok {
+			return zero, fmt.Errorf("%s was not listed in any selected region (%s)", id, strings.Join(s.regions, ", "))
+		}
+		if segment == "" {
+			return repos[0], nil
+		}
+		name = string(common.StringToRegion(segment))
+	}
+	for i, r := range s.regions {
+		if r == name || string(common.StringToRegion(r)) == name {
+			return repos[i], nil
+		}
+	}
+	return zero, fmt.Errorf("%s is in region %s, which is not selected (%s)", id, name, strings.Join(s.regions, ", "))
+}
+
+// ocidRegion returns the region segment of id, as a short code or a region
+// name, when id is an OCID of the form ocid1.<type>.<realm>.<region>.<unique ID>.
+func ocidRegion(id string) (string, bool) {
+	parts := strings.Split(id, ".")
+	if len(parts) < 5 || parts[0] != "ocid1" {
+		return "", false
+	}
+	return strings.ToLower(parts[3]), true
+}
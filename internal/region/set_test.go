@@ -0,0 +1,174 @@
+package region
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeImageRepository serves one image per region and records the lookups it answered.
+type fakeImageRepository struct {
+	compute.ImageRepository
+	region string
+	err    error
+	got    *[]string
+}
+
+func (f fakeImageRepository) ListImages(ctx context.Context, compartmentID string) ([]compute.Image, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return []compute.Image{{OCID: "img-" + f.region, DisplayName: compartmentID}}, nil
+}
+
+func (f fakeImageRepository) GetImage(ctx context.Context, ocid string) (*compute.Image, error) {
+	*f.got = append(*f.got, f.region)
+	return &compute.Image{OCID: ocid}, nil
+}
+
+func newFakeImages(set *Set, failing string) (compute.ImageRepository, *[]string, error) {
+	var got []string
+	repo, err := NewImageRepository(set, func(name string) (compute.ImageRepository, error) {
+		repo := fakeImageRepository{region: name, got: &got}
+		if name == failing {
+			repo.err = errors.New("not authorized")
+		}
+		return repo, nil
+	})
+	return repo, &got, err
+}
+
+func TestNewSet_DropsDuplicates(t *testing.T) {
+	set := NewSet([]string{"us-ashburn-1", "", "eu-frankfurt-1", "us-ashburn-1"})
+	assert.Equal(t, []string{"us-ashburn-1", "eu-frankfurt-1"}, set.Regions())
+
+	var nilSet *Set
+	assert.Nil(t, nilSet.Regions())
+	assert.Equal(t, "", nilSet.RegionOf("x"))
+}
+
+func TestList_MergesRegionsInOrder(t *testing.T) {
+	set := NewSet([]string{"us-ashburn-1", "us-phoenix-1", "eu-frankfurt-1"})
+	repo, _, err := newFakeImages(set, "")
+	require.NoError(t, err)
+
+	images, err := repo.ListImages(context.Background(), "comp")
+	require.NoError(t, err)
+
+	ids := make([]string, len(images))
+	for i, image := range images {
+		ids[i] = image.OCID
+	}
+	assert.Equal(t, []string{"img-us-ashburn-1", "img-us-phoenix-1", "img-eu-frankfurt-1"}, ids)
+	assert.Equal(t, "us-phoenix-1", set.RegionOf("img-us-phoenix-1"))
+}
+
+func TestGet_RoutesToListedRegion(t *testing.T) {
+	set := NewSet([]string{"us-ashburn-1", "eu-frankfurt-1"})
+	repo, got, err := newFakeImages(set, "")
+	require.NoError(t, err)
+
+	_, err = repo.ListImages(context.Background(), "comp")
+	require.NoError(t, err)
+	_, err = repo.GetImage(context.Background(), "img-eu-frankfurt-1")
+	require.NoError(t, err)
+	_, err = repo.GetImage(context.Background(), "unknown")
+	assert.ErrorContains(t, err, "not listed in any selected region")
+
+	assert.Equal(t, []string{"eu-frankfurt-1"}, *got, "unknown IDs go to no region")
+}
+
+func TestGet_RoutesByOCIDRegion(t *testing.T) {
+	set := NewSet([]string{"us-ashburn-1", "eu-frankfurt-1"})
+	repo, got, err := newFakeImages(set, "")
+	require.NoError(t, err)
+
+	for _, ocid := range []string{
+		"ocid1.image.oc1.fra.aaaa",
+		"ocid1.image.oc1.eu-frankfurt-1.aaaa",
+		"ocid1.image.oc1..aaaa",
+	} {
+		_, err = repo.GetImage(context.Background(), ocid)
+		require.NoError(t, err, ocid)
+	}
+	assert.Equal(t, []string{"eu-frankfurt-1", "eu-frankfurt-1", "us-ashburn-1"}, *got, "short codes and region names route alike; OCIDs without a region go to the first one")
+
+	_, err = repo.GetImage(context.Background(), "ocid1.image.oc1.phx.aaaa")
+	assert.ErrorContains(t, err, "us-phoenix-1, which is not selected")
+}
+
+func TestList_ReportsFailingRegion(t *testing.T) {
+	set := NewSet([]string{"us-ashburn-1", "eu-frankfurt-1"})
+	repo, _, err := newFakeImages(set, "eu-frankfurt-1")
+	require.NoError(t, err)
+
+	_, err = repo.ListImages(context.Background(), "comp")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "region eu-frankfurt-1")
+}
+
+func TestNilSet_BuildsConfiguredRegion(t *testing.T) {
+	var built []string
+	repo, err := NewImageRepository(nil, func(name string) (compute.ImageRepository, error) {
+		built = append(built, name)
+		return fakeImageRepository{}, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{""}, built)
+	assert.IsType(t, fakeImageRepository{}, repo)
+}
+
+// fakeNodePoolInventory serves one node per call, named after its region.
+type fakeNodePoolInventory struct{ region string }
+
+func (f fakeNodePoolInventory) ListNodes(ctx context.Context, clusterID, nodePoolID string) ([]compute.Node, error) {
+	return []compute.Node{{OCID: "node-" + f.region, NodePoolID: nodePoolID}}, nil
+}
+
+func (f fakeNodePoolInventory) ListVirtualNodes(ctx context.Context, clusterID, virtualNodePoolID string) ([]compute.VirtualNode, error) {
+	return []compute.VirtualNode{{OCID: "virtual-node-" + f.region, VirtualNodePoolID: virtualNodePoolID}}, nil
+}
+
+func TestNodePoolInventory_RoutesByClusterAndRecordsNodes(t *testing.T) {
+	set := NewSet([]string{"us-ashburn-1", "eu-frankfurt-1"})
+	set.record("eu-frankfurt-1", []string{"cluster-fra"})
+	inventory, err := NewNodePoolInventory(set, func(name string) (compute.NodePoolInventory, error) {
+		return fakeNodePoolInventory{region: name}, nil
+	})
+	require.NoError(t, err)
+
+	nodes, err := inventory.ListNodes(context.Background(), "cluster-fra", "np1")
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	assert.Equal(t, "node-eu-frankfurt-1", nodes[0].OCID)
+	assert.Equal(t, "eu-frankfurt-1", set.RegionOf("node-eu-frankfurt-1"), "node instances are looked up in the cluster region")
+
+	virtualNodes, err := inventory.ListVirtualNodes(context.Background(), "cluster-fra", "vnp1")
+	require.NoError(t, err)
+	require.Len(t, virtualNodes, 1)
+	assert.Equal(t, "virtual-node-eu-frankfurt-1", virtualNodes[0].OCID)
+}
+
+// fakeClusterKubeconfig returns the name of its region as the kubeconfig.
+type fakeClusterKubeconfig struct{ region string }
+
+func (f fakeClusterKubeconfig) CreateKubeconfig(ctx context.Context, clusterID, endpoint string) ([]byte, error) {
+	return []byte(f.region), nil
+}
+
+func TestClusterKubeconfig_RoutesByCluster(t *testing.T) {
+	set := NewSet([]string{"us-ashburn-1", "eu-frankfurt-1"})
+	set.record("eu-frankfurt-1", []string{"cluster-fra"})
+	generator, err := NewClusterKubeconfig(set, func(name string) (compute.ClusterKubeconfig, error) {
+		return fakeClusterKubeconfig{region: name}, nil
+	})
+	require.NoError(t, err)
+
+	content, err := generator.CreateKubeconfig(context.Background(), "cluster-fra", compute.EndpointPublic)
+	require.NoError(t, err)
+	assert.Equal(t, "eu-frankfurt-1", string(content))
+}
@@ -0,0 +1,59 @@
+package region
+
+import (
+	"context"
+
+	"github.com/cnopslabs/ocloud/internal/domain/storage/objectstorage"
+)
+
+// bucketRepository lists buckets in every region. Bucket and object operations
+// are keyed by bucket name and go to the region the bucket was listed from.
+type bucketRepository struct {
+	objectstorage.ObjectStorageRepository
+	set   *Set
+	repos []objectstorage.ObjectStorageRepository
+}
+
+// NewObjectStorageRepository builds one repository per region of set. A nil set
+// builds a single repository for the configured region. Operations that are not
+// tied to a bucket use the first region.
+func NewObjectStorageRepository(set *Set, build func(region string) (objectstorage.ObjectStorageRepository, error)) (objectstorage.ObjectStorageRepository, error) {
+	if set == nil {
+		return build("")
+	}
+	repos, err := buildAll(set, build)
+	if err != nil {
+		return nil, err
+	}
+	return &bucketRepository{ObjectStorageRepository: repos[0], set: set, repos: repos}, nil
+}
+
+func (r *bucketRepository) ListBuckets(ctx context.Context, compartmentID string) ([]objectstorage.Bucket, error) {
+	return list(ctx, r.set, r.repos, func(ctx context.Context, repo objectstorage.ObjectStorageRepository) ([]objectstorage.Bucket, error) {
+		return repo.ListBuckets(ctx, compartmentID)
+	}, func(b objectstorage.Bucket) string { return b.Name })
+}
+
+func (r *bucketRepository) GetBucketByName(ctx context.Context, compartmentID, name string) (*objectstorage.Bucket, error) {
+	repo, err := pick(r.set, r.repos, name)
+	if err != nil {
+		return nil, err
+	}
+	return repo.GetBucketByName(ctx, compartmentID, name)
+}
+
+func (r *bucketRepository) ListObjects(ctx context.Context, namespace, bucketName string) ([]objectstorage.Object, error) {
+	repo, err := pick(r.set, r.repos, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	return repo.ListObjects(ctx, namespace, bucketName)
+}
+
+func (r *bucketRepository) GetObjectHead(ctx context.Context, namespace, bucketName, objectName string) (*objectstorage.Object, error) {
+	repo, err := pick(r.set, r.repos, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	return repo.GetObjectHead(ctx, namespace, bucketName, objectName)
+}
@@ -0,0 +1,27 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+
+	diskcache "github.com/cnopslabs/ocloud/internal/cache"
+	"github.com/cnopslabs/ocloud/internal/logger"
+)
+
+// ClearCache removes cached resource listings. When tenancy is empty the whole
+// cache is removed; otherwise only the entries of that tenancy are.
+func ClearCache(tenancy string) error {
+	dir, err := diskcache.DefaultDir()
+	if err != nil {
+		return fmt.Errorf("resolving cache directory: %w", err)
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "ClearCache", "dir", dir, "tenancy", tenancy)
+
+	removed, err := diskcache.Clear(dir, tenancy)
+	if err != nil {
+		return fmt.Errorf("clearing cache: %w", err)
+	}
+
+	PrintCleared(os.Stdout, removed, tenancy)
+	return nil
+}
@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	diskcache "github.com/cnopslabs/ocloud/internal/cache"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// PrintStatus displays cache entries in a table or in the requested structured format.
+func PrintStatus(out io.Writer, dir string, entries []diskcache.EntryInfo, format printer.OutputFormat) error {
+	p := printer.New(out)
+	if !format.IsTable() {
+		return util.MarshalDataResponse(p, format, entries, nil)
+	}
+
+	if len(entries) == 0 {
+		_, err := fmt.Fprintf(out, "No cached entries in %s\n", dir)
+		return err
+	}
+
+	headers := []string{"TENANCY", "COMPARTMENT", "RESOURCE", "ITEMS", "SIZE", "AGE", "STATUS"}
+	rows := make([][]string, 0, len(entries))
+	for _, e := range entries {
+		status := "fresh"
+		if e.Expired {
+			status = "expired"
+		}
+		rows = append(rows, []string{
+			e.Tenancy,
+			e.Compartment,
+			e.Resource,
+			strconv.Itoa(e.Items),
+			util.HumanizeBytesIEC(e.SizeBytes),
+			e.Age.String(),
+			status,
+		})
+	}
+
+	title := text.Colors{text.FgMagenta}.Sprint(fmt.Sprintf("Resource Cache: %s", dir))
+	p.PrintTableNoTruncate(title, headers, rows)
+	return nil
+}
+
+// PrintCleared reports how many cache entries were removed.
+func PrintCleared(out io.Writer, removed int, tenancy string) {
+	scope := "all tenancies"
+	if tenancy != "" {
+		scope = "tenancy " + tenancy
+	}
+	_, _ = fmt.Fprintf(out, "Removed %d cached entries for %s\n", removed, scope)
+}
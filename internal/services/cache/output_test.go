@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	diskcache "github.com/cnopslabs/ocloud/internal/cache"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEntries() []diskcache.EntryInfo {
+	return []diskcache.EntryInfo{
+		{Tenancy: "t1", Compartment: "comp", Resource: "instances", Items: 3, SizeBytes: 2048, Age: 90 * time.Second},
+		{Tenancy: "t1", Compartment: "comp", Resource: "vcns", Items: 1, SizeBytes: 100, Age: time.Hour, Expired: true},
+	}
+}
+
+func TestPrintStatus_Table(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, PrintStatus(&buf, "/tmp/cache", testEntries(), printer.TableOutput))
+
+	out := buf.String()
+	assert.Contains(t, out, "instances")
+	assert.Contains(t, out, "1m30s")
+	assert.Contains(t, out, "fresh")
+	assert.Contains(t, out, "expired")
+}
+
+func TestPrintStatus_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, PrintStatus(&buf, "/tmp/cache", nil, printer.TableOutput))
+	assert.Contains(t, buf.String(), "No cached entries in /tmp/cache")
+}
+
+func TestPrintStatus_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, PrintStatus(&buf, "/tmp/cache", testEntries(), printer.JSONOutput))
+
+	var resp struct {
+		Items []diskcache.EntryInfo `json:"items"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	require.Len(t, resp.Items, 2)
+	assert.Equal(t, "vcns", resp.Items[1].Resource)
+	assert.True(t, resp.Items[1].Expired)
+}
+
+func TestPrintCleared(t *testing.T) {
+	var buf bytes.Buffer
+	PrintCleared(&buf, 4, "")
+	assert.Equal(t, "Removed 4 cached entries for all tenancies\n", buf.String())
+
+	buf.Reset()
+	PrintCleared(&buf, 1, "t1")
+	assert.Equal(t, "Removed 1 cached entries for tenancy t1\n", buf.String())
+}
@@ -0,0 +1,31 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	diskcache "github.com/cnopslabs/ocloud/internal/cache"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
+)
+
+// ShowStatus lists the entries held in the on-disk resource cache.
+// Entries older than ttl are reported as expired.
+func ShowStatus(format printer.OutputFormat, ttl time.Duration) error {
+	dir, err := diskcache.DefaultDir()
+	if err != nil {
+		return fmt.Errorf("resolving cache directory: %w", err)
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "ShowStatus", "dir", dir, "ttl", ttl)
+
+	entries, err := diskcache.Status(dir, ttl)
+	if err != nil {
+		return fmt.Errorf("reading cache status: %w", err)
+	}
+
+	if err := PrintStatus(os.Stdout, dir, entries, format); err != nil {
+		return fmt.Errorf("printing cache status: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/oci"
+	ociImage "github.com/cnopslabs/ocloud/internal/oci/compute/image"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/services/compute/instance"
+)
+
+// ImageDetails is an image with the shapes it can be launched on and, when they
+// were looked up, the instances that currently run it. Instances is null in
+// JSON when they were not looked up and empty when none runs the image.
+type ImageDetails struct {
+	Image
+	CompatibleShapes []compute.ImageShapeCompatibility `json:"CompatibleShapes"`
+	Instances        []ImageInstance                   `json:"Instances"`
+}
+
+// ImageInstance is an instance launched from an image.
+type ImageInstance struct {
+	Name  string `json:"Name"`
+	ID    string `json:"ID"`
+	State string `json:"State"`
+}
+
+// instanceLister lists the instances of the compartment.
+type instanceLister interface {
+	ListInstances(ctx context.Context) ([]compute.Instance, error)
+}
+
+// newCatalogService builds a Service that can also look up platform images,
+// image shape compatibility and the instances of the compartment.
+func newCatalogService(appCtx *app.ApplicationContext) (*Service, error) {
+	service, err := NewServiceFromAppContext(appCtx)
+	if err != nil {
+		return nil, err
+	}
+	computeClient, err := oci.NewComputeClient(appCtx.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("creating compute client: %w", err)
+	}
+	service.catalog = ociImage.NewAdapter(computeClient)
+	service.instances, err = instance.NewServiceFromAppContext(appCtx)
+	if err != nil {
+		return nil, fmt.Errorf("creating instance service: %w", err)
+	}
+	return service, nil
+}
+
+// GetImage displays the image with the given name or OCID, the shapes it can
+// be launched on and the instances that run it.
+func GetImage(appCtx *app.ApplicationContext, nameOrID string, format printer.OutputFormat) error {
+	service, err := newCatalogService(appCtx)
+	if err != nil {
+		return fmt.Errorf("creating image service: %w", err)
+	}
+
+	details, err := service.GetImageDetails(context.Background(), nameOrID)
+	if err != nil {
+		return fmt.Errorf("getting image: %w", err)
+	}
+	return PrintImageDetails(details, appCtx, format)
+}
+
+// GetLatestImage displays the newest platform image of an operating system
+// version that can be launched on shape; an empty shape accepts any shape.
+func GetLatestImage(appCtx *app.ApplicationContext, operatingSystem, version, shape string, format printer.OutputFormat) error {
+	service, err := newCatalogService(appCtx)
+	if err != nil {
+		return fmt.Errorf("creating image service: %w", err)
+	}
+
+	details, err := service.LatestPlatformImage(context.Background(), operatingSystem, version, shape)
+	if err != nil {
+		return err
+	}
+	return PrintImageDetails(details, appCtx, format)
+}
+
+// GetImageDetails resolves nameOrID to an image and adds its compatible shapes
+// and the instances, other than terminated ones, launched from it.
+func (s *Service) GetImageDetails(ctx context.Context, nameOrID string) (*ImageDetails, error) {
+	img, err := s.ResolveImage(ctx, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	shapes, err := s.catalog.ListCompatibleShapes(ctx, img.OCID)
+	if err != nil {
+		return nil, fmt.Errorf("listing compatible shapes of %s: %w", img.DisplayName, err)
+	}
+	instances, err := s.instances.ListInstances(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing instances: %w", err)
+	}
+
+	details := &ImageDetails{Image: img, CompatibleShapes: shapes, Instances: []ImageInstance{}}
+	for _, inst := range instances {
+		if inst.ImageID == img.OCID && !strings.EqualFold(inst.State, "TERMINATED") {
+			details.Instances = append(details.Instances, ImageInstance{Name: inst.DisplayName, ID: inst.OCID, State: inst.State})
+		}
+	}
+	sort.Slice(details.Instances, func(i, j int) bool { return details.Instances[i].Name < details.Instances[j].Name })
+	return details, nil
+}
+
+// LatestPlatformImage returns the newest available platform image of an
+// operating system version that lists shape among its compatible shapes,
+// reporting just that shape. With an empty shape the newest image is returned
+// with all its shapes.
+func (s *Service) LatestPlatformImage(ctx context.Context, operatingSystem, version, shape string) (*ImageDetails, error) {
+	logger.LogWithLevel(s.logger, logger.Debug, "resolving latest platform image", "os", operatingSystem, "version", version, "shape", shape)
+	candidates, err := s.catalog.ListPlatformImages(ctx, s.compartmentID, operatingSystem, version)
+	if err != nil {
+		return nil, fmt.Errorf("listing platform images: %w", err)
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no platform image found for %s %s", operatingSystem, version)
+	}
+
+	for _, img := range candidates {
+		shapes, err := s.catalog.ListCompatibleShapes(ctx, img.OCID)
+		if err != nil {
+			return nil, fmt.Errorf("listing compatible shapes of %s: %w", img.DisplayName, err)
+		}
+		if shape == "" {
+			return &ImageDetails{Image: img, CompatibleShapes: shapes}, nil
+		}
+		for _, c := range shapes {
+			if strings.EqualFold(c.Shape, shape) {
+				return &ImageDetails{Image: img, CompatibleShapes: []compute.ImageShapeCompatibility{c}}, nil
+			}
+		}
+		logger.LogWithLevel(s.logger, logger.Debug, "platform image not compatible with shape", "image", img.DisplayName, "shape", shape)
+	}
+	return nil, fmt.Errorf("none of the %d %s %s platform images is compatible with %s", len(candidates), operatingSystem, version, shape)
+}
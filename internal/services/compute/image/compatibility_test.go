@@ -0,0 +1,132 @@
+package image
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/printer"
+)
+
+// fakeCatalog serves platform images and the compatible shapes of each image.
+type fakeCatalog struct {
+	platform []compute.Image
+	shapes   map[string][]compute.ImageShapeCompatibility
+}
+
+func (f *fakeCatalog) ListPlatformImages(ctx context.Context, compartmentID, operatingSystem, version string) ([]compute.Image, error) {
+	return f.platform, nil
+}
+
+func (f *fakeCatalog) ListCompatibleShapes(ctx context.Context, imageID string) ([]compute.ImageShapeCompatibility, error) {
+	return f.shapes[imageID], nil
+}
+
+type fakeInstanceLister []compute.Instance
+
+func (f fakeInstanceLister) ListInstances(ctx context.Context) ([]compute.Instance, error) {
+	return f, nil
+}
+
+func TestGetImageDetails(t *testing.T) {
+	repo := &mockImageRepository{images: []compute.Image{{OCID: "ocid1.image.oc1..a", DisplayName: "golden-web"}}}
+	service := NewService(repo, logr.Discard(), "c1")
+	service.catalog = &fakeCatalog{shapes: map[string][]compute.ImageShapeCompatibility{
+		"ocid1.image.oc1..a": {{Shape: "VM.Standard.E5.Flex", MinOCPUs: 1, MaxOCPUs: 94}},
+	}}
+	service.instances = fakeInstanceLister{
+		{DisplayName: "web-2", OCID: "i2", ImageID: "ocid1.image.oc1..a", State: "STOPPED"},
+		{DisplayName: "web-1", OCID: "i1", ImageID: "ocid1.image.oc1..a", State: "RUNNING"},
+		{DisplayName: "web-0", OCID: "i0", ImageID: "ocid1.image.oc1..a", State: "TERMINATED"},
+		{DisplayName: "db-1", OCID: "i3", ImageID: "ocid1.image.oc1..b", State: "RUNNING"},
+	}
+
+	details, err := service.GetImageDetails(context.Background(), "golden-web")
+	require.NoError(t, err)
+	assert.Equal(t, "ocid1.image.oc1..a", details.OCID)
+	assert.Len(t, details.CompatibleShapes, 1)
+	assert.Equal(t, []ImageInstance{
+		{Name: "web-1", ID: "i1", State: "RUNNING"},
+		{Name: "web-2", ID: "i2", State: "STOPPED"},
+	}, details.Instances)
+}
+
+func TestLatestPlatformImage(t *testing.T) {
+	catalog := &fakeCatalog{
+		platform: []compute.Image{
+			{OCID: "new", DisplayName: "Oracle-Linux-9.6-aarch64-2026.09.30-0"},
+			{OCID: "old", DisplayName: "Oracle-Linux-9.6-2026.09.15-0"},
+		},
+		shapes: map[string][]compute.ImageShapeCompatibility{
+			"new": {{Shape: "VM.Standard.A1.Flex"}},
+			"old": {{Shape: "VM.Standard.E4.Flex"}, {Shape: "VM.Standard.E5.Flex", MinOCPUs: 1, MaxOCPUs: 94}},
+		},
+	}
+	service := NewService(&mockImageRepository{}, logr.Discard(), "c1")
+	service.catalog = catalog
+
+	details, err := service.LatestPlatformImage(context.Background(), "Oracle Linux", "9", "vm.standard.e5.flex")
+	require.NoError(t, err)
+	assert.Equal(t, "old", details.OCID, "the newest image is skipped when it does not support the shape")
+	assert.Equal(t, []compute.ImageShapeCompatibility{{Shape: "VM.Standard.E5.Flex", MinOCPUs: 1, MaxOCPUs: 94}}, details.CompatibleShapes)
+	assert.Nil(t, details.Instances)
+
+	details, err = service.LatestPlatformImage(context.Background(), "Oracle Linux", "9", "")
+	require.NoError(t, err)
+	assert.Equal(t, "new", details.OCID)
+
+	_, err = service.LatestPlatformImage(context.Background(), "Oracle Linux", "9", "BM.GPU.H100.8")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "none of the 2 Oracle Linux 9 platform images is compatible with BM.GPU.H100.8")
+
+	catalog.platform = nil
+	_, err = service.LatestPlatformImage(context.Background(), "Oracle Linux", "7", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no platform image found for Oracle Linux 7")
+}
+
+func TestPrintImageDetails(t *testing.T) {
+	details := &ImageDetails{
+		Image:            Image{OCID: "ocid1.image.oc1..a", DisplayName: "golden-web"},
+		CompatibleShapes: []compute.ImageShapeCompatibility{{Shape: "VM.Standard.E5.Flex", MinOCPUs: 1, MaxOCPUs: 94, MinMemoryGB: 1, MaxMemoryGB: 1049}},
+		Instances:        []ImageInstance{},
+	}
+
+	var buf bytes.Buffer
+	appCtx := &app.ApplicationContext{Stdout: &buf}
+	require.NoError(t, PrintImageDetails(details, appCtx, printer.TableOutput))
+	assert.Contains(t, buf.String(), "VM.Standard.E5.Flex")
+	assert.Contains(t, buf.String(), "1-1049 GB")
+	assert.Contains(t, buf.String(), "No instances run this image.")
+
+	buf.Reset()
+	require.NoError(t, PrintImageDetails(details, appCtx, printer.JSONOutput))
+	assert.Contains(t, buf.String(), `"Instances": []`, "no instances is told apart from not looked up")
+
+	buf.Reset()
+	details.Instances = nil
+	require.NoError(t, PrintImageDetails(details, appCtx, printer.TableOutput))
+	assert.NotContains(t, buf.String(), "No instances run this image.")
+
+	buf.Reset()
+	require.NoError(t, PrintImageDetails(details, appCtx, printer.JSONOutput))
+	var decoded ImageDetails
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, "golden-web", decoded.DisplayName)
+	assert.Equal(t, details.CompatibleShapes, decoded.CompatibleShapes)
+	assert.Contains(t, buf.String(), `"Instances": null`)
+}
+
+func TestFormatRange(t *testing.T) {
+	assert.Equal(t, "-", formatRange(0, 0, ""))
+	assert.Equal(t, "1-94", formatRange(1, 94, ""))
+	assert.Equal(t, "≥ 2 GB", formatRange(2, 0, " GB"))
+	assert.Equal(t, "≤ 16 GB", formatRange(0, 16, " GB"))
+}
@@ -5,21 +5,17 @@ import (
 	"fmt"
 
 	"github.com/cnopslabs/ocloud/internal/app"
-	"github.com/cnopslabs/ocloud/internal/oci"
-	ociImage "github.com/cnopslabs/ocloud/internal/oci/compute/image"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/cnopslabs/ocloud/internal/services/util"
 )
 
 // GetImages retrieves and displays a paginated list of images.
-func GetImages(appCtx *app.ApplicationContext, limit int, page int, useJSON bool) error {
-	computeClient, err := oci.NewComputeClient(appCtx.Provider)
+func GetImages(appCtx *app.ApplicationContext, limit int, page int, format printer.OutputFormat) error {
+	service, err := NewServiceFromAppContext(appCtx)
 	if err != nil {
-		return fmt.Errorf("creating compute client: %w", err)
+		return fmt.Errorf("creating image service: %w", err)
 	}
 
-	imageAdapter := ociImage.NewAdapter(computeClient)
-	service := NewService(imageAdapter, appCtx.Logger, appCtx.CompartmentID)
-
 	images, totalCount, nextPageToken, err := service.FetchPaginatedImages(context.Background(), limit, page)
 	if err != nil {
 		return fmt.Errorf("listing images: %w", err)
@@ -30,5 +26,5 @@ func GetImages(appCtx *app.ApplicationContext, limit int, page int, useJSON bool
 		TotalCount:    totalCount,
 		Limit:         limit,
 		NextPageToken: nextPageToken,
-	}, useJSON)
+	}, format)
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -29,7 +30,7 @@ func TestGetImagesSimple(t *testing.T) {
 		Stdout:          io.Discard, // Discard output to avoid cluttering the test output
 	}
 
-	err := GetImages(appCtx, 20, 1, false)
+	err := GetImages(appCtx, 20, 1, printer.TableOutput)
 
 	assert.NoError(t, err)
 }
@@ -0,0 +1,368 @@
+package image
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/oci"
+	ociImage "github.com/cnopslabs/ocloud/internal/oci/compute/image"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/services/compute/instance"
+	"github.com/cnopslabs/ocloud/internal/services/storage/objectstorage"
+	"github.com/cnopslabs/ocloud/internal/tui"
+)
+
+const (
+	// workRequestPollInterval is how often a work request is checked while waiting.
+	workRequestPollInterval = 10 * time.Second
+	// workRequestWaitTimeout bounds the wait for a work request; exports and
+	// imports of large images take well over an hour.
+	workRequestWaitTimeout = 3 * time.Hour
+)
+
+// Export formats accepted by ExportImage; OCI keeps the image metadata so
+// that the image can be imported again without options.
+const (
+	FormatOCI   = "OCI"
+	FormatQCOW2 = "QCOW2"
+	FormatVMDK  = "VMDK"
+	FormatVHD   = "VHD"
+	FormatVDI   = "VDI"
+)
+
+// ExportFormats lists the formats an image can be exported to.
+var ExportFormats = []string{FormatOCI, FormatQCOW2, FormatVMDK, FormatVHD, FormatVDI}
+
+// ImportFormats lists the formats an image can be imported from.
+var ImportFormats = []string{FormatOCI, FormatQCOW2, FormatVMDK}
+
+// LifecycleOptions controls the image create, export and import commands.
+type LifecycleOptions struct {
+	// Name is the display name of a created or imported image.
+	Name string
+	// Format is the export format, or the import format when it cannot be
+	// told from the object name.
+	Format                 string
+	OperatingSystem        string
+	OperatingSystemVersion string
+	// NoWait returns as soon as OCI accepted the request.
+	NoWait bool
+}
+
+// LifecycleResult reports the outcome of an image create, export or import.
+type LifecycleResult struct {
+	Operation     string `json:"Operation"`
+	ImageName     string `json:"ImageName"`
+	ImageID       string `json:"ImageID"`
+	Object        string `json:"Object,omitempty"`
+	WorkRequestID string `json:"WorkRequestID"`
+	Status        string `json:"Status"`
+}
+
+// namespaceResolver resolves the Object Storage namespace of the tenancy.
+type namespaceResolver interface {
+	GetNamespace(ctx context.Context) (string, error)
+}
+
+// newLifecycleService builds a Service that can also create, export and import
+// images and resolve the Object Storage namespace.
+func newLifecycleService(appCtx *app.ApplicationContext) (*Service, error) {
+	service, err := NewServiceFromAppContext(appCtx)
+	if err != nil {
+		return nil, err
+	}
+	computeClient, err := oci.NewComputeClient(appCtx.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("creating compute client: %w", err)
+	}
+	workRequestClient, err := oci.NewWorkRequestClient(appCtx.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("creating work request client: %w", err)
+	}
+	service.controller = ociImage.NewAdapter(computeClient).WithWorkRequests(workRequestClient)
+	service.namespaces, err = objectstorage.NewServiceFromAppContext(appCtx)
+	if err != nil {
+		return nil, fmt.Errorf("creating object storage service: %w", err)
+	}
+	return service, nil
+}
+
+// CreateImage captures a custom image of the boot volume of the instance given
+// by exact name or OCID and, unless opts.NoWait is set, waits until it is available.
+func CreateImage(ctx context.Context, appCtx *app.ApplicationContext, instanceTarget string, opts LifecycleOptions, format printer.OutputFormat) error {
+	service, err := newLifecycleService(appCtx)
+	if err != nil {
+		return fmt.Errorf("creating image service: %w", err)
+	}
+	instances, err := instance.NewServiceFromAppContext(appCtx)
+	if err != nil {
+		return fmt.Errorf("creating instance service: %w", err)
+	}
+	inst, err := instances.ResolveInstance(ctx, instanceTarget)
+	if err != nil {
+		return err
+	}
+
+	logger.LogWithLevel(service.logger, logger.Debug, "creating image", "instance", inst.OCID, "name", opts.Name)
+	img, workRequestID, err := service.controller.CreateImageFromInstance(ctx, service.compartmentID, inst.OCID, opts.Name)
+	if err != nil {
+		return fmt.Errorf("creating image from %s: %w", inst.DisplayName, err)
+	}
+	result := LifecycleResult{Operation: "create", ImageName: img.DisplayName, ImageID: img.OCID, WorkRequestID: workRequestID, Status: "ACCEPTED"}
+	return service.finish(ctx, appCtx, result, opts.NoWait, format)
+}
+
+// ExportImage exports the image given by name or OCID to bucket in the
+// tenancy namespace and, unless opts.NoWait is set, waits until the export
+// completed. object defaults to the image name with the format as extension.
+func ExportImage(ctx context.Context, appCtx *app.ApplicationContext, imageTarget, bucket, object string, opts LifecycleOptions, format printer.OutputFormat) error {
+	exportFormat, err := normalizeFormat(opts.Format, ExportFormats)
+	if err != nil {
+		return err
+	}
+	service, err := newLifecycleService(appCtx)
+	if err != nil {
+		return fmt.Errorf("creating image service: %w", err)
+	}
+	img, err := service.ResolveImage(ctx, imageTarget)
+	if err != nil {
+		return err
+	}
+	namespace, err := service.namespaces.GetNamespace(ctx)
+	if err != nil {
+		return fmt.Errorf("getting object storage namespace: %w", err)
+	}
+	if object == "" {
+		object = DefaultExportObject(img.DisplayName, exportFormat)
+	}
+	dest := compute.ObjectLocation{Namespace: namespace, Bucket: bucket, Object: object}
+
+	logger.LogWithLevel(service.logger, logger.Debug, "exporting image", "id", img.OCID, "bucket", bucket, "object", object, "format", exportFormat)
+	workRequestID, err := service.controller.ExportImage(ctx, img.OCID, dest, exportFormat)
+	if err != nil {
+		return fmt.Errorf("exporting image %s: %w", img.DisplayName, err)
+	}
+	result := LifecycleResult{Operation: "export", ImageName: img.DisplayName, ImageID: img.OCID, Object: bucket + "/" + object, WorkRequestID: workRequestID, Status: "ACCEPTED"}
+	return service.finish(ctx, appCtx, result, opts.NoWait, format)
+}
+
+// ImportImage imports a custom image from object in bucket of the tenancy
+// namespace and, unless opts.NoWait is set, waits until it is available. The
+// format is taken from opts.Format or else from the object extension, and the
+// name defaults to the object name without its extension.
+func ImportImage(ctx context.Context, appCtx *app.ApplicationContext, bucket, object string, opts LifecycleOptions, format printer.OutputFormat) error {
+	importFormat := opts.Format
+	if importFormat == "" {
+		importFormat = FormatFromObject(object)
+	}
+	importFormat, err := normalizeFormat(importFormat, ImportFormats)
+	if err != nil {
+		return err
+	}
+	service, err := newLifecycleService(appCtx)
+	if err != nil {
+		return fmt.Errorf("creating image service: %w", err)
+	}
+	namespace, err := service.namespaces.GetNamespace(ctx)
+	if err != nil {
+		return fmt.Errorf("getting object storage namespace: %w", err)
+	}
+
+	req := compute.ImageImport{
+		CompartmentID:          service.compartmentID,
+		DisplayName:            opts.Name,
+		Source:                 compute.ObjectLocation{Namespace: namespace, Bucket: bucket, Object: object},
+		OperatingSystem:        opts.OperatingSystem,
+		OperatingSystemVersion: opts.OperatingSystemVersion,
+	}
+	if req.DisplayName == "" {
+		req.DisplayName = strings.TrimSuffix(path.Base(object), path.Ext(object))
+	}
+	if importFormat != FormatOCI {
+		req.SourceImageType = importFormat
+	}
+
+	logger.LogWithLevel(service.logger, logger.Debug, "importing image", "bucket", bucket, "object", object, "format", importFormat)
+	img, workRequestID, err := service.controller.ImportImage(ctx, req)
+	if err != nil {
+		return fmt.Errorf("importing image from %s/%s: %w", bucket, object, err)
+	}
+	result := LifecycleResult{Operation: "import", ImageName: img.DisplayName, ImageID: img.OCID, Object: bucket + "/" + object, WorkRequestID: workRequestID, Status: "ACCEPTED"}
+	return service.finish(ctx, appCtx, result, opts.NoWait, format)
+}
+
+// finish waits for the work request of result unless noWait is set and prints result.
+func (s *Service) finish(ctx context.Context, appCtx *app.ApplicationContext, result LifecycleResult, noWait bool, format printer.OutputFormat) error {
+	var waitErr error
+	if !noWait && result.WorkRequestID != "" {
+		var wr *compute.WorkRequest
+		wr, waitErr = s.waitWithProgress(ctx, result)
+		if wr != nil {
+			result.Status = wr.Status
+		}
+	}
+	if err := PrintLifecycleResult(appCtx, result, format); err != nil {
+		return fmt.Errorf("printing image %s result: %w", result.Operation, err)
+	}
+	return waitErr
+}
+
+// ResolveImage maps target to one image. OCIDs are looked up directly; names go
+// through the fuzzy search, where an exact (case-insensitive) name match wins
+// and otherwise the search must return a single image.
+func (s *Service) ResolveImage(ctx context.Context, target string) (Image, error) {
+	if strings.HasPrefix(target, "ocid1.image.") {
+		img, err := s.imageRepo.GetImage(ctx, target)
+		if err != nil {
+			return Image{}, fmt.Errorf("getting image %s: %w", target, err)
+		}
+		return *img, nil
+	}
+
+	matches, err := s.FuzzySearch(ctx, target)
+	if err != nil {
+		return Image{}, fmt.Errorf("finding image %q: %w", target, err)
+	}
+	var exact []Image
+	for _, m := range matches {
+		if strings.EqualFold(m.DisplayName, target) {
+			exact = append(exact, m)
+		}
+	}
+	if len(exact) > 0 {
+		matches = exact
+	}
+
+	switch len(matches) {
+	case 0:
+		return Image{}, fmt.Errorf("no image matches %q", target)
+	case 1:
+		return matches[0], nil
+	default:
+		names := make([]string, 0, len(matches))
+		for _, m := range matches {
+			names = append(names, m.DisplayName)
+		}
+		return Image{}, fmt.Errorf("%q matches %d images (%s); use an exact name or the OCID", target, len(matches), strings.Join(names, ", "))
+	}
+}
+
+// WaitForWorkRequest polls the work request every interval until it succeeded,
+// failed or was canceled, calling progress after every poll. A failed or
+// canceled work request is returned with an error.
+func (s *Service) WaitForWorkRequest(ctx context.Context, workRequestID string, interval time.Duration, progress func(*compute.WorkRequest)) (*compute.WorkRequest, error) {
+	for {
+		wr, err := s.controller.GetWorkRequest(ctx, workRequestID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, err
+		}
+		if progress != nil {
+			progress(wr)
+		}
+		switch wr.Status {
+		case "SUCCEEDED":
+			return wr, nil
+		case "FAILED", "CANCELED":
+			if len(wr.Errors) > 0 {
+				return wr, fmt.Errorf("work request %s: %s", strings.ToLower(wr.Status), strings.Join(wr.Errors, "; "))
+			}
+			return wr, fmt.Errorf("work request %s", strings.ToLower(wr.Status))
+		}
+
+		select {
+		case <-ctx.Done():
+			return wr, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// waitWithProgress runs WaitForWorkRequest behind a progress bar. Quitting the
+// progress display stops the wait without failing, as the work request goes on.
+func (s *Service) waitWithProgress(ctx context.Context, result LifecycleResult) (*compute.WorkRequest, error) {
+	ctx, cancel := context.WithTimeout(ctx, workRequestWaitTimeout)
+	defer cancel()
+
+	runner := tui.NewProgressRunner(fmt.Sprintf("Waiting for image %s of %s", result.Operation, result.ImageName))
+	runner.Start()
+
+	type outcome struct {
+		wr  *compute.WorkRequest
+		err error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		wr, err := s.WaitForWorkRequest(ctx, result.WorkRequestID, workRequestPollInterval, func(wr *compute.WorkRequest) {
+			runner.UpdateProgress(float64(wr.PercentComplete)/100, fmt.Sprintf("%.0f%%", wr.PercentComplete), "", wr.Status)
+		})
+		switch {
+		case err == nil:
+			runner.SendDone()
+		case !errors.Is(err, context.Canceled):
+			runner.SendError(err)
+		}
+		done <- outcome{wr, err}
+	}()
+
+	runErr := runner.Run()
+	cancel()
+	res := <-done
+	switch {
+	case errors.Is(res.err, context.DeadlineExceeded):
+		return res.wr, fmt.Errorf("image %s did not complete within %s; follow work request %s", result.Operation, workRequestWaitTimeout, result.WorkRequestID)
+	case errors.Is(res.err, context.Canceled):
+		return res.wr, nil
+	case res.err != nil:
+		return res.wr, fmt.Errorf("image %s of %s: %w", result.Operation, result.ImageName, res.err)
+	}
+	return res.wr, runErr
+}
+
+// DefaultExportObject returns the object name an image is exported to when
+// none is given: its name with the lower-cased format as extension.
+func DefaultExportObject(imageName, format string) string {
+	name := strings.Map(func(r rune) rune {
+		if r == '/' || r == ' ' {
+			return '-'
+		}
+		return r
+	}, imageName)
+	return name + "." + strings.ToLower(format)
+}
+
+// FormatFromObject guesses the image format from an object name extension,
+// returning "" when the extension is not a known format.
+func FormatFromObject(object string) string {
+	ext := strings.ToUpper(strings.TrimPrefix(path.Ext(object), "."))
+	for _, f := range ImportFormats {
+		if ext == f {
+			return f
+		}
+	}
+	return ""
+}
+
+// normalizeFormat upper-cases format and checks it against allowed; an empty
+// format selects the first allowed one.
+func normalizeFormat(format string, allowed []string) (string, error) {
+	if format == "" {
+		return allowed[0], nil
+	}
+	format = strings.ToUpper(format)
+	for _, f := range allowed {
+		if format == f {
+			return format, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported image format %q (want one of %s)", format, strings.Join(allowed, ", "))
+}
@@ -0,0 +1,116 @@
+package image
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/printer"
+)
+
+// fakeController replays a sequence of work request states, one per GetWorkRequest call.
+type fakeController struct {
+	compute.ImageController
+	states []compute.WorkRequest
+	polls  int
+}
+
+func (f *fakeController) GetWorkRequest(ctx context.Context, id string) (*compute.WorkRequest, error) {
+	wr := f.states[0]
+	if len(f.states) > 1 {
+		f.states = f.states[1:]
+	}
+	f.polls++
+	wr.ID = id
+	return &wr, nil
+}
+
+func TestWaitForWorkRequest(t *testing.T) {
+	ctrl := &fakeController{states: []compute.WorkRequest{
+		{Status: "ACCEPTED"},
+		{Status: "IN_PROGRESS", PercentComplete: 50},
+		{Status: "SUCCEEDED", PercentComplete: 100},
+	}}
+	service := NewService(&mockImageRepository{}, logr.Discard(), "c1")
+	service.controller = ctrl
+
+	var seen []float32
+	wr, err := service.WaitForWorkRequest(context.Background(), "wr1", time.Millisecond, func(wr *compute.WorkRequest) {
+		seen = append(seen, wr.PercentComplete)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "SUCCEEDED", wr.Status)
+	assert.Equal(t, []float32{0, 50, 100}, seen)
+	assert.Equal(t, 3, ctrl.polls)
+}
+
+func TestWaitForWorkRequest_Failed(t *testing.T) {
+	service := NewService(&mockImageRepository{}, logr.Discard(), "c1")
+	service.controller = &fakeController{states: []compute.WorkRequest{
+		{Status: "FAILED", Errors: []string{"bucket golden not found"}},
+	}}
+
+	wr, err := service.WaitForWorkRequest(context.Background(), "wr1", time.Millisecond, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "work request failed: bucket golden not found")
+	assert.Equal(t, "FAILED", wr.Status)
+}
+
+func TestResolveImage(t *testing.T) {
+	repo := &mockImageRepository{images: []compute.Image{
+		{OCID: "ocid1.image.oc1..a", DisplayName: "golden-web"},
+		{OCID: "ocid1.image.oc1..b", DisplayName: "golden-web-old"},
+		{OCID: "ocid1.image.oc1..c", DisplayName: "golden-db"},
+	}}
+	service := NewService(repo, logr.Discard(), "c1")
+
+	img, err := service.ResolveImage(context.Background(), "GOLDEN-WEB")
+	require.NoError(t, err)
+	assert.Equal(t, "ocid1.image.oc1..a", img.OCID, "an exact name wins over partial matches")
+
+	img, err = service.ResolveImage(context.Background(), "ocid1.image.oc1..c")
+	require.NoError(t, err)
+	assert.Equal(t, "golden-db", img.DisplayName)
+
+	_, err = service.ResolveImage(context.Background(), "golden")
+	assert.ErrorContains(t, err, "matches 3 images")
+}
+
+func TestFormats(t *testing.T) {
+	assert.Equal(t, "golden-web-2026.oci", DefaultExportObject("golden web/2026", FormatOCI))
+	assert.Equal(t, "QCOW2", FormatFromObject("exports/ubuntu.qcow2"))
+	assert.Equal(t, "", FormatFromObject("exports/ubuntu.img"))
+
+	f, err := normalizeFormat("vmdk", ExportFormats)
+	require.NoError(t, err)
+	assert.Equal(t, FormatVMDK, f)
+	f, err = normalizeFormat("", ImportFormats)
+	require.NoError(t, err)
+	assert.Equal(t, FormatOCI, f)
+	_, err = normalizeFormat("vhd", ImportFormats)
+	assert.ErrorContains(t, err, "unsupported image format")
+}
+
+func TestPrintLifecycleResult(t *testing.T) {
+	result := LifecycleResult{Operation: "export", ImageName: "golden-web", ImageID: "ocid1.image.oc1..a", Object: "images/golden-web.oci", WorkRequestID: "wr1", Status: "SUCCEEDED"}
+
+	var buf bytes.Buffer
+	appCtx := &app.ApplicationContext{Stdout: &buf}
+	require.NoError(t, PrintLifecycleResult(appCtx, result, printer.TableOutput))
+	assert.Contains(t, buf.String(), "images/golden-web.oci")
+	assert.Contains(t, buf.String(), "SUCCEEDED")
+
+	buf.Reset()
+	require.NoError(t, PrintLifecycleResult(appCtx, result, printer.JSONOutput))
+	var decoded LifecycleResult
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, result, decoded)
+}
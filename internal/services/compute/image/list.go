@@ -6,28 +6,26 @@ import (
 	"fmt"
 
 	"github.com/cnopslabs/ocloud/internal/app"
-	"github.com/cnopslabs/ocloud/internal/oci"
 	ociImage "github.com/cnopslabs/ocloud/internal/oci/compute/image"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/cnopslabs/ocloud/internal/tui"
 )
 
 // ListImages lists all images in the given compartment, allowing the user to select one via a TUI and display its details.
-func ListImages(ctx context.Context, appCtx *app.ApplicationContext, useJSON bool) error {
-	computeClient, err := oci.NewComputeClient(appCtx.Provider)
+func ListImages(ctx context.Context, appCtx *app.ApplicationContext, format printer.OutputFormat) error {
+	service, err := NewServiceFromAppContext(appCtx)
 	if err != nil {
-		return fmt.Errorf("creating compute client: %w", err)
+		return fmt.Errorf("creating image service: %w", err)
 	}
 
-	imageAdapter := ociImage.NewAdapter(computeClient)
-	service := NewService(imageAdapter, appCtx.Logger, appCtx.CompartmentID)
-
 	images, err := service.imageRepo.ListImages(ctx, appCtx.CompartmentID)
 	if err != nil {
 		return fmt.Errorf("listing images: %w", err)
 	}
 
 	// TUI
-	model := ociImage.NewImageListModel(images)
+	model := ociImage.NewImageListModel(images).
+		WithQueryFilter(ToSearchableImages(images), GetSearchableFields(), GetBoostedFields())
 	id, err := tui.Run(model)
 	if err != nil {
 		if errors.Is(err, tui.ErrCancelled) {
@@ -41,5 +39,5 @@ func ListImages(ctx context.Context, appCtx *app.ApplicationContext, useJSON boo
 		return fmt.Errorf("getting image: %w", err)
 	}
 
-	return PrintImageInfo(image, appCtx, useJSON)
+	return PrintImageInfo(image, appCtx, format)
 }
@@ -1,21 +1,23 @@
 package image
 
 import (
+	"fmt"
+
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/cnopslabs/ocloud/internal/services/util"
 )
 
 // PrintImagesInfo displays instances in a formatted table or JSON format.
-func PrintImagesInfo(images []Image, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, useJSON bool) error {
+func PrintImagesInfo(images []Image, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, format printer.OutputFormat) error {
 	p := printer.New(appCtx.Stdout)
 
 	if pagination != nil {
 		util.AdjustPaginationInfo(pagination)
 	}
 
-	if useJSON {
-		return util.MarshalDataToJSONResponse[Image](p, images, pagination)
+	if !format.IsTable() {
+		return util.MarshalScopedResponse(p, format, appCtx, images, func(i Image) string { return i.OCID }, pagination)
 	}
 
 	if util.ValidateAndReportEmpty(images, pagination, appCtx.Stdout) {
@@ -36,7 +38,7 @@ func PrintImagesInfo(images []Image, appCtx *app.ApplicationContext, pagination
 			"Name", "Created", "OperatingSystem", "OSVersion", "LaunchMode",
 		}
 
-		title := util.FormatColoredTitle(appCtx, image.DisplayName)
+		title := util.FormatColoredResourceTitle(appCtx, image.OCID, image.DisplayName)
 
 		p.PrintKeyValues(title, imageData, orderedKeys)
 	}
@@ -46,11 +48,11 @@ func PrintImagesInfo(images []Image, appCtx *app.ApplicationContext, pagination
 }
 
 // PrintImageInfo prints a detailed view of an image.
-func PrintImageInfo(image *Image, appCtx *app.ApplicationContext, useJSON bool) error {
+func PrintImageInfo(image *Image, appCtx *app.ApplicationContext, format printer.OutputFormat) error {
 	p := printer.New(appCtx.Stdout)
 
-	if useJSON {
-		return p.MarshalToJSON(image)
+	if !format.IsTable() {
+		return p.Marshal(format, image)
 	}
 
 	imageData := map[string]string{
@@ -66,9 +68,82 @@ func PrintImageInfo(image *Image, appCtx *app.ApplicationContext, useJSON bool)
 		"OCID", "Name", "Created", "OperatingSystem", "OSVersion", "LaunchMode",
 	}
 
-	title := util.FormatColoredTitle(appCtx, image.DisplayName)
+	title := util.FormatColoredResourceTitle(appCtx, image.OCID, image.DisplayName)
 
 	p.PrintKeyValues(title, imageData, orderedKeys)
 
 	return nil
 }
+
+// PrintLifecycleResult displays the outcome of an image create, export or import.
+func PrintLifecycleResult(appCtx *app.ApplicationContext, result LifecycleResult, format printer.OutputFormat) error {
+	p := printer.New(appCtx.Stdout)
+
+	if !format.IsTable() {
+		return p.Marshal(format, result)
+	}
+
+	data := map[string]string{
+		"Operation":    result.Operation,
+		"Image":        result.ImageName,
+		"Image OCID":   result.ImageID,
+		"Object":       result.Object,
+		"Work Request": result.WorkRequestID,
+		"Status":       result.Status,
+	}
+	keys := []string{"Operation", "Image", "Image OCID"}
+	if result.Object != "" {
+		keys = append(keys, "Object")
+	}
+	keys = append(keys, "Work Request", "Status")
+
+	p.PrintKeyValues(util.FormatColoredTitle(appCtx, "Image "+result.Operation), data, keys)
+	return nil
+}
+
+// PrintImageDetails displays an image with its compatible shapes and the
+// instances that run it in a table or the requested structured format.
+func PrintImageDetails(details *ImageDetails, appCtx *app.ApplicationContext, format printer.OutputFormat) error {
+	p := printer.New(appCtx.Stdout)
+
+	if !format.IsTable() {
+		return p.Marshal(format, details)
+	}
+
+	if err := PrintImageInfo(&details.Image, appCtx, format); err != nil {
+		return err
+	}
+
+	shapeRows := make([][]string, 0, len(details.CompatibleShapes))
+	for _, c := range details.CompatibleShapes {
+		shapeRows = append(shapeRows, []string{c.Shape, formatRange(c.MinOCPUs, c.MaxOCPUs, ""), formatRange(c.MinMemoryGB, c.MaxMemoryGB, " GB")})
+	}
+	p.PrintTableNoTruncate(util.FormatColoredTitle(appCtx, fmt.Sprintf("Compatible Shapes (%d)", len(shapeRows))), []string{"SHAPE", "OCPUS", "MEMORY"}, shapeRows)
+
+	if details.Instances == nil {
+		return nil
+	}
+	if len(details.Instances) == 0 {
+		_, err := fmt.Fprintln(appCtx.Stdout, "No instances run this image.")
+		return err
+	}
+	instanceRows := make([][]string, 0, len(details.Instances))
+	for _, inst := range details.Instances {
+		instanceRows = append(instanceRows, []string{inst.Name, inst.State, inst.ID})
+	}
+	p.PrintTableNoTruncate(util.FormatColoredTitle(appCtx, fmt.Sprintf("Instances (%d)", len(instanceRows))), []string{"NAME", "STATE", "OCID"}, instanceRows)
+	return nil
+}
+
+// formatRange renders the limits of a flexible shape, "-" when there are none.
+func formatRange(minVal, maxVal int, unit string) string {
+	switch {
+	case minVal == 0 && maxVal == 0:
+		return "-"
+	case maxVal == 0:
+		return fmt.Sprintf("≥ %d%s", minVal, unit)
+	case minVal == 0:
+		return fmt.Sprintf("≤ %d%s", maxVal, unit)
+	}
+	return fmt.Sprintf("%d-%d%s", minVal, maxVal, unit)
+}
@@ -6,29 +6,26 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
-	"github.com/cnopslabs/ocloud/internal/oci"
-	ociImage "github.com/cnopslabs/ocloud/internal/oci/compute/image"
+	"github.com/cnopslabs/ocloud/internal/printer"
 )
 
 // SearchImages performs a search for images based on a given search term.
 // It uses fuzzy matching to find relevant images in the specified OCI compartment.
-// The results are printed in either a tabular or JSON format depending on the `useJSON` flag.
+// The results are printed in either a tabular or JSON format depending on the requested output format.
 // An error is returned if there are issues with creating required clients, searching images, or printing results.
-func SearchImages(appCtx *app.ApplicationContext, search string, useJSON bool) error {
-	computeClient, err := oci.NewComputeClient(appCtx.Provider)
+func SearchImages(appCtx *app.ApplicationContext, search string, format printer.OutputFormat) error {
+	service, err := NewServiceFromAppContext(appCtx)
 	if err != nil {
-		return fmt.Errorf("creating compute client: %w", err)
+		return fmt.Errorf("creating image service: %w", err)
 	}
-
-	imageAdapter := ociImage.NewAdapter(computeClient)
-	service := NewService(imageAdapter, appCtx.Logger, appCtx.CompartmentID)
+	service.indexStore = appCtx.Cache
 
 	matchedImages, err := service.FuzzySearch(context.Background(), search)
 	if err != nil {
 		return fmt.Errorf("finding images: %w", err)
 	}
 
-	err = PrintImagesInfo(matchedImages, appCtx, nil, useJSON)
+	err = PrintImagesInfo(matchedImages, appCtx, nil, format)
 	if err != nil {
 		return fmt.Errorf("printing images: %w", err)
 	}
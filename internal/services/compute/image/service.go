@@ -4,18 +4,29 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
 	"github.com/cnopslabs/ocloud/internal/domain/compute"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/oci"
+	ociImage "github.com/cnopslabs/ocloud/internal/oci/compute/image"
+	"github.com/cnopslabs/ocloud/internal/region"
 	"github.com/cnopslabs/ocloud/internal/services/search"
 	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/subtree"
 	"github.com/go-logr/logr"
 )
 
 // Service is the application-layer service for image operations.
 type Service struct {
 	imageRepo     compute.ImageRepository
+	controller    compute.ImageController
+	catalog       compute.ImageCatalog
+	instances     instanceLister
+	namespaces    namespaceResolver
 	logger        logr.Logger
 	compartmentID string
+	indexStore    *cache.Store
 }
 
 // NewService initializes a new Service instance.
@@ -27,6 +38,30 @@ func NewService(repo compute.ImageRepository, logger logr.Logger, compartmentID
 	}
 }
 
+// NewServiceFromAppContext creates a Service backed by the OCI adapter and the
+// resource cache of the application context.
+func NewServiceFromAppContext(appCtx *app.ApplicationContext) (*Service, error) {
+	repo, err := newRepository(appCtx)
+	if err != nil {
+		return nil, err
+	}
+	service := NewService(repo, appCtx.Logger, appCtx.CompartmentID)
+	service.indexStore = appCtx.Cache
+	return service, nil
+}
+
+// newRepository builds the image repository of appCtx.
+func newRepository(appCtx *app.ApplicationContext) (compute.ImageRepository, error) {
+	return region.NewImageRepository(appCtx.Regions, func(name string) (compute.ImageRepository, error) {
+		regional := appCtx.ForRegion(name)
+		computeClient, err := oci.NewComputeClient(regional.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("creating compute client: %w", err)
+		}
+		return subtree.NewImageRepository(cache.NewImageRepository(ociImage.NewAdapter(computeClient), regional.Cache), regional.Subtree), nil
+	})
+}
+
 // FetchPaginatedImages retrieves a paginated list of images.
 func (s *Service) FetchPaginatedImages(ctx context.Context, limit, pageNum int) ([]Image, int, string, error) {
 	s.logger.V(logger.Debug).Info("listing images", "limit", limit, "pageNum", pageNum)
@@ -44,6 +79,16 @@ func (s *Service) FetchPaginatedImages(ctx context.Context, limit, pageNum int)
 
 // FuzzySearch performs a fuzzy search for images.
 func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]Image, error) {
+	matches, err := s.ScoredSearch(ctx, searchPattern)
+	if err != nil {
+		return nil, err
+	}
+	return search.Items(matches), nil
+}
+
+// ScoredSearch performs a fuzzy search for images and returns the matches,
+// best first, with their relevance scores.
+func (s *Service) ScoredSearch(ctx context.Context, searchPattern string) ([]search.Match[Image], error) {
 	s.logger.V(logger.Debug).Info("finding images with fuzzy search", "pattern", searchPattern)
 
 	allImages, err := s.imageRepo.ListImages(ctx, s.compartmentID)
@@ -53,24 +98,35 @@ func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]Imag
 
 	searchableImages := ToSearchableImages(allImages)
 	indexMapping := search.NewIndexMapping(GetSearchableFields())
-	idx, err := search.BuildIndex(searchableImages, indexMapping)
+	idx, err := search.OpenIndex(s.indexStore, s.compartmentID, cache.ResourceImages, searchableImages, indexMapping)
 	if err != nil {
 		return nil, fmt.Errorf("building search index: %w", err)
 	}
+	defer func() { _ = idx.Close() }()
 
 	s.logger.V(logger.Debug).Info("Search index built successfully.", "numEntries", len(allImages))
 
-	matchedIdxs, err := search.FuzzySearch(idx, searchPattern, GetSearchableFields(), GetBoostedFields())
+	matchedIdxs, err := search.ScoredFuzzySearch(idx, searchPattern, GetSearchableFields(), GetBoostedFields())
 	if err != nil {
 		return nil, fmt.Errorf("search: %w", err)
 	}
 
-	results := make([]Image, 0, len(matchedIdxs))
-	for _, i := range matchedIdxs {
-		if i >= 0 && i < len(allImages) {
-			results = append(results, allImages[i])
-		}
-	}
+	results := search.Rank(allImages, matchedIdxs)
 
 	return results, nil
 }
+
+// Reindex lists images and rebuilds their persisted search index.
+// It returns the number of indexed images.
+func (s *Service) Reindex(ctx context.Context) (int, error) {
+	all, err := s.imageRepo.ListImages(ctx, s.compartmentID)
+	if err != nil {
+		return 0, fmt.Errorf("fetching images: %w", err)
+	}
+
+	idx, err := search.OpenIndex(s.indexStore, s.compartmentID, cache.ResourceImages, ToSearchableImages(all), search.NewIndexMapping(GetSearchableFields()))
+	if err != nil {
+		return 0, fmt.Errorf("building search index: %w", err)
+	}
+	return len(all), idx.Close()
+}
@@ -0,0 +1,373 @@
+package instance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/oci"
+	ociInst "github.com/cnopslabs/ocloud/internal/oci/compute/instance"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/region"
+	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/tui"
+)
+
+const (
+	// actionPollInterval is how often the lifecycle state is checked while waiting.
+	actionPollInterval = 5 * time.Second
+	// actionWaitTimeout bounds the wait for instances to settle after an action.
+	actionWaitTimeout = 30 * time.Minute
+)
+
+// powerAction describes how an `ocloud compute instance <verb>` command maps to OCI.
+type powerAction struct {
+	action compute.InstanceAction
+	// targetState is the lifecycle state the instance settles in once the action completed.
+	targetState string
+	// restarts marks actions that start and end in targetState, so that they
+	// are sent to instances already in it.
+	restarts bool
+}
+
+var powerActions = map[string]powerAction{
+	"start":    {action: compute.InstanceActionStart, targetState: "RUNNING"},
+	"stop":     {action: compute.InstanceActionStop, targetState: "STOPPED"},
+	"softstop": {action: compute.InstanceActionSoftStop, targetState: "STOPPED"},
+	"reset":    {action: compute.InstanceActionReset, targetState: "RUNNING", restarts: true},
+	"reboot":   {action: compute.InstanceActionSoftReset, targetState: "RUNNING", restarts: true},
+}
+
+// ActionOptions controls how RunInstanceAction confirms and follows an action.
+type ActionOptions struct {
+	// Yes skips the interactive confirmation.
+	Yes bool
+	// NoWait returns as soon as OCI accepted the action.
+	NoWait bool
+}
+
+// ActionResult reports what happened to one instance.
+type ActionResult struct {
+	Name   string `json:"Name"`
+	ID     string `json:"ID"`
+	Action string `json:"Action"`
+	State  string `json:"State"`
+	Result string `json:"Result"`
+}
+
+// RunInstanceAction resolves targets (exact names or OCIDs) to instances and
+// sends them the power action verb (start, stop, softstop, reset or reboot). It
+// asks for confirmation unless opts.Yes is set and, unless opts.NoWait is set,
+// waits with a progress display until every instance reached the resulting state.
+func RunInstanceAction(ctx context.Context, appCtx *app.ApplicationContext, verb string, targets []string, opts ActionOptions, format printer.OutputFormat) error {
+	pa, ok := powerActions[verb]
+	if !ok {
+		return fmt.Errorf("unknown instance action %q", verb)
+	}
+	service, err := newActionService(appCtx)
+	if err != nil {
+		return fmt.Errorf("creating instance service: %w", err)
+	}
+
+	instances, err := service.resolveForAction(ctx, targets)
+	if err != nil {
+		return err
+	}
+
+	var results []ActionResult
+	var pending []Instance
+	for _, current := range instances {
+		if !pa.restarts && strings.EqualFold(current.State, pa.targetState) {
+			results = append(results, ActionResult{Name: current.DisplayName, ID: current.OCID, Action: verb, State: current.State, Result: "already " + current.State})
+			continue
+		}
+		pending = append(pending, current)
+	}
+
+	var failed []error
+	if len(pending) > 0 {
+		if !opts.Yes && !util.PromptYesNo(confirmationPrompt(verb, pending)) {
+			_, _ = fmt.Fprintln(appCtx.Stderr, "Aborted.")
+			return nil
+		}
+
+		pending, results, failed = service.sendAction(ctx, verb, pa, pending, results)
+		if len(pending) > 0 {
+			invalidateInstanceCaches(appCtx, service.compartmentID, pending)
+		}
+		if !opts.NoWait && len(pending) > 0 {
+			states, err := waitWithProgress(ctx, service, pa, pending)
+			if err != nil {
+				failed = append(failed, err)
+			}
+			for i := range results {
+				if state, ok := states[results[i].ID]; ok {
+					results[i].State = state
+					if strings.EqualFold(state, pa.targetState) {
+						results[i].Result = "done"
+					}
+				}
+			}
+		}
+	}
+
+	if err := PrintActionResults(appCtx, results, format); err != nil {
+		return fmt.Errorf("printing action results: %w", err)
+	}
+	return errors.Join(failed...)
+}
+
+// invalidateInstanceCaches drops the cached instance listings of the current
+// compartment and of the compartments of instances in every selected region,
+// so that the next listing shows the new states. Failures are only logged.
+func invalidateInstanceCaches(appCtx *app.ApplicationContext, compartmentID string, instances []Instance) {
+	compartments := []string{compartmentID}
+	for _, inst := range instances {
+		if inst.CompartmentID != "" && !slices.Contains(compartments, inst.CompartmentID) {
+			compartments = append(compartments, inst.CompartmentID)
+		}
+	}
+	stores := []*cache.Store{appCtx.Cache}
+	if regions := appCtx.Regions.Regions(); len(regions) > 0 {
+		stores = stores[:0]
+		for _, name := range regions {
+			stores = append(stores, appCtx.ForRegion(name).Cache)
+		}
+	}
+	for _, store := range stores {
+		for _, id := range compartments {
+			for _, resource := range []string{cache.ResourceInstances, cache.ResourceEnrichedInstances, cache.ResourceInstancesWithDetails} {
+				if err := store.Invalidate(id, resource); err != nil {
+					logger.LogWithLevel(appCtx.Logger, logger.Debug, "invalidating instance cache", "compartment", id, "resource", resource, "error", err)
+				}
+			}
+		}
+	}
+}
+
+// newActionService builds a Service that can also change the power state of instances.
+func newActionService(appCtx *app.ApplicationContext) (*Service, error) {
+	service, err := NewServiceFromAppContext(appCtx)
+	if err != nil {
+		return nil, err
+	}
+	service.controller, err = region.NewInstanceController(appCtx.Regions, func(name string) (compute.InstanceController, error) {
+		regional := appCtx.ForRegion(name)
+		computeClient, err := oci.NewComputeClient(regional.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("creating compute client: %w", err)
+		}
+		networkClient, err := oci.NewNetworkClient(regional.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("creating network client: %w", err)
+		}
+		return ociInst.NewAdapter(computeClient, networkClient), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service, nil
+}
+
+// resolveForAction maps each target of a power action to the current state of
+// one instance. It never acts on a fuzzy hit: names
+// must equal a display name (case-insensitively), and OCIDs are looked up so
+// that the confirmation shows the real name and state.
+func (s *Service) resolveForAction(ctx context.Context, targets []string) ([]Instance, error) {
+	var resolved []Instance
+	seen := map[string]bool{}
+	for _, target := range targets {
+		id := target
+		if !isInstanceOCID(target) {
+			inst, err := s.resolveExactName(ctx, target)
+			if err != nil {
+				return nil, err
+			}
+			id = inst.OCID
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		current, err := s.controller.GetInstance(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("getting instance %s: %w", target, err)
+		}
+		resolved = append(resolved, *current)
+	}
+	return resolved, nil
+}
+
+// resolveExactName returns the only instance whose display name is name. When
+// there is none, the closest fuzzy match is named in the error.
+func (s *Service) resolveExactName(ctx context.Context, name string) (Instance, error) {
+	all, err := s.instanceRepo.ListInstances(ctx, s.compartmentID)
+	if err != nil {
+		return Instance{}, fmt.Errorf("finding instance %q: %w", name, err)
+	}
+	var exact []Instance
+	for _, inst := range all {
+		if strings.EqualFold(inst.DisplayName, name) {
+			exact = append(exact, inst)
+		}
+	}
+
+	switch len(exact) {
+	case 0:
+		if matches, err := s.FuzzySearch(ctx, name); err == nil && len(matches) > 0 {
+			return Instance{}, fmt.Errorf("no instance is named %q (closest match: %s); use the exact name or the OCID", name, matches[0].DisplayName)
+		}
+		return Instance{}, fmt.Errorf("no instance is named %q", name)
+	case 1:
+		return exact[0], nil
+	default:
+		return Instance{}, fmt.Errorf("%q names %d instances; use the OCID", name, len(exact))
+	}
+}
+
+// ResolveInstance maps target to one instance the way the power actions do:
+// an OCID is used as given, and a name must equal the display name of exactly
+// one instance (case-insensitively). A fuzzy hit is never accepted, because
+// callers act on the instance.
+func (s *Service) ResolveInstance(ctx context.Context, target string) (Instance, error) {
+	if isInstanceOCID(target) {
+		return Instance{OCID: target, DisplayName: target}, nil
+	}
+	return s.resolveExactName(ctx, target)
+}
+
+func isInstanceOCID(target string) bool {
+	return strings.HasPrefix(target, "ocid1.instance.")
+}
+
+// sendAction sends the action to each instance and returns the instances that
+// accepted it, the results so far, and the errors of those that did not.
+func (s *Service) sendAction(ctx context.Context, verb string, pa powerAction, instances []Instance, results []ActionResult) ([]Instance, []ActionResult, []error) {
+	var accepted []Instance
+	var failed []error
+	for _, inst := range instances {
+		logger.LogWithLevel(s.logger, logger.Debug, "sending instance action", "action", pa.action, "id", inst.OCID)
+		updated, err := s.controller.InstanceAction(ctx, inst.OCID, pa.action)
+		if err != nil {
+			results = append(results, ActionResult{Name: inst.DisplayName, ID: inst.OCID, Action: verb, State: inst.State, Result: "failed: " + err.Error()})
+			failed = append(failed, fmt.Errorf("%s %s: %w", verb, inst.DisplayName, err))
+			continue
+		}
+		results = append(results, ActionResult{Name: inst.DisplayName, ID: inst.OCID, Action: verb, State: updated.State, Result: "submitted"})
+		inst.State = updated.State
+		accepted = append(accepted, inst)
+	}
+	return accepted, results, failed
+}
+
+// WaitForState polls the instances every interval until each one is in target,
+// calling progress with the latest states after every poll. The states of
+// instances are seeded from their State field. With restart set, as for a reset
+// or reboot, an instance counts only once it was seen in another state and is
+// back in target, since OCI often still reports it in target right after the
+// action was accepted. The last observed states are returned.
+func (s *Service) WaitForState(ctx context.Context, instances []Instance, target string, restart bool, interval time.Duration, progress func(states map[string]string, reached int)) (map[string]string, error) {
+	states := make(map[string]string, len(instances))
+	left := make(map[string]bool, len(instances))
+	for _, inst := range instances {
+		states[inst.OCID] = inst.State
+		left[inst.OCID] = !restart || !strings.EqualFold(inst.State, target)
+	}
+
+	for {
+		reached := 0
+		for _, inst := range instances {
+			if left[inst.OCID] && strings.EqualFold(states[inst.OCID], target) {
+				reached++
+			}
+		}
+		if progress != nil {
+			progress(states, reached)
+		}
+		if reached == len(instances) {
+			return states, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return states, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		for _, inst := range instances {
+			current, err := s.controller.GetInstance(ctx, inst.OCID)
+			if err != nil {
+				if ctx.Err() != nil {
+					return states, ctx.Err()
+				}
+				return states, fmt.Errorf("getting instance %s: %w", inst.DisplayName, err)
+			}
+			states[inst.OCID] = current.State
+			if !strings.EqualFold(current.State, target) {
+				left[inst.OCID] = true
+			}
+		}
+	}
+}
+
+// waitWithProgress runs WaitForState behind a progress bar. Quitting the
+// progress display stops the wait without failing, as the actions were sent.
+func waitWithProgress(ctx context.Context, service *Service, pa powerAction, instances []Instance) (map[string]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, actionWaitTimeout)
+	defer cancel()
+
+	runner := tui.NewProgressRunner(fmt.Sprintf("Waiting for %d instance(s) to be %s", len(instances), pa.targetState))
+	runner.Start()
+
+	type outcome struct {
+		states map[string]string
+		err    error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		states, err := service.WaitForState(ctx, instances, pa.targetState, pa.restarts, actionPollInterval, func(states map[string]string, reached int) {
+			runner.UpdateProgress(float64(reached)/float64(len(instances)), fmt.Sprintf("%d/%d %s", reached, len(instances), pa.targetState), "", describeStates(instances, states))
+		})
+		if err != nil && !errors.Is(err, context.Canceled) {
+			runner.SendError(err)
+		}
+		done <- outcome{states, err}
+	}()
+
+	runErr := runner.Run()
+	cancel()
+	result := <-done
+	switch {
+	case errors.Is(result.err, context.DeadlineExceeded):
+		return result.states, fmt.Errorf("instances did not reach %s within %s", pa.targetState, actionWaitTimeout)
+	case errors.Is(result.err, context.Canceled):
+		return result.states, nil
+	case result.err != nil:
+		return result.states, result.err
+	}
+	return result.states, runErr
+}
+
+func describeStates(instances []Instance, states map[string]string) string {
+	parts := make([]string, 0, len(instances))
+	for _, inst := range instances {
+		parts = append(parts, inst.DisplayName+" "+states[inst.OCID])
+	}
+	return strings.Join(parts, " · ")
+}
+
+func confirmationPrompt(verb string, instances []Instance) string {
+	names := make([]string, 0, len(instances))
+	for _, inst := range instances {
+		names = append(names, fmt.Sprintf("%s (%s)", inst.DisplayName, inst.State))
+	}
+	return fmt.Sprintf("%s %d instance(s): %s?", strings.ToUpper(verb[:1])+verb[1:], len(instances), strings.Join(names, ", "))
+}
@@ -0,0 +1,202 @@
+package instance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+)
+
+type fakeInstanceRepository struct {
+	instances []compute.Instance
+}
+
+func (f *fakeInstanceRepository) GetEnrichedInstance(ctx context.Context, ocid string) (*compute.Instance, error) {
+	for _, inst := range f.instances {
+		if inst.OCID == ocid {
+			return &inst, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (f *fakeInstanceRepository) ListEnrichedInstances(ctx context.Context, compartmentID string) ([]compute.Instance, error) {
+	return f.instances, nil
+}
+
+func (f *fakeInstanceRepository) ListInstances(ctx context.Context, compartmentID string) ([]compute.Instance, error) {
+	return f.instances, nil
+}
+
+// fakeController replays a sequence of states per instance, one per GetInstance call.
+type fakeController struct {
+	states  map[string][]string
+	actions map[string]compute.InstanceAction
+	fail    map[string]error
+}
+
+func (f *fakeController) GetInstance(ctx context.Context, ocid string) (*compute.Instance, error) {
+	seq := f.states[ocid]
+	state := seq[0]
+	if len(seq) > 1 {
+		f.states[ocid] = seq[1:]
+	}
+	return &compute.Instance{OCID: ocid, DisplayName: ocid, State: state}, nil
+}
+
+func (f *fakeController) InstanceAction(ctx context.Context, ocid string, action compute.InstanceAction) (*compute.Instance, error) {
+	if err := f.fail[ocid]; err != nil {
+		return nil, err
+	}
+	f.actions[ocid] = action
+	return f.GetInstance(ctx, ocid)
+}
+
+func newActionTestService(controller *fakeController) *Service {
+	repo := &fakeInstanceRepository{instances: []compute.Instance{
+		{OCID: "ocid1.instance.oc1..web1", DisplayName: "frontend-1", State: "RUNNING"},
+		{OCID: "ocid1.instance.oc1..web2", DisplayName: "frontend-2", State: "RUNNING"},
+		{OCID: "ocid1.instance.oc1..db", DisplayName: "postgres-primary", State: "STOPPED"},
+	}}
+	service := NewService(repo, logr.Discard(), "ocid1.compartment.oc1..test")
+	service.controller = controller
+	return service
+}
+
+func TestResolveInstance(t *testing.T) {
+	service := newActionTestService(&fakeController{})
+	ctx := context.Background()
+
+	inst, err := service.ResolveInstance(ctx, "FRONTEND-1")
+	require.NoError(t, err)
+	assert.Equal(t, "ocid1.instance.oc1..web1", inst.OCID)
+
+	inst, err = service.ResolveInstance(ctx, "ocid1.instance.oc1..db")
+	require.NoError(t, err)
+	assert.Equal(t, "ocid1.instance.oc1..db", inst.OCID)
+
+	_, err = service.ResolveInstance(ctx, "postgres")
+	assert.ErrorContains(t, err, `no instance is named "postgres" (closest match: postgres-primary)`, "a partial name is rejected even with a single fuzzy hit")
+}
+
+func TestResolveForAction(t *testing.T) {
+	controller := &fakeController{states: map[string][]string{
+		"ocid1.instance.oc1..web1": {"RUNNING"},
+		"ocid1.instance.oc1..db":   {"STOPPED"},
+	}}
+	service := newActionTestService(controller)
+	ctx := context.Background()
+
+	resolved, err := service.resolveForAction(ctx, []string{"FRONTEND-1", "ocid1.instance.oc1..db", "ocid1.instance.oc1..web1"})
+	require.NoError(t, err)
+	require.Len(t, resolved, 2, "a name and the OCID of the same instance resolve once")
+	assert.Equal(t, "ocid1.instance.oc1..web1", resolved[0].OCID)
+	assert.Equal(t, "STOPPED", resolved[1].State, "OCIDs are looked up")
+
+	_, err = service.resolveForAction(ctx, []string{"frontend-3"})
+	assert.ErrorContains(t, err, `no instance is named "frontend-3" (closest match: frontend-`, "a single fuzzy hit is never acted upon")
+
+	_, err = service.resolveForAction(ctx, []string{"nothing-like-this"})
+	assert.EqualError(t, err, `no instance is named "nothing-like-this"`)
+}
+
+func TestSendAction(t *testing.T) {
+	controller := &fakeController{
+		states:  map[string][]string{"a": {"STOPPING"}},
+		actions: map[string]compute.InstanceAction{},
+		fail:    map[string]error{"b": errors.New("conflict")},
+	}
+	service := newActionTestService(controller)
+
+	accepted, results, failed := service.sendAction(context.Background(), "stop", powerActions["stop"], []Instance{
+		{OCID: "a", DisplayName: "a", State: "RUNNING"},
+		{OCID: "b", DisplayName: "b", State: "RUNNING"},
+	}, nil)
+
+	require.Len(t, accepted, 1)
+	assert.Equal(t, "STOPPING", accepted[0].State)
+	assert.Equal(t, compute.InstanceActionStop, controller.actions["a"])
+	require.Len(t, results, 2)
+	assert.Equal(t, "submitted", results[0].Result)
+	assert.Equal(t, "failed: conflict", results[1].Result)
+	require.Len(t, failed, 1)
+	assert.ErrorContains(t, failed[0], "stop b")
+}
+
+func TestWaitForState(t *testing.T) {
+	t.Run("stop", func(t *testing.T) {
+		service := newActionTestService(&fakeController{states: map[string][]string{
+			"a": {"STOPPING", "STOPPED"},
+			"b": {"STOPPED"},
+		}})
+		var reachedSeen []int
+		states, err := service.WaitForState(context.Background(), []Instance{
+			{OCID: "a", State: "STOPPING"},
+			{OCID: "b", State: "STOPPING"},
+		}, "STOPPED", false, time.Millisecond, func(_ map[string]string, reached int) {
+			reachedSeen = append(reachedSeen, reached)
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"a": "STOPPED", "b": "STOPPED"}, states)
+		assert.Equal(t, []int{0, 1, 2}, reachedSeen)
+	})
+
+	t.Run("reboot waits until the instance left RUNNING and is back", func(t *testing.T) {
+		controller := &fakeController{states: map[string][]string{"a": {"STARTING", "RUNNING"}}}
+		service := newActionTestService(controller)
+		polls := 0
+		_, err := service.WaitForState(context.Background(), []Instance{{OCID: "a", State: "STOPPING"}}, "RUNNING", true, time.Millisecond, func(map[string]string, int) {
+			polls++
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, polls)
+
+		controller.states["a"] = []string{"RUNNING", "STOPPING", "STARTING", "RUNNING"}
+		polls = 0
+		states, err := service.WaitForState(context.Background(), []Instance{{OCID: "a", State: "RUNNING"}}, "RUNNING", true, time.Millisecond, func(map[string]string, int) {
+			polls++
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "RUNNING", states["a"])
+		assert.Equal(t, 5, polls, "a reset OCI still reports as RUNNING is waited for until it restarted")
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		service := newActionTestService(&fakeController{states: map[string][]string{"a": {"STOPPING"}}})
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := service.WaitForState(ctx, []Instance{{OCID: "a", State: "STOPPING"}}, "STOPPED", false, time.Millisecond, nil)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestInvalidateInstanceCaches(t *testing.T) {
+	store := cache.NewStore(t.TempDir(), "tenancy", time.Hour, false)
+	for _, id := range []string{"ocid1.compartment.oc1..root", "ocid1.compartment.oc1..child", "ocid1.compartment.oc1..other"} {
+		_, err := cache.Fetch(store, id, cache.ResourceEnrichedInstances, func() ([]compute.Instance, error) {
+			return []compute.Instance{{OCID: "ocid1.instance.oc1..web1", State: "RUNNING"}}, nil
+		})
+		require.NoError(t, err)
+	}
+	appCtx := &app.ApplicationContext{Cache: store, Logger: logr.Discard()}
+
+	invalidateInstanceCaches(appCtx, "ocid1.compartment.oc1..root", []Instance{{OCID: "ocid1.instance.oc1..web1", CompartmentID: "ocid1.compartment.oc1..child"}})
+
+	for id, cached := range map[string]bool{"ocid1.compartment.oc1..root": false, "ocid1.compartment.oc1..child": false, "ocid1.compartment.oc1..other": true} {
+		loaded := false
+		_, err := cache.Fetch(store, id, cache.ResourceEnrichedInstances, func() ([]compute.Instance, error) {
+			loaded = true
+			return nil, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, !cached, loaded, id)
+	}
+}
@@ -0,0 +1,224 @@
+package instance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/oci"
+	ociInst "github.com/cnopslabs/ocloud/internal/oci/compute/instance"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/services/identity/bastion"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// consolePollInterval is how often a console capture or connection is checked while waiting.
+	consolePollInterval = 2 * time.Second
+	// consoleWaitTimeout bounds the wait for a console capture or connection.
+	consoleWaitTimeout = 5 * time.Minute
+)
+
+// ConsoleHistoryOutput is the structured output of console-history.
+type ConsoleHistoryOutput struct {
+	Name    string `json:"Name"`
+	ID      string `json:"ID"`
+	Content string `json:"Content"`
+}
+
+// ConsoleConnectionOutput is the structured output of console connect.
+type ConsoleConnectionOutput struct {
+	Name         string `json:"Name"`
+	ID           string `json:"ID"`
+	ConnectionID string `json:"ConnectionID"`
+	Command      string `json:"Command"`
+	Fingerprint  string `json:"Fingerprint"`
+}
+
+// newConsoleService builds a Service that can also reach the serial console of instances.
+func newConsoleService(appCtx *app.ApplicationContext) (*Service, error) {
+	service, err := NewServiceFromAppContext(appCtx)
+	if err != nil {
+		return nil, err
+	}
+	computeClient, err := oci.NewComputeClient(appCtx.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("creating compute client: %w", err)
+	}
+	networkClient, err := oci.NewNetworkClient(appCtx.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("creating network client: %w", err)
+	}
+	service.console = ociInst.NewAdapter(computeClient, networkClient)
+	return service, nil
+}
+
+// GetConsoleHistory captures the serial console output of the instance given
+// by exact name or OCID and prints it as is, or in the requested structured format.
+func GetConsoleHistory(ctx context.Context, appCtx *app.ApplicationContext, target string, format printer.OutputFormat) error {
+	service, err := newConsoleService(appCtx)
+	if err != nil {
+		return fmt.Errorf("creating instance service: %w", err)
+	}
+	inst, err := service.ResolveInstance(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, consoleWaitTimeout)
+	defer cancel()
+	content, err := service.FetchConsoleHistory(ctx, inst.OCID, consolePollInterval)
+	if err != nil {
+		return fmt.Errorf("fetching console history of %s: %w", inst.DisplayName, err)
+	}
+
+	if !format.IsTable() {
+		return printer.New(appCtx.Stdout).Marshal(format, ConsoleHistoryOutput{Name: inst.DisplayName, ID: inst.OCID, Content: content})
+	}
+	_, err = fmt.Fprint(appCtx.Stdout, content)
+	return err
+}
+
+// FetchConsoleHistory captures the console output of an instance, polls every
+// interval until the capture succeeded and returns its content. The capture
+// is deleted afterwards so that repeated calls do not pile up captures.
+func (s *Service) FetchConsoleHistory(ctx context.Context, instanceID string, interval time.Duration) (string, error) {
+	history, err := s.console.CaptureConsoleHistory(ctx, instanceID)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		// The capture outlives a cancelled ctx, so clean it up regardless.
+		if err := s.console.DeleteConsoleHistory(context.Background(), history.ID); err != nil {
+			logger.LogWithLevel(s.logger, logger.Debug, "deleting console history", "id", history.ID, "error", err)
+		}
+	}()
+
+	for history.State != "SUCCEEDED" {
+		if history.State == "FAILED" {
+			return "", errors.New("console history capture failed")
+		}
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(interval):
+		}
+		if history, err = s.console.GetConsoleHistory(ctx, history.ID); err != nil {
+			return "", err
+		}
+	}
+	return s.console.GetConsoleHistoryContent(ctx, history.ID)
+}
+
+// ConnectConsole opens a serial console connection to the instance given by
+// exact name or OCID for the public key in publicKeyPath and prints the ssh
+// command that uses privateKeyPath, or runs it when execute is set. A
+// connection created to run the command is deleted once the session ended.
+func ConnectConsole(ctx context.Context, appCtx *app.ApplicationContext, target, publicKeyPath, privateKeyPath string, execute bool, format printer.OutputFormat) error {
+	publicKey, err := os.ReadFile(publicKeyPath)
+	if err != nil {
+		return fmt.Errorf("reading public key: %w", err)
+	}
+	service, err := newConsoleService(appCtx)
+	if err != nil {
+		return fmt.Errorf("creating instance service: %w", err)
+	}
+	inst, err := service.ResolveInstance(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, consoleWaitTimeout)
+	defer cancel()
+	conn, created, err := service.OpenConsoleConnection(waitCtx, inst.OCID, strings.TrimSpace(string(publicKey)), consolePollInterval)
+	if err != nil {
+		return fmt.Errorf("connecting to the console of %s: %w", inst.DisplayName, err)
+	}
+	command := ConsoleSSHCommand(conn.ConnectionString, privateKeyPath)
+
+	if execute {
+		if created {
+			defer func() {
+				// OCI allows one connection per instance, so do not leave this one behind.
+				if err := service.console.DeleteConsoleConnection(context.Background(), conn.ID); err != nil {
+					logger.LogWithLevel(logger.CmdLogger, logger.Info, "Could not delete the console connection", "id", conn.ID, "error", err)
+				}
+			}()
+		}
+		logger.LogWithLevel(logger.CmdLogger, logger.Info, "Connecting to the serial console; press Enter if no prompt appears, type ~. to disconnect", "instance", inst.DisplayName)
+		return bastion.RunShell(ctx, appCtx.Stdout, appCtx.Stderr, command)
+	}
+
+	if !format.IsTable() {
+		return printer.New(appCtx.Stdout).Marshal(format, ConsoleConnectionOutput{
+			Name: inst.DisplayName, ID: inst.OCID, ConnectionID: conn.ID, Command: command, Fingerprint: conn.Fingerprint,
+		})
+	}
+	_, err = fmt.Fprintf(appCtx.Stdout, "Serial console connection for %s is %s. Connect with:\n\n%s\n", inst.DisplayName, conn.State, command)
+	return err
+}
+
+// OpenConsoleConnection returns a console connection for publicKey that is
+// ACTIVE, polling every interval while it is created. OCI allows a single
+// connection per instance, so an existing connection for the same key is
+// reused, and one for another key is reported rather than replaced. created
+// tells whether the connection was created by this call.
+func (s *Service) OpenConsoleConnection(ctx context.Context, instanceID, publicKey string, interval time.Duration) (conn *compute.ConsoleConnection, created bool, err error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return nil, false, fmt.Errorf("parsing public key: %w", err)
+	}
+	fingerprint := ssh.FingerprintLegacyMD5(key)
+
+	existing, err := s.console.ListConsoleConnections(ctx, instanceID)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, c := range existing {
+		if c.State != "ACTIVE" && c.State != "CREATING" {
+			continue
+		}
+		if !strings.EqualFold(c.Fingerprint, fingerprint) {
+			return nil, false, fmt.Errorf("the instance already has console connection %s for another key (%s); use that key or delete the connection", c.ID, c.Fingerprint)
+		}
+		logger.LogWithLevel(s.logger, logger.Debug, "reusing console connection", "id", c.ID)
+		conn = &c
+		break
+	}
+	if conn == nil {
+		if conn, err = s.console.CreateConsoleConnection(ctx, instanceID, publicKey); err != nil {
+			return nil, false, err
+		}
+		created = true
+	}
+
+	for conn.State != "ACTIVE" {
+		if conn.State == "FAILED" || conn.State == "DELETED" {
+			return nil, created, fmt.Errorf("console connection is %s", conn.State)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, created, ctx.Err()
+		case <-time.After(interval):
+		}
+		if conn, err = s.console.GetConsoleConnection(ctx, conn.ID); err != nil {
+			return nil, created, err
+		}
+	}
+	return conn, created, nil
+}
+
+// ConsoleSSHCommand adds the private key to both ssh invocations of the
+// connection string OCI generated, the outer one and its ProxyCommand.
+func ConsoleSSHCommand(connectionString, privateKeyPath string) string {
+	if privateKeyPath == "" {
+		return connectionString
+	}
+	return strings.ReplaceAll(connectionString, "ssh ", "ssh -i "+privateKeyPath+" ")
+}
@@ -0,0 +1,132 @@
+package instance
+
+import (
+	"context"
+	"crypto/ed25519"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+)
+
+// fakeConsole replays a sequence of states for captures and connections, one per Get call.
+type fakeConsole struct {
+	historyStates    []string
+	connectionStates []string
+	existing         []compute.ConsoleConnection
+	deleted          []string
+	publicKey        string
+}
+
+func (f *fakeConsole) CaptureConsoleHistory(ctx context.Context, instanceID string) (*compute.ConsoleHistory, error) {
+	return f.GetConsoleHistory(ctx, "h1")
+}
+
+func (f *fakeConsole) GetConsoleHistory(ctx context.Context, id string) (*compute.ConsoleHistory, error) {
+	state := f.historyStates[0]
+	if len(f.historyStates) > 1 {
+		f.historyStates = f.historyStates[1:]
+	}
+	return &compute.ConsoleHistory{ID: id, State: state}, nil
+}
+
+func (f *fakeConsole) GetConsoleHistoryContent(ctx context.Context, id string) (string, error) {
+	return "Kernel panic - not syncing\n", nil
+}
+
+func (f *fakeConsole) DeleteConsoleHistory(ctx context.Context, id string) error {
+	f.deleted = append(f.deleted, id)
+	return nil
+}
+
+func (f *fakeConsole) CreateConsoleConnection(ctx context.Context, instanceID, publicKey string) (*compute.ConsoleConnection, error) {
+	f.publicKey = publicKey
+	return f.GetConsoleConnection(ctx, "c1")
+}
+
+func (f *fakeConsole) GetConsoleConnection(ctx context.Context, id string) (*compute.ConsoleConnection, error) {
+	state := f.connectionStates[0]
+	if len(f.connectionStates) > 1 {
+		f.connectionStates = f.connectionStates[1:]
+	}
+	return &compute.ConsoleConnection{ID: id, State: state, ConnectionString: "ssh -o ProxyCommand='ssh -W %h:%p -p 443 c1@instance-console.us-ashburn-1.oci.oraclecloud.com' i1"}, nil
+}
+
+func (f *fakeConsole) ListConsoleConnections(ctx context.Context, instanceID string) ([]compute.ConsoleConnection, error) {
+	return f.existing, nil
+}
+
+func (f *fakeConsole) DeleteConsoleConnection(ctx context.Context, id string) error {
+	f.deleted = append(f.deleted, id)
+	return nil
+}
+
+func TestFetchConsoleHistory(t *testing.T) {
+	console := &fakeConsole{historyStates: []string{"REQUESTED", "GETTING-HISTORY", "SUCCEEDED"}}
+	service := newActionTestService(&fakeController{})
+	service.console = console
+
+	content, err := service.FetchConsoleHistory(context.Background(), "ocid1.instance.oc1..web1", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "Kernel panic - not syncing\n", content)
+	assert.Equal(t, []string{"h1"}, console.deleted, "the capture is deleted once read")
+
+	console = &fakeConsole{historyStates: []string{"REQUESTED", "FAILED"}}
+	service.console = console
+	_, err = service.FetchConsoleHistory(context.Background(), "ocid1.instance.oc1..web1", 0)
+	assert.ErrorContains(t, err, "capture failed")
+	assert.Equal(t, []string{"h1"}, console.deleted, "failed captures are deleted too")
+}
+
+func TestOpenConsoleConnection(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	sshPub, err := ssh.NewPublicKey(pub)
+	require.NoError(t, err)
+	publicKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPub)))
+	fingerprint := ssh.FingerprintLegacyMD5(sshPub)
+	ctx := context.Background()
+
+	console := &fakeConsole{connectionStates: []string{"CREATING", "ACTIVE"}}
+	service := newActionTestService(&fakeController{})
+	service.console = console
+	conn, created, err := service.OpenConsoleConnection(ctx, "ocid1.instance.oc1..web1", publicKey, 0)
+	require.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, "ACTIVE", conn.State)
+	assert.Equal(t, publicKey, console.publicKey)
+
+	console = &fakeConsole{existing: []compute.ConsoleConnection{
+		{ID: "old", State: "DELETED", Fingerprint: "00:11"},
+		{ID: "c0", State: "ACTIVE", Fingerprint: fingerprint},
+	}}
+	service.console = console
+	conn, created, err = service.OpenConsoleConnection(ctx, "ocid1.instance.oc1..web1", publicKey, 0)
+	require.NoError(t, err)
+	assert.False(t, created, "an active connection for the same key is reused")
+	assert.Equal(t, "c0", conn.ID)
+	assert.Empty(t, console.publicKey)
+
+	service.console = &fakeConsole{existing: []compute.ConsoleConnection{{ID: "c0", State: "ACTIVE", Fingerprint: "00:11"}}}
+	_, _, err = service.OpenConsoleConnection(ctx, "ocid1.instance.oc1..web1", publicKey, 0)
+	assert.ErrorContains(t, err, "already has console connection c0 for another key")
+
+	service.console = &fakeConsole{connectionStates: []string{"CREATING", "FAILED"}}
+	_, _, err = service.OpenConsoleConnection(ctx, "ocid1.instance.oc1..web1", publicKey, 0)
+	assert.ErrorContains(t, err, "FAILED")
+
+	_, _, err = service.OpenConsoleConnection(ctx, "ocid1.instance.oc1..web1", "not a key", 0)
+	assert.ErrorContains(t, err, "parsing public key")
+}
+
+func TestConsoleSSHCommand(t *testing.T) {
+	cs := "ssh -o ProxyCommand='ssh -W %h:%p -p 443 c1@instance-console.us-ashburn-1.oci.oraclecloud.com' i1"
+	assert.Equal(t,
+		"ssh -i ~/.ssh/id_ed25519 -o ProxyCommand='ssh -i ~/.ssh/id_ed25519 -W %h:%p -p 443 c1@instance-console.us-ashburn-1.oci.oraclecloud.com' i1",
+		ConsoleSSHCommand(cs, "~/.ssh/id_ed25519"))
+	assert.Equal(t, cs, ConsoleSSHCommand(cs, ""))
+}
@@ -0,0 +1,283 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/services/identity/bastion"
+	"gopkg.in/yaml.v3"
+)
+
+// Export formats accepted by `ocloud compute instance export --format`.
+const (
+	ExportSSHConfig   = "ssh-config"
+	ExportAnsibleINI  = "ansible-ini"
+	ExportAnsibleYAML = "ansible-yaml"
+)
+
+// ExportFormats lists the supported export formats.
+var ExportFormats = []string{ExportSSHConfig, ExportAnsibleINI, ExportAnsibleYAML}
+
+// ExportOptions controls how instances are written out.
+type ExportOptions struct {
+	// Format is one of ExportFormats.
+	Format string
+	// User overrides the SSH user, which otherwise follows the image OS.
+	User string
+	// IdentityFile is the private key used for the instances and the bastion.
+	IdentityFile string
+	// BastionSession is the OCID of a bastion session to route the connection
+	// to its target instance through with a ProxyCommand; empty connects directly.
+	BastionSession string
+	// Region is the region of the bastion session.
+	Region string
+	// BastionTargetID and BastionTargetIP identify the instance the bastion
+	// session reaches; only that host gets the ProxyCommand.
+	BastionTargetID string
+	BastionTargetIP string
+}
+
+// exportHost is one instance as it appears in an export.
+type exportHost struct {
+	Alias string
+	IP    string
+	User  string
+	OCID  string
+	// Groups are the Ansible groups derived from the freeform tags.
+	Groups []string
+}
+
+var nonAliasChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// ExportInstances lists the instances of the compartment and writes them to
+// appCtx.Stdout as an SSH config or an Ansible inventory.
+func ExportInstances(ctx context.Context, appCtx *app.ApplicationContext, opts ExportOptions) error {
+	if opts.BastionSession != "" {
+		if opts.Region == "" {
+			region, err := appCtx.Provider.Region()
+			if err != nil {
+				return fmt.Errorf("getting region: %w", err)
+			}
+			opts.Region = region
+		}
+		bastionService, err := bastion.NewServiceFromAppContext(appCtx)
+		if err != nil {
+			return fmt.Errorf("creating bastion service: %w", err)
+		}
+		opts.BastionTargetID, opts.BastionTargetIP, err = bastionService.SessionTarget(ctx, opts.BastionSession)
+		if err != nil {
+			return fmt.Errorf("resolving bastion session target: %w", err)
+		}
+	}
+
+	service, err := NewServiceFromAppContext(appCtx)
+	if err != nil {
+		return fmt.Errorf("creating instance service: %w", err)
+	}
+	instances, err := service.ListEnrichedInstances(ctx)
+	if err != nil {
+		return fmt.Errorf("listing instances: %w", err)
+	}
+	return WriteExport(appCtx.Stdout, instances, opts)
+}
+
+// WriteExport writes instances to w in opts.Format. Instances without a
+// private IP and terminated instances are left out. With opts.BastionSession,
+// the session's target must be among the exported hosts.
+func WriteExport(w io.Writer, instances []Instance, opts ExportOptions) error {
+	hosts := exportHosts(instances, opts.User)
+	if opts.BastionSession != "" && !slices.ContainsFunc(hosts, func(h exportHost) bool { return bastionTarget(h, opts) }) {
+		return fmt.Errorf("bastion session %s does not target any exported instance", opts.BastionSession)
+	}
+	switch opts.Format {
+	case ExportSSHConfig:
+		return writeSSHConfig(w, hosts, opts)
+	case ExportAnsibleINI:
+		return writeAnsibleINI(w, hosts, opts)
+	case ExportAnsibleYAML:
+		return writeAnsibleYAML(w, hosts, opts)
+	default:
+		return fmt.Errorf("unknown export format %q (expected %s)", opts.Format, strings.Join(ExportFormats, ", "))
+	}
+}
+
+// DefaultSSHUser returns the default login user of platform images running imageOS.
+func DefaultSSHUser(imageOS string) string {
+	if strings.Contains(strings.ToLower(imageOS), "ubuntu") {
+		return "ubuntu"
+	}
+	return "opc"
+}
+
+// exportHosts turns instances into hosts with unique aliases, sorted by alias.
+func exportHosts(instances []Instance, user string) []exportHost {
+	var hosts []exportHost
+	seen := map[string]int{}
+	for _, inst := range instances {
+		if inst.PrimaryIP == "" || strings.HasPrefix(inst.State, "TERMINAT") {
+			continue
+		}
+		name := inst.Hostname
+		if name == "" {
+			name = inst.DisplayName
+		}
+		alias := strings.Trim(nonAliasChars.ReplaceAllString(name, "-"), "-")
+		if alias == "" {
+			alias = inst.PrimaryIP
+		}
+		seen[alias]++
+		if n := seen[alias]; n > 1 {
+			alias = fmt.Sprintf("%s-%d", alias, n)
+		}
+
+		u := user
+		if u == "" {
+			u = DefaultSSHUser(inst.ImageOS)
+		}
+		hosts = append(hosts, exportHost{Alias: alias, IP: inst.PrimaryIP, User: u, OCID: inst.OCID, Groups: tagGroups(inst.FreeformTags)})
+	}
+	sort.Slice(hosts, func(i, j int) bool { return hosts[i].Alias < hosts[j].Alias })
+	return hosts
+}
+
+// tagGroups maps freeform tags to Ansible group names such as env_prod.
+func tagGroups(tags map[string]string) []string {
+	groups := make([]string, 0, len(tags))
+	for k, v := range tags {
+		name := strings.ToLower(k)
+		if v != "" {
+			name += "_" + strings.ToLower(v)
+		}
+		name = strings.Trim(nonAliasChars.ReplaceAllString(strings.NewReplacer(".", "_", "-", "_").Replace(name), "_"), "_")
+		if name != "" {
+			groups = append(groups, name)
+		}
+	}
+	sort.Strings(groups)
+	return groups
+}
+
+// bastionTarget reports whether h is the instance the bastion session reaches.
+func bastionTarget(h exportHost, opts ExportOptions) bool {
+	if opts.BastionTargetID != "" {
+		return h.OCID == opts.BastionTargetID
+	}
+	return opts.BastionTargetIP != "" && h.IP == opts.BastionTargetIP
+}
+
+// proxyCommand returns the ProxyCommand of h, which is empty unless h is the
+// target of the bastion session.
+func proxyCommand(h exportHost, opts ExportOptions) string {
+	if opts.BastionSession == "" || !bastionTarget(h, opts) {
+		return ""
+	}
+	key := opts.IdentityFile
+	if key == "" {
+		key = "~/.ssh/id_rsa"
+	}
+	return bastion.BuildProxyCommand(key, opts.BastionSession, opts.Region)
+}
+
+func writeSSHConfig(w io.Writer, hosts []exportHost, opts ExportOptions) error {
+	var b strings.Builder
+	b.WriteString("# Generated by ocloud compute instance export\n")
+	for _, h := range hosts {
+		fmt.Fprintf(&b, "\n# %s\nHost %s\n    HostName %s\n    User %s\n", h.OCID, h.Alias, h.IP, h.User)
+		if opts.IdentityFile != "" {
+			fmt.Fprintf(&b, "    IdentityFile %s\n", opts.IdentityFile)
+		}
+		if proxy := proxyCommand(h, opts); proxy != "" {
+			fmt.Fprintf(&b, "    ProxyCommand %s\n", proxy)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// hostVars are the Ansible connection variables of h.
+func hostVars(h exportHost, opts ExportOptions) [][2]string {
+	vars := [][2]string{{"ansible_host", h.IP}, {"ansible_user", h.User}}
+	if opts.IdentityFile != "" {
+		vars = append(vars, [2]string{"ansible_ssh_private_key_file", opts.IdentityFile})
+	}
+	if proxy := proxyCommand(h, opts); proxy != "" {
+		vars = append(vars, [2]string{"ansible_ssh_common_args", fmt.Sprintf("-o ProxyCommand=\"%s\"", proxy)})
+	}
+	return vars
+}
+
+func writeAnsibleINI(w io.Writer, hosts []exportHost, opts ExportOptions) error {
+	var b strings.Builder
+	b.WriteString("# Generated by ocloud compute instance export\n")
+	members := map[string][]string{}
+	for _, h := range hosts {
+		b.WriteString(h.Alias)
+		for _, v := range hostVars(h, opts) {
+			fmt.Fprintf(&b, " %s=%s", v[0], iniValue(v[1]))
+		}
+		b.WriteString("\n")
+		for _, g := range h.Groups {
+			members[g] = append(members[g], h.Alias)
+		}
+	}
+	for _, g := range sortedKeys(members) {
+		fmt.Fprintf(&b, "\n[%s]\n%s\n", g, strings.Join(members[g], "\n"))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// iniValue quotes values with spaces so that the INI inventory parser keeps them whole.
+func iniValue(v string) string {
+	if !strings.ContainsAny(v, " \t") {
+		return v
+	}
+	return "'" + strings.ReplaceAll(v, "'", `'\''`) + "'"
+}
+
+func writeAnsibleYAML(w io.Writer, hosts []exportHost, opts ExportOptions) error {
+	all := map[string]any{}
+	if len(hosts) > 0 {
+		hostMap := map[string]map[string]string{}
+		children := map[string]map[string]map[string]any{}
+		for _, h := range hosts {
+			vars := map[string]string{}
+			for _, v := range hostVars(h, opts) {
+				vars[v[0]] = v[1]
+			}
+			hostMap[h.Alias] = vars
+			for _, g := range h.Groups {
+				if children[g] == nil {
+					children[g] = map[string]map[string]any{"hosts": {}}
+				}
+				children[g]["hosts"][h.Alias] = nil
+			}
+		}
+		all["hosts"] = hostMap
+		if len(children) > 0 {
+			all["children"] = children
+		}
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(map[string]any{"all": all}); err != nil {
+		return fmt.Errorf("encoding ansible inventory: %w", err)
+	}
+	return enc.Close()
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,92 @@
+package instance
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func exportTestInstances() []Instance {
+	return []Instance{
+		{OCID: "ocid1.instance.oc1..web", DisplayName: "Web 1", Hostname: "web1", PrimaryIP: "10.0.0.5", ImageOS: "Oracle Linux", State: "RUNNING", FreeformTags: map[string]string{"env": "prod", "role": "web"}},
+		{OCID: "ocid1.instance.oc1..db", DisplayName: "db-1", PrimaryIP: "10.0.1.7", ImageOS: "Canonical Ubuntu", State: "STOPPED", FreeformTags: map[string]string{"env": "prod"}},
+		{OCID: "ocid1.instance.oc1..gone", DisplayName: "gone", PrimaryIP: "10.0.0.9", State: "TERMINATED"},
+		{OCID: "ocid1.instance.oc1..nic", DisplayName: "no-ip", State: "PROVISIONING"},
+	}
+}
+
+func TestWriteExport_SSHConfig(t *testing.T) {
+	var buf bytes.Buffer
+	opts := ExportOptions{Format: ExportSSHConfig, IdentityFile: "~/.ssh/oci", BastionSession: "ocid1.bastionsession.oc1.iad.aaaa", Region: "us-ashburn-1", BastionTargetID: "ocid1.instance.oc1..web"}
+	require.NoError(t, WriteExport(&buf, exportTestInstances(), opts))
+	out := buf.String()
+
+	assert.Contains(t, out, "Host web1\n    HostName 10.0.0.5\n    User opc\n    IdentityFile ~/.ssh/oci\n    ProxyCommand ssh -i ~/.ssh/oci -W %h:%p -p 22 ocid1.bastionsession.oc1.iad.aaaa@host.bastion.us-ashburn-1.oci.oraclecloud.com\n")
+	assert.Contains(t, out, "Host db-1\n    HostName 10.0.1.7\n    User ubuntu\n    IdentityFile ~/.ssh/oci\n")
+	assert.Equal(t, 1, strings.Count(out, "ProxyCommand"), "only the session's target goes through the bastion")
+	assert.NotContains(t, out, "gone", "terminated instances are left out")
+	assert.NotContains(t, out, "no-ip", "instances without an IP are left out")
+}
+
+func TestWriteExport_AnsibleINI(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteExport(&buf, exportTestInstances(), ExportOptions{Format: ExportAnsibleINI, User: "admin"}))
+	out := buf.String()
+
+	assert.Contains(t, out, "web1 ansible_host=10.0.0.5 ansible_user=admin\n")
+	assert.Contains(t, out, "[env_prod]\ndb-1\nweb1\n")
+	assert.Contains(t, out, "[role_web]\nweb1\n")
+}
+
+func TestWriteExport_AnsibleYAML(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteExport(&buf, exportTestInstances(), ExportOptions{Format: ExportAnsibleYAML}))
+
+	var inv struct {
+		All struct {
+			Hosts    map[string]map[string]string `yaml:"hosts"`
+			Children map[string]struct {
+				Hosts map[string]any `yaml:"hosts"`
+			} `yaml:"children"`
+		} `yaml:"all"`
+	}
+	require.NoError(t, yaml.Unmarshal(buf.Bytes(), &inv))
+	assert.Equal(t, "10.0.0.5", inv.All.Hosts["web1"]["ansible_host"])
+	assert.Equal(t, "ubuntu", inv.All.Hosts["db-1"]["ansible_user"])
+	assert.Len(t, inv.All.Children["env_prod"].Hosts, 2)
+}
+
+func TestWriteExport_BastionSessionTargetByIP(t *testing.T) {
+	var buf bytes.Buffer
+	opts := ExportOptions{Format: ExportAnsibleINI, BastionSession: "ocid1.bastionsession.oc1.iad.aaaa", Region: "us-ashburn-1", BastionTargetIP: "10.0.1.7"}
+	require.NoError(t, WriteExport(&buf, exportTestInstances(), opts))
+	out := buf.String()
+
+	assert.Contains(t, out, "db-1 ansible_host=10.0.1.7 ansible_user=ubuntu ansible_ssh_common_args=")
+	assert.Contains(t, out, "web1 ansible_host=10.0.0.5 ansible_user=opc\n")
+}
+
+func TestWriteExport_BastionSessionTargetNotExported(t *testing.T) {
+	opts := ExportOptions{Format: ExportSSHConfig, BastionSession: "ocid1.bastionsession.oc1.iad.aaaa", BastionTargetID: "ocid1.instance.oc1..gone"}
+	err := WriteExport(&bytes.Buffer{}, exportTestInstances(), opts)
+	assert.ErrorContains(t, err, "does not target any exported instance")
+}
+
+func TestWriteExport_UnknownFormat(t *testing.T) {
+	err := WriteExport(&bytes.Buffer{}, nil, ExportOptions{Format: "csv"})
+	assert.ErrorContains(t, err, "unknown export format")
+}
+
+func TestExportHosts_DeduplicatesAliases(t *testing.T) {
+	hosts := exportHosts([]Instance{
+		{DisplayName: "app", PrimaryIP: "10.0.0.1"},
+		{DisplayName: "app", PrimaryIP: "10.0.0.2"},
+	}, "")
+	require.Len(t, hosts, 2)
+	assert.Equal(t, "app", hosts[0].Alias)
+	assert.Equal(t, "app-2", hosts[1].Alias)
+}
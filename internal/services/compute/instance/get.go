@@ -5,34 +5,40 @@ import (
 	"fmt"
 
 	"github.com/cnopslabs/ocloud/internal/app"
-	"github.com/cnopslabs/ocloud/internal/oci"
-	ociInst "github.com/cnopslabs/ocloud/internal/oci/compute/instance"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/watch"
 )
 
-// GetInstances retrieves and displays a paginated list of instances.
-func GetInstances(appCtx *app.ApplicationContext, useJSON bool, limit, page int, showDetails bool) error {
-	computeClient, err := oci.NewComputeClient(appCtx.Provider)
-	if err != nil {
-		return fmt.Errorf("creating compute client: %w", err)
+// GetInstances retrieves and displays a paginated list of instances. With
+// showDetails set, it also shows their boot and block volumes and the secondary
+// private IPs of their VNICs, and with showMetadata set, their decoded launch
+// metadata and agent plugins.
+func GetInstances(appCtx *app.ApplicationContext, format printer.OutputFormat, limit, page int, showDetails, showMetadata bool) error {
+	newService := newServiceFromAppContext
+	if showMetadata {
+		newService = newMetadataService
 	}
-	networkClient, err := oci.NewNetworkClient(appCtx.Provider)
+	service, err := newService(appCtx, showDetails)
 	if err != nil {
-		return fmt.Errorf("creating network client: %w", err)
+		return fmt.Errorf("creating instance service: %w", err)
 	}
 
-	instanceAdapter := ociInst.NewAdapter(computeClient, networkClient)
-	service := NewService(instanceAdapter, appCtx.Logger, appCtx.CompartmentID)
-
-	instances, totalCount, nextPageToken, err := service.FetchPaginatedInstances(context.Background(), limit, page)
-	if err != nil {
-		return fmt.Errorf("listing instances: %w", err)
-	}
+	return util.Watch(context.Background(), appCtx, func(ctx context.Context) ([]watch.State, error) {
+		instances, totalCount, nextPageToken, err := service.FetchPaginatedInstances(ctx, limit, page)
+		if err != nil {
+			return nil, fmt.Errorf("listing instances: %w", err)
+		}
+		if showMetadata {
+			service.LoadAgentPlugins(ctx, instances)
+		}
 
-	return PrintInstancesInfo(instances, appCtx, &util.PaginationInfo{
-		CurrentPage:   page,
-		TotalCount:    totalCount,
-		Limit:         limit,
-		NextPageToken: nextPageToken,
-	}, useJSON, showDetails)
+		err = PrintInstancesInfo(instances, appCtx, &util.PaginationInfo{
+			CurrentPage:   page,
+			TotalCount:    totalCount,
+			Limit:         limit,
+			NextPageToken: nextPageToken,
+		}, format, showDetails, showMetadata)
+		return util.WatchStates(instances, watchState), err
+	})
 }
@@ -6,28 +6,23 @@ import (
 	"fmt"
 
 	"github.com/cnopslabs/ocloud/internal/app"
-	"github.com/cnopslabs/ocloud/internal/oci"
 	ociInst "github.com/cnopslabs/ocloud/internal/oci/compute/instance"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/services/util"
 	"github.com/cnopslabs/ocloud/internal/tui"
+	"github.com/cnopslabs/ocloud/internal/watch"
 )
 
-// ListInstances lists instances in a formatted table or JSON format.
-func ListInstances(appCtx *app.ApplicationContext, useJSON bool) error {
+// ListInstances lets the user pick an instance interactively and displays it.
+// With showStorage, the boot volume and block volume attachments are included.
+func ListInstances(appCtx *app.ApplicationContext, format printer.OutputFormat, showStorage bool) error {
 
 	ctx := context.Background()
 
-	computeClient, err := oci.NewComputeClient(appCtx.Provider)
+	service, err := newServiceFromAppContext(appCtx, showStorage)
 	if err != nil {
-		return fmt.Errorf("creating compute client: %w", err)
+		return fmt.Errorf("creating instance service: %w", err)
 	}
-
-	networkClient, err := oci.NewNetworkClient(appCtx.Provider)
-	if err != nil {
-		return fmt.Errorf("creating network client: %w", err)
-	}
-
-	imageAdapter := ociInst.NewAdapter(computeClient, networkClient)
-	service := NewService(imageAdapter, appCtx.Logger, appCtx.CompartmentID)
 	allInstances, err := service.ListInstances(ctx)
 
 	if err != nil {
@@ -35,7 +30,8 @@ func ListInstances(appCtx *app.ApplicationContext, useJSON bool) error {
 	}
 
 	//TUI
-	model := ociInst.NewImageListModel(allInstances)
+	model := ociInst.NewImageListModel(allInstances).
+		WithQueryFilter(ToSearchableInstances(allInstances), GetSearchableFields(), GetBoostedFields())
 	id, err := tui.Run(model)
 	if err != nil {
 		if errors.Is(err, tui.ErrCancelled) {
@@ -44,11 +40,13 @@ func ListInstances(appCtx *app.ApplicationContext, useJSON bool) error {
 		return fmt.Errorf("selecting instance: %w", err)
 	}
 
-	instance, err := service.instanceRepo.GetEnrichedInstance(ctx, id)
-
-	if err != nil {
-		return fmt.Errorf("getting instance: %w", err)
-	}
+	return util.Watch(ctx, appCtx, func(ctx context.Context) ([]watch.State, error) {
+		instance, err := service.instanceRepo.GetEnrichedInstance(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("getting instance: %w", err)
+		}
 
-	return PrintInstanceInfo(instance, appCtx, useJSON, true)
+		err = PrintInstanceInfo(instance, appCtx, format, true)
+		return []watch.State{watchState(*instance)}, err
+	})
 }
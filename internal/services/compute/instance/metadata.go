@@ -0,0 +1,161 @@
+package instance
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/oci"
+	ociInst "github.com/cnopslabs/ocloud/internal/oci/compute/instance"
+	"github.com/cnopslabs/ocloud/internal/region"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// userDataKey is the metadata key holding the base64-encoded cloud-init user data.
+	userDataKey = "user_data"
+	// sshKeysKey is the metadata key holding the authorized public keys, one per line.
+	sshKeysKey = "ssh_authorized_keys"
+)
+
+// InstanceMetadata is the launch metadata and agent state of an instance, as
+// shown by get --metadata.
+type InstanceMetadata struct {
+	UserData string `json:"UserData,omitempty"`
+	// UserDataError is set instead of UserData when user_data could not be decoded.
+	UserDataError    string                 `json:"UserDataError,omitempty"`
+	SSHKeys          []SSHKey               `json:"SSHKeys,omitempty"`
+	Metadata         map[string]string      `json:"Metadata,omitempty"`
+	ExtendedMetadata map[string]interface{} `json:"ExtendedMetadata,omitempty"`
+	LaunchMode       string                 `json:"LaunchMode,omitempty"`
+	LaunchOptions    *compute.LaunchOptions `json:"LaunchOptions,omitempty"`
+	AgentConfig      *compute.AgentConfig   `json:"AgentConfig,omitempty"`
+	AgentPlugins     []compute.AgentPlugin  `json:"AgentPlugins,omitempty"`
+}
+
+// SSHKey describes one entry of ssh_authorized_keys.
+type SSHKey struct {
+	Type        string `json:"Type,omitempty"`
+	Fingerprint string `json:"Fingerprint,omitempty"`
+	Comment     string `json:"Comment,omitempty"`
+	Error       string `json:"Error,omitempty"`
+}
+
+// NewInstanceMetadata decodes the metadata of inst. Keys other than user_data
+// and ssh_authorized_keys are kept as they are.
+func NewInstanceMetadata(inst Instance) InstanceMetadata {
+	md := InstanceMetadata{
+		ExtendedMetadata: inst.ExtendedMetadata,
+		LaunchMode:       inst.LaunchMode,
+		LaunchOptions:    inst.LaunchOptions,
+		AgentConfig:      inst.AgentConfig,
+		AgentPlugins:     inst.AgentPlugins,
+	}
+	for k, v := range inst.Metadata {
+		switch k {
+		case userDataKey:
+			userData, err := DecodeUserData(v)
+			if err != nil {
+				md.UserDataError = err.Error()
+			} else {
+				md.UserData = userData
+			}
+		case sshKeysKey:
+			md.SSHKeys = ParseSSHKeys(v)
+		default:
+			if md.Metadata == nil {
+				md.Metadata = map[string]string{}
+			}
+			md.Metadata[k] = v
+		}
+	}
+	return md
+}
+
+// DecodeUserData decodes base64-encoded user data, decompressing it when it is gzipped.
+func DecodeUserData(encoded string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return "", fmt.Errorf("decoding user_data: %w", err)
+	}
+	if len(data) > 1 && data[0] == 0x1f && data[1] == 0x8b {
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return "", fmt.Errorf("decompressing user_data: %w", err)
+		}
+		defer func() { _ = zr.Close() }()
+		if data, err = io.ReadAll(zr); err != nil {
+			return "", fmt.Errorf("decompressing user_data: %w", err)
+		}
+	}
+	return string(data), nil
+}
+
+// ParseSSHKeys returns the type, SHA256 fingerprint and comment of each key in
+// an authorized_keys value. Blank lines and comments are skipped; keys that do
+// not parse are reported with an error.
+func ParseSSHKeys(authorizedKeys string) []SSHKey {
+	var keys []SSHKey
+	for _, line := range strings.Split(authorizedKeys, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		pub, comment, _, _, err := ssh.ParseAuthorizedKey([]byte(line))
+		if err != nil {
+			keys = append(keys, SSHKey{Error: err.Error()})
+			continue
+		}
+		keys = append(keys, SSHKey{Type: pub.Type(), Fingerprint: ssh.FingerprintSHA256(pub), Comment: comment})
+	}
+	return keys
+}
+
+// newMetadataService builds a Service whose instances keep their launch metadata
+// and that can also read their Oracle Cloud Agent plugins; details is as for
+// newServiceFromAppContext. Its listings bypass the resource cache.
+func newMetadataService(appCtx *app.ApplicationContext, details bool) (*Service, error) {
+	repo, err := newRepository(appCtx, details, true)
+	if err != nil {
+		return nil, err
+	}
+	service := NewService(repo, appCtx.Logger, appCtx.CompartmentID)
+	service.indexStore = appCtx.Cache
+	service.agent, err = region.NewInstanceAgent(appCtx.Regions, func(name string) (compute.InstanceAgent, error) {
+		pluginClient, err := oci.NewPluginClient(appCtx.ForRegion(name).Provider)
+		if err != nil {
+			return nil, err
+		}
+		return ociInst.NewAgentAdapter(pluginClient), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service, nil
+}
+
+// LoadAgentPlugins sets the AgentPlugins of each instance. Instances whose agent
+// cannot be read, e.g. because it is not running, are left without plugins.
+func (s *Service) LoadAgentPlugins(ctx context.Context, instances []Instance) {
+	for i := range instances {
+		compartmentID := instances[i].CompartmentID
+		if compartmentID == "" {
+			compartmentID = s.compartmentID
+		}
+		plugins, err := s.agent.ListAgentPlugins(ctx, compartmentID, instances[i].OCID)
+		if err != nil {
+			logger.LogWithLevel(s.logger, logger.Debug, "skipping agent plugins", "id", instances[i].OCID, "error", err)
+			continue
+		}
+		sort.Slice(plugins, func(a, b int) bool { return plugins[a].Name < plugins[b].Name })
+		instances[i].AgentPlugins = plugins
+	}
+}
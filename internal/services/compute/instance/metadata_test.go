@@ -0,0 +1,103 @@
+package instance
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/logger"
+)
+
+const cloudConfig = "#cloud-config\npackages:\n  - nginx\n"
+
+func TestDecodeUserData(t *testing.T) {
+	got, err := DecodeUserData(base64.StdEncoding.EncodeToString([]byte(cloudConfig)))
+	require.NoError(t, err)
+	assert.Equal(t, cloudConfig, got)
+
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	_, _ = zw.Write([]byte(cloudConfig))
+	require.NoError(t, zw.Close())
+	got, err = DecodeUserData(base64.StdEncoding.EncodeToString(gz.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, cloudConfig, got, "gzipped user data is decompressed")
+
+	_, err = DecodeUserData("not base64!")
+	assert.ErrorContains(t, err, "decoding user_data")
+}
+
+func TestParseSSHKeys(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	sshPub, err := ssh.NewPublicKey(pub)
+	require.NoError(t, err)
+	line := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPub))) + " alice@laptop"
+
+	keys := ParseSSHKeys(line + "\n\n# retired key\nssh-rsa garbage\n")
+	require.Len(t, keys, 2)
+	assert.Equal(t, "ssh-ed25519", keys[0].Type)
+	assert.Equal(t, ssh.FingerprintSHA256(sshPub), keys[0].Fingerprint)
+	assert.Equal(t, "alice@laptop", keys[0].Comment)
+	assert.NotEmpty(t, keys[1].Error)
+}
+
+func TestNewInstanceMetadata(t *testing.T) {
+	inst := Instance{
+		Metadata: map[string]string{
+			"user_data":           base64.StdEncoding.EncodeToString([]byte(cloudConfig)),
+			"ssh_authorized_keys": "",
+			"hostclass":           "web",
+		},
+		LaunchMode:  "PARAVIRTUALIZED",
+		AgentConfig: &compute.AgentConfig{PluginsConfig: map[string]string{"Bastion": "ENABLED"}},
+	}
+
+	md := NewInstanceMetadata(inst)
+	assert.Equal(t, cloudConfig, md.UserData)
+	assert.Empty(t, md.SSHKeys)
+	assert.Equal(t, map[string]string{"hostclass": "web"}, md.Metadata, "decoded keys are not repeated")
+	assert.Equal(t, "PARAVIRTUALIZED", md.LaunchMode)
+	assert.Same(t, inst.AgentConfig, md.AgentConfig)
+}
+
+// fakeAgent reports plugins per instance and fails for instances it does not know.
+type fakeAgent struct {
+	plugins      map[string][]compute.AgentPlugin
+	compartments []string
+}
+
+func (f *fakeAgent) ListAgentPlugins(ctx context.Context, compartmentID, instanceID string) ([]compute.AgentPlugin, error) {
+	f.compartments = append(f.compartments, compartmentID)
+	plugins, ok := f.plugins[instanceID]
+	if !ok {
+		return nil, errors.New("agent not reachable")
+	}
+	return plugins, nil
+}
+
+func TestLoadAgentPlugins(t *testing.T) {
+	agent := &fakeAgent{plugins: map[string][]compute.AgentPlugin{
+		"i1": {{Name: "Vulnerability Scanning", Status: "STOPPED"}, {Name: "Bastion", Status: "RUNNING"}},
+	}}
+	service := NewService(nil, logger.NewTestLogger(), "ocid1.compartment.oc1..root")
+	service.agent = agent
+
+	instances := []Instance{{OCID: "i1", CompartmentID: "ocid1.compartment.oc1..child"}, {OCID: "i2"}}
+	service.LoadAgentPlugins(context.Background(), instances)
+
+	require.Len(t, instances[0].AgentPlugins, 2)
+	assert.Equal(t, "Bastion", instances[0].AgentPlugins[0].Name, "plugins are sorted by name")
+	assert.Nil(t, instances[1].AgentPlugins, "an unreachable agent is skipped")
+	assert.Equal(t, []string{"ocid1.compartment.oc1..child", "ocid1.compartment.oc1..root"}, agent.compartments)
+}
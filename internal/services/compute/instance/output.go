@@ -2,6 +2,7 @@ package instance
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/cnopslabs/ocloud/internal/app"
@@ -12,30 +13,35 @@ import (
 
 // InstanceOutput defines the structure for the JSON output of an instance.
 type InstanceOutput struct {
-	Name              string                 `json:"Name"`
-	ID                string                 `json:"ID"`
-	IP                string                 `json:"IP"`
-	ImageID           string                 `json:"ImageID"`
-	SubnetID          string                 `json:"SubnetID"`
-	Shape             string                 `json:"Shape"`
-	State             string                 `json:"State"`
-	CreatedAt         time.Time              `json:"CreatedAt"`
-	Placement         Placement              `json:"Placement"`
-	Resources         Resources              `json:"Resources"`
-	ImageName         string                 `json:"ImageName,omitempty"`
-	ImageOS           string                 `json:"ImageOS,omitempty"`
-	InstanceTags      map[string]interface{} `json:"InstanceTags"`
-	Hostname          string                 `json:"Hostname,omitempty"`
-	SubnetName        string                 `json:"SubnetName,omitempty"`
-	VcnID             string                 `json:"VcnID,omitempty"`
-	VcnName           string                 `json:"VcnName,omitempty"`
-	PrivateDNSEnabled bool                   `json:"PrivateDNSEnabled,omitempty"`
-	RouteTableID      string                 `json:"RouteTableID,omitempty"`
-	RouteTableName    string                 `json:"RouteTableName,omitempty"`
-	SecurityListIDs   []string               `json:"SecurityListIDs,omitempty"`
-	SecurityListNames []string               `json:"SecurityListNames,omitempty"`
-	NsgIDs            []string               `json:"NsgIDs,omitempty"`
-	NsgNames          []string               `json:"NsgNames,omitempty"`
+	Name              string                     `json:"Name"`
+	ID                string                     `json:"ID"`
+	IP                string                     `json:"IP"`
+	ImageID           string                     `json:"ImageID"`
+	SubnetID          string                     `json:"SubnetID"`
+	Shape             string                     `json:"Shape"`
+	State             string                     `json:"State"`
+	CreatedAt         time.Time                  `json:"CreatedAt"`
+	Placement         Placement                  `json:"Placement"`
+	Resources         Resources                  `json:"Resources"`
+	ImageName         string                     `json:"ImageName,omitempty"`
+	ImageOS           string                     `json:"ImageOS,omitempty"`
+	InstanceTags      map[string]interface{}     `json:"InstanceTags"`
+	Hostname          string                     `json:"Hostname,omitempty"`
+	SubnetName        string                     `json:"SubnetName,omitempty"`
+	VcnID             string                     `json:"VcnID,omitempty"`
+	VcnName           string                     `json:"VcnName,omitempty"`
+	PrivateDNSEnabled bool                       `json:"PrivateDNSEnabled,omitempty"`
+	RouteTableID      string                     `json:"RouteTableID,omitempty"`
+	RouteTableName    string                     `json:"RouteTableName,omitempty"`
+	SecurityListIDs   []string                   `json:"SecurityListIDs,omitempty"`
+	SecurityListNames []string                   `json:"SecurityListNames,omitempty"`
+	NsgIDs            []string                   `json:"NsgIDs,omitempty"`
+	NsgNames          []string                   `json:"NsgNames,omitempty"`
+	PublicIP          string                     `json:"PublicIP,omitempty"`
+	Vnics             []compute.Vnic             `json:"Vnics,omitempty"`
+	BootVolume        *compute.BootVolume        `json:"BootVolume,omitempty"`
+	BlockVolumes      []compute.VolumeAttachment `json:"BlockVolumes,omitempty"`
+	Metadata          *InstanceMetadata          `json:"Metadata,omitempty"`
 }
 
 // Placement represents the location of an instance.
@@ -52,14 +58,15 @@ type Resources struct {
 }
 
 // PrintInstancesInfo displays instances in a formatted table or JSON format.
-func PrintInstancesInfo(instances []compute.Instance, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, useJSON bool, showImageDetails bool) error {
+// showMetadata adds the decoded launch metadata, launch options and agent state.
+func PrintInstancesInfo(instances []compute.Instance, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, format printer.OutputFormat, showImageDetails, showMetadata bool) error {
 	p := printer.New(appCtx.Stdout)
 
 	if pagination != nil {
 		util.AdjustPaginationInfo(pagination)
 	}
 
-	if useJSON {
+	if !format.IsTable() {
 		outputInstances := make([]InstanceOutput, len(instances))
 		for i, inst := range instances {
 			outputInstances[i] = InstanceOutput{
@@ -97,9 +104,17 @@ func PrintInstancesInfo(instances []compute.Instance, appCtx *app.ApplicationCon
 				SecurityListNames: inst.SecurityListNames,
 				NsgIDs:            inst.NsgIDs,
 				NsgNames:          inst.NsgNames,
+				PublicIP:          inst.PublicIP,
+				Vnics:             inst.Vnics,
+				BootVolume:        inst.BootVolume,
+				BlockVolumes:      inst.BlockVolumes,
+			}
+			if showMetadata {
+				md := NewInstanceMetadata(inst)
+				outputInstances[i].Metadata = &md
 			}
 		}
-		return util.MarshalDataToJSONResponse(p, outputInstances, pagination)
+		return util.MarshalScopedResponse(p, format, appCtx, outputInstances, func(o InstanceOutput) string { return o.ID }, pagination)
 	}
 
 	if util.ValidateAndReportEmpty(instances, pagination, appCtx.Stdout) {
@@ -166,24 +181,39 @@ func PrintInstancesInfo(instances []compute.Instance, appCtx *app.ApplicationCon
 				imageKeys = append(imageKeys, fmt.Sprintf("  NSG %d", i+1))
 			}
 			orderedKeys = append(orderedKeys, imageKeys...)
+			orderedKeys = append(orderedKeys, addNetworkDetails(instanceData, &instance)...)
+
+			// Storage is only known when the listing asked for it
+			if instance.BootVolume != nil {
+				orderedKeys = append(orderedKeys, addStorageDetails(instanceData, &instance)...)
+			}
+		}
+
+		var md InstanceMetadata
+		if showMetadata {
+			md = NewInstanceMetadata(instance)
+			orderedKeys = append(orderedKeys, addMetadataDetails(instanceData, md)...)
 		}
 
-		title := util.FormatColoredTitle(appCtx, instance.DisplayName)
+		title := util.FormatColoredResourceTitle(appCtx, instance.OCID, instance.DisplayName)
 		p.PrintKeyValues(title, instanceData, orderedKeys)
+		if md.UserData != "" {
+			_, _ = fmt.Fprintf(appCtx.Stdout, "%s\n%s\n", util.FormatColoredTitle(appCtx, "User Data: "+instance.DisplayName), strings.TrimRight(md.UserData, "\n"))
+		}
 	}
 
 	util.LogPaginationInfo(pagination, appCtx)
 	return nil
 }
 
-func PrintInstanceInfo(instance *compute.Instance, appCtx *app.ApplicationContext, useJSON bool, showDetails bool) error {
+func PrintInstanceInfo(instance *compute.Instance, appCtx *app.ApplicationContext, format printer.OutputFormat, showDetails bool) error {
 	p := printer.New(appCtx.Stdout)
 
 	if instance == nil {
 		return fmt.Errorf("instance is nil")
 	}
 
-	if useJSON {
+	if !format.IsTable() {
 		out := InstanceOutput{
 			Name:      instance.DisplayName,
 			ID:        instance.OCID,
@@ -219,8 +249,12 @@ func PrintInstanceInfo(instance *compute.Instance, appCtx *app.ApplicationContex
 			SecurityListNames: instance.SecurityListNames,
 			NsgIDs:            instance.NsgIDs,
 			NsgNames:          instance.NsgNames,
+			PublicIP:          instance.PublicIP,
+			Vnics:             instance.Vnics,
+			BootVolume:        instance.BootVolume,
+			BlockVolumes:      instance.BlockVolumes,
 		}
-		return p.MarshalToJSON(out)
+		return p.Marshal(format, out)
 	}
 
 	instanceData := map[string]string{
@@ -282,10 +316,217 @@ func PrintInstanceInfo(instance *compute.Instance, appCtx *app.ApplicationContex
 			imageKeys = append(imageKeys, fmt.Sprintf("  NSG %d", i+1))
 		}
 		orderedKeys = append(orderedKeys, imageKeys...)
+		orderedKeys = append(orderedKeys, addNetworkDetails(instanceData, instance)...)
+
+		// Storage is only known when the lookup asked for it
+		if instance.BootVolume != nil {
+			orderedKeys = append(orderedKeys, addStorageDetails(instanceData, instance)...)
+		}
 	}
 
-	title := util.FormatColoredTitle(appCtx, instance.DisplayName)
+	title := util.FormatColoredResourceTitle(appCtx, instance.OCID, instance.DisplayName)
 	p.PrintKeyValues(title, instanceData, orderedKeys)
 
 	return nil
 }
+
+// addNetworkDetails adds the public IP and one row per VNIC and per private IP
+// of instance to data and returns their keys in display order.
+func addNetworkDetails(data map[string]string, instance *compute.Instance) []string {
+	var keys []string
+	if instance.PublicIP != "" {
+		data["Public IP"] = instance.PublicIP
+		keys = append(keys, "Public IP")
+	}
+	if len(instance.Vnics) == 0 {
+		return keys
+	}
+
+	data["VNICs"] = fmt.Sprintf("%d attached", len(instance.Vnics))
+	keys = append(keys, "VNICs")
+	for i, v := range instance.Vnics {
+		key := fmt.Sprintf("  VNIC %d", i+1)
+		desc := fmt.Sprintf("%s in %s", v.Name, v.SubnetName)
+		if v.IsPrimary {
+			desc += " (primary)"
+		}
+		data[key] = desc
+		keys = append(keys, key)
+
+		ips := append([]compute.PrivateIP{{IP: v.PrivateIP, PublicIP: v.PublicIP, PublicIPLifetime: v.PublicIPLifetime}}, v.SecondaryIPs...)
+		for j, ip := range ips {
+			ipKey := fmt.Sprintf("  VNIC %d IP %d", i+1, j+1)
+			data[ipKey] = formatPrivateIP(ip)
+			keys = append(keys, ipKey)
+		}
+		if !v.IsPrimary && len(v.NsgNames) > 0 {
+			nsgKey := fmt.Sprintf("  VNIC %d NSGs", i+1)
+			data[nsgKey] = strings.Join(v.NsgNames, ", ")
+			keys = append(keys, nsgKey)
+		}
+	}
+	return keys
+}
+
+// addMetadataDetails adds the launch mode and options, SSH key fingerprints,
+// remaining metadata keys and agent state of md to data and returns their keys
+// in display order. The user data is printed separately as it spans lines.
+func addMetadataDetails(data map[string]string, md InstanceMetadata) []string {
+	data["Launch Mode"] = md.LaunchMode
+	keys := []string{"Launch Mode"}
+	keys = append(keys, addLaunchOptions(data, md.LaunchOptions)...)
+
+	switch {
+	case md.UserDataError != "":
+		data["User Data"] = md.UserDataError
+	case md.UserData != "":
+		data["User Data"] = fmt.Sprintf("%d bytes, shown below", len(md.UserData))
+	default:
+		data["User Data"] = "None"
+	}
+	keys = append(keys, "User Data")
+
+	if len(md.SSHKeys) == 0 {
+		data["SSH Keys"] = "None"
+	} else {
+		data["SSH Keys"] = fmt.Sprintf("%d authorized", len(md.SSHKeys))
+	}
+	keys = append(keys, "SSH Keys")
+	for i, k := range md.SSHKeys {
+		key := fmt.Sprintf("  SSH Key %d", i+1)
+		if k.Error != "" {
+			data[key] = "invalid: " + k.Error
+		} else {
+			data[key] = strings.TrimSpace(fmt.Sprintf("%s %s %s", k.Fingerprint, k.Type, k.Comment))
+		}
+		keys = append(keys, key)
+	}
+
+	for _, k := range sortedKeys(md.Metadata) {
+		key := "  Metadata " + k
+		data[key] = md.Metadata[k]
+		keys = append(keys, key)
+	}
+	for _, k := range sortedKeys(md.ExtendedMetadata) {
+		key := "  Extended Metadata " + k
+		data[key] = fmt.Sprint(md.ExtendedMetadata[k])
+		keys = append(keys, key)
+	}
+
+	data["Agent"] = formatAgentConfig(md.AgentConfig)
+	keys = append(keys, "Agent")
+	if len(md.AgentPlugins) == 0 {
+		data["Agent Plugins"] = "Unavailable"
+		return append(keys, "Agent Plugins")
+	}
+	data["Agent Plugins"] = fmt.Sprintf("%d reported", len(md.AgentPlugins))
+	keys = append(keys, "Agent Plugins")
+	for _, plugin := range md.AgentPlugins {
+		key := "  Plugin " + plugin.Name
+		status := plugin.Status
+		if md.AgentConfig != nil {
+			if desired, ok := md.AgentConfig.PluginsConfig[plugin.Name]; ok {
+				status += fmt.Sprintf(" (desired %s)", desired)
+			}
+		}
+		data[key] = status
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// addLaunchOptions adds one row per launch option to data and returns their keys.
+func addLaunchOptions(data map[string]string, o *compute.LaunchOptions) []string {
+	if o == nil {
+		data["Launch Options"] = "None"
+		return []string{"Launch Options"}
+	}
+	rows := [][2]string{
+		{"  Firmware", o.Firmware},
+		{"  Boot Volume Type", o.BootVolumeType},
+		{"  Network Type", o.NetworkType},
+		{"  Data Volume Type", o.RemoteDataVolumeType},
+		{"  PV Encryption", onOff(o.IsPvEncryptionInTransitEnabled)},
+		{"  Volume Naming", onOff(o.IsConsistentVolumeNamingEnabled)},
+	}
+	data["Launch Options"] = ""
+	keys := []string{"Launch Options"}
+	for _, r := range rows {
+		data[r[0]] = r[1]
+		keys = append(keys, r[0])
+	}
+	return keys
+}
+
+// formatAgentConfig renders the Oracle Cloud Agent switches in one line.
+func formatAgentConfig(c *compute.AgentConfig) string {
+	if c == nil {
+		return "None"
+	}
+	if c.AreAllPluginsDisabled {
+		return "all plugins disabled"
+	}
+	return fmt.Sprintf("monitoring %s, management %s", onOff(!c.IsMonitoringDisabled), onOff(!c.IsManagementDisabled))
+}
+
+func onOff(b bool) string {
+	if b {
+		return "on"
+	}
+	return "off"
+}
+
+// formatPrivateIP renders a private IP with the public IP mapped to it, if any.
+func formatPrivateIP(ip compute.PrivateIP) string {
+	if ip.PublicIP == "" {
+		return ip.IP
+	}
+	if ip.PublicIPLifetime == "" {
+		return fmt.Sprintf("%s → %s", ip.IP, ip.PublicIP)
+	}
+	return fmt.Sprintf("%s → %s (%s)", ip.IP, ip.PublicIP, strings.ToLower(ip.PublicIPLifetime))
+}
+
+// addStorageDetails adds the boot volume and block volume rows of instance to
+// data and returns their keys in display order.
+func addStorageDetails(data map[string]string, instance *compute.Instance) []string {
+	bv := instance.BootVolume
+	data["Boot Volume"] = fmt.Sprintf("%s (%d GB, %s, %d VPUs/GB)", bv.Name, bv.SizeGB, bv.PerformanceTier, bv.VpusPerGB)
+	keys := []string{"Boot Volume", "Block Volumes"}
+
+	if len(instance.BlockVolumes) == 0 {
+		data["Block Volumes"] = "None"
+		return keys
+	}
+	data["Block Volumes"] = fmt.Sprintf("%d attached", len(instance.BlockVolumes))
+	for i, v := range instance.BlockVolumes {
+		access := "read/write"
+		if v.ReadOnly {
+			access = "read-only"
+		}
+		device := v.Device
+		if device == "" {
+			device = "no device path"
+		}
+		key := fmt.Sprintf("  Volume %d", i+1)
+		data[key] = fmt.Sprintf("%s: %s, %s, %s, %d GB, %s (%d VPUs/GB)", v.VolumeName, device, v.AttachmentType, access, v.SizeGB, v.PerformanceTier, v.VpusPerGB)
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// PrintActionResults displays the outcome of a power action, one row per instance.
+func PrintActionResults(appCtx *app.ApplicationContext, results []ActionResult, format printer.OutputFormat) error {
+	p := printer.New(appCtx.Stdout)
+	if !format.IsTable() {
+		return util.MarshalDataResponse(p, format, results, nil)
+	}
+
+	headers := []string{"NAME", "ACTION", "STATE", "RESULT"}
+	rows := make([][]string, 0, len(results))
+	for _, r := range results {
+		rows = append(rows, []string{r.Name, r.Action, r.State, r.Result})
+	}
+	p.PrintTableNoTruncate(util.FormatColoredTitle(appCtx, "Instance Actions"), headers, rows)
+	return nil
+}
@@ -0,0 +1,130 @@
+package instance
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
+)
+
+func TestPrintInstanceInfo_Storage(t *testing.T) {
+	inst := &compute.Instance{
+		OCID:        "ocid1.instance.oc1..a",
+		DisplayName: "web-1",
+		State:       "RUNNING",
+		BootVolume:  &compute.BootVolume{Name: "web-1 (Boot Volume)", SizeGB: 50, VpusPerGB: 10, PerformanceTier: "Balanced"},
+		BlockVolumes: []compute.VolumeAttachment{{
+			VolumeName:      "data",
+			Device:          "/dev/oracleoci/oraclevdb",
+			AttachmentType:  "paravirtualized",
+			ReadOnly:        true,
+			SizeGB:          1024,
+			VpusPerGB:       20,
+			PerformanceTier: "Higher Performance",
+		}},
+	}
+
+	var buf bytes.Buffer
+	appCtx := &app.ApplicationContext{Logger: logger.NewTestLogger(), Stdout: &buf}
+
+	require.NoError(t, PrintInstanceInfo(inst, appCtx, printer.TableOutput, true))
+	out := buf.String()
+	assert.Contains(t, out, "web-1 (Boot Volume) (50 GB, Balanced")
+	assert.Contains(t, out, "1 attached")
+	assert.Contains(t, out, "data: /dev/oracleoci/oraclevdb")
+
+	buf.Reset()
+	require.NoError(t, PrintInstanceInfo(inst, appCtx, printer.TableOutput, false))
+	assert.NotContains(t, buf.String(), "Boot Volume", "storage is part of the details shown with --all")
+
+	buf.Reset()
+	require.NoError(t, PrintInstanceInfo(inst, appCtx, printer.JSONOutput, true))
+	var decoded InstanceOutput
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	require.NotNil(t, decoded.BootVolume)
+	assert.Equal(t, int64(50), decoded.BootVolume.SizeGB)
+	require.Len(t, decoded.BlockVolumes, 1)
+	assert.Equal(t, "paravirtualized", decoded.BlockVolumes[0].AttachmentType)
+}
+
+func TestPrintInstanceInfo_Vnics(t *testing.T) {
+	inst := &compute.Instance{
+		OCID:        "ocid1.instance.oc1..b",
+		DisplayName: "gateway-1",
+		PrimaryIP:   "10.0.1.6",
+		PublicIP:    "129.146.10.20",
+		Vnics: []compute.Vnic{
+			{Name: "gateway-1", IsPrimary: true, SubnetName: "public", PrivateIP: "10.0.1.6", PublicIP: "129.146.10.20", PublicIPLifetime: "EPHEMERAL"},
+			{Name: "backend", SubnetName: "private", PrivateIP: "10.0.2.5", NsgNames: []string{"db-clients"}, SecondaryIPs: []compute.PrivateIP{
+				{IP: "10.0.2.6", PublicIP: "150.136.1.1", PublicIPLifetime: "RESERVED"},
+			}},
+		},
+	}
+
+	var buf bytes.Buffer
+	appCtx := &app.ApplicationContext{Logger: logger.NewTestLogger(), Stdout: &buf}
+
+	require.NoError(t, PrintInstanceInfo(inst, appCtx, printer.TableOutput, true))
+	out := buf.String()
+	assert.Contains(t, out, "2 attached")
+	assert.Contains(t, out, "gateway-1 in public (primary)")
+	assert.Contains(t, out, "10.0.1.6 → 129.146.10.20 (ephemeral)")
+	assert.Contains(t, out, "10.0.2.6 → 150.136.1.1 (reserved)")
+	assert.Contains(t, out, "db-clients")
+
+	buf.Reset()
+	require.NoError(t, PrintInstanceInfo(inst, appCtx, printer.JSONOutput, true))
+	var decoded InstanceOutput
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, "129.146.10.20", decoded.PublicIP)
+	require.Len(t, decoded.Vnics, 2)
+	assert.Equal(t, "RESERVED", decoded.Vnics[1].SecondaryIPs[0].PublicIPLifetime)
+}
+
+func TestPrintInstancesInfo_Metadata(t *testing.T) {
+	instances := []compute.Instance{{
+		OCID:        "ocid1.instance.oc1..c",
+		DisplayName: "web-2",
+		Metadata: map[string]string{
+			"user_data": "I2Nsb3VkLWNvbmZpZwpydW5jbWQ6IFtlY2hvIGhpXQo=",
+			"hostclass": "web",
+		},
+		LaunchMode:    "NATIVE",
+		LaunchOptions: &compute.LaunchOptions{Firmware: "UEFI_64", IsPvEncryptionInTransitEnabled: true},
+		AgentConfig:   &compute.AgentConfig{PluginsConfig: map[string]string{"Bastion": "ENABLED"}},
+		AgentPlugins:  []compute.AgentPlugin{{Name: "Bastion", Status: "RUNNING"}},
+	}}
+
+	var buf bytes.Buffer
+	appCtx := &app.ApplicationContext{Logger: logger.NewTestLogger(), Stdout: &buf}
+
+	require.NoError(t, PrintInstancesInfo(instances, appCtx, nil, printer.TableOutput, false, true))
+	out := buf.String()
+	assert.Contains(t, out, "UEFI_64")
+	assert.Contains(t, out, "PV Encryption")
+	assert.Contains(t, out, "RUNNING (desired ENABLED)")
+	assert.Contains(t, out, "runcmd: [echo hi]", "the user data is printed decoded")
+
+	buf.Reset()
+	require.NoError(t, PrintInstancesInfo(instances, appCtx, nil, printer.TableOutput, false, false))
+	assert.NotContains(t, buf.String(), "Launch Options")
+
+	buf.Reset()
+	require.NoError(t, PrintInstancesInfo(instances, appCtx, nil, printer.JSONOutput, false, true))
+	var decoded struct {
+		Items []InstanceOutput `json:"items"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	require.Len(t, decoded.Items, 1)
+	require.NotNil(t, decoded.Items[0].Metadata)
+	assert.Equal(t, "#cloud-config\nruncmd: [echo hi]\n", decoded.Items[0].Metadata.UserData)
+	assert.Equal(t, map[string]string{"hostclass": "web"}, decoded.Items[0].Metadata.Metadata)
+	assert.Equal(t, "RUNNING", decoded.Items[0].Metadata.AgentPlugins[0].Status)
+}
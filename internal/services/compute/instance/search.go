@@ -6,34 +6,32 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
-	"github.com/cnopslabs/ocloud/internal/oci"
-	ociInst "github.com/cnopslabs/ocloud/internal/oci/compute/instance"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/watch"
 )
 
 // SearchInstances queries and retrieves matching instances based on a fuzzy search pattern.
-// It uses OCI clients to fetch instance details and prints results based on the provided flags.
-func SearchInstances(appCtx *app.ApplicationContext, search string, useJSON, showDetails bool) error {
-	computeClient, err := oci.NewComputeClient(appCtx.Provider)
+// It uses OCI clients to fetch instance details and prints results based on the provided flags;
+// showDetails also shows the boot and block volumes and the secondary private IPs of each match.
+func SearchInstances(appCtx *app.ApplicationContext, search string, format printer.OutputFormat, showDetails bool) error {
+	service, err := newServiceFromAppContext(appCtx, showDetails)
 	if err != nil {
-		return fmt.Errorf("creating compute client: %w", err)
-	}
-	networkClient, err := oci.NewNetworkClient(appCtx.Provider)
-	if err != nil {
-		return fmt.Errorf("creating network client: %w", err)
+		return fmt.Errorf("creating instance service: %w", err)
 	}
+	service.indexStore = appCtx.Cache
 
-	instanceAdapter := ociInst.NewAdapter(computeClient, networkClient)
-	service := NewService(instanceAdapter, appCtx.Logger, appCtx.CompartmentID)
+	return util.Watch(context.Background(), appCtx, func(ctx context.Context) ([]watch.State, error) {
+		matchedInstances, err := service.FuzzySearch(ctx, search)
+		if err != nil {
+			return nil, fmt.Errorf("finding instances: %w", err)
+		}
 
-	matchedInstances, err := service.FuzzySearch(context.Background(), search)
-	if err != nil {
-		return fmt.Errorf("finding instances: %w", err)
-	}
-
-	err = PrintInstancesInfo(matchedInstances, appCtx, nil, useJSON, showDetails)
-	if err != nil {
-		return fmt.Errorf("printing instances: %w", err)
-	}
-	logger.LogWithLevel(logger.CmdLogger, logger.Info, "Found matching instances", "search", search, "matched", len(matchedInstances))
-	return nil
+		err = PrintInstancesInfo(matchedInstances, appCtx, nil, format, showDetails, false)
+		if err != nil {
+			return nil, fmt.Errorf("printing instances: %w", err)
+		}
+		logger.LogWithLevel(logger.CmdLogger, logger.Info, "Found matching instances", "search", search, "matched", len(matchedInstances))
+		return util.WatchStates(matchedInstances, watchState), nil
+	})
 }
@@ -22,13 +22,33 @@ func (s SearchableInstance) ToIndexable() map[string]any {
 	securityListNames := strings.Join(s.SecurityListNames, " ")
 	nsgNames := strings.Join(s.NsgNames, " ")
 
+	// Every private and public IP across the VNICs, so that secondary and
+	// reserved addresses find the instance too
+	var ips []string
+	addIP := func(ip string) {
+		if ip != "" {
+			ips = append(ips, ip)
+		}
+	}
+	for _, v := range s.Vnics {
+		addIP(v.PrivateIP)
+		addIP(v.PublicIP)
+		for _, ip := range v.SecondaryIPs {
+			addIP(ip.IP)
+			addIP(ip.PublicIP)
+		}
+	}
+
 	return map[string]any{
 		"Name":          strings.ToLower(s.DisplayName),
 		"Hostname":      strings.ToLower(s.Hostname),
 		"PrimaryIP":     strings.ToLower(s.PrimaryIP),
+		"PublicIP":      strings.ToLower(s.PublicIP),
+		"IPs":           strings.Join(ips, " "),
 		"ImageName":     strings.ToLower(s.ImageName),
 		"ImageOS":       strings.ToLower(s.ImageOS),
 		"Shape":         strings.ToLower(s.Shape),
+		"State":         strings.ToLower(s.State),
 		"OCID":          strings.ToLower(s.OCID),
 		"FD":            strings.ToLower(s.FaultDomain),
 		"AD":            strings.ToLower(s.AvailabilityDomain),
@@ -44,8 +64,8 @@ func (s SearchableInstance) ToIndexable() map[string]any {
 // GetSearchableFields returns the list of fields to be indexed.
 func GetSearchableFields() []string {
 	return []string{
-		"Name", "Hostname", "ImageName", "ImageOS", "Shape",
-		"PrimaryIP", "OCID", "VcnName", "SubnetName", "FD", "AD",
+		"Name", "Hostname", "ImageName", "ImageOS", "Shape", "State",
+		"PrimaryIP", "PublicIP", "IPs", "OCID", "VcnName", "SubnetName", "FD", "AD",
 		"SecurityLists", "NSGs",
 		"TagsKV", "TagsVal",
 	}
@@ -1,9 +1,11 @@
 package instance
 
 import (
+	"context"
 	"testing"
 
 	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
 )
 
@@ -15,6 +17,7 @@ func TestSearchableInstance_ToIndexable_WithSecurityListsAndNSGs(t *testing.T) {
 		ImageName:          "Oracle Linux 8",
 		ImageOS:            "Oracle Linux",
 		Shape:              "VM.Standard3.Flex",
+		State:              "RUNNING",
 		OCID:               "ocid1.instance.oc1..aaa",
 		FaultDomain:        "FAULT-DOMAIN-1",
 		AvailabilityDomain: "AD-1",
@@ -36,6 +39,7 @@ func TestSearchableInstance_ToIndexable_WithSecurityListsAndNSGs(t *testing.T) {
 	require.Equal(t, "oracle linux 8", indexed["ImageName"])
 	require.Equal(t, "oracle linux", indexed["ImageOS"])
 	require.Equal(t, "vm.standard3.flex", indexed["Shape"])
+	require.Equal(t, "running", indexed["State"])
 	require.Equal(t, "ocid1.instance.oc1..aaa", indexed["OCID"])
 	require.Equal(t, "fault-domain-1", indexed["FD"])
 	require.Equal(t, "ad-1", indexed["AD"])
@@ -177,3 +181,37 @@ func TestToSearchableInstances(t *testing.T) {
 	require.Contains(t, indexed1["SecurityLists"].(string), "sl2")
 	require.Contains(t, indexed1["NSGs"].(string), "nsg2")
 }
+
+func TestSearchableInstance_ToIndexable_AllVnicIPs(t *testing.T) {
+	instance := compute.Instance{
+		DisplayName: "gateway-1",
+		PrimaryIP:   "10.0.1.5",
+		PublicIP:    "129.146.10.20",
+		Vnics: []compute.Vnic{
+			{IsPrimary: true, PrivateIP: "10.0.1.5", PublicIP: "129.146.10.20"},
+			{PrivateIP: "10.0.2.5", SecondaryIPs: []compute.PrivateIP{
+				{IP: "10.0.2.6", PublicIP: "150.136.1.1", PublicIPLifetime: "RESERVED"},
+			}},
+		},
+	}
+
+	indexed := SearchableInstance{instance}.ToIndexable()
+	require.Equal(t, "129.146.10.20", indexed["PublicIP"])
+	require.Equal(t, "10.0.1.5 129.146.10.20 10.0.2.5 10.0.2.6 150.136.1.1", indexed["IPs"])
+}
+
+func TestSearchInstances_ByReservedPublicIP(t *testing.T) {
+	instances := []Instance{
+		{OCID: "ocid1.instance.oc1..a", DisplayName: "app-1", PrimaryIP: "10.0.1.5"},
+		{OCID: "ocid1.instance.oc1..b", DisplayName: "gateway-1", PrimaryIP: "10.0.1.6", Vnics: []compute.Vnic{
+			{IsPrimary: true, PrivateIP: "10.0.1.6"},
+			{PrivateIP: "10.0.2.5", SecondaryIPs: []compute.PrivateIP{{IP: "10.0.2.6", PublicIP: "150.136.1.1", PublicIPLifetime: "RESERVED"}}},
+		}},
+	}
+
+	service := NewService(&fakeInstanceRepository{instances: instances}, logr.Discard(), "ocid1.compartment.oc1..test")
+	matches, err := service.FuzzySearch(context.Background(), "150.136.1.1")
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	require.Equal(t, "gateway-1", matches[0].DisplayName)
+}
@@ -4,18 +4,28 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
 	"github.com/cnopslabs/ocloud/internal/domain/compute"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/oci"
+	ociInst "github.com/cnopslabs/ocloud/internal/oci/compute/instance"
+	"github.com/cnopslabs/ocloud/internal/region"
 	"github.com/cnopslabs/ocloud/internal/services/search"
 	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/subtree"
 	"github.com/go-logr/logr"
 )
 
 // Service is the application-layer service, for instance, operations.
 type Service struct {
 	instanceRepo  compute.InstanceRepository
+	controller    compute.InstanceController
+	console       compute.InstanceConsole
+	agent         compute.InstanceAgent
 	logger        logr.Logger
 	compartmentID string
+	indexStore    *cache.Store
 }
 
 // NewService initializes a new Service instance.
@@ -27,6 +37,57 @@ func NewService(repo compute.InstanceRepository, logger logr.Logger, compartment
 	}
 }
 
+// NewServiceFromAppContext creates a Service backed by the OCI adapter and the
+// resource cache of the application context.
+func NewServiceFromAppContext(appCtx *app.ApplicationContext) (*Service, error) {
+	return newServiceFromAppContext(appCtx, false)
+}
+
+// newServiceFromAppContext is NewServiceFromAppContext where, with details set,
+// enriched lookups and listings also report the boot volume and block volume
+// attachments, and listings the secondary private IPs of every VNIC.
+func newServiceFromAppContext(appCtx *app.ApplicationContext, details bool) (*Service, error) {
+	repo, err := newRepository(appCtx, details, false)
+	if err != nil {
+		return nil, err
+	}
+	service := NewService(repo, appCtx.Logger, appCtx.CompartmentID)
+	service.indexStore = appCtx.Cache
+	return service, nil
+}
+
+// newRepository builds the instance repository of appCtx; details is as for
+// newServiceFromAppContext. With metadata set, instances keep their launch
+// metadata and are never cached, since user_data often holds secrets.
+func newRepository(appCtx *app.ApplicationContext, details, metadata bool) (compute.InstanceRepository, error) {
+	return region.NewInstanceRepository(appCtx.Regions, func(name string) (compute.InstanceRepository, error) {
+		regional := appCtx.ForRegion(name)
+		computeClient, err := oci.NewComputeClient(regional.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("creating compute client: %w", err)
+		}
+		networkClient, err := oci.NewNetworkClient(regional.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("creating network client: %w", err)
+		}
+		adapter := ociInst.NewAdapter(computeClient, networkClient)
+		store := regional.Cache
+		if metadata {
+			adapter.WithMetadata()
+			store = nil
+		}
+		if !details {
+			return subtree.NewInstanceRepository(cache.NewInstanceRepository(adapter, store), regional.Subtree), nil
+		}
+		storageClient, err := oci.NewBlockstorageClient(regional.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("creating block storage client: %w", err)
+		}
+		adapter.WithStorage(storageClient).WithVnicDetails()
+		return subtree.NewInstanceRepository(cache.NewInstanceRepositoryWithDetails(adapter, store), regional.Subtree), nil
+	})
+}
+
 // ListInstances retrieves a list of instances.
 func (s *Service) ListInstances(ctx context.Context) ([]Instance, error) {
 	s.logger.V(logger.Debug).Info("listing instances")
@@ -37,6 +98,16 @@ func (s *Service) ListInstances(ctx context.Context) ([]Instance, error) {
 	return instances, nil
 }
 
+// ListEnrichedInstances retrieves all instances with their network and image details.
+func (s *Service) ListEnrichedInstances(ctx context.Context) ([]Instance, error) {
+	s.logger.V(logger.Debug).Info("listing enriched instances")
+	instances, err := s.instanceRepo.ListEnrichedInstances(ctx, s.compartmentID)
+	if err != nil {
+		return nil, fmt.Errorf("listing instances from repository: %w", err)
+	}
+	return instances, nil
+}
+
 // FetchPaginatedInstances retrieves a paginated list of instances.
 func (s *Service) FetchPaginatedInstances(ctx context.Context, limit int, pageNum int) ([]Instance, int, string, error) {
 	s.logger.V(logger.Debug).Info("listing instances", "limit", limit, "pageNum", pageNum)
@@ -54,6 +125,16 @@ func (s *Service) FetchPaginatedInstances(ctx context.Context, limit int, pageNu
 
 // FuzzySearch performs a fuzzy search for instances.
 func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]Instance, error) {
+	matches, err := s.ScoredSearch(ctx, searchPattern)
+	if err != nil {
+		return nil, err
+	}
+	return search.Items(matches), nil
+}
+
+// ScoredSearch performs a fuzzy search for instances and returns the matches,
+// best first, with their relevance scores.
+func (s *Service) ScoredSearch(ctx context.Context, searchPattern string) ([]search.Match[Instance], error) {
 	s.logger.V(logger.Debug).Info("finding instances", "pattern", searchPattern)
 
 	all, err := s.instanceRepo.ListEnrichedInstances(ctx, s.compartmentID)
@@ -63,24 +144,35 @@ func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]Inst
 
 	searchableInstances := ToSearchableInstances(all)
 	indexMapping := search.NewIndexMapping(GetSearchableFields())
-	idx, err := search.BuildIndex(searchableInstances, indexMapping)
+	idx, err := search.OpenIndex(s.indexStore, s.compartmentID, cache.ResourceEnrichedInstances, searchableInstances, indexMapping)
 	if err != nil {
 		return nil, fmt.Errorf("build index: %w", err)
 	}
+	defer func() { _ = idx.Close() }()
 
 	s.logger.V(logger.Debug).Info("index ready", "count", len(all))
 
-	matchedIdxs, err := search.FuzzySearch(idx, searchPattern, GetSearchableFields(), GetBoostedFields())
+	matchedIdxs, err := search.ScoredFuzzySearch(idx, searchPattern, GetSearchableFields(), GetBoostedFields())
 	if err != nil {
 		return nil, fmt.Errorf("search: %w", err)
 	}
 
-	results := make([]Instance, 0, len(matchedIdxs))
-	for _, i := range matchedIdxs {
-		if i >= 0 && i < len(all) {
-			results = append(results, all[i])
-		}
-	}
+	results := search.Rank(all, matchedIdxs)
 
 	return results, nil
 }
+
+// Reindex lists instances and rebuilds their persisted search index.
+// It returns the number of indexed instances.
+func (s *Service) Reindex(ctx context.Context) (int, error) {
+	all, err := s.instanceRepo.ListEnrichedInstances(ctx, s.compartmentID)
+	if err != nil {
+		return 0, fmt.Errorf("fetching instances: %w", err)
+	}
+
+	idx, err := search.OpenIndex(s.indexStore, s.compartmentID, cache.ResourceEnrichedInstances, ToSearchableInstances(all), search.NewIndexMapping(GetSearchableFields()))
+	if err != nil {
+		return 0, fmt.Errorf("building search index: %w", err)
+	}
+	return len(all), idx.Close()
+}
@@ -2,7 +2,13 @@ package instance
 
 import (
 	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/watch"
 )
 
 // Instance is an alias to the domain model.
 type Instance = compute.Instance
+
+// watchState is the part of an instance that --watch compares between polls.
+func watchState(i Instance) watch.State {
+	return watch.State{ID: i.OCID, Name: i.DisplayName, State: i.State}
+}
@@ -0,0 +1,44 @@
+package instancepool
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/services/util"
+)
+
+// GetInstancePools retrieves and displays a paginated list of instance pools.
+func GetInstancePools(appCtx *app.ApplicationContext, limit int, page int, format printer.OutputFormat) error {
+	service, err := NewServiceFromAppContext(appCtx)
+	if err != nil {
+		return fmt.Errorf("creating instance pool service: %w", err)
+	}
+
+	pools, totalCount, nextPageToken, err := service.FetchPaginatedInstancePools(context.Background(), limit, page)
+	if err != nil {
+		return fmt.Errorf("listing instance pools: %w", err)
+	}
+
+	return PrintInstancePoolsInfo(pools, appCtx, &util.PaginationInfo{
+		CurrentPage:   page,
+		TotalCount:    totalCount,
+		Limit:         limit,
+		NextPageToken: nextPageToken,
+	}, format)
+}
+
+// GetInstancePool displays the instance pool with the given name or OCID.
+func GetInstancePool(appCtx *app.ApplicationContext, nameOrID string, format printer.OutputFormat) error {
+	service, err := NewServiceFromAppContext(appCtx)
+	if err != nil {
+		return fmt.Errorf("creating instance pool service: %w", err)
+	}
+
+	pool, err := service.FindInstancePool(context.Background(), nameOrID)
+	if err != nil {
+		return fmt.Errorf("getting instance pool: %w", err)
+	}
+	return PrintInstancePoolInfo(pool, appCtx, format)
+}
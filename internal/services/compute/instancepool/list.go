@@ -0,0 +1,43 @@
+package instancepool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	ociPool "github.com/cnopslabs/ocloud/internal/oci/compute/instancepool"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/tui"
+)
+
+// ListInstancePools lets the user pick an instance pool interactively and displays its details.
+func ListInstancePools(ctx context.Context, appCtx *app.ApplicationContext, format printer.OutputFormat) error {
+	service, err := NewServiceFromAppContext(appCtx)
+	if err != nil {
+		return fmt.Errorf("creating instance pool service: %w", err)
+	}
+
+	pools, err := service.ListInstancePools(ctx)
+	if err != nil {
+		return fmt.Errorf("listing instance pools: %w", err)
+	}
+
+	// TUI
+	model := ociPool.NewInstancePoolListModel(pools).
+		WithQueryFilter(ToSearchableInstancePools(pools), GetSearchableFields(), GetBoostedFields())
+	id, err := tui.Run(model)
+	if err != nil {
+		if errors.Is(err, tui.ErrCancelled) {
+			return nil
+		}
+		return fmt.Errorf("selecting instance pool: %w", err)
+	}
+
+	for _, pool := range pools {
+		if pool.OCID == id {
+			return PrintInstancePoolInfo(&pool, appCtx, format)
+		}
+	}
+	return fmt.Errorf("instance pool %s not found", id)
+}
@@ -0,0 +1,175 @@
+package instancepool
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/services/util"
+)
+
+// PrintInstancePoolsInfo displays instance pools in a table with one row per
+// pool, or in the requested structured format.
+func PrintInstancePoolsInfo(pools []InstancePool, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, format printer.OutputFormat) error {
+	p := printer.New(appCtx.Stdout)
+
+	if pagination != nil {
+		util.AdjustPaginationInfo(pagination)
+	}
+
+	if !format.IsTable() {
+		return util.MarshalDataResponse(p, format, pools, pagination)
+	}
+
+	if util.ValidateAndReportEmpty(pools, pagination, appCtx.Stdout) {
+		return nil
+	}
+
+	headers := []string{"NAME", "STATE", "SIZE", "SHAPE", "IMAGE", "LOAD BALANCERS", "MEMBERS"}
+	rows := make([][]string, 0, len(pools))
+	for _, pool := range pools {
+		shape, image := "-", "-"
+		if cfg := pool.Configuration; cfg != nil {
+			shape, image = cfg.Shape, imageName(cfg.ImageName, cfg.ImageID)
+		}
+		// A load balancer attached through several backend sets is listed once.
+		var lbs []string
+		seen := map[string]bool{}
+		for _, lb := range pool.LoadBalancers {
+			if !seen[lb.LoadBalancerID] {
+				seen[lb.LoadBalancerID] = true
+				lbs = append(lbs, loadBalancerName(lb.LoadBalancerName, lb.LoadBalancerID))
+			}
+		}
+		rows = append(rows, []string{
+			pool.DisplayName,
+			pool.State,
+			strconv.Itoa(pool.Size),
+			shape,
+			image,
+			orDash(strings.Join(lbs, ", ")),
+			members(pool),
+		})
+	}
+	p.PrintTableNoTruncate(util.FormatColoredTitle(appCtx, "Instance Pools"), headers, rows)
+
+	util.LogPaginationInfo(pagination, appCtx)
+	return nil
+}
+
+// PrintInstancePoolInfo prints a detailed view of an instance pool with its
+// instance configuration, load balancers and members.
+func PrintInstancePoolInfo(pool *InstancePool, appCtx *app.ApplicationContext, format printer.OutputFormat) error {
+	p := printer.New(appCtx.Stdout)
+
+	if !format.IsTable() {
+		return p.Marshal(format, pool)
+	}
+
+	data := map[string]string{
+		"Name":                 pool.DisplayName,
+		"OCID":                 pool.OCID,
+		"State":                pool.State,
+		"Size":                 strconv.Itoa(pool.Size),
+		"Created":              pool.TimeCreated.Format("2006-01-02 15:04:05"),
+		"Availability Domains": strings.Join(pool.AvailabilityDomains, ", "),
+	}
+	keys := []string{"Name", "OCID", "State", "Size", "Created", "Availability Domains"}
+
+	if cfg := pool.Configuration; cfg != nil {
+		data["Instance Configuration"] = cfg.Name
+		data["Shape"] = cfg.Shape
+		data["Image"] = imageName(cfg.ImageName, cfg.ImageID)
+		keys = append(keys, "Instance Configuration", "Shape")
+		if cfg.OCPUs > 0 {
+			data["OCPUs"] = formatNumber(cfg.OCPUs)
+			data["Memory (GB)"] = formatNumber(cfg.MemoryGB)
+			keys = append(keys, "OCPUs", "Memory (GB)")
+		}
+		keys = append(keys, "Image")
+
+		metadataKeys := make([]string, 0, len(cfg.Metadata))
+		for k := range cfg.Metadata {
+			metadataKeys = append(metadataKeys, k)
+		}
+		sort.Strings(metadataKeys)
+		for _, k := range metadataKeys {
+			key := "  Metadata " + k
+			data[key] = cfg.Metadata[k]
+			keys = append(keys, key)
+		}
+	}
+
+	data["Load Balancers"] = fmt.Sprintf("%d attached", len(pool.LoadBalancers))
+	keys = append(keys, "Load Balancers")
+	for i, lb := range pool.LoadBalancers {
+		key := fmt.Sprintf("  LB %d", i+1)
+		desc := fmt.Sprintf("%s / %s:%d", loadBalancerName(lb.LoadBalancerName, lb.LoadBalancerID), lb.BackendSetName, lb.Port)
+		if lb.BackendSetHealth != "" {
+			desc += " (" + lb.BackendSetHealth + ")"
+		}
+		data[key] = desc
+		keys = append(keys, key)
+	}
+
+	data["Members"] = members(*pool)
+	keys = append(keys, "Members")
+	for i, m := range pool.Members {
+		key := fmt.Sprintf("  Member %d", i+1)
+		desc := fmt.Sprintf("%s (%s", m.DisplayName, m.State)
+		if m.PrimaryIP != "" {
+			desc += ", " + m.PrimaryIP
+		}
+		if m.AvailabilityDomain != "" {
+			desc += ", " + m.AvailabilityDomain
+		}
+		data[key] = desc + ")"
+		keys = append(keys, key)
+	}
+
+	title := util.FormatColoredTitle(appCtx, pool.DisplayName)
+	p.PrintKeyValues(title, data, keys)
+	return nil
+}
+
+// members is the member count with the number of RUNNING members.
+func members(pool InstancePool) string {
+	running := 0
+	for _, m := range pool.Members {
+		if m.State == "RUNNING" {
+			running++
+		}
+	}
+	return fmt.Sprintf("%d (%d running)", len(pool.Members), running)
+}
+
+// imageName falls back to the image OCID when the image could not be read.
+func imageName(name, id string) string {
+	if name != "" {
+		return name
+	}
+	return orDash(id)
+}
+
+// loadBalancerName falls back to the load balancer OCID when it could not be read.
+func loadBalancerName(name, id string) string {
+	if name != "" {
+		return name
+	}
+	return id
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// formatNumber prints whole numbers without decimals.
+func formatNumber(f float32) string {
+	return strconv.FormatFloat(float64(f), 'f', -1, 32)
+}
@@ -0,0 +1,30 @@
+package instancepool
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
+)
+
+// SearchInstancePools fuzzy-searches the instance pools of the compartment and
+// prints the matches in a table or the requested structured format.
+func SearchInstancePools(appCtx *app.ApplicationContext, search string, format printer.OutputFormat) error {
+	service, err := NewServiceFromAppContext(appCtx)
+	if err != nil {
+		return fmt.Errorf("creating instance pool service: %w", err)
+	}
+
+	matched, err := service.FuzzySearch(context.Background(), search)
+	if err != nil {
+		return fmt.Errorf("finding instance pools: %w", err)
+	}
+
+	if err := PrintInstancePoolsInfo(matched, appCtx, nil, format); err != nil {
+		return fmt.Errorf("printing instance pools: %w", err)
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Info, "Found matching instance pools", "search", search, "matched", len(matched))
+	return nil
+}
@@ -0,0 +1,65 @@
+package instancepool
+
+import (
+	"strings"
+
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/services/search"
+	"github.com/cnopslabs/ocloud/internal/services/util"
+)
+
+// SearchableInstancePool is an adapter to make compute.InstancePool searchable.
+type SearchableInstancePool struct {
+	compute.InstancePool
+}
+
+// ToIndexable converts an InstancePool to a map of searchable fields.
+func (s SearchableInstancePool) ToIndexable() map[string]any {
+	tagsKV, _ := util.FlattenTags(s.FreeformTags, s.DefinedTags)
+	tagsVal, _ := util.ExtractTagValues(s.FreeformTags, s.DefinedTags)
+
+	var shape, image, configuration string
+	if cfg := s.Configuration; cfg != nil {
+		shape, image, configuration = cfg.Shape, cfg.ImageName, cfg.Name
+	}
+	lbs := make([]string, 0, len(s.LoadBalancers))
+	for _, lb := range s.LoadBalancers {
+		lbs = append(lbs, lb.LoadBalancerName, lb.BackendSetName)
+	}
+	members := make([]string, 0, len(s.Members))
+	for _, m := range s.Members {
+		members = append(members, m.DisplayName)
+	}
+	return map[string]any{
+		"Name":          strings.ToLower(s.DisplayName),
+		"OCID":          strings.ToLower(s.OCID),
+		"State":         strings.ToLower(s.State),
+		"Shape":         strings.ToLower(shape),
+		"Image":         strings.ToLower(image),
+		"Configuration": strings.ToLower(configuration),
+		"LoadBalancers": strings.ToLower(strings.Join(lbs, " ")),
+		"Members":       strings.ToLower(strings.Join(members, " ")),
+		"AD":            strings.ToLower(strings.Join(s.AvailabilityDomains, " ")),
+		"TagsKV":        strings.ToLower(tagsKV),
+		"TagsVal":       strings.ToLower(tagsVal),
+	}
+}
+
+// GetSearchableFields returns the list of fields to be indexed.
+func GetSearchableFields() []string {
+	return []string{"Name", "OCID", "State", "Shape", "Image", "Configuration", "LoadBalancers", "Members", "AD", "TagsKV", "TagsVal"}
+}
+
+// GetBoostedFields returns the list of fields to be boosted in the search.
+func GetBoostedFields() []string {
+	return []string{"Name", "Members"}
+}
+
+// ToSearchableInstancePools converts a slice of compute.InstancePool to a slice of search.Indexable.
+func ToSearchableInstancePools(pools []InstancePool) []search.Indexable {
+	searchable := make([]search.Indexable, len(pools))
+	for i, p := range pools {
+		searchable[i] = SearchableInstancePool{p}
+	}
+	return searchable
+}
@@ -0,0 +1,231 @@
+package instancepool
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/domain/network/loadbalancer"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/oci"
+	ociInst "github.com/cnopslabs/ocloud/internal/oci/compute/instance"
+	ociPool "github.com/cnopslabs/ocloud/internal/oci/compute/instancepool"
+	ociLb "github.com/cnopslabs/ocloud/internal/oci/network/loadbalancer"
+	"github.com/cnopslabs/ocloud/internal/services/search"
+	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/subtree"
+	"github.com/go-logr/logr"
+)
+
+// Service is the application-layer service for instance pool operations.
+type Service struct {
+	poolRepo      compute.InstancePoolRepository
+	lbRepo        loadbalancer.LoadBalancerRepository
+	instanceRepo  compute.InstanceRepository
+	logger        logr.Logger
+	compartmentID string
+	indexStore    *cache.Store
+}
+
+// NewService initializes a new Service instance. lbRepo resolves the names and
+// backend set health of attached load balancers and instanceRepo links pool
+// members to their instance records; either may be nil to skip that join.
+func NewService(poolRepo compute.InstancePoolRepository, lbRepo loadbalancer.LoadBalancerRepository, instanceRepo compute.InstanceRepository, logger logr.Logger, compartmentID string) *Service {
+	return &Service{
+		poolRepo:      poolRepo,
+		lbRepo:        lbRepo,
+		instanceRepo:  instanceRepo,
+		logger:        logger,
+		compartmentID: compartmentID,
+	}
+}
+
+// NewServiceFromAppContext creates a Service backed by the OCI adapters and the
+// resource cache of the application context. Pools are listed across the
+// compartment subtree with --recursive; --regions is not supported because the
+// load balancers and instances of a pool are looked up in its own region.
+func NewServiceFromAppContext(appCtx *app.ApplicationContext) (*Service, error) {
+	managementClient, err := oci.NewComputeManagementClient(appCtx.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("creating compute management client: %w", err)
+	}
+	computeClient, err := oci.NewComputeClient(appCtx.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("creating compute client: %w", err)
+	}
+	networkClient, err := oci.NewNetworkClient(appCtx.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("creating network client: %w", err)
+	}
+	lbClient, err := oci.NewLoadBalancerClient(appCtx.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("creating load balancer client: %w", err)
+	}
+	certsClient, err := oci.NewCertificatesManagementClient(appCtx.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("creating certificates management client: %w", err)
+	}
+
+	poolRepo := subtree.NewInstancePoolRepository(cache.NewInstancePoolRepository(ociPool.NewAdapter(managementClient, computeClient), appCtx.Cache), appCtx.Subtree)
+	instanceRepo := subtree.NewInstanceRepository(cache.NewInstanceRepository(ociInst.NewAdapter(computeClient, networkClient), appCtx.Cache), appCtx.Subtree)
+	lbRepo := ociLb.NewAdapter(lbClient, networkClient, certsClient)
+
+	service := NewService(poolRepo, lbRepo, instanceRepo, appCtx.Logger, appCtx.CompartmentID)
+	service.indexStore = appCtx.Cache
+	return service, nil
+}
+
+// GetInstancePool returns the pool with the given OCID, joined with its load
+// balancers and member instances.
+func (s *Service) GetInstancePool(ctx context.Context, ocid string) (*InstancePool, error) {
+	s.logger.V(logger.Debug).Info("getting instance pool", "ocid", ocid)
+
+	pool, err := s.poolRepo.GetEnrichedInstancePool(ctx, ocid)
+	if err != nil {
+		return nil, fmt.Errorf("getting instance pool from repository: %w", err)
+	}
+	pools, err := s.join(ctx, []InstancePool{*pool})
+	if err != nil {
+		return nil, err
+	}
+	return &pools[0], nil
+}
+
+// ListInstancePools returns the pools of the compartment, each joined with its
+// load balancers and member instances.
+func (s *Service) ListInstancePools(ctx context.Context) ([]InstancePool, error) {
+	s.logger.V(logger.Debug).Info("listing instance pools", "compartmentID", s.compartmentID)
+
+	pools, err := s.poolRepo.ListEnrichedInstancePools(ctx, s.compartmentID)
+	if err != nil {
+		return nil, fmt.Errorf("listing instance pools from repository: %w", err)
+	}
+	return s.join(ctx, pools)
+}
+
+// FetchPaginatedInstancePools retrieves a paginated list of instance pools.
+func (s *Service) FetchPaginatedInstancePools(ctx context.Context, limit, pageNum int) ([]InstancePool, int, string, error) {
+	s.logger.V(logger.Debug).Info("listing instance pools", "limit", limit, "pageNum", pageNum)
+
+	allPools, err := s.ListInstancePools(ctx)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	pagedResults, totalCount, nextPageToken := util.PaginateSlice(allPools, limit, pageNum)
+
+	s.logger.Info("completed instance pool listing", "returnedCount", len(pagedResults), "totalCount", totalCount)
+	return pagedResults, totalCount, nextPageToken, nil
+}
+
+// FindInstancePool returns the pool whose OCID or display name (case-insensitive) is nameOrID.
+func (s *Service) FindInstancePool(ctx context.Context, nameOrID string) (*InstancePool, error) {
+	if strings.HasPrefix(nameOrID, "ocid1.instancepool.") {
+		return s.GetInstancePool(ctx, nameOrID)
+	}
+
+	pools, err := s.ListInstancePools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, pool := range pools {
+		if strings.EqualFold(pool.DisplayName, nameOrID) {
+			return &pool, nil
+		}
+	}
+	return nil, fmt.Errorf("instance pool %q not found", nameOrID)
+}
+
+// FuzzySearch performs a fuzzy search for instance pools.
+func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]InstancePool, error) {
+	matches, err := s.ScoredSearch(ctx, searchPattern)
+	if err != nil {
+		return nil, err
+	}
+	return search.Items(matches), nil
+}
+
+// ScoredSearch performs a fuzzy search for instance pools and returns the
+// matches, best first, with their relevance scores.
+func (s *Service) ScoredSearch(ctx context.Context, searchPattern string) ([]search.Match[InstancePool], error) {
+	s.logger.V(logger.Debug).Info("finding instance pools with fuzzy search", "pattern", searchPattern)
+
+	allPools, err := s.ListInstancePools(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching all instance pools for search: %w", err)
+	}
+
+	indexMapping := search.NewIndexMapping(GetSearchableFields())
+	idx, err := search.OpenIndex(s.indexStore, s.compartmentID, cache.ResourceEnrichedInstancePools, ToSearchableInstancePools(allPools), indexMapping)
+	if err != nil {
+		return nil, fmt.Errorf("building search index: %w", err)
+	}
+	defer func() { _ = idx.Close() }()
+
+	matchedIdxs, err := search.ScoredFuzzySearch(idx, searchPattern, GetSearchableFields(), GetBoostedFields())
+	if err != nil {
+		return nil, fmt.Errorf("search: %w", err)
+	}
+
+	return search.Rank(allPools, matchedIdxs), nil
+}
+
+// join fills in the load balancer names and backend set health of the pools
+// and replaces their members with the matching instance records. A load
+// balancer that cannot be read, e.g. for lack of permissions in its
+// compartment, is left with its OCID only.
+func (s *Service) join(ctx context.Context, pools []InstancePool) ([]InstancePool, error) {
+	if s.lbRepo != nil {
+		lbs := map[string]*loadbalancer.LoadBalancer{}
+		for i := range pools {
+			for j := range pools[i].LoadBalancers {
+				attachment := &pools[i].LoadBalancers[j]
+				lb, seen := lbs[attachment.LoadBalancerID]
+				if !seen {
+					var err error
+					lb, err = s.lbRepo.GetLoadBalancer(ctx, attachment.LoadBalancerID)
+					if err != nil {
+						s.logger.V(logger.Debug).Info("skipping load balancer of instance pool", "pool", pools[i].DisplayName, "loadBalancer", attachment.LoadBalancerID, "error", err)
+						lb = nil
+					}
+					lbs[attachment.LoadBalancerID] = lb
+				}
+				if lb != nil {
+					attachment.LoadBalancerName = lb.Name
+					attachment.BackendSetHealth = lb.BackendHealth[attachment.BackendSetName]
+				}
+			}
+		}
+	}
+
+	if s.instanceRepo == nil {
+		return pools, nil
+	}
+	hasMembers := false
+	for _, pool := range pools {
+		hasMembers = hasMembers || len(pool.Members) > 0
+	}
+	if !hasMembers {
+		return pools, nil
+	}
+
+	instances, err := s.instanceRepo.ListInstances(ctx, s.compartmentID)
+	if err != nil {
+		return nil, fmt.Errorf("listing instances to link pool members: %w", err)
+	}
+	byID := make(map[string]compute.Instance, len(instances))
+	for _, inst := range instances {
+		byID[inst.OCID] = inst
+	}
+	for i := range pools {
+		for j, member := range pools[i].Members {
+			if inst, ok := byID[member.OCID]; ok {
+				pools[i].Members[j] = inst
+			}
+		}
+	}
+	return pools, nil
+}
@@ -0,0 +1,182 @@
+package instancepool
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/domain/network/loadbalancer"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
+)
+
+type mockInstancePoolRepository struct {
+	pools []compute.InstancePool
+}
+
+func (m *mockInstancePoolRepository) GetEnrichedInstancePool(ctx context.Context, ocid string) (*compute.InstancePool, error) {
+	for _, p := range m.pools {
+		if p.OCID == ocid {
+			return &p, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (m *mockInstancePoolRepository) ListInstancePools(ctx context.Context, compartmentID string) ([]compute.InstancePool, error) {
+	return m.pools, nil
+}
+
+func (m *mockInstancePoolRepository) ListEnrichedInstancePools(ctx context.Context, compartmentID string) ([]compute.InstancePool, error) {
+	// Copy the members so that the join of one call does not leak into the next.
+	pools := make([]compute.InstancePool, len(m.pools))
+	for i, p := range m.pools {
+		p.Members = append([]compute.Instance(nil), p.Members...)
+		p.LoadBalancers = append([]compute.InstancePoolLoadBalancer(nil), p.LoadBalancers...)
+		pools[i] = p
+	}
+	return pools, nil
+}
+
+type mockLoadBalancerRepository struct {
+	lbs   map[string]loadbalancer.LoadBalancer
+	calls int
+}
+
+func (m *mockLoadBalancerRepository) GetLoadBalancer(ctx context.Context, ocid string) (*loadbalancer.LoadBalancer, error) {
+	m.calls++
+	lb, ok := m.lbs[ocid]
+	if !ok {
+		return nil, errors.New("not authorized")
+	}
+	return &lb, nil
+}
+
+func (m *mockLoadBalancerRepository) ListLoadBalancers(ctx context.Context, compartmentID string) ([]loadbalancer.LoadBalancer, error) {
+	return nil, nil
+}
+
+func (m *mockLoadBalancerRepository) GetEnrichedLoadBalancer(ctx context.Context, ocid string) (*loadbalancer.LoadBalancer, error) {
+	return m.GetLoadBalancer(ctx, ocid)
+}
+
+func (m *mockLoadBalancerRepository) ListEnrichedLoadBalancers(ctx context.Context, compartmentID string) ([]loadbalancer.LoadBalancer, error) {
+	return nil, nil
+}
+
+type mockInstanceRepository struct {
+	instances []compute.Instance
+}
+
+func (m *mockInstanceRepository) GetEnrichedInstance(ctx context.Context, ocid string) (*compute.Instance, error) {
+	return nil, nil
+}
+
+func (m *mockInstanceRepository) ListEnrichedInstances(ctx context.Context, compartmentID string) ([]compute.Instance, error) {
+	return m.instances, nil
+}
+
+func (m *mockInstanceRepository) ListInstances(ctx context.Context, compartmentID string) ([]compute.Instance, error) {
+	return m.instances, nil
+}
+
+func newTestService() (*Service, *mockLoadBalancerRepository) {
+	pools := &mockInstancePoolRepository{pools: []compute.InstancePool{
+		{
+			OCID: "ocid1.instancepool.oc1..web", DisplayName: "web-pool", State: "RUNNING", Size: 2,
+			Configuration: &compute.InstanceConfiguration{Name: "web-config", Shape: "VM.Standard.E5.Flex", OCPUs: 2, MemoryGB: 16, ImageName: "Oracle-Linux-9"},
+			LoadBalancers: []compute.InstancePoolLoadBalancer{
+				{LoadBalancerID: "ocid1.loadbalancer.oc1..public", BackendSetName: "web", Port: 80},
+				{LoadBalancerID: "ocid1.loadbalancer.oc1..public", BackendSetName: "api", Port: 8080},
+				{LoadBalancerID: "ocid1.loadbalancer.oc1..hidden", BackendSetName: "web", Port: 80},
+			},
+			Members: []compute.Instance{
+				{OCID: "ocid1.instance.oc1..1", DisplayName: "inst-1", State: "RUNNING"},
+				{OCID: "ocid1.instance.oc1..2", DisplayName: "inst-2", State: "RUNNING"},
+			},
+		},
+		{OCID: "ocid1.instancepool.oc1..batch", DisplayName: "batch-pool", State: "STOPPED"},
+	}}
+	lbs := &mockLoadBalancerRepository{lbs: map[string]loadbalancer.LoadBalancer{
+		"ocid1.loadbalancer.oc1..public": {Name: "public-lb", BackendHealth: map[string]string{"web": "OK", "api": "WARNING"}},
+	}}
+	instances := &mockInstanceRepository{instances: []compute.Instance{
+		{OCID: "ocid1.instance.oc1..1", DisplayName: "inst-1", State: "RUNNING", PrimaryIP: "10.0.0.11", Hostname: "inst-1"},
+	}}
+	return NewService(pools, lbs, instances, logr.Discard(), "test-compartment"), lbs
+}
+
+func TestService_ListInstancePoolsJoinsLoadBalancersAndMembers(t *testing.T) {
+	service, lbs := newTestService()
+
+	pools, err := service.ListInstancePools(context.Background())
+	require.NoError(t, err)
+	require.Len(t, pools, 2)
+
+	web := pools[0]
+	assert.Equal(t, "public-lb", web.LoadBalancers[0].LoadBalancerName)
+	assert.Equal(t, "OK", web.LoadBalancers[0].BackendSetHealth)
+	assert.Equal(t, "WARNING", web.LoadBalancers[1].BackendSetHealth)
+	assert.Empty(t, web.LoadBalancers[2].LoadBalancerName, "unreadable load balancers keep their OCID only")
+	assert.Equal(t, 2, lbs.calls, "each load balancer is read once")
+
+	assert.Equal(t, "10.0.0.11", web.Members[0].PrimaryIP, "members link to the instance records")
+	assert.Equal(t, "inst-2", web.Members[1].DisplayName, "members without a record keep the pool membership")
+}
+
+func TestService_FindInstancePool(t *testing.T) {
+	service, _ := newTestService()
+
+	pool, err := service.FindInstancePool(context.Background(), "WEB-POOL")
+	require.NoError(t, err)
+	assert.Equal(t, "ocid1.instancepool.oc1..web", pool.OCID)
+
+	pool, err = service.FindInstancePool(context.Background(), "ocid1.instancepool.oc1..batch")
+	require.NoError(t, err)
+	assert.Equal(t, "batch-pool", pool.DisplayName)
+
+	_, err = service.FindInstancePool(context.Background(), "nope")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestService_FuzzySearch(t *testing.T) {
+	service, _ := newTestService()
+
+	results, err := service.FuzzySearch(context.Background(), "public-lb")
+	require.NoError(t, err)
+	require.NotEmpty(t, results)
+	assert.Equal(t, "web-pool", results[0].DisplayName)
+}
+
+func TestPrintInstancePoolInfo(t *testing.T) {
+	service, _ := newTestService()
+	pool, err := service.FindInstancePool(context.Background(), "web-pool")
+	require.NoError(t, err)
+	pool.Configuration.Metadata = map[string]string{"ssh_authorized_keys": "ssh-ed25519 AAAA"}
+
+	var buf bytes.Buffer
+	appCtx := &app.ApplicationContext{Logger: logger.NewTestLogger(), Stdout: &buf}
+	require.NoError(t, PrintInstancePoolInfo(pool, appCtx, printer.TableOutput))
+	out := buf.String()
+	assert.Contains(t, out, "VM.Standard.E5.Flex")
+	assert.Contains(t, out, "Oracle-Linux-9")
+	assert.Contains(t, out, "ssh-ed25519 AAAA")
+	assert.Contains(t, out, "public-lb / web:80 (OK)")
+	assert.Contains(t, out, "inst-1 (RUNNING, 10.0.0.11)")
+	assert.Contains(t, out, "2 (2 running)")
+
+	buf.Reset()
+	pools, err := service.ListInstancePools(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, PrintInstancePoolsInfo(pools, appCtx, nil, printer.TableOutput))
+	out = buf.String()
+	assert.Contains(t, out, "batch-pool")
+	assert.Contains(t, out, "0 (0 running)")
+}
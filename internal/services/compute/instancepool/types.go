@@ -0,0 +1,8 @@
+package instancepool
+
+import (
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+)
+
+// InstancePool is an alias to the domain model.
+type InstancePool = compute.InstancePool
@@ -0,0 +1,353 @@
+package oke
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/domain"
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/oci"
+	ocioke "github.com/cnopslabs/ocloud/internal/oci/compute/oke"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/region"
+	"github.com/cnopslabs/ocloud/internal/services/util"
+	"gopkg.in/yaml.v3"
+)
+
+// KubeContext is a kubeconfig context managed by ocloud: its user generates
+// tokens for an OKE cluster with the OCI CLI.
+type KubeContext struct {
+	Name      string `json:"Name"`
+	ClusterID string `json:"ClusterID"`
+	Region    string `json:"Region"`
+	Server    string `json:"Server"`
+	Current   bool   `json:"Current"`
+}
+
+// AddKubeconfigOptions controls how AddKubeconfig reaches a cluster.
+type AddKubeconfigOptions struct {
+	// Endpoint is compute.EndpointPublic or compute.EndpointPrivate; empty prefers the public
+	// endpoint when the cluster has one.
+	Endpoint string
+	// Context names the kubeconfig context; empty uses context-<cluster id suffix>.
+	Context string
+}
+
+// SkippedContext is a kube context PruneKubeconfigs kept because its cluster
+// could not be confirmed deleted.
+type SkippedContext struct {
+	Name   string
+	Reason string
+}
+
+// PruneOptions controls how PruneKubeconfigs confirms its changes.
+type PruneOptions struct {
+	// Yes skips the interactive confirmation.
+	Yes bool
+}
+
+// newKubeconfigService builds a Service that can also generate kubeconfigs and
+// look clusters up in the region a context names.
+func newKubeconfigService(appCtx *app.ApplicationContext) (*Service, error) {
+	service, err := NewServiceFromAppContext(appCtx)
+	if err != nil {
+		return nil, err
+	}
+	service.kubeconfigs, err = region.NewClusterKubeconfig(appCtx.Regions, func(name string) (compute.ClusterKubeconfig, error) {
+		containerEngineClient, err := oci.NewContainerEngineClient(appCtx.ForRegion(name).Provider)
+		if err != nil {
+			return nil, fmt.Errorf("creating container engine client: %w", err)
+		}
+		return ocioke.NewAdapter(containerEngineClient), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	service.clustersIn = func(name string) (compute.ClusterRepository, error) {
+		containerEngineClient, err := oci.NewContainerEngineClient(appCtx.ForRegion(name).Provider)
+		if err != nil {
+			return nil, fmt.Errorf("creating container engine client: %w", err)
+		}
+		return ocioke.NewAdapter(containerEngineClient), nil
+	}
+	return service, nil
+}
+
+// AddKubeconfig writes kubeconfig entries reaching the cluster with the given
+// name or OCID directly through its public or private endpoint, and prints the
+// resulting context.
+func AddKubeconfig(appCtx *app.ApplicationContext, nameOrID string, opts AddKubeconfigOptions, format printer.OutputFormat) error {
+	service, err := newKubeconfigService(appCtx)
+	if err != nil {
+		return fmt.Errorf("creating cluster service: %w", err)
+	}
+
+	ctx := context.Background()
+	cluster, err := service.FindCluster(ctx, nameOrID)
+	if err != nil {
+		return fmt.Errorf("getting cluster: %w", err)
+	}
+	clusterRegion := appCtx.Regions.RegionOf(cluster.OCID)
+	if clusterRegion == "" {
+		if clusterRegion, err = appCtx.Provider.Region(); err != nil {
+			return fmt.Errorf("get region: %w", err)
+		}
+	}
+
+	kc, err := service.AddKubeconfig(ctx, cluster, clusterRegion, opts)
+	if err != nil {
+		return err
+	}
+	return PrintKubeContexts(appCtx, []KubeContext{kc}, format)
+}
+
+// AddKubeconfig generates the kubeconfig of cluster for the chosen endpoint and
+// merges its server into the kubeconfig files under an ocloud-managed context.
+// The context becomes the current one when none is set.
+func (s *Service) AddKubeconfig(ctx context.Context, cluster *Cluster, clusterRegion string, opts AddKubeconfigOptions) (KubeContext, error) {
+	endpoint, err := chooseEndpoint(cluster, opts.Endpoint)
+	if err != nil {
+		return KubeContext{}, err
+	}
+	ctxName := opts.Context
+	if ctxName == "" {
+		ctxName = "context-" + shortID(cluster.OCID) + "-" + endpoint
+	}
+
+	kcs, err := loadKubeconfigs()
+	if err != nil {
+		return KubeContext{}, err
+	}
+	if existing, ok := kcs.context(ctxName); ok {
+		if owned, ok := kcs.ownedContext(existing); !ok || owned.ClusterID != cluster.OCID {
+			return KubeContext{}, fmt.Errorf("kube context %q already exists for another cluster; choose another name with --context", ctxName)
+		}
+	}
+
+	logger.LogWithLevel(s.logger, logger.Debug, "creating kubeconfig", "cluster", cluster.OCID, "endpoint", endpoint)
+	content, err := s.kubeconfigs.CreateKubeconfig(ctx, cluster.OCID, endpoint)
+	if err != nil {
+		return KubeContext{}, fmt.Errorf("creating kubeconfig of cluster %s: %w", cluster.DisplayName, err)
+	}
+	var generated kubeConfig
+	if err := yaml.Unmarshal(content, &generated); err != nil {
+		return KubeContext{}, fmt.Errorf("parsing generated kubeconfig: %w", err)
+	}
+	if len(generated.Clusters) == 0 || generated.Clusters[0].Cluster.Server == "" {
+		return KubeContext{}, fmt.Errorf("generated kubeconfig of cluster %s has no server", cluster.DisplayName)
+	}
+	server := generated.Clusters[0].Cluster
+
+	kcs.upsertOKEContext(ctxName, "cluster-"+shortID(cluster.OCID)+"-"+endpoint, cluster.OCID, clusterRegion, kcCluster{
+		Server:                   server.Server,
+		CertificateAuthorityData: server.CertificateAuthorityData,
+	})
+	if kcs.currentContext() == "" {
+		kcs.setCurrentContext(ctxName)
+	}
+	if err := kcs.save(); err != nil {
+		return KubeContext{}, err
+	}
+
+	return KubeContext{
+		Name:      ctxName,
+		ClusterID: cluster.OCID,
+		Region:    clusterRegion,
+		Server:    server.Server,
+		Current:   kcs.currentContext() == ctxName,
+	}, nil
+}
+
+// chooseEndpoint resolves the requested endpoint, checking that the cluster exposes it.
+func chooseEndpoint(cluster *Cluster, requested string) (string, error) {
+	switch strings.ToLower(requested) {
+	case "":
+		if cluster.PublicEndpoint != "" {
+			return compute.EndpointPublic, nil
+		}
+		if cluster.PrivateEndpoint != "" {
+			return compute.EndpointPrivate, nil
+		}
+		return "", fmt.Errorf("cluster %s has no endpoint", cluster.DisplayName)
+	case compute.EndpointPublic:
+		if cluster.PublicEndpoint == "" {
+			return "", fmt.Errorf("cluster %s has no public endpoint; use --endpoint %s", cluster.DisplayName, compute.EndpointPrivate)
+		}
+		return compute.EndpointPublic, nil
+	case compute.EndpointPrivate:
+		if cluster.PrivateEndpoint == "" {
+			return "", fmt.Errorf("cluster %s has no private endpoint", cluster.DisplayName)
+		}
+		return compute.EndpointPrivate, nil
+	default:
+		return "", fmt.Errorf("invalid endpoint %q: must be %s or %s", requested, compute.EndpointPublic, compute.EndpointPrivate)
+	}
+}
+
+// ListKubeconfigs prints the kubeconfig contexts managed by ocloud.
+func ListKubeconfigs(appCtx *app.ApplicationContext, format printer.OutputFormat) error {
+	contexts, err := ListKubeContexts()
+	if err != nil {
+		return err
+	}
+	return PrintKubeContexts(appCtx, contexts, format)
+}
+
+// ListKubeContexts returns the kubeconfig contexts managed by ocloud, sorted by name.
+func ListKubeContexts() ([]KubeContext, error) {
+	kcs, err := loadKubeconfigs()
+	if err != nil {
+		return nil, err
+	}
+	return kcs.ownedContexts(), nil
+}
+
+// RemoveKubeconfig deletes an ocloud-managed context, with the cluster and user
+// entries only it used.
+func RemoveKubeconfig(appCtx *app.ApplicationContext, name string) error {
+	kcs, err := loadKubeconfigs()
+	if err != nil {
+		return err
+	}
+	existing, ok := kcs.context(name)
+	if !ok {
+		return fmt.Errorf("kube context %q not found", name)
+	}
+	if _, ok := kcs.ownedContext(existing); !ok {
+		return fmt.Errorf("kube context %q is not managed by ocloud", name)
+	}
+
+	kcs.removeContext(name)
+	if err := kcs.save(); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(appCtx.Stdout, "Removed kube context %s.\n", name)
+	return err
+}
+
+// PruneKubeconfigs removes the ocloud-managed contexts whose cluster was
+// deleted, after confirmation unless opts.Yes is set, and prints them.
+func PruneKubeconfigs(appCtx *app.ApplicationContext, opts PruneOptions, format printer.OutputFormat) error {
+	service, err := newKubeconfigService(appCtx)
+	if err != nil {
+		return fmt.Errorf("creating cluster service: %w", err)
+	}
+	contexts, err := ListKubeContexts()
+	if err != nil {
+		return err
+	}
+
+	stale, skipped := service.StaleContexts(context.Background(), contexts)
+	for _, s := range skipped {
+		_, _ = fmt.Fprintf(appCtx.Stderr, "Keeping kube context %s: %s.\n", s.Name, s.Reason)
+	}
+	if len(stale) > 0 {
+		if !opts.Yes && !util.PromptYesNo(fmt.Sprintf("Remove %d kube contexts of deleted clusters (%s)?", len(stale), strings.Join(contextNames(stale), ", "))) {
+			_, _ = fmt.Fprintln(appCtx.Stderr, "Aborted.")
+			return nil
+		}
+		kcs, err := loadKubeconfigs()
+		if err != nil {
+			return err
+		}
+		for _, kc := range stale {
+			kcs.removeContext(kc.Name)
+		}
+		if err := kcs.save(); err != nil {
+			return err
+		}
+	}
+
+	if format.IsTable() && len(stale) == 0 {
+		_, err := fmt.Fprintln(appCtx.Stdout, "No kube contexts of deleted clusters found.")
+		return err
+	}
+	return PrintKubeContexts(appCtx, stale, format)
+}
+
+// StaleContexts returns the contexts whose cluster OCI reports DELETED, and
+// the contexts whose cluster could not be checked. A cluster that is not found
+// counts as unchecked: OCI returns the same 404 for clusters the current
+// profile cannot see, such as those of another tenancy.
+func (s *Service) StaleContexts(ctx context.Context, contexts []KubeContext) ([]KubeContext, []SkippedContext) {
+	stale := []KubeContext{}
+	var skipped []SkippedContext
+	repos := map[string]compute.ClusterRepository{}
+	for _, kc := range contexts {
+		repo, ok := repos[kc.Region]
+		if !ok {
+			var err error
+			if repo, err = s.clustersIn(kc.Region); err != nil {
+				skipped = append(skipped, SkippedContext{Name: kc.Name, Reason: err.Error()})
+				continue
+			}
+			repos[kc.Region] = repo
+		}
+
+		cluster, err := repo.GetCluster(ctx, kc.ClusterID)
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			skipped = append(skipped, SkippedContext{Name: kc.Name, Reason: "cluster not found or not visible to the current profile"})
+		case err != nil:
+			logger.LogWithLevel(s.logger, logger.Debug, "keeping kube context", "context", kc.Name, "error", err)
+			skipped = append(skipped, SkippedContext{Name: kc.Name, Reason: err.Error()})
+		case strings.EqualFold(cluster.State, "DELETED"):
+			stale = append(stale, kc)
+		}
+	}
+	return stale, skipped
+}
+
+// ownedContexts returns the contexts managed by ocloud, sorted by name.
+func (s *kubeconfigSet) ownedContexts() []KubeContext {
+	contexts := []KubeContext{}
+	seen := map[string]bool{}
+	for _, f := range s.files {
+		for _, c := range f.config.Contexts {
+			if seen[c.Name] {
+				continue
+			}
+			seen[c.Name] = true
+			if kc, ok := s.ownedContext(c); ok {
+				contexts = append(contexts, kc)
+			}
+		}
+	}
+	sort.Slice(contexts, func(i, j int) bool { return contexts[i].Name < contexts[j].Name })
+	return contexts
+}
+
+// ownedContext describes c when its user generates tokens with the OCI CLI.
+func (s *kubeconfigSet) ownedContext(c namedContext) (KubeContext, bool) {
+	u, ok := s.user(c.Context.User)
+	if !ok {
+		return KubeContext{}, false
+	}
+	clusterID, clusterRegion, ok := okeExecTarget(u.User.Exec)
+	if !ok {
+		return KubeContext{}, false
+	}
+	kc := KubeContext{
+		Name:      c.Name,
+		ClusterID: clusterID,
+		Region:    clusterRegion,
+		Current:   s.currentContext() == c.Name,
+	}
+	if cl, ok := s.cluster(c.Context.Cluster); ok {
+		kc.Server = cl.Cluster.Server
+	}
+	return kc, true
+}
+
+func contextNames(contexts []KubeContext) []string {
+	names := make([]string, len(contexts))
+	for i, kc := range contexts {
+		names[i] = kc.Name
+	}
+	return names
+}
@@ -5,30 +5,31 @@ import (
 	"fmt"
 
 	"github.com/cnopslabs/ocloud/internal/app"
-	"github.com/cnopslabs/ocloud/internal/oci"
-	ocioke "github.com/cnopslabs/ocloud/internal/oci/compute/oke"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/watch"
 )
 
-// GetClusters retrieves and displays a paginated list of OKE clusters.
-func GetClusters(appCtx *app.ApplicationContext, useJSON bool, limit, page int) error {
-	containerEngineClient, err := oci.NewContainerEngineClient(appCtx.Provider)
+// GetClusters retrieves and displays a paginated list of OKE clusters, with
+// their options and add-ons when showAll is true.
+func GetClusters(appCtx *app.ApplicationContext, format printer.OutputFormat, limit, page int, showAll bool) error {
+	service, err := newServiceFromAppContext(appCtx, showAll)
 	if err != nil {
-		return fmt.Errorf("creating container engine client: %w", err)
+		return fmt.Errorf("creating cluster service: %w", err)
 	}
 
-	clusterAdapter := ocioke.NewAdapter(containerEngineClient)
-	service := NewService(clusterAdapter, appCtx.Logger, appCtx.CompartmentID)
+	return util.Watch(context.Background(), appCtx, func(ctx context.Context) ([]watch.State, error) {
+		clusters, totalCount, nextPageToken, err := service.FetchPaginatedClusters(ctx, limit, page)
+		if err != nil {
+			return nil, fmt.Errorf("listing clusters: %w", err)
+		}
 
-	clusters, totalCount, nextPageToken, err := service.FetchPaginatedClusters(context.Background(), limit, page)
-	if err != nil {
-		return fmt.Errorf("listing clusters: %w", err)
-	}
-
-	return PrintOKETable(clusters, appCtx, &util.PaginationInfo{
-		CurrentPage:   page,
-		TotalCount:    totalCount,
-		Limit:         limit,
-		NextPageToken: nextPageToken,
-	}, useJSON)
+		err = PrintOKETable(clusters, appCtx, &util.PaginationInfo{
+			CurrentPage:   page,
+			TotalCount:    totalCount,
+			Limit:         limit,
+			NextPageToken: nextPageToken,
+		}, format, showAll)
+		return util.WatchStates(clusters, watchState), err
+	})
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -32,7 +33,7 @@ func TestGetClusters(t *testing.T) {
 	}
 
 	// Call ListClusters with default parameters
-	err := GetClusters(appCtx, false, 10, 1)
+	err := GetClusters(appCtx, printer.TableOutput, 10, 1, false)
 
 	// but if we did, we would expect no error
 	assert.NoError(t, err)
@@ -64,7 +65,7 @@ func TestGetClustersWithJSON(t *testing.T) {
 	}
 
 	// Call ListClusters with default parameters and useJSON=true
-	err := GetClusters(appCtx, true, 10, 1)
+	err := GetClusters(appCtx, printer.JSONOutput, 10, 1, false)
 
 	// but if we did, we would expect no error
 	assert.NoError(t, err)
@@ -96,7 +97,7 @@ func TestGetClustersWithPagination(t *testing.T) {
 	}
 
 	// Call ListClusters with pagination parameters
-	err := GetClusters(appCtx, false, 5, 2)
+	err := GetClusters(appCtx, printer.TableOutput, 5, 2, false)
 
 	// but if we did, we would expect no error
 	assert.NoError(t, err)
@@ -129,7 +130,7 @@ func TestGetClustersError(t *testing.T) {
 	}
 
 	// Call ListClusters with default parameters
-	err := GetClusters(appCtx, false, 10, 1)
+	err := GetClusters(appCtx, printer.TableOutput, 10, 1, false)
 
 	// but if we did, we would expect an error
 	assert.Error(t, err)
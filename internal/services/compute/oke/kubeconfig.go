@@ -1,10 +1,14 @@
 package oke
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"net"
+	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -12,7 +16,8 @@ import (
 	"github.com/cnopslabs/ocloud/internal/services/util"
 )
 
-// kubeconfig model (unexported)
+// kubeconfig model (unexported). Each level keeps the keys it does not model in
+// Extra, so that entries written by other tools survive a rewrite.
 type kubeConfig struct {
 	APIVersion     string         `yaml:"apiVersion"`
 	Kind           string         `yaml:"kind"`
@@ -20,98 +25,93 @@ type kubeConfig struct {
 	Users          []namedUser    `yaml:"users"`
 	Contexts       []namedContext `yaml:"contexts"`
 	CurrentContext string         `yaml:"current-context"`
+	Extra          map[string]any `yaml:",inline"`
 }
 
 // a namedCluster represents a named Kubernetes cluster configuration consisting of a name and its corresponding details.
 type namedCluster struct {
-	Name    string    `yaml:"name"`
-	Cluster kcCluster `yaml:"cluster"`
+	Name    string         `yaml:"name"`
+	Cluster kcCluster      `yaml:"cluster"`
+	Extra   map[string]any `yaml:",inline"`
 }
 
 // kcCluster represents a Kubernetes cluster configuration consisting of a server address and certificate details.
 type kcCluster struct {
-	Server                   string `yaml:"server"`
-	CertificateAuthorityData string `yaml:"certificate-authority-data,omitempty"`
-	InsecureSkipTLSVerify    bool   `yaml:"insecure-skip-tls-verify,omitempty"`
+	Server                   string         `yaml:"server"`
+	CertificateAuthorityData string         `yaml:"certificate-authority-data,omitempty"`
+	InsecureSkipTLSVerify    bool           `yaml:"insecure-skip-tls-verify,omitempty"`
+	Extra                    map[string]any `yaml:",inline"`
 }
 
 // namedUser represents a named Kubernetes user configuration consisting of a name and its corresponding details.
 type namedUser struct {
-	Name string `yaml:"name"`
-	User kcUser `yaml:"user"`
+	Name  string         `yaml:"name"`
+	User  kcUser         `yaml:"user"`
+	Extra map[string]any `yaml:",inline"`
 }
 
 // kcUser represents a Kubernetes user configuration.
 type kcUser struct {
-	Exec *kcExec `yaml:"exec,omitempty"`
+	Exec  *kcExec        `yaml:"exec,omitempty"`
+	Extra map[string]any `yaml:",inline"`
 }
 
 // kcExec represents a Kubernetes exec configuration.
 type kcExec struct {
-	APIVersion         string   `yaml:"apiVersion"`
-	Command            string   `yaml:"command"`
-	Args               []string `yaml:"args"`
-	Env                []any    `yaml:"env"`
-	InteractiveMode    string   `yaml:"interactiveMode"`
-	ProvideClusterInfo bool     `yaml:"provideClusterInfo"`
+	APIVersion         string         `yaml:"apiVersion"`
+	Command            string         `yaml:"command"`
+	Args               []string       `yaml:"args"`
+	Env                []any          `yaml:"env"`
+	InteractiveMode    string         `yaml:"interactiveMode"`
+	ProvideClusterInfo bool           `yaml:"provideClusterInfo"`
+	Extra              map[string]any `yaml:",inline"`
 }
 
 // namedContext represents a named Kubernetes context configuration consisting of a name and its corresponding details.
 type namedContext struct {
-	Name    string    `yaml:"name"`
-	Context kcContext `yaml:"context"`
+	Name    string         `yaml:"name"`
+	Context kcContext      `yaml:"context"`
+	Extra   map[string]any `yaml:",inline"`
 }
 
 // kcContext represents Kubernetes context details including cluster, namespace, and user mappings.
 type kcContext struct {
-	Cluster   string `yaml:"cluster"`
-	Namespace string `yaml:"namespace"`
-	User      string `yaml:"user"`
+	Cluster   string         `yaml:"cluster"`
+	Namespace string         `yaml:"namespace"`
+	User      string         `yaml:"user"`
+	Extra     map[string]any `yaml:",inline"`
 }
 
 // EnsureKubeconfigForOKE ensures kubeconfig entries for the given cluster/region and local port.
-// If entries already exist, it is a no-op.
+// If a context already reaches the cluster through a local tunnel, it is a no-op.
 func EnsureKubeconfigForOKE(cluster Cluster, region string, localPort int) error {
-	home, err := os.UserHomeDir()
+	kcs, err := loadKubeconfigs()
 	if err != nil {
-		return fmt.Errorf("get home dir: %w", err)
+		return err
 	}
-	kubeDir := filepath.Join(home, ".kube")
-	cfgPath := filepath.Join(kubeDir, "config")
 
-	if err := os.MkdirAll(kubeDir, 0o700); err != nil {
-		return fmt.Errorf("ensure kube dir: %w", err)
-	}
-
-	var kc kubeConfig
-	if b, err := os.ReadFile(cfgPath); err == nil {
-		_ = yaml.Unmarshal(b, &kc)
-	} else if !errors.Is(err, os.ErrNotExist) {
-		return fmt.Errorf("read kubeconfig: %w", err)
-	}
-	if kc.APIVersion == "" {
-		kc.APIVersion = "v1"
-	}
-
-	// First, check if any existing user exec config already targets this exact cluster id, region and profile.
-	for _, u := range kc.Users {
-		if u.User.Exec == nil {
-			continue
-		}
-		if matchOKEExec(u.User.Exec, cluster.OCID, region) {
-			return nil
-		}
+	// First, check if a context already targets this exact cluster id and region through a tunnel.
+	if kcs.hasOKETunnel(cluster.OCID, region) {
+		return nil
 	}
 
 	suffix := shortID(cluster.OCID)
 	cName := "cluster-" + suffix
 	uName := "user-" + suffix
 	ctxName := "context-" + suffix
+	if _, taken := kcs.context(ctxName); taken {
+		// The name is used by a context added for the cluster endpoint; keep it.
+		ctxName += "-tunnel"
+	}
 
 	// If all present by our naming, skip
-	if hasNamed(kc.Users, func(n namedUser) bool { return n.Name == uName }) &&
-		hasNamed(kc.Clusters, func(n namedCluster) bool { return n.Name == cName }) &&
-		hasNamed(kc.Contexts, func(n namedContext) bool { return n.Name == ctxName }) {
+	if kcs.defines(func(kc *kubeConfig) bool {
+		return hasNamed(kc.Users, func(n namedUser) bool { return n.Name == uName })
+	}) && kcs.defines(func(kc *kubeConfig) bool {
+		return hasNamed(kc.Clusters, func(n namedCluster) bool { return n.Name == cName })
+	}) && kcs.defines(func(kc *kubeConfig) bool {
+		return hasNamed(kc.Contexts, func(n namedContext) bool { return n.Name == ctxName })
+	}) {
 		return nil
 	}
 
@@ -124,68 +124,373 @@ func EnsureKubeconfigForOKE(cluster Cluster, region string, localPort int) error
 		}
 	}
 
-	server := fmt.Sprintf("https://127.0.0.1:%d", localPort)
+	kcs.upsertOKEContext(ctxName, cName, cluster.OCID, region, kcCluster{
+		Server:                fmt.Sprintf("https://127.0.0.1:%d", localPort),
+		InsecureSkipTLSVerify: true,
+	})
+	if kcs.currentContext() == "" {
+		kcs.setCurrentContext(ctxName)
+	}
+	return kcs.save()
+}
+
+// kubeconfigFile is one file of the kubeconfig search path.
+type kubeconfigFile struct {
+	path   string
+	exists bool
+	config kubeConfig
+	dirty  bool
+}
 
-	kc.Clusters = upsertCluster(kc.Clusters, namedCluster{
-		Name: cName,
-		Cluster: kcCluster{
-			Server:                server,
-			InsecureSkipTLSVerify: true,
-		},
+// kubeconfigSet is the merged view kubectl has of the files listed in
+// KUBECONFIG, or of ~/.kube/config when the variable is unset. Like kubectl, the
+// first file defining a name wins, changes to an entry go to the file defining
+// it, and new entries go to the first existing file, or to the last listed file
+// when none exists yet.
+type kubeconfigSet struct {
+	files []*kubeconfigFile
+}
+
+// kubeconfigPaths returns the kubeconfig files in precedence order.
+func kubeconfigPaths() ([]string, error) {
+	var paths []string
+	for _, p := range filepath.SplitList(os.Getenv("KUBECONFIG")) {
+		if p != "" {
+			paths = append(paths, p)
+		}
+	}
+	if len(paths) > 0 {
+		return paths, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("get home dir: %w", err)
+	}
+	return []string{filepath.Join(home, ".kube", "config")}, nil
+}
+
+// loadKubeconfigs reads every kubeconfig file; missing files are empty.
+func loadKubeconfigs() (*kubeconfigSet, error) {
+	paths, err := kubeconfigPaths()
+	if err != nil {
+		return nil, err
+	}
+	kcs := &kubeconfigSet{}
+	seen := map[string]bool{}
+	for _, p := range paths {
+		if seen[p] {
+			continue
+		}
+		seen[p] = true
+		f := &kubeconfigFile{path: p}
+		b, err := os.ReadFile(p)
+		switch {
+		case err == nil:
+			f.exists = true
+			if err := yaml.Unmarshal(b, &f.config); err != nil {
+				return nil, fmt.Errorf("parse kubeconfig %s: %w", p, err)
+			}
+		case !errors.Is(err, os.ErrNotExist):
+			return nil, fmt.Errorf("read kubeconfig: %w", err)
+		}
+		kcs.files = append(kcs.files, f)
+	}
+	return kcs, nil
+}
+
+// defines reports whether any file satisfies has.
+func (s *kubeconfigSet) defines(has func(*kubeConfig) bool) bool {
+	return s.definingFile(has) != nil
+}
+
+// definingFile returns the first file satisfying has, or nil.
+func (s *kubeconfigSet) definingFile(has func(*kubeConfig) bool) *kubeconfigFile {
+	for _, f := range s.files {
+		if has(&f.config) {
+			return f
+		}
+	}
+	return nil
+}
+
+// destination returns the file an entry goes to: the one already defining it,
+// else the first existing file, else the last one.
+func (s *kubeconfigSet) destination(has func(*kubeConfig) bool) *kubeconfigFile {
+	if f := s.definingFile(has); f != nil {
+		return f
+	}
+	for _, f := range s.files {
+		if f.exists {
+			return f
+		}
+	}
+	return s.files[len(s.files)-1]
+}
+
+// hasOKETunnel reports whether a context reaches the cluster in region through
+// a local tunnel: its user generates tokens for the cluster and its server is
+// on the loopback address.
+func (s *kubeconfigSet) hasOKETunnel(clusterID, region string) bool {
+	for _, f := range s.files {
+		for _, c := range f.config.Contexts {
+			u, ok := s.user(c.Context.User)
+			if !ok || !matchOKEExec(u.User.Exec, clusterID, region) {
+				continue
+			}
+			if cl, ok := s.cluster(c.Context.Cluster); ok && isLoopbackServer(cl.Cluster.Server) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isLoopbackServer reports whether server is a URL on the loopback address.
+func isLoopbackServer(server string) bool {
+	u, err := url.Parse(server)
+	if err != nil {
+		return false
+	}
+	if u.Hostname() == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(u.Hostname())
+	return ip != nil && ip.IsLoopback()
+}
+
+// cluster, user and context return the first entry with the given name.
+func (s *kubeconfigSet) cluster(name string) (namedCluster, bool) {
+	for _, f := range s.files {
+		for _, c := range f.config.Clusters {
+			if c.Name == name {
+				return c, true
+			}
+		}
+	}
+	return namedCluster{}, false
+}
+
+func (s *kubeconfigSet) user(name string) (namedUser, bool) {
+	for _, f := range s.files {
+		for _, u := range f.config.Users {
+			if u.Name == name {
+				return u, true
+			}
+		}
+	}
+	return namedUser{}, false
+}
+
+func (s *kubeconfigSet) context(name string) (namedContext, bool) {
+	for _, f := range s.files {
+		for _, c := range f.config.Contexts {
+			if c.Name == name {
+				return c, true
+			}
+		}
+	}
+	return namedContext{}, false
+}
+
+// currentContext returns the first current-context set.
+func (s *kubeconfigSet) currentContext() string {
+	for _, f := range s.files {
+		if f.config.CurrentContext != "" {
+			return f.config.CurrentContext
+		}
+	}
+	return ""
+}
+
+// setCurrentContext sets current-context in the file that sets it, or in the first file.
+func (s *kubeconfigSet) setCurrentContext(name string) {
+	f := s.definingFile(func(kc *kubeConfig) bool { return kc.CurrentContext != "" })
+	if f == nil {
+		f = s.files[0]
+	}
+	f.config.CurrentContext = name
+	f.dirty = true
+}
+
+// upsertOKEContext adds or replaces the context ctxName of an OKE cluster with
+// its cluster entry cName and a user generating tokens with the OCI CLI. Each
+// way of reaching the cluster gets its own cName, as the server differs; the
+// user is shared.
+func (s *kubeconfigSet) upsertOKEContext(ctxName, cName, clusterID, region string, server kcCluster) {
+	uName := "user-" + shortID(clusterID)
+
+	f := s.destination(func(kc *kubeConfig) bool {
+		return hasNamed(kc.Clusters, func(n namedCluster) bool { return n.Name == cName })
 	})
+	f.config.Clusters = upsertCluster(f.config.Clusters, namedCluster{Name: cName, Cluster: server})
+	f.dirty = true
 
-	kc.Users = upsertUser(kc.Users, namedUser{
-		Name: uName,
-		User: kcUser{Exec: &kcExec{
-			APIVersion:         "client.authentication.k8s.io/v1beta1",
-			Command:            "oci",
-			Args:               []string{"ce", "cluster", "generate-token", "--cluster-id", cluster.OCID, "--region", region, "--auth", "security_token"},
-			Env:                []any{},
-			InteractiveMode:    "",
-			ProvideClusterInfo: false,
-		}},
+	f = s.destination(func(kc *kubeConfig) bool {
+		return hasNamed(kc.Users, func(n namedUser) bool { return n.Name == uName })
 	})
+	f.config.Users = upsertUser(f.config.Users, namedUser{Name: uName, User: kcUser{Exec: okeExec(clusterID, region)}})
+	f.dirty = true
 
-	kc.Contexts = upsertContext(kc.Contexts, namedContext{
-		Name: ctxName,
-		Context: kcContext{
-			Cluster:   cName,
-			Namespace: "",
-			User:      uName,
-		},
+	f = s.destination(func(kc *kubeConfig) bool {
+		return hasNamed(kc.Contexts, func(n namedContext) bool { return n.Name == ctxName })
+	})
+	f.config.Contexts = upsertContext(f.config.Contexts, namedContext{
+		Name:    ctxName,
+		Context: kcContext{Cluster: cName, User: uName},
 	})
+	f.dirty = true
+}
 
-	if kc.CurrentContext == "" {
-		kc.CurrentContext = ctxName
+// removeContext deletes the named context from every file, along with the
+// cluster and user entries no remaining context refers to, and clears
+// current-context where it pointed to it.
+func (s *kubeconfigSet) removeContext(name string) {
+	ctx, ok := s.context(name)
+	if !ok {
+		return
+	}
+	for _, f := range s.files {
+		before := len(f.config.Contexts)
+		f.config.Contexts = slices.DeleteFunc(f.config.Contexts, func(c namedContext) bool { return c.Name == name })
+		if len(f.config.Contexts) != before {
+			f.dirty = true
+		}
+		if f.config.CurrentContext == name {
+			f.config.CurrentContext = ""
+			f.dirty = true
+		}
 	}
 
-	// write atomically to the target file.
-	// Backup if exists first.
-	if _, err := os.Stat(cfgPath); err == nil {
-		if old, err := os.ReadFile(cfgPath); err == nil {
-			bak := cfgPath + ".bak"
-			_ = os.WriteFile(bak, old, 0o600)
+	referenced := func(match func(kcContext) bool) bool {
+		return s.defines(func(kc *kubeConfig) bool {
+			return hasNamed(kc.Contexts, func(c namedContext) bool { return match(c.Context) })
+		})
+	}
+	dropCluster := !referenced(func(c kcContext) bool { return c.Cluster == ctx.Context.Cluster })
+	dropUser := !referenced(func(c kcContext) bool { return c.User == ctx.Context.User })
+	for _, f := range s.files {
+		if dropCluster {
+			before := len(f.config.Clusters)
+			f.config.Clusters = slices.DeleteFunc(f.config.Clusters, func(c namedCluster) bool { return c.Name == ctx.Context.Cluster })
+			f.dirty = f.dirty || len(f.config.Clusters) != before
+		}
+		if dropUser {
+			before := len(f.config.Users)
+			f.config.Users = slices.DeleteFunc(f.config.Users, func(u namedUser) bool { return u.Name == ctx.Context.User })
+			f.dirty = f.dirty || len(f.config.Users) != before
 		}
 	}
+}
 
-	f, err := os.OpenFile(cfgPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
-	if err != nil {
-		return fmt.Errorf("open kubeconfig for write: %w", err)
+// save writes the changed files.
+func (s *kubeconfigSet) save() error {
+	for _, f := range s.files {
+		if !f.dirty {
+			continue
+		}
+		if err := f.save(); err != nil {
+			return err
+		}
+		f.dirty = false
+		f.exists = true
+	}
+	return nil
+}
+
+// save replaces the file atomically, after keeping its previous content in a
+// .bak file next to it.
+func (f *kubeconfigFile) save() error {
+	if f.config.APIVersion == "" {
+		f.config.APIVersion = "v1"
+	}
+	if f.config.Kind == "" {
+		f.config.Kind = "Config"
 	}
-	defer f.Close()
 
-	enc := yaml.NewEncoder(f)
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
 	enc.SetIndent(2)
-	if err := enc.Encode(&kc); err != nil {
+	if err := enc.Encode(&f.config); err != nil {
 		_ = enc.Close()
 		return fmt.Errorf("marshal kubeconfig: %w", err)
 	}
 	if err := enc.Close(); err != nil {
-		return fmt.Errorf("finalize kubeconfig write: %w", err)
+		return fmt.Errorf("marshal kubeconfig: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(f.path), 0o700); err != nil {
+		return fmt.Errorf("ensure kube dir: %w", err)
+	}
+	if old, err := os.ReadFile(f.path); err == nil {
+		if err := writeFileAtomic(f.path+".bak", old, 0o600); err != nil {
+			return fmt.Errorf("back up kubeconfig: %w", err)
+		}
+	}
+	if err := writeFileAtomic(f.path, buf.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("write kubeconfig: %w", err)
 	}
 	return nil
 }
 
+// writeFileAtomic writes data to a temporary file in the directory of path and
+// renames it over path, so that readers never see a partially written file.
+// When path is a symlink, its target is replaced and the link is kept.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) (err error) {
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		path = resolved
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	if _, err = tmp.Write(data); err != nil {
+		return err
+	}
+	if err = tmp.Chmod(perm); err != nil {
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// okeExec returns the exec section generating tokens for the cluster with the OCI CLI.
+func okeExec(clusterID, region string) *kcExec {
+	return &kcExec{
+		APIVersion:         "client.authentication.k8s.io/v1beta1",
+		Command:            "oci",
+		Args:               []string{"ce", "cluster", "generate-token", "--cluster-id", clusterID, "--region", region, "--auth", "security_token"},
+		Env:                []any{},
+		InteractiveMode:    "",
+		ProvideClusterInfo: false,
+	}
+}
+
+// okeExecTarget returns the cluster and region exec generates tokens for, and
+// false when exec is not an OCI CLI token generator.
+func okeExecTarget(exec *kcExec) (clusterID, region string, ok bool) {
+	if exec == nil {
+		return "", "", false
+	}
+	flags := parseArgsToMap(exec.Args)
+	clusterID, region = flags["--cluster-id"], flags["--region"]
+	if clusterID == "" || !matchOKEExec(exec, clusterID, region) {
+		return "", "", false
+	}
+	return clusterID, region, true
+}
+
 // shortID returns a shortened version of the given cluster id.
 func shortID(id string) string {
 	// Try to take suffix after the last '.' or '/'
@@ -301,32 +606,13 @@ func containsStr(arr []string, needle string) bool {
 	return false
 }
 
-// KubeconfigExistsForOKE checks whether ~/.kube/config already contains an entry
-// for the given OKE cluster identified by cluster ID, region.
-// It returns true if a matching user exec section is found, false if not.
+// KubeconfigExistsForOKE checks whether the kubeconfig files already contain a
+// context reaching the given OKE cluster, identified by cluster ID and region,
+// through a local tunnel. Contexts for the cluster endpoints do not count.
 func KubeconfigExistsForOKE(cluster Cluster, region string) (bool, error) {
-	home, err := os.UserHomeDir()
+	kcs, err := loadKubeconfigs()
 	if err != nil {
-		return false, fmt.Errorf("get home dir: %w", err)
-	}
-	cfgPath := filepath.Join(home, ".kube", "config")
-
-	var kc kubeConfig
-	if b, err := os.ReadFile(cfgPath); err == nil {
-		_ = yaml.Unmarshal(b, &kc)
-	} else if errors.Is(err, os.ErrNotExist) {
-		return false, nil
-	} else {
-		return false, fmt.Errorf("read kubeconfig: %w", err)
-	}
-
-	for _, u := range kc.Users {
-		if u.User.Exec == nil {
-			continue
-		}
-		if matchOKEExec(u.User.Exec, cluster.OCID, region) {
-			return true, nil
-		}
+		return false, err
 	}
-	return false, nil
+	return kcs.hasOKETunnel(cluster.OCID, region), nil
 }
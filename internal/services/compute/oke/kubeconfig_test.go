@@ -0,0 +1,305 @@
+package oke
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/printer"
+)
+
+const foreignKubeconfig = `apiVersion: v1
+kind: Config
+preferences:
+  colors: true
+clusters:
+- name: kind
+  cluster:
+    server: https://127.0.0.1:40000
+    certificate-authority-data: Zm9v
+users:
+- name: kind-admin
+  user:
+    client-certificate-data: Y2VydA==
+    client-key-data: a2V5
+contexts:
+- name: kind
+  context:
+    cluster: kind
+    user: kind-admin
+current-context: kind
+`
+
+const generatedKubeconfig = `apiVersion: v1
+kind: ""
+clusters:
+- name: cluster-ac3dmnrqg4ba
+  cluster:
+    server: https://203.0.113.10:6443
+    certificate-authority-data: Y2EtZGF0YQ==
+users:
+- name: user-ac3dmnrqg4ba
+  user:
+    exec:
+      command: oci
+contexts: []
+current-context: context-ac3dmnrqg4ba
+`
+
+// fakeKubeconfigs returns generatedKubeconfig and records the endpoint it was asked for.
+type fakeKubeconfigs struct{ endpoint string }
+
+func (f *fakeKubeconfigs) CreateKubeconfig(ctx context.Context, clusterID, endpoint string) ([]byte, error) {
+	f.endpoint = endpoint
+	return []byte(generatedKubeconfig), nil
+}
+
+// useKubeconfigs points KUBECONFIG at the given files of a temporary directory.
+func useKubeconfigs(t *testing.T, names ...string) []string {
+	t.Helper()
+	dir := t.TempDir()
+	paths := make([]string, len(names))
+	for i, n := range names {
+		paths[i] = filepath.Join(dir, n)
+	}
+	t.Setenv("KUBECONFIG", strings.Join(paths, string(os.PathListSeparator)))
+	return paths
+}
+
+func readKubeconfig(t *testing.T, path string) kubeConfig {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var kc kubeConfig
+	require.NoError(t, yaml.Unmarshal(b, &kc))
+	return kc
+}
+
+func TestAddKubeconfig_PreservesForeignEntries(t *testing.T) {
+	paths := useKubeconfigs(t, "config")
+	require.NoError(t, os.WriteFile(paths[0], []byte(foreignKubeconfig), 0o600))
+
+	kubeconfigs := &fakeKubeconfigs{}
+	service := NewService(&mockClusterRepository{}, logr.Discard(), "c1")
+	service.kubeconfigs = kubeconfigs
+	cluster := &Cluster{OCID: "ocid1.cluster.oc1.phx.aaaac3dmnrqg4ba", DisplayName: "prod-oke", PublicEndpoint: "203.0.113.10:6443", PrivateEndpoint: "10.0.0.3:6443"}
+
+	kc, err := service.AddKubeconfig(context.Background(), cluster, "us-phoenix-1", AddKubeconfigOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, compute.EndpointPublic, kubeconfigs.endpoint)
+	assert.Equal(t, KubeContext{Name: "context-ac3dmnrqg4ba-public", ClusterID: cluster.OCID, Region: "us-phoenix-1", Server: "https://203.0.113.10:6443"}, kc)
+
+	written := readKubeconfig(t, paths[0])
+	assert.Equal(t, "kind", written.CurrentContext, "an existing current context is kept")
+	assert.Equal(t, map[string]any{"colors": true}, written.Extra["preferences"])
+	require.Len(t, written.Users, 2)
+	assert.Equal(t, "Y2VydA==", written.Users[0].User.Extra["client-certificate-data"])
+	assert.Equal(t, "Y2EtZGF0YQ==", written.Clusters[1].Cluster.CertificateAuthorityData)
+	assert.True(t, matchOKEExec(written.Users[1].User.Exec, cluster.OCID, "us-phoenix-1"))
+
+	backup, err := os.ReadFile(paths[0] + ".bak")
+	require.NoError(t, err)
+	assert.Equal(t, foreignKubeconfig, string(backup))
+	info, err := os.Stat(paths[0])
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	entries, err := os.ReadDir(filepath.Dir(paths[0]))
+	require.NoError(t, err)
+	assert.Len(t, entries, 2, "no temporary file is left behind")
+
+	_, err = service.AddKubeconfig(context.Background(), cluster, "us-phoenix-1", AddKubeconfigOptions{Context: "kind"})
+	assert.ErrorContains(t, err, `kube context "kind" already exists for another cluster`)
+
+	_, err = service.AddKubeconfig(context.Background(), &Cluster{DisplayName: "private-oke", PrivateEndpoint: "10.0.0.3:6443"}, "us-phoenix-1", AddKubeconfigOptions{Endpoint: compute.EndpointPublic})
+	assert.EqualError(t, err, "cluster private-oke has no public endpoint; use --endpoint private")
+}
+
+func TestKubeconfigSet_FollowsKUBECONFIG(t *testing.T) {
+	paths := useKubeconfigs(t, "missing", "work", "personal")
+	require.NoError(t, os.WriteFile(paths[1], []byte("apiVersion: v1\nkind: Config\n"), 0o600))
+	require.NoError(t, os.WriteFile(paths[2], []byte(foreignKubeconfig), 0o600))
+
+	service := NewService(&mockClusterRepository{}, logr.Discard(), "c1")
+	service.kubeconfigs = &fakeKubeconfigs{}
+	a := &Cluster{OCID: "ocid1.cluster.oc1..aaaa", DisplayName: "a", PrivateEndpoint: "10.0.0.3:6443"}
+	b := &Cluster{OCID: "ocid1.cluster.oc1..bbbb", DisplayName: "b", PrivateEndpoint: "10.0.0.4:6443"}
+	_, err := service.AddKubeconfig(context.Background(), a, "eu-frankfurt-1", AddKubeconfigOptions{Context: "a"})
+	require.NoError(t, err)
+	_, err = service.AddKubeconfig(context.Background(), b, "eu-frankfurt-1", AddKubeconfigOptions{Context: "b"})
+	require.NoError(t, err)
+
+	_, err = os.Stat(paths[0])
+	assert.True(t, os.IsNotExist(err), "new entries go to the first existing file")
+	assert.Len(t, readKubeconfig(t, paths[1]).Contexts, 2)
+	assert.Len(t, readKubeconfig(t, paths[2]).Contexts, 1, "the other files are left alone")
+
+	contexts, err := ListKubeContexts()
+	require.NoError(t, err)
+	require.Len(t, contexts, 2, "foreign contexts are not listed")
+	assert.Equal(t, "a", contexts[0].Name)
+	assert.Equal(t, "eu-frankfurt-1", contexts[0].Region)
+	assert.False(t, contexts[0].Current)
+
+	var buf bytes.Buffer
+	appCtx := &app.ApplicationContext{Stdout: &buf}
+	assert.EqualError(t, RemoveKubeconfig(appCtx, "kind"), `kube context "kind" is not managed by ocloud`)
+	require.NoError(t, RemoveKubeconfig(appCtx, "a"))
+	work := readKubeconfig(t, paths[1])
+	require.Len(t, work.Contexts, 1)
+	assert.Equal(t, "b", work.Contexts[0].Name)
+	assert.Len(t, work.Users, 1, "the user only the removed context used is removed")
+	assert.Len(t, work.Clusters, 1)
+
+	buf.Reset()
+	require.NoError(t, PrintKubeContexts(appCtx, contexts, printer.TableOutput))
+	assert.Contains(t, buf.String(), "https://203.0.113.10:6443")
+}
+
+func TestKubeconfigSave_KeepsSymlink(t *testing.T) {
+	paths := useKubeconfigs(t, "config")
+	target := filepath.Join(t.TempDir(), "dotfiles-kubeconfig")
+	require.NoError(t, os.WriteFile(target, []byte(foreignKubeconfig), 0o600))
+	require.NoError(t, os.Symlink(target, paths[0]))
+
+	kcs, err := loadKubeconfigs()
+	require.NoError(t, err)
+	kcs.upsertOKEContext("prod", "cluster-aaaa", "ocid1.cluster.oc1..aaaa", "us-ashburn-1", kcCluster{Server: "https://10.0.0.3:6443"})
+	require.NoError(t, kcs.save())
+
+	info, err := os.Lstat(paths[0])
+	require.NoError(t, err)
+	assert.NotZero(t, info.Mode()&os.ModeSymlink, "the kubeconfig is still a symlink")
+	assert.Len(t, readKubeconfig(t, target).Contexts, 2, "the link target was written")
+}
+
+func TestKubeconfigSet_RemoveClearsCurrentAndKeepsSharedEntries(t *testing.T) {
+	useKubeconfigs(t, "config")
+	kcs, err := loadKubeconfigs()
+	require.NoError(t, err)
+	kcs.upsertOKEContext("one", "cluster-aaaa", "ocid1.cluster.oc1..aaaa", "us-ashburn-1", kcCluster{Server: "https://10.0.0.3:6443"})
+	kcs.upsertOKEContext("two", "cluster-aaaa", "ocid1.cluster.oc1..aaaa", "us-ashburn-1", kcCluster{Server: "https://10.0.0.3:6443"})
+	kcs.setCurrentContext("one")
+	require.NoError(t, kcs.save())
+
+	kcs, err = loadKubeconfigs()
+	require.NoError(t, err)
+	kcs.removeContext("one")
+	require.NoError(t, kcs.save())
+
+	kc := readKubeconfig(t, kcs.files[0].path)
+	assert.Empty(t, kc.CurrentContext)
+	assert.Len(t, kc.Contexts, 1)
+	assert.Len(t, kc.Users, 1, "the user is still used by the other context")
+	assert.Len(t, kc.Clusters, 1)
+}
+
+func TestEnsureKubeconfigForOKE_IsNoOpWhenUserExists(t *testing.T) {
+	paths := useKubeconfigs(t, "config")
+	kcs, err := loadKubeconfigs()
+	require.NoError(t, err)
+	kcs.upsertOKEContext("prod", "cluster-aaaa", "ocid1.cluster.oc1..aaaa", "us-ashburn-1", kcCluster{Server: "https://127.0.0.1:6443"})
+	require.NoError(t, kcs.save())
+
+	exists, err := KubeconfigExistsForOKE(Cluster{OCID: "ocid1.cluster.oc1..aaaa"}, "us-ashburn-1")
+	require.NoError(t, err)
+	assert.True(t, exists)
+	require.NoError(t, EnsureKubeconfigForOKE(Cluster{OCID: "ocid1.cluster.oc1..aaaa"}, "us-ashburn-1", 6444))
+	assert.Equal(t, "https://127.0.0.1:6443", readKubeconfig(t, paths[0]).Clusters[0].Cluster.Server)
+
+	exists, err = KubeconfigExistsForOKE(Cluster{OCID: "ocid1.cluster.oc1..aaaa"}, "eu-frankfurt-1")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestKubeconfigAdd_KeepsTunnelContext(t *testing.T) {
+	paths := useKubeconfigs(t, "config")
+	service := NewService(&mockClusterRepository{}, logr.Discard(), "c1")
+	service.kubeconfigs = &fakeKubeconfigs{}
+	cluster := &Cluster{OCID: "ocid1.cluster.oc1.phx.aaaac3dmnrqg4ba", DisplayName: "prod-oke", PublicEndpoint: "203.0.113.10:6443"}
+	servers := func() map[string]string {
+		kcs, err := loadKubeconfigs()
+		require.NoError(t, err)
+		out := map[string]string{}
+		for _, c := range readKubeconfig(t, paths[0]).Contexts {
+			cl, ok := kcs.cluster(c.Context.Cluster)
+			require.True(t, ok)
+			out[c.Name] = cl.Cluster.Server
+		}
+		return out
+	}
+
+	require.NoError(t, EnsureKubeconfigForOKE(*cluster, "us-phoenix-1", 6443))
+	_, err := service.AddKubeconfig(context.Background(), cluster, "us-phoenix-1", AddKubeconfigOptions{})
+	require.NoError(t, err)
+	want := map[string]string{
+		"context-ac3dmnrqg4ba":        "https://127.0.0.1:6443",
+		"context-ac3dmnrqg4ba-public": "https://203.0.113.10:6443",
+	}
+	assert.Equal(t, want, servers())
+
+	exists, err := KubeconfigExistsForOKE(*cluster, "us-phoenix-1")
+	require.NoError(t, err)
+	assert.True(t, exists, "the tunnel context is still found after add")
+
+	// The other way round: add first, then the tunnel flow creates its own context.
+	paths = useKubeconfigs(t, "config")
+	_, err = service.AddKubeconfig(context.Background(), cluster, "us-phoenix-1", AddKubeconfigOptions{Context: "context-ac3dmnrqg4ba"})
+	require.NoError(t, err)
+	exists, err = KubeconfigExistsForOKE(*cluster, "us-phoenix-1")
+	require.NoError(t, err)
+	assert.False(t, exists, "a context for the public endpoint is not a tunnel")
+	require.NoError(t, EnsureKubeconfigForOKE(*cluster, "us-phoenix-1", 6443))
+	assert.Equal(t, map[string]string{
+		"context-ac3dmnrqg4ba":        "https://203.0.113.10:6443",
+		"context-ac3dmnrqg4ba-tunnel": "https://127.0.0.1:6443",
+	}, servers())
+}
+
+func TestStaleContexts(t *testing.T) {
+	repos := map[string]*mockClusterRepository{
+		"us-ashburn-1": {clusters: []compute.Cluster{
+			{OCID: "live", State: "ACTIVE"},
+			{OCID: "deleted", State: "DELETED"},
+		}},
+		"eu-frankfurt-1": {err: errors.New("not authorized")},
+	}
+	service := NewService(&mockClusterRepository{}, logr.Discard(), "c1")
+	service.clustersIn = func(region string) (compute.ClusterRepository, error) {
+		return repos[region], nil
+	}
+
+	stale, skipped := service.StaleContexts(context.Background(), []KubeContext{
+		{Name: "live", ClusterID: "live", Region: "us-ashburn-1"},
+		{Name: "deleted", ClusterID: "deleted", Region: "us-ashburn-1"},
+		{Name: "gone", ClusterID: "gone", Region: "us-ashburn-1"},
+		{Name: "unknown", ClusterID: "other", Region: "eu-frankfurt-1"},
+	})
+	assert.Equal(t, []string{"deleted"}, contextNames(stale))
+	assert.Equal(t, []SkippedContext{
+		{Name: "gone", Reason: "cluster not found or not visible to the current profile"},
+		{Name: "unknown", Reason: "not authorized"},
+	}, skipped, "a 404 may be a cluster of another tenancy")
+}
+
+func TestOKEExecTarget(t *testing.T) {
+	clusterID, region, ok := okeExecTarget(okeExec("ocid1.cluster.oc1..aaaa", "us-ashburn-1"))
+	assert.True(t, ok)
+	assert.Equal(t, "ocid1.cluster.oc1..aaaa", clusterID)
+	assert.Equal(t, "us-ashburn-1", region)
+
+	_, _, ok = okeExecTarget(&kcExec{Command: "aws", Args: []string{"eks", "get-token", "--cluster-name", "x"}})
+	assert.False(t, ok)
+	_, _, ok = okeExecTarget(nil)
+	assert.False(t, ok)
+}
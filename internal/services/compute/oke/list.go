@@ -6,29 +6,29 @@ import (
 	"fmt"
 
 	"github.com/cnopslabs/ocloud/internal/app"
-	"github.com/cnopslabs/ocloud/internal/oci"
 	ociOke "github.com/cnopslabs/ocloud/internal/oci/compute/oke"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/services/util"
 	"github.com/cnopslabs/ocloud/internal/tui"
+	"github.com/cnopslabs/ocloud/internal/watch"
 )
 
 // ListClusters lists all OKE clusters in the tenancy.
-func ListClusters(appCtx *app.ApplicationContext, useJSON bool) error {
+func ListClusters(appCtx *app.ApplicationContext, format printer.OutputFormat) error {
 	ctx := context.Background()
-	containerEngineClient, err := oci.NewContainerEngineClient(appCtx.Provider)
+	service, err := NewServiceFromAppContext(appCtx)
 	if err != nil {
-		return fmt.Errorf("creating container engine client: %w", err)
+		return fmt.Errorf("creating cluster service: %w", err)
 	}
 
-	clusterAdapter := ociOke.NewAdapter(containerEngineClient)
-	service := NewService(clusterAdapter, appCtx.Logger, appCtx.CompartmentID)
-
 	clusters, err := service.ListClusters(ctx)
 	if err != nil {
 		return fmt.Errorf("listing allClusters: %w", err)
 	}
 
 	// TUI
-	model := ociOke.NewImageListModel(clusters)
+	model := ociOke.NewImageListModel(clusters).
+		WithQueryFilter(ToSearchableClusters(clusters), GetSearchableFields(), GetBoostedFields())
 	id, err := tui.Run(model)
 	if err != nil {
 		if errors.Is(err, tui.ErrCancelled) {
@@ -37,11 +37,18 @@ func ListClusters(appCtx *app.ApplicationContext, useJSON bool) error {
 		return fmt.Errorf("selecting image: %w", err)
 	}
 
-	cluster, err := service.clusterRepo.GetCluster(ctx, id)
+	// Only the selected cluster is looked up with its add-ons.
+	detail, err := newServiceFromAppContext(appCtx, true)
 	if err != nil {
-		return fmt.Errorf("getting image: %w", err)
+		return fmt.Errorf("creating cluster service: %w", err)
 	}
+	return util.Watch(ctx, appCtx, func(ctx context.Context) ([]watch.State, error) {
+		cluster, err := detail.clusterRepo.GetCluster(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("getting image: %w", err)
+		}
 
-	return PrintOKEInfo(appCtx, cluster, useJSON)
-
+		err = PrintOKEInfo(appCtx, cluster, format, true)
+		return []watch.State{watchState(*cluster)}, err
+	})
 }
@@ -0,0 +1,167 @@
+package oke
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/oci"
+	ociInst "github.com/cnopslabs/ocloud/internal/oci/compute/instance"
+	ocioke "github.com/cnopslabs/ocloud/internal/oci/compute/oke"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/region"
+	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/subtree"
+	"github.com/cnopslabs/ocloud/internal/watch"
+)
+
+// newNodeService builds a Service that can also list the nodes of node pools
+// and join them to their instances; addons is as for newServiceFromAppContext.
+func newNodeService(appCtx *app.ApplicationContext, addons bool) (*Service, error) {
+	service, err := newServiceFromAppContext(appCtx, addons)
+	if err != nil {
+		return nil, err
+	}
+	service.nodes, err = region.NewNodePoolInventory(appCtx.Regions, func(name string) (compute.NodePoolInventory, error) {
+		containerEngineClient, err := oci.NewContainerEngineClient(appCtx.ForRegion(name).Provider)
+		if err != nil {
+			return nil, fmt.Errorf("creating container engine client: %w", err)
+		}
+		return ocioke.NewAdapter(containerEngineClient), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	service.instanceRepo, err = region.NewInstanceRepository(appCtx.Regions, func(name string) (compute.InstanceRepository, error) {
+		regional := appCtx.ForRegion(name)
+		computeClient, err := oci.NewComputeClient(regional.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("creating compute client: %w", err)
+		}
+		networkClient, err := oci.NewNetworkClient(regional.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("creating network client: %w", err)
+		}
+		return subtree.NewInstanceRepository(cache.NewInstanceRepository(ociInst.NewAdapter(computeClient, networkClient), regional.Cache), regional.Subtree), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service, nil
+}
+
+// GetCluster displays the cluster with the given name or OCID, with the nodes
+// of each node pool when showNodes is set and its options and add-ons when
+// showAll is set.
+func GetCluster(appCtx *app.ApplicationContext, nameOrID string, showNodes, showAll bool, format printer.OutputFormat) error {
+	var service *Service
+	var err error
+	if showNodes {
+		service, err = newNodeService(appCtx, showAll)
+	} else {
+		service, err = newServiceFromAppContext(appCtx, showAll)
+	}
+	if err != nil {
+		return fmt.Errorf("creating cluster service: %w", err)
+	}
+
+	return util.Watch(context.Background(), appCtx, func(ctx context.Context) ([]watch.State, error) {
+		cluster, err := service.FindCluster(ctx, nameOrID)
+		if err != nil {
+			return nil, fmt.Errorf("getting cluster: %w", err)
+		}
+		if showNodes {
+			if err := service.LoadNodes(ctx, cluster); err != nil {
+				return nil, err
+			}
+		}
+		err = PrintOKEInfo(appCtx, cluster, format, showAll)
+		return []watch.State{watchState(*cluster)}, err
+	})
+}
+
+// FindCluster returns the cluster whose OCID or display name (case-insensitive) is nameOrID.
+func (s *Service) FindCluster(ctx context.Context, nameOrID string) (*Cluster, error) {
+	if strings.HasPrefix(nameOrID, "ocid1.cluster.") {
+		cluster, err := s.clusterRepo.GetCluster(ctx, nameOrID)
+		if err != nil {
+			return nil, fmt.Errorf("getting cluster from repository: %w", err)
+		}
+		return cluster, nil
+	}
+
+	clusters, err := s.ListClusters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range clusters {
+		if strings.EqualFold(c.DisplayName, nameOrID) {
+			return &c, nil
+		}
+	}
+	return nil, fmt.Errorf("cluster %q not found", nameOrID)
+}
+
+// LoadNodes sets the Nodes of every node pool of cluster and links each node to
+// its enriched instance record, for the subnet and NSGs it runs in. Nodes are
+// matched against the instances of the compartment first; the others, e.g. in
+// node pools of another compartment, are looked up one by one and left without
+// an instance when that fails. The VirtualNodes of every virtual node pool are
+// set too; virtual nodes have no instance.
+func (s *Service) LoadNodes(ctx context.Context, cluster *Cluster) error {
+	for i := range cluster.VirtualNodePools {
+		pool := &cluster.VirtualNodePools[i]
+		nodes, err := s.nodes.ListVirtualNodes(ctx, cluster.OCID, pool.OCID)
+		if err != nil {
+			return fmt.Errorf("listing virtual nodes of virtual node pool %s: %w", pool.DisplayName, err)
+		}
+		pool.VirtualNodes = nodes
+	}
+
+	total := 0
+	for i := range cluster.NodePools {
+		pool := &cluster.NodePools[i]
+		nodes, err := s.nodes.ListNodes(ctx, cluster.OCID, pool.OCID)
+		if err != nil {
+			return fmt.Errorf("listing nodes of node pool %s: %w", pool.DisplayName, err)
+		}
+		pool.Nodes = nodes
+		total += len(nodes)
+	}
+	if total == 0 {
+		return nil
+	}
+
+	instances, err := s.instanceRepo.ListEnrichedInstances(ctx, s.compartmentID)
+	if err != nil {
+		return fmt.Errorf("listing instances to link nodes: %w", err)
+	}
+	byID := make(map[string]compute.Instance, len(instances))
+	for _, inst := range instances {
+		byID[inst.OCID] = inst
+	}
+
+	for i := range cluster.NodePools {
+		for j := range cluster.NodePools[i].Nodes {
+			node := &cluster.NodePools[i].Nodes[j]
+			if node.OCID == "" {
+				continue
+			}
+			if inst, ok := byID[node.OCID]; ok {
+				node.Instance = &inst
+				continue
+			}
+			inst, err := s.instanceRepo.GetEnrichedInstance(ctx, node.OCID)
+			if err != nil {
+				logger.LogWithLevel(s.logger, logger.Debug, "skipping instance of node", "node", node.DisplayName, "id", node.OCID, "error", err)
+				continue
+			}
+			node.Instance = inst
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package oke
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNodeInventory serves the nodes of each node pool and virtual node pool by pool OCID.
+type fakeNodeInventory struct {
+	nodes   map[string][]compute.Node
+	virtual map[string][]compute.VirtualNode
+}
+
+func (f fakeNodeInventory) ListNodes(ctx context.Context, clusterID, nodePoolID string) ([]compute.Node, error) {
+	return f.nodes[nodePoolID], nil
+}
+
+func (f fakeNodeInventory) ListVirtualNodes(ctx context.Context, clusterID, virtualNodePoolID string) ([]compute.VirtualNode, error) {
+	return f.virtual[virtualNodePoolID], nil
+}
+
+// fakeInstanceRepository lists the instances of the compartment and looks up
+// the others in byID.
+type fakeInstanceRepository struct {
+	compute.InstanceRepository
+	listed []compute.Instance
+	byID   map[string]compute.Instance
+	gets   []string
+}
+
+func (f *fakeInstanceRepository) ListEnrichedInstances(ctx context.Context, compartmentID string) ([]compute.Instance, error) {
+	return f.listed, nil
+}
+
+func (f *fakeInstanceRepository) GetEnrichedInstance(ctx context.Context, ocid string) (*compute.Instance, error) {
+	f.gets = append(f.gets, ocid)
+	if inst, ok := f.byID[ocid]; ok {
+		return &inst, nil
+	}
+	return nil, errors.New("not authorized")
+}
+
+func TestFindCluster(t *testing.T) {
+	repo := &mockClusterRepository{clusters: []compute.Cluster{
+		{OCID: "ocid1.cluster.oc1..a", DisplayName: "prod-oke"},
+		{OCID: "ocid1.cluster.oc1..b", DisplayName: "dev-oke"},
+	}}
+	service := NewService(repo, logr.Discard(), "c1")
+
+	c, err := service.FindCluster(context.Background(), "PROD-OKE")
+	require.NoError(t, err)
+	assert.Equal(t, "ocid1.cluster.oc1..a", c.OCID)
+
+	c, err = service.FindCluster(context.Background(), "ocid1.cluster.oc1..b")
+	require.NoError(t, err)
+	assert.Equal(t, "dev-oke", c.DisplayName)
+
+	_, err = service.FindCluster(context.Background(), "staging")
+	assert.EqualError(t, err, `cluster "staging" not found`)
+}
+
+func TestLoadNodes(t *testing.T) {
+	service := NewService(&mockClusterRepository{}, logr.Discard(), "c1")
+	service.nodes = fakeNodeInventory{
+		nodes: map[string][]compute.Node{
+			"np1": {{OCID: "i1", DisplayName: "n1"}, {OCID: "i2", DisplayName: "n2"}},
+			"np2": {{OCID: "i3", DisplayName: "n3"}, {DisplayName: "n4", State: "CREATING"}},
+		},
+		virtual: map[string][]compute.VirtualNode{
+			"vnp1": {{OCID: "vn1", DisplayName: "virtual-1", State: "ACTIVE"}},
+		},
+	}
+	instances := &fakeInstanceRepository{
+		listed: []compute.Instance{{OCID: "i1", SubnetName: "workers", NsgNames: []string{"oke-workers"}}},
+		byID:   map[string]compute.Instance{"i2": {OCID: "i2", SubnetName: "workers-other"}},
+	}
+	service.instanceRepo = instances
+
+	cluster := &Cluster{
+		OCID:             "ocid1.cluster.oc1..a",
+		NodePools:        []NodePool{{OCID: "np1"}, {OCID: "np2"}, {OCID: "np3"}},
+		VirtualNodePools: []VirtualNodePool{{OCID: "vnp1"}},
+	}
+	require.NoError(t, service.LoadNodes(context.Background(), cluster))
+
+	pool := cluster.NodePools[0]
+	require.Len(t, pool.Nodes, 2)
+	require.NotNil(t, pool.Nodes[0].Instance)
+	assert.Equal(t, []string{"oke-workers"}, pool.Nodes[0].Instance.NsgNames)
+	require.NotNil(t, pool.Nodes[1].Instance, "nodes outside the compartment listing are looked up one by one")
+	assert.Equal(t, "workers-other", pool.Nodes[1].Instance.SubnetName)
+
+	assert.Nil(t, cluster.NodePools[1].Nodes[0].Instance, "a failed lookup leaves the node without an instance")
+	assert.Nil(t, cluster.NodePools[1].Nodes[1].Instance)
+	assert.Equal(t, []string{"i2", "i3"}, instances.gets, "nodes without an instance yet are not looked up")
+	assert.Empty(t, cluster.NodePools[2].Nodes)
+	assert.Equal(t, []compute.VirtualNode{{OCID: "vn1", DisplayName: "virtual-1", State: "ACTIVE"}}, cluster.VirtualNodePools[0].VirtualNodes)
+}
+
+func TestPrintOKEInfo_Nodes(t *testing.T) {
+	cluster := &Cluster{
+		OCID:        "ocid1.cluster.oc1..a",
+		DisplayName: "prod-oke",
+		NodePools: []NodePool{
+			{DisplayName: "pool-a", Nodes: []compute.Node{
+				{
+					OCID: "ocid1.instance.oc1..n1", DisplayName: "oke-n1", State: "ACTIVE", PrivateIP: "10.0.10.7",
+					AvailabilityDomain: "Uocm:PHX-AD-1", FaultDomain: "FAULT-DOMAIN-2", KubernetesVersion: "v1.31.1",
+					SubnetID: "ocid1.subnet.oc1..w", Instance: &compute.Instance{SubnetName: "workers", NsgNames: []string{"oke-workers"}},
+				},
+				{OCID: "ocid1.instance.oc1..n2", DisplayName: "oke-n2", State: "FAILING", Error: "LimitExceeded: service limit reached"},
+			}},
+			{DisplayName: "pool-b", Nodes: []compute.Node{}},
+			{DisplayName: "pool-c"},
+		},
+	}
+
+	var buf bytes.Buffer
+	appCtx := &app.ApplicationContext{Logger: logger.NewTestLogger(), Stdout: &buf}
+	require.NoError(t, PrintOKEInfo(appCtx, cluster, printer.TableOutput, false))
+
+	out := buf.String()
+	assert.Contains(t, out, "Nodes: pool-a (2)")
+	assert.Contains(t, out, "PHX-AD-1")
+	assert.Contains(t, out, "oke-workers")
+	assert.Contains(t, out, "ocid1.instance.oc1..n1")
+	assert.Contains(t, out, "LimitExceeded: service limit reached")
+	assert.Contains(t, out, "No nodes in this node pool.")
+	assert.NotContains(t, out, "Nodes: pool-c", "node pools without loaded nodes get no node table")
+}
@@ -3,6 +3,7 @@ package oke
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/cnopslabs/ocloud/internal/app"
@@ -11,15 +12,16 @@ import (
 )
 
 // PrintOKETable groups cluster metadata and node-pool details into one table per cluster.
-func PrintOKETable(clusters []Cluster, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, useJSON bool) error {
+// When showAll is true, the options and add-ons of each cluster follow its table.
+func PrintOKETable(clusters []Cluster, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, format printer.OutputFormat, showAll bool) error {
 	p := printer.New(appCtx.Stdout)
 
 	if pagination != nil {
 		util.AdjustPaginationInfo(pagination)
 	}
 
-	if useJSON {
-		return util.MarshalDataToJSONResponse[Cluster](p, clusters, pagination)
+	if !format.IsTable() {
+		return util.MarshalScopedResponse(p, format, appCtx, clusters, clusterID, pagination)
 	}
 
 	if util.ValidateAndReportEmpty(clusters, pagination, appCtx.Stdout) {
@@ -51,25 +53,41 @@ func PrintOKETable(clusters []Cluster, appCtx *app.ApplicationContext, paginatio
 			})
 		}
 
-		title := util.FormatColoredTitle(appCtx, fmt.Sprintf("Cluster: %s (%d node pools)", c.DisplayName, len(c.NodePools)))
+		for _, vnp := range c.VirtualNodePools {
+			rows = append(rows, []string{
+				vnp.DisplayName,
+				"VirtualNodePool",
+				vnp.KubernetesVersion,
+				vnp.PodShape,
+				fmt.Sprintf("%d", vnp.Size),
+				vnp.State,
+			})
+		}
+
+		title := util.FormatColoredResourceTitle(appCtx, c.OCID, fmt.Sprintf("Cluster: %s (%s)", c.DisplayName, poolCounts(c)))
 		p.PrintTable(title, headers, rows)
 		fmt.Fprintln(appCtx.Stdout)
+
+		if showAll {
+			renderClusterOptions(p, appCtx, c)
+		}
 	}
 
 	util.LogPaginationInfo(pagination, appCtx)
 	return nil
 }
 
-// PrintOKEsInfo displays instances in a formatted table or JSON format.
-func PrintOKEsInfo(clusters []Cluster, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, useJSON bool) error {
+// PrintOKEsInfo displays clusters in a formatted table or JSON format, with
+// their options and add-ons when showAll is true.
+func PrintOKEsInfo(clusters []Cluster, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, format printer.OutputFormat, showAll bool) error {
 	p := printer.New(appCtx.Stdout)
 
 	if pagination != nil {
 		util.AdjustPaginationInfo(pagination)
 	}
 
-	if useJSON {
-		return util.MarshalDataToJSONResponse[Cluster](p, clusters, pagination)
+	if !format.IsTable() {
+		return util.MarshalScopedResponse(p, format, appCtx, clusters, clusterID, pagination)
 	}
 
 	if util.ValidateAndReportEmpty(clusters, pagination, appCtx.Stdout) {
@@ -81,30 +99,31 @@ func PrintOKEsInfo(clusters []Cluster, appCtx *app.ApplicationContext, paginatio
 	})
 
 	for _, c := range clusters {
-		renderCluster(p, appCtx, c)
+		renderCluster(p, appCtx, c, showAll)
 	}
 
 	util.LogPaginationInfo(pagination, appCtx)
 	return nil
 }
 
-// PrintOKEInfo prints a detailed view of a cluster.
-func PrintOKEInfo(appCtx *app.ApplicationContext, c *Cluster, useJSON bool) error {
+// PrintOKEInfo prints a detailed view of a cluster, with its options and
+// add-ons when showAll is true.
+func PrintOKEInfo(appCtx *app.ApplicationContext, c *Cluster, format printer.OutputFormat, showAll bool) error {
 	if c == nil {
 		return fmt.Errorf("nil cluster")
 	}
 	p := printer.New(appCtx.Stdout)
 
-	if useJSON {
-		return util.MarshalDataToJSONResponse[Cluster](p, []Cluster{*c}, nil)
+	if !format.IsTable() {
+		return util.MarshalScopedResponse(p, format, appCtx, []Cluster{*c}, clusterID, nil)
 	}
 
-	renderCluster(p, appCtx, *c)
+	renderCluster(p, appCtx, *c, showAll)
 	return nil
 }
 
 // renderCluster renders a cluster in a formatted table or JSON format.
-func renderCluster(p *printer.Printer, appCtx *app.ApplicationContext, c Cluster) {
+func renderCluster(p *printer.Printer, appCtx *app.ApplicationContext, c Cluster, showAll bool) {
 	created := "-"
 	if !c.TimeCreated.IsZero() {
 		created = c.TimeCreated.Format("2006-01-02 15:04:05")
@@ -120,8 +139,12 @@ func renderCluster(p *printer.Printer, appCtx *app.ApplicationContext, c Cluster
 		"Node Pools":       fmt.Sprintf("%d", len(c.NodePools)),
 	}
 	order := []string{"ID", "Name", "K8s Version", "Created", "State", "Private Endpoint", "Node Pools"}
+	if len(c.VirtualNodePools) > 0 {
+		summary["Virtual Node Pools"] = fmt.Sprintf("%d", len(c.VirtualNodePools))
+		order = append(order, "Virtual Node Pools")
+	}
 
-	title := util.FormatColoredTitle(appCtx, fmt.Sprintf("Cluster: %s", c.DisplayName))
+	title := util.FormatColoredResourceTitle(appCtx, c.OCID, fmt.Sprintf("Cluster: %s", c.DisplayName))
 	p.PrintKeyValues(title, summary, order)
 	fmt.Fprintln(appCtx.Stdout)
 
@@ -140,4 +163,274 @@ func renderCluster(p *printer.Printer, appCtx *app.ApplicationContext, c Cluster
 		p.PrintTable(tableTitle, headers, rows)
 		fmt.Fprintln(appCtx.Stdout)
 	}
+
+	if len(c.VirtualNodePools) > 0 {
+		headers := []string{"Virtual Node Pool", "Version", "Pod Shape", "Size", "State"}
+		rows := make([][]string, len(c.VirtualNodePools))
+		for i, vnp := range c.VirtualNodePools {
+			rows[i] = []string{
+				vnp.DisplayName,
+				vnp.KubernetesVersion,
+				vnp.PodShape,
+				fmt.Sprintf("%d", vnp.Size),
+				vnp.State,
+			}
+		}
+		p.PrintTable(util.FormatColoredTitle(appCtx, "Virtual Node Pools"), headers, rows)
+		fmt.Fprintln(appCtx.Stdout)
+	}
+
+	if showAll {
+		renderClusterOptions(p, appCtx, c)
+	}
+
+	for _, np := range c.NodePools {
+		if np.Nodes != nil {
+			renderNodes(p, appCtx, np)
+		}
+	}
+	for _, vnp := range c.VirtualNodePools {
+		if vnp.VirtualNodes != nil {
+			renderVirtualNodes(p, appCtx, vnp)
+		}
+	}
+}
+
+// poolCounts describes how many node pools, and virtual node pools if any, a cluster has.
+func poolCounts(c Cluster) string {
+	counts := fmt.Sprintf("%d node pools", len(c.NodePools))
+	if len(c.VirtualNodePools) > 0 {
+		counts += fmt.Sprintf(", %d virtual node pools", len(c.VirtualNodePools))
+	}
+	return counts
+}
+
+// renderClusterOptions prints the pod networking, admission and image policy
+// options of a cluster, then its add-ons.
+func renderClusterOptions(p *printer.Printer, appCtx *app.ApplicationContext, c Cluster) {
+	o := c.Options
+	imagePolicy := "disabled"
+	if o.ImagePolicyEnabled {
+		imagePolicy = fmt.Sprintf("enabled (%d signing keys)", len(o.ImageSigningKeyIDs))
+	}
+	podSecurityPolicy := "disabled"
+	if o.PodSecurityPolicyEnabled {
+		podSecurityPolicy = "enabled"
+	}
+	options := map[string]string{
+		"CNI":                 orDash(o.CNIType),
+		"Pods CIDR":           orDash(o.PodsCIDR),
+		"Services CIDR":       orDash(o.ServicesCIDR),
+		"Public Endpoint":     orDash(c.PublicEndpoint),
+		"Pod Security Policy": podSecurityPolicy,
+		"Image Policy":        imagePolicy,
+	}
+	order := []string{"CNI", "Pods CIDR", "Services CIDR", "Public Endpoint", "Pod Security Policy", "Image Policy"}
+	p.PrintKeyValues(util.FormatColoredTitle(appCtx, "Cluster Options"), options, order)
+	fmt.Fprintln(appCtx.Stdout)
+
+	title := util.FormatColoredTitle(appCtx, fmt.Sprintf("Add-ons (%d)", len(c.Addons)))
+	if len(c.Addons) == 0 {
+		fmt.Fprintln(appCtx.Stdout, title)
+		if c.Addons == nil {
+			fmt.Fprintln(appCtx.Stdout, "Add-ons could not be listed.")
+		} else {
+			fmt.Fprintln(appCtx.Stdout, "No add-ons installed.")
+		}
+		fmt.Fprintln(appCtx.Stdout)
+		return
+	}
+	rows := make([][]string, len(c.Addons))
+	for i, a := range c.Addons {
+		rows[i] = []string{a.Name, orDash(a.Version), a.State, orDash(a.Error)}
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i][0] < rows[j][0] })
+	p.PrintTableNoTruncate(title, []string{"Add-on", "Version", "State", "Error"}, rows)
+	fmt.Fprintln(appCtx.Stdout)
+}
+
+// orDash returns s, or "-" when it is empty.
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// renderNodes prints the nodes of a node pool, followed by the errors OCI
+// reported for any of them.
+func renderNodes(p *printer.Printer, appCtx *app.ApplicationContext, np NodePool) {
+	title := util.FormatColoredTitle(appCtx, fmt.Sprintf("Nodes: %s (%d)", np.DisplayName, len(np.Nodes)))
+	if len(np.Nodes) == 0 {
+		fmt.Fprintln(appCtx.Stdout, title)
+		fmt.Fprintln(appCtx.Stdout, "No nodes in this node pool.")
+		fmt.Fprintln(appCtx.Stdout)
+		return
+	}
+
+	headers := []string{"Node", "State", "Private IP", "AD", "FD", "Version", "Subnet", "NSGs", "Instance"}
+	rows := make([][]string, 0, len(np.Nodes))
+	var errorRows [][]string
+	for _, n := range np.Nodes {
+		subnet, nsgs := n.SubnetID, "-"
+		if n.Instance != nil {
+			if n.Instance.SubnetName != "" {
+				subnet = n.Instance.SubnetName
+			}
+			if len(n.Instance.NsgNames) > 0 {
+				nsgs = strings.Join(n.Instance.NsgNames, ", ")
+			}
+		}
+		rows = append(rows, []string{
+			n.DisplayName,
+			n.State,
+			n.PrivateIP,
+			shortAD(n.AvailabilityDomain),
+			strings.TrimPrefix(n.FaultDomain, "FAULT-DOMAIN-"),
+			n.KubernetesVersion,
+			subnet,
+			nsgs,
+			n.OCID,
+		})
+		if n.Error != "" {
+			errorRows = append(errorRows, []string{n.DisplayName, n.Error})
+		} else if n.LifecycleDetails != "" && n.State != "ACTIVE" {
+			errorRows = append(errorRows, []string{n.DisplayName, n.LifecycleDetails})
+		}
+	}
+	p.PrintTableNoTruncate(title, headers, rows)
+	fmt.Fprintln(appCtx.Stdout)
+
+	if len(errorRows) > 0 {
+		p.PrintTableNoTruncate(util.FormatColoredTitle(appCtx, fmt.Sprintf("Node Errors: %s", np.DisplayName)), []string{"Node", "Message"}, errorRows)
+		fmt.Fprintln(appCtx.Stdout)
+	}
+}
+
+// renderVirtualNodes prints the virtual nodes of a virtual node pool, with the
+// error OCI reported for any of them.
+func renderVirtualNodes(p *printer.Printer, appCtx *app.ApplicationContext, vnp VirtualNodePool) {
+	title := util.FormatColoredTitle(appCtx, fmt.Sprintf("Virtual Nodes: %s (%d)", vnp.DisplayName, len(vnp.VirtualNodes)))
+	if len(vnp.VirtualNodes) == 0 {
+		fmt.Fprintln(appCtx.Stdout, title)
+		fmt.Fprintln(appCtx.Stdout, "No virtual nodes in this virtual node pool.")
+		fmt.Fprintln(appCtx.Stdout)
+		return
+	}
+
+	headers := []string{"Virtual Node", "State", "Private IP", "AD", "FD", "Version", "Subnet", "Error"}
+	rows := make([][]string, 0, len(vnp.VirtualNodes))
+	for _, n := range vnp.VirtualNodes {
+		message := n.Error
+		if message == "" && n.State != "ACTIVE" {
+			message = n.LifecycleDetails
+		}
+		rows = append(rows, []string{
+			n.DisplayName,
+			n.State,
+			n.PrivateIP,
+			shortAD(n.AvailabilityDomain),
+			strings.TrimPrefix(n.FaultDomain, "FAULT-DOMAIN-"),
+			n.KubernetesVersion,
+			n.SubnetID,
+			orDash(message),
+		})
+	}
+	p.PrintTableNoTruncate(title, headers, rows)
+	fmt.Fprintln(appCtx.Stdout)
+}
+
+// shortAD drops the tenancy prefix of an availability domain, "Uocm:PHX-AD-1" becomes "PHX-AD-1".
+func shortAD(ad string) string {
+	if i := strings.LastIndex(ad, ":"); i >= 0 {
+		return ad[i+1:]
+	}
+	return ad
+}
+
+// clusterID keys clusters for their compartment path.
+func clusterID(c Cluster) string { return c.OCID }
+
+// PrintUpgradeReport displays the versions of a cluster, its lagging node pools
+// and the suggested upgrade order, in a table or the requested structured format.
+func PrintUpgradeReport(appCtx *app.ApplicationContext, report UpgradeReport, format printer.OutputFormat) error {
+	p := printer.New(appCtx.Stdout)
+
+	if !format.IsTable() {
+		return p.Marshal(format, report)
+	}
+
+	available := "none"
+	if len(report.AvailableUpgrades) > 0 {
+		available = strings.Join(report.AvailableUpgrades, ", ")
+	}
+	lagging := 0
+	for _, np := range report.NodePools {
+		if np.Lagging {
+			lagging++
+		}
+	}
+	compliance := "yes"
+	if !report.Compliant {
+		compliance = fmt.Sprintf("no, %d of %d node pools lag by more than one minor version", lagging, len(report.NodePools))
+	}
+	summary := map[string]string{
+		"Control Plane": report.ControlPlaneVersion,
+		"Available":     available,
+		"Latest":        report.LatestVersion,
+		"Compliant":     compliance,
+	}
+	order := []string{"Control Plane", "Available", "Latest", "Compliant"}
+	title := util.FormatColoredResourceTitle(appCtx, report.ClusterID, fmt.Sprintf("Upgrades: %s", report.ClusterName))
+	p.PrintKeyValues(title, summary, order)
+	fmt.Fprintln(appCtx.Stdout)
+
+	if len(report.NodePools) > 0 {
+		rows := make([][]string, len(report.NodePools))
+		for i, np := range report.NodePools {
+			status := "OK"
+			if np.Lagging {
+				status = "LAGGING"
+			}
+			rows[i] = []string{np.Name, np.Version, strconv.Itoa(np.MinorsBehind), status}
+		}
+		p.PrintTable(util.FormatColoredTitle(appCtx, "Node Pools"), []string{"Node Pool", "Version", "Minors Behind", "Status"}, rows)
+		fmt.Fprintln(appCtx.Stdout)
+	}
+
+	if len(report.Steps) == 0 {
+		_, err := fmt.Fprintln(appCtx.Stdout, "The control plane and every node pool run the latest available version.")
+		return err
+	}
+	rows := make([][]string, len(report.Steps))
+	for i, s := range report.Steps {
+		rows[i] = []string{strconv.Itoa(s.Order), s.Target, s.Name, s.From, s.To, s.Reason}
+	}
+	p.PrintTableNoTruncate(util.FormatColoredTitle(appCtx, "Suggested Upgrade Order"), []string{"#", "Target", "Name", "From", "To", "Reason"}, rows)
+	return nil
+}
+
+// PrintKubeContexts displays kubeconfig contexts managed by ocloud in a table or
+// the requested structured format.
+func PrintKubeContexts(appCtx *app.ApplicationContext, contexts []KubeContext, format printer.OutputFormat) error {
+	p := printer.New(appCtx.Stdout)
+
+	if !format.IsTable() {
+		return p.Marshal(format, contexts)
+	}
+
+	if len(contexts) == 0 {
+		_, err := fmt.Fprintln(appCtx.Stdout, "No kube contexts managed by ocloud.")
+		return err
+	}
+	rows := make([][]string, len(contexts))
+	for i, kc := range contexts {
+		current := ""
+		if kc.Current {
+			current = "*"
+		}
+		rows[i] = []string{current, kc.Name, kc.Region, kc.Server, kc.ClusterID}
+	}
+	p.PrintTableNoTruncate(util.FormatColoredTitle(appCtx, "Kube Contexts"), []string{"Current", "Context", "Region", "Server", "Cluster"}, rows)
+	return nil
 }
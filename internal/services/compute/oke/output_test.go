@@ -2,10 +2,13 @@ package oke
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -25,9 +28,84 @@ func TestPrintOKETable(t *testing.T) {
 		Stdout: &buf,
 	}
 
-	err := PrintOKETable(clusters, appCtx, nil, false)
+	err := PrintOKETable(clusters, appCtx, nil, printer.TableOutput, false)
 	assert.NoError(t, err)
 
 	output := buf.String()
 	assert.Contains(t, output, "TestCluster1")
 }
+
+func TestPrintOKEInfo_All(t *testing.T) {
+	cluster := &Cluster{
+		OCID:        "ocid1.cluster.oc1..a",
+		DisplayName: "prod-oke",
+		Options: compute.ClusterOptions{
+			CNIType:            "OCI_VCN_IP_NATIVE",
+			PodsCIDR:           "10.244.0.0/16",
+			ServicesCIDR:       "10.96.0.0/16",
+			ImagePolicyEnabled: true,
+			ImageSigningKeyIDs: []string{"ocid1.key.oc1..k"},
+		},
+		Addons: []compute.ClusterAddon{
+			{Name: "KubernetesDashboard", Version: "v2.7.0", State: "ACTIVE"},
+			{Name: "CertManager", Version: "v1.14.4", State: "NEEDS_ATTENTION", Error: "InstallFailed: timed out"},
+		},
+	}
+
+	var buf bytes.Buffer
+	appCtx := &app.ApplicationContext{Logger: logger.NewTestLogger(), Stdout: &buf}
+	assert.NoError(t, PrintOKEInfo(appCtx, cluster, printer.TableOutput, false))
+	assert.NotContains(t, buf.String(), "Cluster Options")
+
+	buf.Reset()
+	assert.NoError(t, PrintOKEInfo(appCtx, cluster, printer.TableOutput, true))
+	output := buf.String()
+	assert.Contains(t, output, "OCI_VCN_IP_NATIVE")
+	assert.Contains(t, output, "10.244.0.0/16")
+	assert.Contains(t, output, "enabled (1 signing keys)")
+	assert.Contains(t, output, "InstallFailed: timed out")
+	assert.Less(t, strings.Index(output, "CertManager"), strings.Index(output, "KubernetesDashboard"), "add-ons are sorted by name")
+
+	buf.Reset()
+	cluster.Addons = []compute.ClusterAddon{}
+	assert.NoError(t, PrintOKETable([]Cluster{*cluster}, appCtx, nil, printer.TableOutput, true))
+	assert.Contains(t, buf.String(), "No add-ons installed.")
+
+	buf.Reset()
+	cluster.Addons = nil
+	assert.NoError(t, PrintOKETable([]Cluster{*cluster}, appCtx, nil, printer.TableOutput, true))
+	assert.Contains(t, buf.String(), "Add-ons could not be listed.")
+}
+
+func TestPrintOKE_VirtualNodePools(t *testing.T) {
+	cluster := Cluster{
+		OCID:        "ocid1.cluster.oc1..a",
+		DisplayName: "prod-oke",
+		NodePools:   []NodePool{{DisplayName: "pool-a", NodeShape: "VM.Standard.E4.Flex"}},
+		VirtualNodePools: []VirtualNodePool{
+			{DisplayName: "virtual-a", KubernetesVersion: "v1.31.1", PodShape: "Pod.Standard.E4.Flex", Size: 2, State: "ACTIVE", VirtualNodes: []compute.VirtualNode{
+				{DisplayName: "vnode-1", State: "ACTIVE", PrivateIP: "10.0.20.4", FaultDomain: "FAULT-DOMAIN-1"},
+				{DisplayName: "vnode-2", State: "FAILED", Error: "insufficient capacity"},
+			}},
+		},
+	}
+
+	var buf bytes.Buffer
+	appCtx := &app.ApplicationContext{Logger: logger.NewTestLogger(), Stdout: &buf}
+	assert.NoError(t, PrintOKETable([]Cluster{cluster}, appCtx, nil, printer.TableOutput, false))
+	output := buf.String()
+	assert.Contains(t, output, "1 node pools, 1 virtual node pools")
+	assert.Contains(t, output, "virtual-a")
+
+	buf.Reset()
+	assert.NoError(t, PrintOKEInfo(appCtx, &cluster, printer.TableOutput, false))
+	output = buf.String()
+	assert.Contains(t, output, "Virtual Node Pools")
+	assert.Contains(t, output, "Virtual Nodes: virtual-a (2)")
+	assert.Contains(t, output, "10.0.20.4")
+	assert.Contains(t, output, "insufficient capacity")
+
+	indexed := SearchableCluster{cluster}.ToIndexable()
+	assert.Equal(t, "pool-a,virtual-a", indexed["NodePools"])
+	assert.Equal(t, "vm.standard.e4.flex,pod.standard.e4.flex", indexed["NodeShapes"])
+}
@@ -6,33 +6,35 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
-	"github.com/cnopslabs/ocloud/internal/oci"
-	ocioke "github.com/cnopslabs/ocloud/internal/oci/compute/oke"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/watch"
 )
 
 // SearchOKEClusters searches for OKE clusters matching a search pattern and displays the results in table or JSON format.
 // Parameters:
 // - appCtx: The application context containing configuration and dependencies.
 // - search: The search string used for matching cluster names.
-// - useJSON: A flag indicating whether to display the output in JSON format.
+// - format: The output format (table, JSON, YAML, CSV, TSV or template).
+// - showAll: if true, shows the options and add-ons of each cluster.
 // Returns an error if the search or display operation fails.
-func SearchOKEClusters(appCtx *app.ApplicationContext, search string, useJSON bool) error {
-	containerEngineClient, err := oci.NewContainerEngineClient(appCtx.Provider)
+func SearchOKEClusters(appCtx *app.ApplicationContext, search string, format printer.OutputFormat, showAll bool) error {
+	service, err := newServiceFromAppContext(appCtx, showAll)
 	if err != nil {
-		return fmt.Errorf("creating container engine client: %w", err)
+		return fmt.Errorf("creating cluster service: %w", err)
 	}
+	service.indexStore = appCtx.Cache
 
-	clusterAdapter := ocioke.NewAdapter(containerEngineClient)
-	service := NewService(clusterAdapter, appCtx.Logger, appCtx.CompartmentID)
-
-	matchedClusters, err := service.FuzzySearch(context.Background(), search)
-	if err != nil {
-		return fmt.Errorf("searching clusters: %w", err)
-	}
-	err = PrintOKEsInfo(matchedClusters, appCtx, nil, useJSON)
-	if err != nil {
-		return fmt.Errorf("printing clusters: %w", err)
-	}
-	logger.LogWithLevel(logger.CmdLogger, logger.Info, "Found matching clusters", "search", search, "matched", len(matchedClusters))
-	return nil
+	return util.Watch(context.Background(), appCtx, func(ctx context.Context) ([]watch.State, error) {
+		matchedClusters, err := service.FuzzySearch(ctx, search)
+		if err != nil {
+			return nil, fmt.Errorf("searching clusters: %w", err)
+		}
+		err = PrintOKEsInfo(matchedClusters, appCtx, nil, format, showAll)
+		if err != nil {
+			return nil, fmt.Errorf("printing clusters: %w", err)
+		}
+		logger.LogWithLevel(logger.CmdLogger, logger.Info, "Found matching clusters", "search", search, "matched", len(matchedClusters))
+		return util.WatchStates(matchedClusters, watchState), nil
+	})
 }
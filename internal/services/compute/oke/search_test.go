@@ -6,6 +6,7 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -32,7 +33,7 @@ func TestFindClusters(t *testing.T) {
 	}
 
 	// Call SearchOKEClusters with a search pattern
-	err := SearchOKEClusters(appCtx, "test", false)
+	err := SearchOKEClusters(appCtx, "test", printer.TableOutput, false)
 
 	// but if we did, we would expect no error
 	assert.NoError(t, err)
@@ -64,7 +65,7 @@ func TestFindClustersWithJSON(t *testing.T) {
 	}
 
 	// Call SearchOKEClusters with a search pattern and useJSON=true
-	err := SearchOKEClusters(appCtx, "test", true)
+	err := SearchOKEClusters(appCtx, "test", printer.JSONOutput, false)
 
 	// but if we did, we would expect no error
 	assert.NoError(t, err)
@@ -96,7 +97,7 @@ func TestFindClustersError(t *testing.T) {
 	}
 
 	// Call SearchOKEClusters with a search pattern
-	err := SearchOKEClusters(appCtx, "test", false)
+	err := SearchOKEClusters(appCtx, "test", printer.TableOutput, false)
 
 	// but if we did, we would expect an error
 	assert.Error(t, err)
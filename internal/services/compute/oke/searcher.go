@@ -23,6 +23,10 @@ func (s SearchableCluster) ToIndexable() map[string]any {
 		npNames = append(npNames, np.DisplayName)
 		npShapes = append(npShapes, np.NodeShape)
 	}
+	for _, vnp := range s.VirtualNodePools {
+		npNames = append(npNames, vnp.DisplayName)
+		npShapes = append(npShapes, vnp.PodShape)
+	}
 
 	return map[string]any{
 		"Name":         strings.ToLower(s.DisplayName),
@@ -5,18 +5,30 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
 	"github.com/cnopslabs/ocloud/internal/domain/compute"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/oci"
+	ocioke "github.com/cnopslabs/ocloud/internal/oci/compute/oke"
+	"github.com/cnopslabs/ocloud/internal/region"
 	"github.com/cnopslabs/ocloud/internal/services/search"
 	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/subtree"
 	"github.com/go-logr/logr"
 )
 
 // Service is the application-layer service for OKE operations.
 type Service struct {
-	clusterRepo   compute.ClusterRepository
+	clusterRepo  compute.ClusterRepository
+	nodes        compute.NodePoolInventory
+	instanceRepo compute.InstanceRepository
+	kubeconfigs  compute.ClusterKubeconfig
+	// clustersIn looks clusters up in the given region, whatever regions were selected.
+	clustersIn    func(region string) (compute.ClusterRepository, error)
 	logger        logr.Logger
 	compartmentID string
+	indexStore    *cache.Store
 }
 
 // NewService initializes a new Service instance.
@@ -27,6 +39,40 @@ func NewService(repo compute.ClusterRepository, logger logr.Logger, compartmentI
 		compartmentID: compartmentID,
 	}
 }
+
+// NewServiceFromAppContext creates a Service backed by the OCI adapter and the
+// resource cache of the application context.
+func NewServiceFromAppContext(appCtx *app.ApplicationContext) (*Service, error) {
+	return newServiceFromAppContext(appCtx, false)
+}
+
+// newServiceFromAppContext is NewServiceFromAppContext where, with addons set,
+// clusters also report their installed add-ons.
+func newServiceFromAppContext(appCtx *app.ApplicationContext, addons bool) (*Service, error) {
+	repo, err := newRepository(appCtx, addons)
+	if err != nil {
+		return nil, err
+	}
+	service := NewService(repo, appCtx.Logger, appCtx.CompartmentID)
+	service.indexStore = appCtx.Cache
+	return service, nil
+}
+
+// newRepository builds the cluster repository of appCtx.
+func newRepository(appCtx *app.ApplicationContext, addons bool) (compute.ClusterRepository, error) {
+	return region.NewClusterRepository(appCtx.Regions, func(name string) (compute.ClusterRepository, error) {
+		regional := appCtx.ForRegion(name)
+		containerEngineClient, err := oci.NewContainerEngineClient(regional.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("creating container engine client: %w", err)
+		}
+		adapter := ocioke.NewAdapter(containerEngineClient)
+		if addons {
+			return subtree.NewClusterRepository(cache.NewClusterRepositoryWithAddons(adapter.WithAddons(), regional.Cache), regional.Subtree), nil
+		}
+		return subtree.NewClusterRepository(cache.NewClusterRepository(adapter, regional.Cache), regional.Subtree), nil
+	})
+}
 func (s *Service) ListClusters(ctx context.Context) ([]Cluster, error) {
 	s.logger.V(logger.Debug).Info("listing clusters")
 	clusters, err := s.clusterRepo.ListClusters(ctx, s.compartmentID)
@@ -53,6 +99,16 @@ func (s *Service) FetchPaginatedClusters(ctx context.Context, limit, pageNum int
 
 // FuzzySearch performs a fuzzy search for clusters using the generic search engine.
 func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]Cluster, error) {
+	matches, err := s.ScoredSearch(ctx, searchPattern)
+	if err != nil {
+		return nil, err
+	}
+	return search.Items(matches), nil
+}
+
+// ScoredSearch performs a fuzzy search for clusters and returns the matches,
+// best first, with their relevance scores.
+func (s *Service) ScoredSearch(ctx context.Context, searchPattern string) ([]search.Match[Cluster], error) {
 	s.logger.V(logger.Debug).Info("finding clusters with search", "pattern", searchPattern)
 
 	allClusters, err := s.clusterRepo.ListClusters(ctx, s.compartmentID)
@@ -64,19 +120,20 @@ func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]Clus
 	p := strings.TrimSpace(searchPattern)
 	if p == "" {
 		s.logger.V(logger.Debug).Info("empty search pattern, returning all clusters")
-		return allClusters, nil
+		return search.AllMatches(allClusters), nil
 	}
 
 	// Build index using SearchableCluster adapter
 	idxMapping := search.NewIndexMapping(GetSearchableFields())
 	indexables := ToSearchableClusters(allClusters)
-	idx, err := search.BuildIndex(indexables, idxMapping)
+	idx, err := search.OpenIndex(s.indexStore, s.compartmentID, cache.ResourceClusters, indexables, idxMapping)
 	if err != nil {
 		return nil, fmt.Errorf("building search index: %w", err)
 	}
+	defer func() { _ = idx.Close() }()
 
 	// Execute fuzzy search
-	hits, err := search.FuzzySearch(idx, p, GetSearchableFields(), GetBoostedFields())
+	hits, err := search.ScoredFuzzySearch(idx, p, GetSearchableFields(), GetBoostedFields())
 	if err != nil {
 		return nil, fmt.Errorf("executing search: %w", err)
 	}
@@ -86,13 +143,23 @@ func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]Clus
 		return nil, nil
 	}
 
-	matched := make([]Cluster, 0, len(hits))
-	for _, i := range hits {
-		if i >= 0 && i < len(allClusters) {
-			matched = append(matched, allClusters[i])
-		}
-	}
+	matched := search.Rank(allClusters, hits)
 
 	s.logger.Info("cluster search complete", "matches", len(matched))
 	return matched, nil
 }
+
+// Reindex lists clusters and rebuilds their persisted search index.
+// It returns the number of indexed clusters.
+func (s *Service) Reindex(ctx context.Context) (int, error) {
+	all, err := s.clusterRepo.ListClusters(ctx, s.compartmentID)
+	if err != nil {
+		return 0, fmt.Errorf("fetching clusters: %w", err)
+	}
+
+	idx, err := search.OpenIndex(s.indexStore, s.compartmentID, cache.ResourceClusters, ToSearchableClusters(all), search.NewIndexMapping(GetSearchableFields()))
+	if err != nil {
+		return 0, fmt.Errorf("building search index: %w", err)
+	}
+	return len(all), idx.Close()
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/cnopslabs/ocloud/internal/domain"
 	"github.com/cnopslabs/ocloud/internal/domain/compute"
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
@@ -17,8 +18,15 @@ type mockClusterRepository struct {
 }
 
 func (m *mockClusterRepository) GetCluster(ctx context.Context, ocid string) (*Cluster, error) {
-	//TODO implement me
-	panic("implement me")
+	if m.err != nil {
+		return nil, m.err
+	}
+	for _, c := range m.clusters {
+		if c.OCID == ocid {
+			return &c, nil
+		}
+	}
+	return nil, domain.NewNotFoundError("cluster", ocid)
 }
 
 func (m *mockClusterRepository) ListClusters(ctx context.Context, compartmentID string) ([]compute.Cluster, error) {
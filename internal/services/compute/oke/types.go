@@ -2,6 +2,7 @@ package oke
 
 import (
 	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/watch"
 )
 
 // Cluster is an alias to the domain model.
@@ -9,3 +10,11 @@ type Cluster = compute.Cluster
 
 // NodePool is an alias to the domain model.
 type NodePool = compute.NodePool
+
+// VirtualNodePool is an alias to the domain model.
+type VirtualNodePool = compute.VirtualNodePool
+
+// watchState is the part of a cluster that --watch compares between polls.
+func watchState(c Cluster) watch.State {
+	return watch.State{ID: c.OCID, Name: c.DisplayName, State: c.State}
+}
@@ -0,0 +1,248 @@
+package oke
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/printer"
+)
+
+// maxMinorLag is how many minor versions a node pool may trail the control
+// plane before it is flagged.
+const maxMinorLag = 1
+
+// Upgrade step targets.
+const (
+	TargetControlPlane = "control-plane"
+	TargetNodePool     = "node-pool"
+)
+
+// UpgradeReport is the upgrade advice for one cluster.
+type UpgradeReport struct {
+	ClusterID           string            `json:"ClusterID"`
+	ClusterName         string            `json:"ClusterName"`
+	ControlPlaneVersion string            `json:"ControlPlaneVersion"`
+	AvailableUpgrades   []string          `json:"AvailableUpgrades"`
+	LatestVersion       string            `json:"LatestVersion"`
+	NodePools           []NodePoolVersion `json:"NodePools"`
+	// Compliant is false when any node pool lags the control plane by more
+	// than one minor version.
+	Compliant bool          `json:"Compliant"`
+	Steps     []UpgradeStep `json:"Steps"`
+	CheckedAt time.Time     `json:"CheckedAt"`
+}
+
+// NodePoolVersion is the Kubernetes version of a node pool relative to the control plane.
+type NodePoolVersion struct {
+	ID           string `json:"ID"`
+	Name         string `json:"Name"`
+	Version      string `json:"Version"`
+	MinorsBehind int    `json:"MinorsBehind"`
+	Lagging      bool   `json:"Lagging"`
+}
+
+// UpgradeStep is one upgrade in the suggested order.
+type UpgradeStep struct {
+	Order  int    `json:"Order"`
+	Target string `json:"Target"`
+	Name   string `json:"Name"`
+	ID     string `json:"ID"`
+	From   string `json:"From"`
+	To     string `json:"To"`
+	Reason string `json:"Reason"`
+}
+
+// GetUpgrades displays the available upgrades of the cluster with the given
+// name or OCID, its lagging node pools and a suggested upgrade order.
+func GetUpgrades(appCtx *app.ApplicationContext, nameOrID string, format printer.OutputFormat) error {
+	service, err := NewServiceFromAppContext(appCtx)
+	if err != nil {
+		return fmt.Errorf("creating cluster service: %w", err)
+	}
+
+	cluster, err := service.FindCluster(context.Background(), nameOrID)
+	if err != nil {
+		return fmt.Errorf("getting cluster: %w", err)
+	}
+	report, err := NewUpgradeReport(*cluster, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	return PrintUpgradeReport(appCtx, report, format)
+}
+
+// NewUpgradeReport compares the node pools of c with its control plane and
+// plans the upgrades: lagging node pools first catch up with the control
+// plane, then the control plane moves one minor version at a time to the
+// latest available version, each node pool following as soon as it would lag
+// too far, and finally every node pool is brought to the latest version.
+func NewUpgradeReport(c Cluster, now time.Time) (UpgradeReport, error) {
+	report := UpgradeReport{
+		ClusterID:           c.OCID,
+		ClusterName:         c.DisplayName,
+		ControlPlaneVersion: c.KubernetesVersion,
+		AvailableUpgrades:   []string{},
+		NodePools:           []NodePoolVersion{},
+		Compliant:           true,
+		Steps:               []UpgradeStep{},
+		CheckedAt:           now,
+	}
+	controlPlane, err := parseKubernetesVersion(c.KubernetesVersion)
+	if err != nil {
+		return report, fmt.Errorf("cluster %s: %w", c.DisplayName, err)
+	}
+
+	pools := make([]NodePool, len(c.NodePools))
+	copy(pools, c.NodePools)
+	sort.Slice(pools, func(i, j int) bool { return pools[i].DisplayName < pools[j].DisplayName })
+	current := make([]kubernetesVersion, len(pools))
+	for i, np := range pools {
+		v, err := parseKubernetesVersion(np.KubernetesVersion)
+		if err != nil {
+			return report, fmt.Errorf("node pool %s: %w", np.DisplayName, err)
+		}
+		current[i] = v
+		behind := controlPlane.minor - v.minor
+		report.NodePools = append(report.NodePools, NodePoolVersion{
+			ID:           np.OCID,
+			Name:         np.DisplayName,
+			Version:      np.KubernetesVersion,
+			MinorsBehind: behind,
+			Lagging:      behind > maxMinorLag,
+		})
+		if behind > maxMinorLag {
+			report.Compliant = false
+		}
+	}
+
+	var upgrades []kubernetesVersion
+	for _, raw := range c.AvailableUpgrades {
+		v, err := parseKubernetesVersion(raw)
+		if err != nil {
+			continue
+		}
+		upgrades = append(upgrades, v)
+	}
+	sort.Slice(upgrades, func(i, j int) bool { return upgrades[i].less(upgrades[j]) })
+	for _, v := range upgrades {
+		report.AvailableUpgrades = append(report.AvailableUpgrades, v.raw)
+	}
+
+	addStep := func(target, name, id, from, to, reason string) {
+		report.Steps = append(report.Steps, UpgradeStep{
+			Order:  len(report.Steps) + 1,
+			Target: target,
+			Name:   name,
+			ID:     id,
+			From:   from,
+			To:     to,
+			Reason: reason,
+		})
+	}
+	upgradePools := func(to kubernetesVersion, mustLagMore bool, reason func(kubernetesVersion) string) {
+		for i, np := range pools {
+			if !current[i].less(to) || (mustLagMore && to.minor-current[i].minor <= maxMinorLag) {
+				continue
+			}
+			addStep(TargetNodePool, np.DisplayName, np.OCID, current[i].raw, to.raw, reason(current[i]))
+			current[i] = to
+		}
+	}
+
+	upgradePools(controlPlane, true, func(v kubernetesVersion) string {
+		return fmt.Sprintf("lags the control plane by %d minor versions", controlPlane.minor-v.minor)
+	})
+
+	hops := upgradePath(controlPlane, upgrades)
+	from := controlPlane
+	for i, to := range hops {
+		reason := "next minor version"
+		if to.minor == from.minor {
+			reason = "latest patch release"
+		}
+		addStep(TargetControlPlane, c.DisplayName, c.OCID, from.raw, to.raw, reason)
+		final := i == len(hops)-1
+		upgradePools(to, !final, func(kubernetesVersion) string {
+			if final {
+				return "match the control plane"
+			}
+			return "keep within one minor version of the control plane"
+		})
+		from = to
+	}
+	if len(hops) > 0 {
+		report.LatestVersion = hops[len(hops)-1].raw
+	} else {
+		report.LatestVersion = controlPlane.raw
+		upgradePools(controlPlane, false, func(kubernetesVersion) string { return "match the control plane" })
+	}
+	return report, nil
+}
+
+// upgradePath returns the control plane versions to go through, in order: the
+// latest patch release of every minor version above current, or the latest
+// patch release of the current minor version when there is no newer minor.
+func upgradePath(current kubernetesVersion, sorted []kubernetesVersion) []kubernetesVersion {
+	latest := map[int]kubernetesVersion{}
+	var minors []int
+	for _, v := range sorted {
+		if !current.less(v) {
+			continue
+		}
+		if _, ok := latest[v.minor]; !ok {
+			minors = append(minors, v.minor)
+		}
+		latest[v.minor] = v
+	}
+
+	var path []kubernetesVersion
+	for _, m := range minors {
+		if m > current.minor {
+			path = append(path, latest[m])
+		}
+	}
+	if len(path) == 0 {
+		if v, ok := latest[current.minor]; ok {
+			path = append(path, v)
+		}
+	}
+	return path
+}
+
+// kubernetesVersion is a parsed Kubernetes version such as v1.31.1.
+type kubernetesVersion struct {
+	raw                 string
+	major, minor, patch int
+}
+
+func (v kubernetesVersion) less(o kubernetesVersion) bool {
+	if v.major != o.major {
+		return v.major < o.major
+	}
+	if v.minor != o.minor {
+		return v.minor < o.minor
+	}
+	return v.patch < o.patch
+}
+
+// parseKubernetesVersion parses "v1.31.1" or "1.31"; a missing patch is 0.
+func parseKubernetesVersion(raw string) (kubernetesVersion, error) {
+	parts := strings.Split(strings.TrimPrefix(strings.TrimSpace(raw), "v"), ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return kubernetesVersion{}, fmt.Errorf("invalid Kubernetes version %q", raw)
+	}
+	nums := make([]int, 3)
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return kubernetesVersion{}, fmt.Errorf("invalid Kubernetes version %q", raw)
+		}
+		nums[i] = n
+	}
+	return kubernetesVersion{raw: raw, major: nums[0], minor: nums[1], patch: nums[2]}, nil
+}
@@ -0,0 +1,120 @@
+package oke
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUpgradeReport(t *testing.T) {
+	cluster := Cluster{
+		OCID:              "ocid1.cluster.oc1..a",
+		DisplayName:       "prod-oke",
+		KubernetesVersion: "v1.30.1",
+		AvailableUpgrades: []string{"v1.31.1", "v1.30.10", "v1.32.1", "v1.31.5"},
+		NodePools: []NodePool{
+			{OCID: "np-c", DisplayName: "pool-c", KubernetesVersion: "v1.29.1"},
+			{OCID: "np-a", DisplayName: "pool-a", KubernetesVersion: "v1.30.1"},
+			{OCID: "np-b", DisplayName: "pool-b", KubernetesVersion: "v1.28.10"},
+		},
+	}
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	report, err := NewUpgradeReport(cluster, now)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v1.30.10", "v1.31.1", "v1.31.5", "v1.32.1"}, report.AvailableUpgrades)
+	assert.Equal(t, "v1.32.1", report.LatestVersion)
+	assert.False(t, report.Compliant)
+	assert.Equal(t, now, report.CheckedAt)
+	assert.Equal(t, []NodePoolVersion{
+		{ID: "np-a", Name: "pool-a", Version: "v1.30.1", MinorsBehind: 0},
+		{ID: "np-b", Name: "pool-b", Version: "v1.28.10", MinorsBehind: 2, Lagging: true},
+		{ID: "np-c", Name: "pool-c", Version: "v1.29.1", MinorsBehind: 1},
+	}, report.NodePools)
+
+	type hop struct{ target, name, from, to string }
+	var got []hop
+	for i, s := range report.Steps {
+		assert.Equal(t, i+1, s.Order)
+		got = append(got, hop{s.Target, s.Name, s.From, s.To})
+	}
+	assert.Equal(t, []hop{
+		{TargetNodePool, "pool-b", "v1.28.10", "v1.30.1"},
+		{TargetControlPlane, "prod-oke", "v1.30.1", "v1.31.5"},
+		{TargetNodePool, "pool-c", "v1.29.1", "v1.31.5"},
+		{TargetControlPlane, "prod-oke", "v1.31.5", "v1.32.1"},
+		{TargetNodePool, "pool-a", "v1.30.1", "v1.32.1"},
+		{TargetNodePool, "pool-b", "v1.30.1", "v1.32.1"},
+		{TargetNodePool, "pool-c", "v1.31.5", "v1.32.1"},
+	}, got)
+	assert.Equal(t, "lags the control plane by 2 minor versions", report.Steps[0].Reason)
+}
+
+func TestNewUpgradeReport_PatchOnlyAndUpToDate(t *testing.T) {
+	report, err := NewUpgradeReport(Cluster{
+		KubernetesVersion: "v1.32.1",
+		AvailableUpgrades: []string{"v1.32.3"},
+		NodePools:         []NodePool{{DisplayName: "pool-a", KubernetesVersion: "v1.32.1"}},
+	}, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, report.Steps, 2)
+	assert.Equal(t, "latest patch release", report.Steps[0].Reason)
+	assert.Equal(t, "v1.32.3", report.Steps[1].To)
+	assert.True(t, report.Compliant)
+
+	report, err = NewUpgradeReport(Cluster{
+		KubernetesVersion: "v1.32.1",
+		NodePools:         []NodePool{{DisplayName: "pool-a", KubernetesVersion: "v1.31.4"}},
+	}, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, "v1.32.1", report.LatestVersion)
+	require.Len(t, report.Steps, 1, "a node pool behind the control plane still catches up")
+	assert.Equal(t, "match the control plane", report.Steps[0].Reason)
+
+	_, err = NewUpgradeReport(Cluster{DisplayName: "broken", KubernetesVersion: "latest"}, time.Time{})
+	assert.EqualError(t, err, `cluster broken: invalid Kubernetes version "latest"`)
+}
+
+func TestPrintUpgradeReport(t *testing.T) {
+	report, err := NewUpgradeReport(Cluster{
+		OCID:              "ocid1.cluster.oc1..a",
+		DisplayName:       "prod-oke",
+		KubernetesVersion: "v1.31.1",
+		AvailableUpgrades: []string{"v1.32.1"},
+		NodePools:         []NodePool{{DisplayName: "pool-a", KubernetesVersion: "v1.29.1"}},
+	}, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	appCtx := &app.ApplicationContext{Logger: logger.NewTestLogger(), Stdout: &buf}
+	require.NoError(t, PrintUpgradeReport(appCtx, report, printer.TableOutput))
+	assert.Contains(t, buf.String(), "LAGGING")
+	assert.Contains(t, buf.String(), "Suggested Upgrade Order")
+	assert.Contains(t, buf.String(), "lags the control plane by 2 minor versions")
+
+	buf.Reset()
+	require.NoError(t, PrintUpgradeReport(appCtx, report, printer.JSONOutput))
+	var decoded UpgradeReport
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, report, decoded)
+}
+
+func TestParseKubernetesVersion(t *testing.T) {
+	v, err := parseKubernetesVersion("v1.31.10")
+	require.NoError(t, err)
+	assert.Equal(t, kubernetesVersion{raw: "v1.31.10", major: 1, minor: 31, patch: 10}, v)
+
+	v, err = parseKubernetesVersion("1.30")
+	require.NoError(t, err)
+	assert.Equal(t, 0, v.patch)
+
+	_, err = parseKubernetesVersion("v1")
+	assert.Error(t, err)
+}
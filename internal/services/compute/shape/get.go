@@ -0,0 +1,44 @@
+package shape
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/services/util"
+)
+
+// GetShapes retrieves and displays a paginated list of shapes.
+func GetShapes(appCtx *app.ApplicationContext, limit int, page int, format printer.OutputFormat) error {
+	service, err := NewServiceFromAppContext(appCtx)
+	if err != nil {
+		return fmt.Errorf("creating shape service: %w", err)
+	}
+
+	shapes, totalCount, nextPageToken, err := service.FetchPaginatedShapes(context.Background(), limit, page)
+	if err != nil {
+		return fmt.Errorf("listing shapes: %w", err)
+	}
+
+	return PrintShapesInfo(shapes, appCtx, &util.PaginationInfo{
+		CurrentPage:   page,
+		TotalCount:    totalCount,
+		Limit:         limit,
+		NextPageToken: nextPageToken,
+	}, format)
+}
+
+// GetShape displays the shape with the given name.
+func GetShape(appCtx *app.ApplicationContext, name string, format printer.OutputFormat) error {
+	service, err := NewServiceFromAppContext(appCtx)
+	if err != nil {
+		return fmt.Errorf("creating shape service: %w", err)
+	}
+
+	shape, err := service.GetShape(context.Background(), name)
+	if err != nil {
+		return fmt.Errorf("getting shape: %w", err)
+	}
+	return PrintShapeInfo(shape, appCtx, format)
+}
@@ -0,0 +1,43 @@
+package shape
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	ociShape "github.com/cnopslabs/ocloud/internal/oci/compute/shape"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/tui"
+)
+
+// ListShapes lets the user pick a shape interactively and displays its details.
+func ListShapes(ctx context.Context, appCtx *app.ApplicationContext, format printer.OutputFormat) error {
+	service, err := NewServiceFromAppContext(appCtx)
+	if err != nil {
+		return fmt.Errorf("creating shape service: %w", err)
+	}
+
+	shapes, err := service.ListShapes(ctx)
+	if err != nil {
+		return fmt.Errorf("listing shapes: %w", err)
+	}
+
+	// TUI
+	model := ociShape.NewShapeListModel(shapes).
+		WithQueryFilter(ToSearchableShapes(shapes), GetSearchableFields(), GetBoostedFields())
+	name, err := tui.Run(model)
+	if err != nil {
+		if errors.Is(err, tui.ErrCancelled) {
+			return nil
+		}
+		return fmt.Errorf("selecting shape: %w", err)
+	}
+
+	for _, shape := range shapes {
+		if shape.Name == name {
+			return PrintShapeInfo(&shape, appCtx, format)
+		}
+	}
+	return fmt.Errorf("shape %q not found", name)
+}
@@ -0,0 +1,123 @@
+package shape
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/services/util"
+)
+
+// PrintShapesInfo displays shapes in a table with one row per shape, or in the
+// requested structured format.
+func PrintShapesInfo(shapes []Shape, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, format printer.OutputFormat) error {
+	p := printer.New(appCtx.Stdout)
+
+	if pagination != nil {
+		util.AdjustPaginationInfo(pagination)
+	}
+
+	if !format.IsTable() {
+		return util.MarshalDataResponse(p, format, shapes, pagination)
+	}
+
+	if util.ValidateAndReportEmpty(shapes, pagination, appCtx.Stdout) {
+		return nil
+	}
+
+	headers := []string{"NAME", "TYPE", "OCPUS", "MEMORY (GB)", "GPUS", "LOCAL DISKS", "ADS", "RUNNING"}
+	rows := make([][]string, 0, len(shapes))
+	for _, s := range shapes {
+		rows = append(rows, []string{
+			s.Name,
+			shapeType(s),
+			ocpuRange(s),
+			memoryRange(s),
+			gpus(s),
+			localDisks(s),
+			strconv.Itoa(len(s.AvailabilityDomains)),
+			strconv.Itoa(s.RunningInstances),
+		})
+	}
+	p.PrintTableNoTruncate(util.FormatColoredTitle(appCtx, "Shapes"), headers, rows)
+
+	util.LogPaginationInfo(pagination, appCtx)
+	return nil
+}
+
+// PrintShapeInfo prints a detailed view of a shape.
+func PrintShapeInfo(shape *Shape, appCtx *app.ApplicationContext, format printer.OutputFormat) error {
+	p := printer.New(appCtx.Stdout)
+
+	if !format.IsTable() {
+		return p.Marshal(format, shape)
+	}
+
+	shapeData := map[string]string{
+		"Name":                 shape.Name,
+		"Type":                 shapeType(*shape),
+		"Processor":            shape.ProcessorDescription,
+		"OCPUs":                ocpuRange(*shape),
+		"Memory (GB)":          memoryRange(*shape),
+		"Network (Gbps)":       formatNumber(shape.NetworkBandwidthGbps),
+		"Max VNICs":            strconv.Itoa(shape.MaxVnicAttachments),
+		"GPUs":                 gpus(*shape),
+		"Local Disks":          localDisks(*shape),
+		"Billing":              shape.BillingType,
+		"Availability Domains": strings.Join(shape.AvailabilityDomains, ", "),
+		"Running Instances":    strconv.Itoa(shape.RunningInstances),
+	}
+
+	orderedKeys := []string{
+		"Name", "Type", "Processor", "OCPUs", "Memory (GB)", "Network (Gbps)", "Max VNICs",
+		"GPUs", "Local Disks", "Billing", "Availability Domains", "Running Instances",
+	}
+
+	title := util.FormatColoredTitle(appCtx, shape.Name)
+	p.PrintKeyValues(title, shapeData, orderedKeys)
+	return nil
+}
+
+// ocpuRange is the OCPU count of a fixed shape or the range of a flexible one.
+func ocpuRange(s Shape) string {
+	if s.IsFlexible && s.OCPUsMax > 0 {
+		return formatNumber(s.OCPUsMin) + "–" + formatNumber(s.OCPUsMax)
+	}
+	return formatNumber(s.OCPUs)
+}
+
+// memoryRange is the memory of a fixed shape or the range of a flexible one.
+func memoryRange(s Shape) string {
+	if s.IsFlexible && s.MemoryMaxGB > 0 {
+		return formatNumber(s.MemoryMinGB) + "–" + formatNumber(s.MemoryMaxGB)
+	}
+	return formatNumber(s.MemoryGB)
+}
+
+func gpus(s Shape) string {
+	if s.GPUs == 0 {
+		return "-"
+	}
+	if s.GPUDescription == "" {
+		return strconv.Itoa(s.GPUs)
+	}
+	return fmt.Sprintf("%d × %s", s.GPUs, s.GPUDescription)
+}
+
+func localDisks(s Shape) string {
+	if s.LocalDisks == 0 {
+		return "-"
+	}
+	desc := fmt.Sprintf("%d (%s GB", s.LocalDisks, formatNumber(s.LocalDisksTotalGB))
+	if s.LocalDiskDescription != "" {
+		desc += ", " + s.LocalDiskDescription
+	}
+	return desc + ")"
+}
+
+// formatNumber prints whole numbers without decimals.
+func formatNumber(f float32) string {
+	return strconv.FormatFloat(float64(f), 'f', -1, 32)
+}
@@ -0,0 +1,30 @@
+package shape
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
+)
+
+// SearchShapes fuzzy-searches the shapes of the compartment and prints the
+// matches in a table or the requested structured format.
+func SearchShapes(appCtx *app.ApplicationContext, search string, format printer.OutputFormat) error {
+	service, err := NewServiceFromAppContext(appCtx)
+	if err != nil {
+		return fmt.Errorf("creating shape service: %w", err)
+	}
+
+	matched, err := service.FuzzySearch(context.Background(), search)
+	if err != nil {
+		return fmt.Errorf("finding shapes: %w", err)
+	}
+
+	if err := PrintShapesInfo(matched, appCtx, nil, format); err != nil {
+		return fmt.Errorf("printing shapes: %w", err)
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Info, "Found matching shapes", "search", search, "matched", len(matched))
+	return nil
+}
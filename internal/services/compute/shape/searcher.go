@@ -0,0 +1,54 @@
+package shape
+
+import (
+	"strings"
+
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/services/search"
+)
+
+// SearchableShape is an adapter to make compute.Shape searchable.
+type SearchableShape struct {
+	compute.Shape
+}
+
+// ToIndexable converts a Shape to a map of searchable fields. The running
+// instance count is left out so that it does not invalidate the persisted index.
+func (s SearchableShape) ToIndexable() map[string]any {
+	return map[string]any{
+		"Name":        strings.ToLower(s.Name),
+		"Processor":   strings.ToLower(s.ProcessorDescription),
+		"Type":        shapeType(s.Shape),
+		"GPU":         strings.ToLower(s.GPUDescription),
+		"LocalDisk":   strings.ToLower(s.LocalDiskDescription),
+		"BillingType": strings.ToLower(s.BillingType),
+		"AD":          strings.ToLower(strings.Join(s.AvailabilityDomains, " ")),
+	}
+}
+
+// GetSearchableFields returns the list of fields to be indexed.
+func GetSearchableFields() []string {
+	return []string{"Name", "Processor", "Type", "GPU", "LocalDisk", "BillingType", "AD"}
+}
+
+// GetBoostedFields returns the list of fields to be boosted in the search.
+func GetBoostedFields() []string {
+	return []string{"Name"}
+}
+
+// ToSearchableShapes converts a slice of compute.Shape to a slice of search.Indexable.
+func ToSearchableShapes(shapes []Shape) []search.Indexable {
+	searchable := make([]search.Indexable, len(shapes))
+	for i, s := range shapes {
+		searchable[i] = SearchableShape{s}
+	}
+	return searchable
+}
+
+// shapeType is "flexible" or "fixed".
+func shapeType(s Shape) string {
+	if s.IsFlexible {
+		return "flexible"
+	}
+	return "fixed"
+}
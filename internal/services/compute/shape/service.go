@@ -0,0 +1,152 @@
+package shape
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/oci"
+	ociInst "github.com/cnopslabs/ocloud/internal/oci/compute/instance"
+	ociShape "github.com/cnopslabs/ocloud/internal/oci/compute/shape"
+	"github.com/cnopslabs/ocloud/internal/services/search"
+	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/go-logr/logr"
+)
+
+// Service is the application-layer service for shape operations.
+type Service struct {
+	shapeRepo     compute.ShapeRepository
+	instanceRepo  compute.InstanceRepository
+	logger        logr.Logger
+	compartmentID string
+	indexStore    *cache.Store
+}
+
+// NewService initializes a new Service instance. instanceRepo is used to count
+// the running instances of each shape; a nil instanceRepo leaves the counts at zero.
+func NewService(shapeRepo compute.ShapeRepository, instanceRepo compute.InstanceRepository, logger logr.Logger, compartmentID string) *Service {
+	return &Service{
+		shapeRepo:     shapeRepo,
+		instanceRepo:  instanceRepo,
+		logger:        logger,
+		compartmentID: compartmentID,
+	}
+}
+
+// NewServiceFromAppContext creates a Service backed by the OCI adapters and the
+// resource cache of the application context.
+func NewServiceFromAppContext(appCtx *app.ApplicationContext) (*Service, error) {
+	computeClient, err := oci.NewComputeClient(appCtx.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("creating compute client: %w", err)
+	}
+	networkClient, err := oci.NewNetworkClient(appCtx.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("creating network client: %w", err)
+	}
+
+	shapeRepo := cache.NewShapeRepository(ociShape.NewAdapter(computeClient, appCtx.IdentityClient), appCtx.Cache)
+	instanceRepo := cache.NewInstanceRepository(ociInst.NewAdapter(computeClient, networkClient), appCtx.Cache)
+	service := NewService(shapeRepo, instanceRepo, appCtx.Logger, appCtx.CompartmentID)
+	service.indexStore = appCtx.Cache
+	return service, nil
+}
+
+// ListShapes returns the shapes of the compartment, each with the number of
+// RUNNING instances in the compartment that use it.
+func (s *Service) ListShapes(ctx context.Context) ([]Shape, error) {
+	s.logger.V(logger.Debug).Info("listing shapes", "compartmentID", s.compartmentID)
+
+	shapes, err := s.shapeRepo.ListShapes(ctx, s.compartmentID)
+	if err != nil {
+		return nil, fmt.Errorf("listing shapes from repository: %w", err)
+	}
+	if s.instanceRepo == nil {
+		return shapes, nil
+	}
+
+	instances, err := s.instanceRepo.ListInstances(ctx, s.compartmentID)
+	if err != nil {
+		return nil, fmt.Errorf("listing instances to count shape usage: %w", err)
+	}
+	running := map[string]int{}
+	for _, inst := range instances {
+		if inst.State == "RUNNING" {
+			running[inst.Shape]++
+		}
+	}
+
+	counted := make([]Shape, len(shapes))
+	for i, shape := range shapes {
+		shape.RunningInstances = running[shape.Name]
+		counted[i] = shape
+	}
+	return counted, nil
+}
+
+// FetchPaginatedShapes retrieves a paginated list of shapes.
+func (s *Service) FetchPaginatedShapes(ctx context.Context, limit, pageNum int) ([]Shape, int, string, error) {
+	s.logger.V(logger.Debug).Info("listing shapes", "limit", limit, "pageNum", pageNum)
+
+	allShapes, err := s.ListShapes(ctx)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	pagedResults, totalCount, nextPageToken := util.PaginateSlice(allShapes, limit, pageNum)
+
+	s.logger.Info("completed shape listing", "returnedCount", len(pagedResults), "totalCount", totalCount)
+	return pagedResults, totalCount, nextPageToken, nil
+}
+
+// GetShape returns the shape with the given name.
+func (s *Service) GetShape(ctx context.Context, name string) (*Shape, error) {
+	shapes, err := s.ListShapes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, shape := range shapes {
+		if strings.EqualFold(shape.Name, name) {
+			return &shape, nil
+		}
+	}
+	return nil, fmt.Errorf("shape %q not found", name)
+}
+
+// FuzzySearch performs a fuzzy search for shapes.
+func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]Shape, error) {
+	matches, err := s.ScoredSearch(ctx, searchPattern)
+	if err != nil {
+		return nil, err
+	}
+	return search.Items(matches), nil
+}
+
+// ScoredSearch performs a fuzzy search for shapes and returns the matches,
+// best first, with their relevance scores.
+func (s *Service) ScoredSearch(ctx context.Context, searchPattern string) ([]search.Match[Shape], error) {
+	s.logger.V(logger.Debug).Info("finding shapes with fuzzy search", "pattern", searchPattern)
+
+	allShapes, err := s.ListShapes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching all shapes for search: %w", err)
+	}
+
+	indexMapping := search.NewIndexMapping(GetSearchableFields())
+	idx, err := search.OpenIndex(s.indexStore, s.compartmentID, cache.ResourceShapes, ToSearchableShapes(allShapes), indexMapping)
+	if err != nil {
+		return nil, fmt.Errorf("building search index: %w", err)
+	}
+	defer func() { _ = idx.Close() }()
+
+	matchedIdxs, err := search.ScoredFuzzySearch(idx, searchPattern, GetSearchableFields(), GetBoostedFields())
+	if err != nil {
+		return nil, fmt.Errorf("search: %w", err)
+	}
+
+	return search.Rank(allShapes, matchedIdxs), nil
+}
@@ -0,0 +1,98 @@
+package shape
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
+)
+
+type mockShapeRepository struct {
+	shapes []compute.Shape
+}
+
+func (m *mockShapeRepository) ListShapes(ctx context.Context, compartmentID string) ([]compute.Shape, error) {
+	return m.shapes, nil
+}
+
+type mockInstanceRepository struct {
+	instances []compute.Instance
+}
+
+func (m *mockInstanceRepository) GetEnrichedInstance(ctx context.Context, ocid string) (*compute.Instance, error) {
+	return nil, nil
+}
+
+func (m *mockInstanceRepository) ListEnrichedInstances(ctx context.Context, compartmentID string) ([]compute.Instance, error) {
+	return m.instances, nil
+}
+
+func (m *mockInstanceRepository) ListInstances(ctx context.Context, compartmentID string) ([]compute.Instance, error) {
+	return m.instances, nil
+}
+
+func newTestService() *Service {
+	shapes := &mockShapeRepository{shapes: []compute.Shape{
+		{Name: "BM.GPU.A10.4", OCPUs: 64, MemoryGB: 1024, GPUs: 4, GPUDescription: "NVIDIA A10", AvailabilityDomains: []string{"AD-1"}},
+		{Name: "VM.Standard.E5.Flex", IsFlexible: true, ProcessorDescription: "AMD EPYC", OCPUsMin: 1, OCPUsMax: 94, MemoryMinGB: 1, MemoryMaxGB: 1049, AvailabilityDomains: []string{"AD-1", "AD-2", "AD-3"}},
+	}}
+	instances := &mockInstanceRepository{instances: []compute.Instance{
+		{OCID: "a", Shape: "VM.Standard.E5.Flex", State: "RUNNING"},
+		{OCID: "b", Shape: "VM.Standard.E5.Flex", State: "RUNNING"},
+		{OCID: "c", Shape: "VM.Standard.E5.Flex", State: "STOPPED"},
+	}}
+	return NewService(shapes, instances, logr.Discard(), "test-compartment")
+}
+
+func TestService_ListShapesCountsRunningInstances(t *testing.T) {
+	service := newTestService()
+
+	shapes, err := service.ListShapes(context.Background())
+	require.NoError(t, err)
+	require.Len(t, shapes, 2)
+	assert.Equal(t, 0, shapes[0].RunningInstances)
+	assert.Equal(t, 2, shapes[1].RunningInstances, "stopped instances are not counted")
+}
+
+func TestService_GetShape(t *testing.T) {
+	service := newTestService()
+
+	shape, err := service.GetShape(context.Background(), "vm.standard.e5.flex")
+	require.NoError(t, err)
+	assert.Equal(t, "VM.Standard.E5.Flex", shape.Name)
+
+	_, err = service.GetShape(context.Background(), "VM.Nope")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestService_FuzzySearch(t *testing.T) {
+	service := newTestService()
+
+	results, err := service.FuzzySearch(context.Background(), "a10")
+	require.NoError(t, err)
+	require.NotEmpty(t, results)
+	assert.Equal(t, "BM.GPU.A10.4", results[0].Name)
+}
+
+func TestPrintShapesInfo(t *testing.T) {
+	service := newTestService()
+	shapes, err := service.ListShapes(context.Background())
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	appCtx := &app.ApplicationContext{Logger: logger.NewTestLogger(), Stdout: &buf}
+	require.NoError(t, PrintShapesInfo(shapes, appCtx, nil, printer.TableOutput))
+	out := buf.String()
+	assert.Contains(t, out, "1–94")
+	assert.Contains(t, out, "1–1049")
+	assert.Contains(t, out, "4 × NVIDIA A10")
+	assert.Contains(t, out, "flexible")
+}
@@ -0,0 +1,8 @@
+package shape
+
+import (
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+)
+
+// Shape is an alias to the domain model.
+type Shape = compute.Shape
@@ -15,6 +15,7 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/config/flags"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/cnopslabs/ocloud/internal/services/configuration/info"
 	"github.com/cnopslabs/ocloud/internal/services/util"
 	"github.com/cnopslabs/ocloud/scripts"
@@ -272,7 +273,7 @@ func (s *Service) promptForRegion() (string, error) {
 // viewConfigurationWithErrorHandling is a helper function to handle viewing configuration
 // and handling common errors like a missing tenancy mapping file.
 func (s *Service) viewConfigurationWithErrorHandling(realm string) error {
-	err := info.ViewConfiguration(false, realm)
+	err := info.ViewConfiguration(printer.TableOutput, realm)
 	if err != nil {
 		if strings.Contains(err.Error(), "tenancy mapping file not found") {
 			logger.LogWithLevel(s.logger, logger.Trace, "Tenancy mapping file not found, continuing without it", "error", err)
@@ -13,15 +13,15 @@ import (
 
 // PrintMappingsFile displays tenancy mapping information in a formatted table or JSON format.
 // It takes a slice of MappingsFile, the application context, and a boolean indicating whether to use JSON format.
-func PrintMappingsFile(mappings []appConfig.MappingsFile, useJSON bool) error {
+func PrintMappingsFile(mappings []appConfig.MappingsFile, format printer.OutputFormat) error {
 
 	p := printer.New(os.Stdout)
 
-	if useJSON {
+	if !format.IsTable() {
 		if len(mappings) == 0 {
-			return p.MarshalToJSON(struct{}{})
+			return p.Marshal(format, struct{}{})
 		}
-		return p.MarshalToJSON(mappings)
+		return p.Marshal(format, mappings)
 	}
 
 	if util.ValidateAndReportEmpty(mappings, nil, os.Stdout) {
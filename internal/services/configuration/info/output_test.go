@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	appConfig "github.com/cnopslabs/ocloud/internal/config"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -12,11 +13,11 @@ import (
 func TestPrintMappingsFile(t *testing.T) {
 	// This is a smoke test since we can't easily mock the printer.New function
 	// Test with empty mappings and JSON output
-	err := PrintMappingsFile([]appConfig.MappingsFile{}, true)
+	err := PrintMappingsFile([]appConfig.MappingsFile{}, printer.JSONOutput)
 	assert.NoError(t, err, "PrintMappingsFile should not return an error with empty mappings and JSON output")
 
 	// Test with empty mappings and table output
-	err = PrintMappingsFile([]appConfig.MappingsFile{}, false)
+	err = PrintMappingsFile([]appConfig.MappingsFile{}, printer.TableOutput)
 	assert.NoError(t, err, "PrintMappingsFile should not return an error with empty mappings and table output")
 
 	// Test with non-empty mappings and JSON output
@@ -44,12 +45,12 @@ func TestPrintMappingsFile(t *testing.T) {
 		os.Stdout = oldStdout
 	}()
 
-	err = PrintMappingsFile(mappings, true)
+	err = PrintMappingsFile(mappings, printer.JSONOutput)
 	assert.NoError(t, err, "PrintMappingsFile should not return an error with non-empty mappings and JSON output")
 
 	// Test with non-empty mappings and table output
 	// We're already redirecting stdout, so we can just call the function
-	err = PrintMappingsFile(mappings, false)
+	err = PrintMappingsFile(mappings, printer.TableOutput)
 	assert.NoError(t, err, "PrintMappingsFile should not return an error with non-empty mappings and table output")
 }
 
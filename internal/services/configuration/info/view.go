@@ -4,12 +4,13 @@ import (
 	"fmt"
 
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
 )
 
 // ViewConfiguration displays the tenancy mapping information.
 // It reads the tenancy-map.yaml file and displays its contents.
 // If the realm is not empty, it filters the mappings by the specified realm.
-func ViewConfiguration(useJSON bool, realm string) error {
+func ViewConfiguration(format printer.OutputFormat, realm string) error {
 	s := NewService()
 	logger.LogWithLevel(s.logger, logger.Debug, "ViewConfiguration", "realm", realm)
 
@@ -18,7 +19,7 @@ func ViewConfiguration(useJSON bool, realm string) error {
 		return fmt.Errorf("loading tenancy mappings: %w", err)
 	}
 
-	err = PrintMappingsFile(result.Mappings, useJSON)
+	err = PrintMappingsFile(result.Mappings, format)
 	if err != nil {
 		return fmt.Errorf("printing tenancy mappings: %w", err)
 	}
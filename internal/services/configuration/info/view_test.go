@@ -2,13 +2,15 @@ package info
 
 import (
 	"testing"
+
+	"github.com/cnopslabs/ocloud/internal/printer"
 )
 
 // TestViewConfiguration tests the ViewConfiguration function
 // This is a smoke test since we can't easily test the actual output
 func TestViewConfiguration(t *testing.T) {
 	// Test with JSON output and empty realm
-	err := ViewConfiguration(true, "")
+	err := ViewConfiguration(printer.JSONOutput, "")
 
 	// We can't make strong assertions about the result since it depends on the actual file,
 	// but we can check that the function returns without error
@@ -20,7 +22,7 @@ func TestViewConfiguration(t *testing.T) {
 	}
 
 	// Test with table output and a specific realm
-	err = ViewConfiguration(false, "OC1")
+	err = ViewConfiguration(printer.TableOutput, "OC1")
 	if err != nil {
 		t.Skip("Skipping test because tenancy mapping file is not available")
 	}
@@ -6,30 +6,31 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
-	ociadb "github.com/cnopslabs/ocloud/internal/oci/database/autonomousdb"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/watch"
 )
 
 // GetAutonomousDatabase retrieves a list of Autonomous Databases and displays them in a table or JSON format.
-func GetAutonomousDatabase(appCtx *app.ApplicationContext, useJSON bool, limit, page int, showAll bool) error {
+func GetAutonomousDatabase(appCtx *app.ApplicationContext, format printer.OutputFormat, limit, page int, showAll bool) error {
 	logger.LogWithLevel(appCtx.Logger, logger.Debug, "Listing Autonomous Databases")
-	adapter, err := ociadb.NewAdapter(appCtx.Provider)
+	service, err := NewServiceFromAppContext(appCtx)
 	if err != nil {
-		return fmt.Errorf("creating database adapter: %w", err)
+		return fmt.Errorf("creating autonomous database service: %w", err)
 	}
 
-	service := NewService(adapter, appCtx)
+	return util.Watch(context.Background(), appCtx, func(ctx context.Context) ([]watch.State, error) {
+		allDatabases, totalCount, nextPageToken, err := service.FetchPaginatedAutonomousDb(ctx, limit, page)
+		if err != nil {
+			return nil, fmt.Errorf("listing autonomous databases: %w", err)
+		}
 
-	ctx := context.Background()
-	allDatabases, totalCount, nextPageToken, err := service.FetchPaginatedAutonomousDb(ctx, limit, page)
-	if err != nil {
-		return fmt.Errorf("listing autonomous databases: %w", err)
-	}
-
-	return PrintAutonomousDbsInfo(allDatabases, appCtx, &util.PaginationInfo{
-		CurrentPage:   page,
-		TotalCount:    totalCount,
-		Limit:         limit,
-		NextPageToken: nextPageToken,
-	}, useJSON, showAll)
+		err = PrintAutonomousDbsInfo(allDatabases, appCtx, &util.PaginationInfo{
+			CurrentPage:   page,
+			TotalCount:    totalCount,
+			Limit:         limit,
+			NextPageToken: nextPageToken,
+		}, format, showAll)
+		return util.WatchStates(allDatabases, watchState), err
+	})
 }
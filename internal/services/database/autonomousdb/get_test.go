@@ -6,6 +6,7 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -28,7 +29,7 @@ func TestGetAutonomousDatabaseSimple(t *testing.T) {
 		Stdout:          io.Discard, // Discard output to avoid cluttering the test output
 	}
 
-	err := GetAutonomousDatabase(appCtx, false, 20, 1, false)
+	err := GetAutonomousDatabase(appCtx, printer.TableOutput, 20, 1, false)
 
 	// but if we did, we would expect no error
 	assert.NoError(t, err)
@@ -53,7 +54,7 @@ func TestGetAutonomousDatabaseOutput(t *testing.T) {
 		Stdout:          io.Discard, // In a real test, we would use a buffer to capture output
 	}
 
-	err := GetAutonomousDatabase(appCtxJSON, true, 20, 1, false)
+	err := GetAutonomousDatabase(appCtxJSON, printer.JSONOutput, 20, 1, false)
 	assert.NoError(t, err)
 
 	// Test with table output
@@ -64,7 +65,7 @@ func TestGetAutonomousDatabaseOutput(t *testing.T) {
 		Stdout:          io.Discard, // In a real test, we would use a buffer to capture output
 	}
 
-	err = GetAutonomousDatabase(appCtxTable, false, 20, 1, false)
+	err = GetAutonomousDatabase(appCtxTable, printer.TableOutput, 20, 1, false)
 	assert.NoError(t, err)
 }
 
@@ -87,15 +88,15 @@ func TestGetAutonomousDatabasePagination(t *testing.T) {
 	}
 
 	// Test page 1
-	err := GetAutonomousDatabase(appCtx, false, 10, 1, false)
+	err := GetAutonomousDatabase(appCtx, printer.TableOutput, 10, 1, false)
 	assert.NoError(t, err)
 
 	// Test page 2
-	err = GetAutonomousDatabase(appCtx, false, 10, 2, false)
+	err = GetAutonomousDatabase(appCtx, printer.TableOutput, 10, 2, false)
 	assert.NoError(t, err)
 
 	// Test with a large page number (beyond available data)
-	err = GetAutonomousDatabase(appCtx, false, 10, 100, false)
+	err = GetAutonomousDatabase(appCtx, printer.TableOutput, 10, 100, false)
 	assert.NoError(t, err)
 }
 
@@ -117,7 +118,7 @@ func TestGetAutonomousDatabaseError(t *testing.T) {
 		Stdout:          io.Discard,
 	}
 
-	err := GetAutonomousDatabase(appCtx, false, 20, 1, false)
+	err := GetAutonomousDatabase(appCtx, printer.TableOutput, 20, 1, false)
 
 	// In a real test with a mock that returns an error, we would expect an error
 	// assert.Error(t, err)
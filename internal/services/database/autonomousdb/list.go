@@ -7,17 +7,19 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	ociadb "github.com/cnopslabs/ocloud/internal/oci/database/autonomousdb"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/services/util"
 	"github.com/cnopslabs/ocloud/internal/tui"
+	"github.com/cnopslabs/ocloud/internal/watch"
 )
 
 // ListAutonomousDatabases lists all Autonomous Databases in the application context.
-func ListAutonomousDatabases(appCtx *app.ApplicationContext, useJSON bool) error {
+func ListAutonomousDatabases(appCtx *app.ApplicationContext, format printer.OutputFormat) error {
 	ctx := context.Background()
-	autonomousDatabaseAdapter, err := ociadb.NewAdapter(appCtx.Provider)
+	service, err := NewServiceFromAppContext(appCtx)
 	if err != nil {
-		return fmt.Errorf("creating autonomous database adapter: %w", err)
+		return fmt.Errorf("creating autonomous database service: %w", err)
 	}
-	service := NewService(autonomousDatabaseAdapter, appCtx)
 	allDatabases, err := service.ListAutonomousDb(ctx)
 
 	if err != nil {
@@ -25,7 +27,8 @@ func ListAutonomousDatabases(appCtx *app.ApplicationContext, useJSON bool) error
 	}
 
 	//TUI
-	model := ociadb.NewDatabaseListModel(allDatabases)
+	model := ociadb.NewDatabaseListModel(allDatabases).
+		WithQueryFilter(ToSearchableAutonomousDBs(allDatabases), GetSearchableFields(), GetBoostedFields())
 	id, err := tui.Run(model)
 	if err != nil {
 		if errors.Is(err, tui.ErrCancelled) {
@@ -34,10 +37,13 @@ func ListAutonomousDatabases(appCtx *app.ApplicationContext, useJSON bool) error
 		return fmt.Errorf("selecting database: %w", err)
 	}
 
-	database, err := service.repo.GetAutonomousDatabase(ctx, id)
-	if err != nil {
-		return fmt.Errorf("getting database: %w", err)
-	}
+	return util.Watch(ctx, appCtx, func(ctx context.Context) ([]watch.State, error) {
+		database, err := service.repo.GetAutonomousDatabase(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("getting database: %w", err)
+		}
 
-	return PrintAutonomousDbInfo(database, appCtx, useJSON, true)
+		err = PrintAutonomousDbInfo(database, appCtx, format, true)
+		return []watch.State{watchState(*database)}, err
+	})
 }
@@ -10,12 +10,12 @@ import (
 )
 
 // PrintAutonomousDbInfo prints a single Autonomous DB.
-// - useJSON: if true, prints the single DB as JSON (no pagination envelope)
+// - format: structured formats print the single DB without a pagination envelope
 // - showAll: if true, prints the detailed view; otherwise, prints the summary view
-func PrintAutonomousDbInfo(db *database.AutonomousDatabase, appCtx *app.ApplicationContext, useJSON bool, showAll bool) error {
+func PrintAutonomousDbInfo(db *database.AutonomousDatabase, appCtx *app.ApplicationContext, format printer.OutputFormat, showAll bool) error {
 	p := printer.New(appCtx.Stdout)
-	if useJSON {
-		return p.MarshalToJSON(db)
+	if !format.IsTable() {
+		return p.Marshal(format, db)
 	}
 
 	return printOneAutonomousDb(p, appCtx, db, showAll)
@@ -23,20 +23,20 @@ func PrintAutonomousDbInfo(db *database.AutonomousDatabase, appCtx *app.Applicat
 
 // PrintAutonomousDbsInfo prints a list of Autonomous DBs.
 // - pagination: optional, will be adjusted and logged if provided
-// - useJSON: if true, prints databases with util.MarshalDataToJSONResponse
+// - format: structured formats print databases with util.MarshalScopedResponse
 // - showAll: if true, prints detailed view; otherwise summary view
-func PrintAutonomousDbsInfo(databases []database.AutonomousDatabase, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, useJSON bool, showAll bool) error {
+func PrintAutonomousDbsInfo(databases []database.AutonomousDatabase, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, format printer.OutputFormat, showAll bool) error {
 	p := printer.New(appCtx.Stdout)
 
 	if pagination != nil {
 		util.AdjustPaginationInfo(pagination)
 	}
 
-	if useJSON {
+	if !format.IsTable() {
 		if len(databases) == 0 && pagination == nil {
-			return p.MarshalToJSON(struct{}{})
+			return p.Marshal(format, struct{}{})
 		}
-		return util.MarshalDataToJSONResponse[database.AutonomousDatabase](p, databases, pagination)
+		return util.MarshalScopedResponse(p, format, appCtx, databases, func(db database.AutonomousDatabase) string { return db.ID }, pagination)
 	}
 
 	if util.ValidateAndReportEmpty(databases, pagination, appCtx.Stdout) {
@@ -54,7 +54,7 @@ func PrintAutonomousDbsInfo(databases []database.AutonomousDatabase, appCtx *app
 }
 
 func printOneAutonomousDb(p *printer.Printer, appCtx *app.ApplicationContext, db *database.AutonomousDatabase, showAll bool) error {
-	title := util.FormatColoredTitle(appCtx, db.Name)
+	title := util.FormatColoredResourceTitle(appCtx, db.ID, db.Name)
 
 	// Prefer names to IDs when available
 	subnetVal := db.SubnetId
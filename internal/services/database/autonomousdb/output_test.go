@@ -8,6 +8,7 @@ import (
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/domain/database"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/cnopslabs/ocloud/internal/services/util"
 	"github.com/stretchr/testify/assert"
 )
@@ -62,7 +63,7 @@ func TestPrintAutonomousDbsInfo_SummaryAndJSON(t *testing.T) {
 	appCtx := &app.ApplicationContext{Logger: logger.NewTestLogger(), Stdout: &buf}
 
 	// Table output (summary)
-	err := PrintAutonomousDbsInfo([]database.AutonomousDatabase{db1, db2}, appCtx, nil, false, false)
+	err := PrintAutonomousDbsInfo([]database.AutonomousDatabase{db1, db2}, appCtx, nil, printer.TableOutput, false)
 	assert.NoError(t, err)
 	out := buf.String()
 	// Validate presence of key fields for both DBs
@@ -78,7 +79,7 @@ func TestPrintAutonomousDbsInfo_SummaryAndJSON(t *testing.T) {
 	// JSON output with pagination
 	buf.Reset()
 	pg := &util.PaginationInfo{TotalCount: 2, Limit: 2, CurrentPage: 1, NextPageToken: ""}
-	err = PrintAutonomousDbsInfo([]database.AutonomousDatabase{db1, db2}, appCtx, pg, true, false)
+	err = PrintAutonomousDbsInfo([]database.AutonomousDatabase{db1, db2}, appCtx, pg, printer.JSONOutput, false)
 	assert.NoError(t, err)
 	jsonOut := buf.String()
 	assert.Contains(t, jsonOut, "\"items\"")
@@ -112,7 +113,7 @@ func TestPrintAutonomousDbInfo_DetailedAccessTypeStorage(t *testing.T) {
 	var buf bytes.Buffer
 	appCtx := &app.ApplicationContext{Logger: logger.NewTestLogger(), Stdout: &buf}
 
-	err := PrintAutonomousDbInfo(db, appCtx, false, true)
+	err := PrintAutonomousDbInfo(db, appCtx, printer.TableOutput, true)
 	assert.NoError(t, err)
 	out := buf.String()
 
@@ -137,7 +138,7 @@ func TestPrintAutonomousDbsInfo_Empty(t *testing.T) {
 	var buf bytes.Buffer
 	appCtx := &app.ApplicationContext{Logger: logger.NewTestLogger(), Stdout: &buf}
 
-	err := PrintAutonomousDbsInfo([]database.AutonomousDatabase{}, appCtx, nil, false, true)
+	err := PrintAutonomousDbsInfo([]database.AutonomousDatabase{}, appCtx, nil, printer.TableOutput, true)
 	assert.NoError(t, err)
 	assert.Contains(t, buf.String(), "No Items found.")
 }
@@ -6,26 +6,28 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
-	ociadb "github.com/cnopslabs/ocloud/internal/oci/database/autonomousdb"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/watch"
 )
 
 // SearchAutonomousDatabases searches for OCI Autonomous Databases matching the given query string in the current context.
-func SearchAutonomousDatabases(appCtx *app.ApplicationContext, search string, useJSON bool, showAll bool) error {
-	adapter, err := ociadb.NewAdapter(appCtx.Provider)
+func SearchAutonomousDatabases(appCtx *app.ApplicationContext, search string, format printer.OutputFormat, showAll bool) error {
+	service, err := NewServiceFromAppContext(appCtx)
 	if err != nil {
-		return fmt.Errorf("creating database adapter: %w", err)
+		return fmt.Errorf("creating autonomous database service: %w", err)
 	}
-	service := NewService(adapter, appCtx)
 
-	ctx := context.Background()
-	matchedDatabases, err := service.FuzzySearch(ctx, search)
-	if err != nil {
-		return fmt.Errorf("finding autonomous databases: %w", err)
-	}
-	err = PrintAutonomousDbsInfo(matchedDatabases, appCtx, nil, useJSON, showAll)
-	if err != nil {
-		return fmt.Errorf("printing autonomous databases: %w", err)
-	}
-	logger.LogWithLevel(logger.CmdLogger, logger.Info, "Found matching autonomous databases", "search", search, "matched", len(matchedDatabases))
-	return nil
+	return util.Watch(context.Background(), appCtx, func(ctx context.Context) ([]watch.State, error) {
+		matchedDatabases, err := service.FuzzySearch(ctx, search)
+		if err != nil {
+			return nil, fmt.Errorf("finding autonomous databases: %w", err)
+		}
+		err = PrintAutonomousDbsInfo(matchedDatabases, appCtx, nil, format, showAll)
+		if err != nil {
+			return nil, fmt.Errorf("printing autonomous databases: %w", err)
+		}
+		logger.LogWithLevel(logger.CmdLogger, logger.Info, "Found matching autonomous databases", "search", search, "matched", len(matchedDatabases))
+		return util.WatchStates(matchedDatabases, watchState), nil
+	})
 }
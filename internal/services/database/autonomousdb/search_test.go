@@ -6,6 +6,7 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -28,7 +29,7 @@ func TestFindAutonomousDatabaseSimple(t *testing.T) {
 		Stdout:          io.Discard, // Discard output to avoid cluttering the test output
 	}
 
-	err := SearchAutonomousDatabases(appCtx, "test", false, false)
+	err := SearchAutonomousDatabases(appCtx, "test", printer.TableOutput, false)
 
 	// but if we did, we would expect no error
 	assert.NoError(t, err)
@@ -53,7 +54,7 @@ func TestFindAutonomousDatabaseOutput(t *testing.T) {
 		Stdout:          io.Discard, // In a real test, we would use a buffer to capture output
 	}
 
-	err := SearchAutonomousDatabases(appCtxJSON, "test", true, false)
+	err := SearchAutonomousDatabases(appCtxJSON, "test", printer.JSONOutput, false)
 	assert.NoError(t, err)
 
 	// Test with table output
@@ -64,7 +65,7 @@ func TestFindAutonomousDatabaseOutput(t *testing.T) {
 		Stdout:          io.Discard, // In a real test, we would use a buffer to capture output
 	}
 
-	err = SearchAutonomousDatabases(appCtxTable, "test", false, false)
+	err = SearchAutonomousDatabases(appCtxTable, "test", printer.TableOutput, false)
 	assert.NoError(t, err)
 }
 
@@ -86,7 +87,7 @@ func TestFindAutonomousDatabaseError(t *testing.T) {
 		Stdout:          io.Discard,
 	}
 
-	err := SearchAutonomousDatabases(appCtx, "test", false, false)
+	err := SearchAutonomousDatabases(appCtx, "test", printer.TableOutput, false)
 
 	// In a real test with a mock that returns an error, we would expect an error
 	// assert.Error(t, err)
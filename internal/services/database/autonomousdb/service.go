@@ -6,10 +6,14 @@ import (
 	"strings"
 
 	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
 	"github.com/cnopslabs/ocloud/internal/domain/database"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	ociadb "github.com/cnopslabs/ocloud/internal/oci/database/autonomousdb"
+	"github.com/cnopslabs/ocloud/internal/region"
 	"github.com/cnopslabs/ocloud/internal/services/search"
 	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/subtree"
 	"github.com/go-logr/logr"
 )
 
@@ -18,6 +22,7 @@ type Service struct {
 	repo          database.AutonomousDatabaseRepository
 	logger        logr.Logger
 	compartmentID string
+	indexStore    *cache.Store
 }
 
 // NewService initializes a new Service instance with the provided application context.
@@ -26,9 +31,32 @@ func NewService(repo database.AutonomousDatabaseRepository, appCtx *app.Applicat
 		repo:          repo,
 		logger:        appCtx.Logger,
 		compartmentID: appCtx.CompartmentID,
+		indexStore:    appCtx.Cache,
 	}
 }
 
+// NewServiceFromAppContext creates a Service backed by the OCI adapter and the
+// resource cache of the application context.
+func NewServiceFromAppContext(appCtx *app.ApplicationContext) (*Service, error) {
+	repo, err := newRepository(appCtx)
+	if err != nil {
+		return nil, err
+	}
+	return NewService(repo, appCtx), nil
+}
+
+// newRepository builds the Autonomous Database repository of appCtx.
+func newRepository(appCtx *app.ApplicationContext) (database.AutonomousDatabaseRepository, error) {
+	return region.NewAutonomousDatabaseRepository(appCtx.Regions, func(name string) (database.AutonomousDatabaseRepository, error) {
+		regional := appCtx.ForRegion(name)
+		adapter, err := ociadb.NewAdapter(regional.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("creating autonomous database adapter: %w", err)
+		}
+		return subtree.NewAutonomousDatabaseRepository(cache.NewAutonomousDatabaseRepository(adapter, regional.Cache), regional.Subtree), nil
+	})
+}
+
 // ListAutonomousDb retrieves and returns all databases from the given compartment in the OCI account.
 func (s *Service) ListAutonomousDb(ctx context.Context) ([]AutonomousDatabase, error) {
 	s.logger.V(logger.Debug).Info("listing autonomous databases")
@@ -39,6 +67,16 @@ func (s *Service) ListAutonomousDb(ctx context.Context) ([]AutonomousDatabase, e
 	return databases, nil
 }
 
+// ListEnrichedAutonomousDb retrieves all databases of the compartment with their enriched details.
+func (s *Service) ListEnrichedAutonomousDb(ctx context.Context) ([]AutonomousDatabase, error) {
+	s.logger.V(logger.Debug).Info("listing enriched autonomous databases")
+	databases, err := s.repo.ListEnrichedAutonomousDatabase(ctx, s.compartmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list enriched autonomous databases: %w", err)
+	}
+	return databases, nil
+}
+
 // FetchPaginatedAutonomousDb retrieves a paginated list of databases with given limit and page number parameters.
 // It returns the slice of databases, total count, next page token, and an error if encountered.
 func (s *Service) FetchPaginatedAutonomousDb(ctx context.Context, limit, pageNum int) ([]AutonomousDatabase, int, string, error) {
@@ -68,6 +106,16 @@ func (s *Service) FetchPaginatedAutonomousDb(ctx context.Context, limit, pageNum
 
 // FuzzySearch performs a fuzzy search for Autonomous Databases using the generic search engine.
 func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]AutonomousDatabase, error) {
+	matches, err := s.ScoredSearch(ctx, searchPattern)
+	if err != nil {
+		return nil, err
+	}
+	return search.Items(matches), nil
+}
+
+// ScoredSearch performs a fuzzy search for autonomous databases and returns the matches,
+// best first, with their relevance scores.
+func (s *Service) ScoredSearch(ctx context.Context, searchPattern string) ([]search.Match[AutonomousDatabase], error) {
 	logger.LogWithLevel(s.logger, logger.Trace, "finding databases with search", "pattern", searchPattern)
 	allDatabases, err := s.repo.ListEnrichedAutonomousDatabase(ctx, s.compartmentID)
 	if err != nil {
@@ -75,28 +123,39 @@ func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]Auto
 	}
 	p := strings.TrimSpace(searchPattern)
 	if p == "" {
-		return allDatabases, nil
+		return search.AllMatches(allDatabases), nil
 	}
 
 	// Build index using SearchableAutonomousDatabase
 	indexables := ToSearchableAutonomousDBs(allDatabases)
 	idxMapping := search.NewIndexMapping(GetSearchableFields())
-	idx, err := search.BuildIndex(indexables, idxMapping)
+	idx, err := search.OpenIndex(s.indexStore, s.compartmentID, cache.ResourceEnrichedAutonomousDatabases, indexables, idxMapping)
 	if err != nil {
 		return nil, fmt.Errorf("building search index: %w", err)
 	}
+	defer func() { _ = idx.Close() }()
 
-	hits, err := search.FuzzySearch(idx, strings.ToLower(p), GetSearchableFields(), GetBoostedFields())
+	hits, err := search.ScoredFuzzySearch(idx, strings.ToLower(p), GetSearchableFields(), GetBoostedFields())
 	if err != nil {
 		return nil, fmt.Errorf("executing search: %w", err)
 	}
 
-	results := make([]AutonomousDatabase, 0, len(hits))
-	for _, i := range hits {
-		if i >= 0 && i < len(allDatabases) {
-			results = append(results, allDatabases[i])
-		}
-	}
+	results := search.Rank(allDatabases, hits)
 
 	return results, nil
 }
+
+// Reindex lists autonomous databases and rebuilds their persisted search index.
+// It returns the number of indexed autonomous databases.
+func (s *Service) Reindex(ctx context.Context) (int, error) {
+	all, err := s.repo.ListEnrichedAutonomousDatabase(ctx, s.compartmentID)
+	if err != nil {
+		return 0, fmt.Errorf("fetching autonomous databases: %w", err)
+	}
+
+	idx, err := search.OpenIndex(s.indexStore, s.compartmentID, cache.ResourceEnrichedAutonomousDatabases, ToSearchableAutonomousDBs(all), search.NewIndexMapping(GetSearchableFields()))
+	if err != nil {
+		return 0, fmt.Errorf("building search index: %w", err)
+	}
+	return len(all), idx.Close()
+}
@@ -2,7 +2,13 @@ package autonomousdb
 
 import (
 	"github.com/cnopslabs/ocloud/internal/domain/database"
+	"github.com/cnopslabs/ocloud/internal/watch"
 )
 
 // AutonomousDatabase represents an autonomous database instance with its attributes and connection details.
 type AutonomousDatabase = database.AutonomousDatabase
+
+// watchState is the part of an autonomous database that --watch compares between polls.
+func watchState(db AutonomousDatabase) watch.State {
+	return watch.State{ID: db.ID, Name: db.Name, State: db.LifecycleState}
+}
@@ -6,30 +6,31 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
-	ocicachecluster "github.com/cnopslabs/ocloud/internal/oci/database/cacheclusterdb"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/watch"
 )
 
 // GetCacheClusters retrieves a list of HeatWave Cache Clusters and displays them in a table or JSON format.
-func GetCacheClusters(appCtx *app.ApplicationContext, useJSON bool, limit, page int, showAll bool) error {
+func GetCacheClusters(appCtx *app.ApplicationContext, format printer.OutputFormat, limit, page int, showAll bool) error {
 	logger.LogWithLevel(appCtx.Logger, logger.Debug, "Listing HeatWave Cache Clusters")
-	adapter, err := ocicachecluster.NewAdapter(appCtx.Provider)
+	service, err := NewServiceFromAppContext(appCtx)
 	if err != nil {
-		return fmt.Errorf("creating cache cluster adapter: %w", err)
+		return fmt.Errorf("creating cache cluster service: %w", err)
 	}
 
-	service := NewService(adapter, appCtx)
+	return util.Watch(context.Background(), appCtx, func(ctx context.Context) ([]watch.State, error) {
+		allClusters, totalCount, nextPageToken, err := service.FetchPaginatedCacheClusters(ctx, limit, page)
+		if err != nil {
+			return nil, fmt.Errorf("listing cache clusters: %w", err)
+		}
 
-	ctx := context.Background()
-	allClusters, totalCount, nextPageToken, err := service.FetchPaginatedCacheClusters(ctx, limit, page)
-	if err != nil {
-		return fmt.Errorf("listing cache clusters: %w", err)
-	}
-
-	return PrintCacheClustersInfo(allClusters, appCtx, &util.PaginationInfo{
-		CurrentPage:   page,
-		TotalCount:    totalCount,
-		Limit:         limit,
-		NextPageToken: nextPageToken,
-	}, useJSON, showAll)
+		err = PrintCacheClustersInfo(allClusters, appCtx, &util.PaginationInfo{
+			CurrentPage:   page,
+			TotalCount:    totalCount,
+			Limit:         limit,
+			NextPageToken: nextPageToken,
+		}, format, showAll)
+		return util.WatchStates(allClusters, watchState), err
+	})
 }
@@ -7,17 +7,19 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	ocicachecluster "github.com/cnopslabs/ocloud/internal/oci/database/cacheclusterdb"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/services/util"
 	"github.com/cnopslabs/ocloud/internal/tui"
+	"github.com/cnopslabs/ocloud/internal/watch"
 )
 
 // ListCacheClusters lists all HeatWave Cache Clusters in the application context with TUI.
-func ListCacheClusters(appCtx *app.ApplicationContext, useJSON bool) error {
+func ListCacheClusters(appCtx *app.ApplicationContext, format printer.OutputFormat) error {
 	ctx := context.Background()
-	cacheClusterAdapter, err := ocicachecluster.NewAdapter(appCtx.Provider)
+	service, err := NewServiceFromAppContext(appCtx)
 	if err != nil {
-		return fmt.Errorf("creating cache cluster adapter: %w", err)
+		return fmt.Errorf("creating cache cluster service: %w", err)
 	}
-	service := NewService(cacheClusterAdapter, appCtx)
 	allClusters, err := service.ListCacheClusters(ctx)
 
 	if err != nil {
@@ -25,7 +27,8 @@ func ListCacheClusters(appCtx *app.ApplicationContext, useJSON bool) error {
 	}
 
 	// TUI
-	model := ocicachecluster.NewCacheClusterListModel(allClusters)
+	model := ocicachecluster.NewCacheClusterListModel(allClusters).
+		WithQueryFilter(ToSearchableCacheClusters(allClusters), GetSearchableFields(), GetBoostedFields())
 	id, err := tui.Run(model)
 	if err != nil {
 		if errors.Is(err, tui.ErrCancelled) {
@@ -34,10 +37,13 @@ func ListCacheClusters(appCtx *app.ApplicationContext, useJSON bool) error {
 		return fmt.Errorf("selecting cache cluster: %w", err)
 	}
 
-	cluster, err := service.repo.GetCacheCluster(ctx, id)
-	if err != nil {
-		return fmt.Errorf("getting cache cluster: %w", err)
-	}
+	return util.Watch(ctx, appCtx, func(ctx context.Context) ([]watch.State, error) {
+		cluster, err := service.repo.GetCacheCluster(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("getting cache cluster: %w", err)
+		}
 
-	return PrintCacheClusterInfo(cluster, appCtx, useJSON, true)
+		err = PrintCacheClusterInfo(cluster, appCtx, format, true)
+		return []watch.State{watchState(*cluster)}, err
+	})
 }
@@ -10,28 +10,28 @@ import (
 )
 
 // PrintCacheClusterInfo prints a single HeatWave Cache Cluster.
-func PrintCacheClusterInfo(cluster *database.CacheCluster, appCtx *app.ApplicationContext, useJSON bool, showAll bool) error {
+func PrintCacheClusterInfo(cluster *database.CacheCluster, appCtx *app.ApplicationContext, format printer.OutputFormat, showAll bool) error {
 	p := printer.New(appCtx.Stdout)
-	if useJSON {
-		return p.MarshalToJSON(cluster)
+	if !format.IsTable() {
+		return p.Marshal(format, cluster)
 	}
 
 	return printOneCacheCluster(p, appCtx, cluster, showAll)
 }
 
 // PrintCacheClustersInfo prints a list of HeatWave Cache Clusters.
-func PrintCacheClustersInfo(clusters []database.CacheCluster, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, useJSON bool, showAll bool) error {
+func PrintCacheClustersInfo(clusters []database.CacheCluster, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, format printer.OutputFormat, showAll bool) error {
 	p := printer.New(appCtx.Stdout)
 
 	if pagination != nil {
 		util.AdjustPaginationInfo(pagination)
 	}
 
-	if useJSON {
+	if !format.IsTable() {
 		if len(clusters) == 0 && pagination == nil {
-			return p.MarshalToJSON(struct{}{})
+			return p.Marshal(format, struct{}{})
 		}
-		return util.MarshalDataToJSONResponse[database.CacheCluster](p, clusters, pagination)
+		return util.MarshalScopedResponse(p, format, appCtx, clusters, func(c database.CacheCluster) string { return c.ID }, pagination)
 	}
 
 	if util.ValidateAndReportEmpty(clusters, pagination, appCtx.Stdout) {
@@ -49,7 +49,7 @@ func PrintCacheClustersInfo(clusters []database.CacheCluster, appCtx *app.Applic
 }
 
 func printOneCacheCluster(p *printer.Printer, appCtx *app.ApplicationContext, cluster *database.CacheCluster, showAll bool) error {
-	title := util.FormatColoredTitle(appCtx, cluster.DisplayName)
+	title := util.FormatColoredResourceTitle(appCtx, cluster.ID, cluster.DisplayName)
 
 	subnetVal := cluster.SubnetId
 	if cluster.SubnetName != "" {
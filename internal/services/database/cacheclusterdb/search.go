@@ -6,26 +6,28 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
-	ocicachecluster "github.com/cnopslabs/ocloud/internal/oci/database/cacheclusterdb"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/watch"
 )
 
 // SearchCacheClusters searches for OCI HeatWave Cache Clusters matching the given query string in the current context.
-func SearchCacheClusters(appCtx *app.ApplicationContext, search string, useJSON bool, showAll bool) error {
-	adapter, err := ocicachecluster.NewAdapter(appCtx.Provider)
+func SearchCacheClusters(appCtx *app.ApplicationContext, search string, format printer.OutputFormat, showAll bool) error {
+	service, err := NewServiceFromAppContext(appCtx)
 	if err != nil {
-		return fmt.Errorf("creating cache cluster adapter: %w", err)
+		return fmt.Errorf("creating cache cluster service: %w", err)
 	}
-	service := NewService(adapter, appCtx)
 
-	ctx := context.Background()
-	matchedClusters, err := service.FuzzySearch(ctx, search)
-	if err != nil {
-		return fmt.Errorf("finding cache clusters: %w", err)
-	}
-	err = PrintCacheClustersInfo(matchedClusters, appCtx, nil, useJSON, showAll)
-	if err != nil {
-		return fmt.Errorf("printing cache clusters: %w", err)
-	}
-	logger.LogWithLevel(logger.CmdLogger, logger.Info, "Found matching cache clusters", "search", search, "matched", len(matchedClusters))
-	return nil
+	return util.Watch(context.Background(), appCtx, func(ctx context.Context) ([]watch.State, error) {
+		matchedClusters, err := service.FuzzySearch(ctx, search)
+		if err != nil {
+			return nil, fmt.Errorf("finding cache clusters: %w", err)
+		}
+		err = PrintCacheClustersInfo(matchedClusters, appCtx, nil, format, showAll)
+		if err != nil {
+			return nil, fmt.Errorf("printing cache clusters: %w", err)
+		}
+		logger.LogWithLevel(logger.CmdLogger, logger.Info, "Found matching cache clusters", "search", search, "matched", len(matchedClusters))
+		return util.WatchStates(matchedClusters, watchState), nil
+	})
 }
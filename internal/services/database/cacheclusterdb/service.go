@@ -6,10 +6,14 @@ import (
 	"strings"
 
 	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
 	"github.com/cnopslabs/ocloud/internal/domain/database"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	ocicachecluster "github.com/cnopslabs/ocloud/internal/oci/database/cacheclusterdb"
+	"github.com/cnopslabs/ocloud/internal/region"
 	"github.com/cnopslabs/ocloud/internal/services/search"
 	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/subtree"
 	"github.com/go-logr/logr"
 )
 
@@ -18,6 +22,7 @@ type Service struct {
 	repo          database.CacheClusterRepository
 	logger        logr.Logger
 	compartmentID string
+	indexStore    *cache.Store
 }
 
 // NewService initializes a new Service instance with the provided application context.
@@ -26,9 +31,32 @@ func NewService(repo database.CacheClusterRepository, appCtx *app.ApplicationCon
 		repo:          repo,
 		logger:        appCtx.Logger,
 		compartmentID: appCtx.CompartmentID,
+		indexStore:    appCtx.Cache,
 	}
 }
 
+// NewServiceFromAppContext creates a Service backed by the OCI adapter and the
+// resource cache of the application context.
+func NewServiceFromAppContext(appCtx *app.ApplicationContext) (*Service, error) {
+	repo, err := newRepository(appCtx)
+	if err != nil {
+		return nil, err
+	}
+	return NewService(repo, appCtx), nil
+}
+
+// newRepository builds the cache cluster repository of appCtx.
+func newRepository(appCtx *app.ApplicationContext) (database.CacheClusterRepository, error) {
+	return region.NewCacheClusterRepository(appCtx.Regions, func(name string) (database.CacheClusterRepository, error) {
+		regional := appCtx.ForRegion(name)
+		adapter, err := ocicachecluster.NewAdapter(regional.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("creating cache cluster adapter: %w", err)
+		}
+		return subtree.NewCacheClusterRepository(cache.NewCacheClusterRepository(adapter, regional.Cache), regional.Subtree), nil
+	})
+}
+
 // ListCacheClusters retrieves and returns all HeatWave cache clusters from the given compartment in the OCI account.
 func (s *Service) ListCacheClusters(ctx context.Context) ([]CacheCluster, error) {
 	s.logger.V(logger.Debug).Info("listing HeatWave cache clusters")
@@ -39,6 +67,16 @@ func (s *Service) ListCacheClusters(ctx context.Context) ([]CacheCluster, error)
 	return clusters, nil
 }
 
+// ListEnrichedCacheClusters retrieves all HeatWave cache clusters of the compartment with their enriched details.
+func (s *Service) ListEnrichedCacheClusters(ctx context.Context) ([]CacheCluster, error) {
+	s.logger.V(logger.Debug).Info("listing enriched HeatWave cache clusters")
+	clusters, err := s.repo.ListEnrichedCacheClusters(ctx, s.compartmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list enriched cache clusters: %w", err)
+	}
+	return clusters, nil
+}
+
 // FetchPaginatedCacheClusters retrieves a paginated list of HeatWave cache clusters with given limit and page number parameters.
 // It returns the slice of clusters, total count, next page token, and an error if encountered.
 func (s *Service) FetchPaginatedCacheClusters(ctx context.Context, limit, pageNum int) ([]CacheCluster, int, string, error) {
@@ -69,6 +107,16 @@ func (s *Service) FetchPaginatedCacheClusters(ctx context.Context, limit, pageNu
 // FuzzySearch performs a fuzzy search across HeatWave cache clusters using a given search pattern.
 // It indexes all searchable cluster fields and returns matching clusters.
 func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]CacheCluster, error) {
+	matches, err := s.ScoredSearch(ctx, searchPattern)
+	if err != nil {
+		return nil, err
+	}
+	return search.Items(matches), nil
+}
+
+// ScoredSearch performs a fuzzy search for cache clusters and returns the matches,
+// best first, with their relevance scores.
+func (s *Service) ScoredSearch(ctx context.Context, searchPattern string) ([]search.Match[CacheCluster], error) {
 	logger.LogWithLevel(s.logger, logger.Trace, "finding cache clusters with search", "pattern", searchPattern)
 	allClusters, err := s.repo.ListEnrichedCacheClusters(ctx, s.compartmentID)
 	if err != nil {
@@ -76,29 +124,40 @@ func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]Cach
 	}
 	p := strings.TrimSpace(searchPattern)
 	if p == "" {
-		return allClusters, nil
+		return search.AllMatches(allClusters), nil
 	}
 
 	// Build index using SearchableCacheCluster
 	indexables := ToSearchableCacheClusters(allClusters)
 	idxMapping := search.NewIndexMapping(GetSearchableFields())
-	idx, err := search.BuildIndex(indexables, idxMapping)
+	idx, err := search.OpenIndex(s.indexStore, s.compartmentID, cache.ResourceEnrichedCacheClusters, indexables, idxMapping)
 	if err != nil {
 		return nil, fmt.Errorf("building search index: %w", err)
 	}
+	defer func() { _ = idx.Close() }()
 
-	hits, err := search.FuzzySearch(idx, strings.ToLower(p), GetSearchableFields(), GetBoostedFields())
+	hits, err := search.ScoredFuzzySearch(idx, strings.ToLower(p), GetSearchableFields(), GetBoostedFields())
 	if err != nil {
 		return nil, fmt.Errorf("executing search: %w", err)
 	}
 
-	results := make([]CacheCluster, 0, len(hits))
-	for _, i := range hits {
-		if i >= 0 && i < len(allClusters) {
-			results = append(results, allClusters[i])
-		}
-	}
+	results := search.Rank(allClusters, hits)
 
 	logger.LogWithLevel(s.logger, logger.Debug, "completed search", "pattern", searchPattern, "totalClusters", len(allClusters), "matchedClusters", len(results))
 	return results, nil
 }
+
+// Reindex lists cache clusters and rebuilds their persisted search index.
+// It returns the number of indexed cache clusters.
+func (s *Service) Reindex(ctx context.Context) (int, error) {
+	all, err := s.repo.ListEnrichedCacheClusters(ctx, s.compartmentID)
+	if err != nil {
+		return 0, fmt.Errorf("fetching cache clusters: %w", err)
+	}
+
+	idx, err := search.OpenIndex(s.indexStore, s.compartmentID, cache.ResourceEnrichedCacheClusters, ToSearchableCacheClusters(all), search.NewIndexMapping(GetSearchableFields()))
+	if err != nil {
+		return 0, fmt.Errorf("building search index: %w", err)
+	}
+	return len(all), idx.Close()
+}
@@ -2,7 +2,13 @@ package cacheclusterdb
 
 import (
 	"github.com/cnopslabs/ocloud/internal/domain/database"
+	"github.com/cnopslabs/ocloud/internal/watch"
 )
 
 // CacheCluster is an alias for the domain model
 type CacheCluster = database.CacheCluster
+
+// watchState is the part of a cache cluster that --watch compares between polls.
+func watchState(c CacheCluster) watch.State {
+	return watch.State{ID: c.ID, Name: c.DisplayName, State: c.LifecycleState}
+}
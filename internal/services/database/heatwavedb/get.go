@@ -6,30 +6,31 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
-	ociheatwave "github.com/cnopslabs/ocloud/internal/oci/database/heatwavedb"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/watch"
 )
 
 // GetHeatWaveDatabase retrieves a list of HeatWave Databases and displays them in a table or JSON format.
-func GetHeatWaveDatabase(appCtx *app.ApplicationContext, useJSON bool, limit, page int, showAll bool) error {
+func GetHeatWaveDatabase(appCtx *app.ApplicationContext, format printer.OutputFormat, limit, page int, showAll bool) error {
 	logger.LogWithLevel(appCtx.Logger, logger.Debug, "Listing HeatWave Databases")
-	adapter, err := ociheatwave.NewAdapter(appCtx.Provider)
+	service, err := NewServiceFromAppContext(appCtx)
 	if err != nil {
-		return fmt.Errorf("creating HeatWave database adapter: %w", err)
+		return fmt.Errorf("creating HeatWave database service: %w", err)
 	}
 
-	service := NewService(adapter, appCtx)
+	return util.Watch(context.Background(), appCtx, func(ctx context.Context) ([]watch.State, error) {
+		allDatabases, totalCount, nextPageToken, err := service.FetchPaginatedHeatWaveDb(ctx, limit, page)
+		if err != nil {
+			return nil, fmt.Errorf("listing HeatWave databases: %w", err)
+		}
 
-	ctx := context.Background()
-	allDatabases, totalCount, nextPageToken, err := service.FetchPaginatedHeatWaveDb(ctx, limit, page)
-	if err != nil {
-		return fmt.Errorf("listing HeatWave databases: %w", err)
-	}
-
-	return PrintHeatWaveDbsInfo(allDatabases, appCtx, &util.PaginationInfo{
-		CurrentPage:   page,
-		TotalCount:    totalCount,
-		Limit:         limit,
-		NextPageToken: nextPageToken,
-	}, useJSON, showAll)
+		err = PrintHeatWaveDbsInfo(allDatabases, appCtx, &util.PaginationInfo{
+			CurrentPage:   page,
+			TotalCount:    totalCount,
+			Limit:         limit,
+			NextPageToken: nextPageToken,
+		}, format, showAll)
+		return util.WatchStates(allDatabases, watchState), err
+	})
 }
@@ -7,17 +7,19 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	ociheatwave "github.com/cnopslabs/ocloud/internal/oci/database/heatwavedb"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/services/util"
 	"github.com/cnopslabs/ocloud/internal/tui"
+	"github.com/cnopslabs/ocloud/internal/watch"
 )
 
 // ListHeatWaveDatabases lists all HeatWave Databases in the application context with TUI.
-func ListHeatWaveDatabases(appCtx *app.ApplicationContext, useJSON bool) error {
+func ListHeatWaveDatabases(appCtx *app.ApplicationContext, format printer.OutputFormat) error {
 	ctx := context.Background()
-	heatwaveDatabaseAdapter, err := ociheatwave.NewAdapter(appCtx.Provider)
+	service, err := NewServiceFromAppContext(appCtx)
 	if err != nil {
-		return fmt.Errorf("creating HeatWave database adapter: %w", err)
+		return fmt.Errorf("creating HeatWave database service: %w", err)
 	}
-	service := NewService(heatwaveDatabaseAdapter, appCtx)
 	allDatabases, err := service.ListHeatWaveDb(ctx)
 
 	if err != nil {
@@ -25,7 +27,8 @@ func ListHeatWaveDatabases(appCtx *app.ApplicationContext, useJSON bool) error {
 	}
 
 	// TUI
-	model := ociheatwave.NewDatabaseListModel(allDatabases)
+	model := ociheatwave.NewDatabaseListModel(allDatabases).
+		WithQueryFilter(ToSearchableHeatWaveDbs(allDatabases), GetSearchableFields(), GetBoostedFields())
 	id, err := tui.Run(model)
 	if err != nil {
 		if errors.Is(err, tui.ErrCancelled) {
@@ -34,10 +37,13 @@ func ListHeatWaveDatabases(appCtx *app.ApplicationContext, useJSON bool) error {
 		return fmt.Errorf("selecting database: %w", err)
 	}
 
-	database, err := service.repo.GetHeatWaveDatabase(ctx, id)
-	if err != nil {
-		return fmt.Errorf("getting database: %w", err)
-	}
+	return util.Watch(ctx, appCtx, func(ctx context.Context) ([]watch.State, error) {
+		database, err := service.repo.GetHeatWaveDatabase(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("getting database: %w", err)
+		}
 
-	return PrintHeatWaveDbInfo(database, appCtx, useJSON, true)
+		err = PrintHeatWaveDbInfo(database, appCtx, format, true)
+		return []watch.State{watchState(*database)}, err
+	})
 }
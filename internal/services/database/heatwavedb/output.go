@@ -10,28 +10,28 @@ import (
 )
 
 // PrintHeatWaveDbInfo prints a single HeatWave DB.
-func PrintHeatWaveDbInfo(db *database.HeatWaveDatabase, appCtx *app.ApplicationContext, useJSON bool, showAll bool) error {
+func PrintHeatWaveDbInfo(db *database.HeatWaveDatabase, appCtx *app.ApplicationContext, format printer.OutputFormat, showAll bool) error {
 	p := printer.New(appCtx.Stdout)
-	if useJSON {
-		return p.MarshalToJSON(db)
+	if !format.IsTable() {
+		return p.Marshal(format, db)
 	}
 
 	return printOneHeatWaveDb(p, appCtx, db, showAll)
 }
 
 // PrintHeatWaveDbsInfo prints a list of HeatWave DBs.
-func PrintHeatWaveDbsInfo(databases []database.HeatWaveDatabase, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, useJSON bool, showAll bool) error {
+func PrintHeatWaveDbsInfo(databases []database.HeatWaveDatabase, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, format printer.OutputFormat, showAll bool) error {
 	p := printer.New(appCtx.Stdout)
 
 	if pagination != nil {
 		util.AdjustPaginationInfo(pagination)
 	}
 
-	if useJSON {
+	if !format.IsTable() {
 		if len(databases) == 0 && pagination == nil {
-			return p.MarshalToJSON(struct{}{})
+			return p.Marshal(format, struct{}{})
 		}
-		return util.MarshalDataToJSONResponse[database.HeatWaveDatabase](p, databases, pagination)
+		return util.MarshalScopedResponse(p, format, appCtx, databases, func(db database.HeatWaveDatabase) string { return db.ID }, pagination)
 	}
 
 	if util.ValidateAndReportEmpty(databases, pagination, appCtx.Stdout) {
@@ -49,7 +49,7 @@ func PrintHeatWaveDbsInfo(databases []database.HeatWaveDatabase, appCtx *app.App
 }
 
 func printOneHeatWaveDb(p *printer.Printer, appCtx *app.ApplicationContext, db *database.HeatWaveDatabase, showAll bool) error {
-	title := util.FormatColoredTitle(appCtx, db.DisplayName)
+	title := util.FormatColoredResourceTitle(appCtx, db.ID, db.DisplayName)
 
 	subnetVal := db.SubnetId
 	if db.SubnetName != "" {
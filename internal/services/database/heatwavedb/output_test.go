@@ -8,6 +8,7 @@ import (
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/domain/database"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/cnopslabs/ocloud/internal/services/util"
 	"github.com/oracle/oci-go-sdk/v65/mysql"
 	"github.com/stretchr/testify/assert"
@@ -62,7 +63,7 @@ func TestPrintHeatWaveDbsInfo_SummaryAndJSON(t *testing.T) {
 	appCtx := &app.ApplicationContext{Logger: logger.NewTestLogger(), Stdout: &buf}
 
 	// Table output (summary)
-	err := PrintHeatWaveDbsInfo([]database.HeatWaveDatabase{db1, db2}, appCtx, nil, false, false)
+	err := PrintHeatWaveDbsInfo([]database.HeatWaveDatabase{db1, db2}, appCtx, nil, printer.TableOutput, false)
 	assert.NoError(t, err)
 	out := buf.String()
 	// Validate presence of key fields for both DBs
@@ -79,7 +80,7 @@ func TestPrintHeatWaveDbsInfo_SummaryAndJSON(t *testing.T) {
 	// JSON output with pagination
 	buf.Reset()
 	pg := &util.PaginationInfo{TotalCount: 2, Limit: 2, CurrentPage: 1, NextPageToken: ""}
-	err = PrintHeatWaveDbsInfo([]database.HeatWaveDatabase{db1, db2}, appCtx, pg, true, false)
+	err = PrintHeatWaveDbsInfo([]database.HeatWaveDatabase{db1, db2}, appCtx, pg, printer.JSONOutput, false)
 	assert.NoError(t, err)
 	jsonOut := buf.String()
 	assert.Contains(t, jsonOut, `"items"`)
@@ -139,7 +140,7 @@ func TestPrintHeatWaveDbsInfo_DetailedView(t *testing.T) {
 	appCtx := &app.ApplicationContext{Logger: logger.NewTestLogger(), Stdout: &buf}
 
 	// Detailed view (showAll=true)
-	err := PrintHeatWaveDbsInfo([]database.HeatWaveDatabase{db}, appCtx, nil, false, true)
+	err := PrintHeatWaveDbsInfo([]database.HeatWaveDatabase{db}, appCtx, nil, printer.TableOutput, true)
 	assert.NoError(t, err)
 	out := buf.String()
 
@@ -164,7 +165,7 @@ func TestPrintHeatWaveDbsInfo_EmptyList(t *testing.T) {
 	var buf bytes.Buffer
 	appCtx := &app.ApplicationContext{Logger: logger.NewTestLogger(), Stdout: &buf}
 
-	err := PrintHeatWaveDbsInfo([]database.HeatWaveDatabase{}, appCtx, nil, false, false)
+	err := PrintHeatWaveDbsInfo([]database.HeatWaveDatabase{}, appCtx, nil, printer.TableOutput, false)
 	assert.NoError(t, err)
 	// The ValidateAndReportEmpty should handle empty lists
 	out := buf.String()
@@ -176,7 +177,7 @@ func TestPrintHeatWaveDbsInfo_EmptyJSON(t *testing.T) {
 	var buf bytes.Buffer
 	appCtx := &app.ApplicationContext{Logger: logger.NewTestLogger(), Stdout: &buf}
 
-	err := PrintHeatWaveDbsInfo([]database.HeatWaveDatabase{}, appCtx, nil, true, false)
+	err := PrintHeatWaveDbsInfo([]database.HeatWaveDatabase{}, appCtx, nil, printer.JSONOutput, false)
 	assert.NoError(t, err)
 	out := buf.String()
 	assert.Contains(t, out, "{}")
@@ -203,7 +204,7 @@ func TestPrintHeatWaveDbInfo_Single(t *testing.T) {
 	appCtx := &app.ApplicationContext{Logger: logger.NewTestLogger(), Stdout: &buf}
 
 	// Summary view
-	err := PrintHeatWaveDbInfo(&db, appCtx, false, false)
+	err := PrintHeatWaveDbInfo(&db, appCtx, printer.TableOutput, false)
 	assert.NoError(t, err)
 	out := buf.String()
 	assert.Contains(t, out, "hw-single-db")
@@ -211,7 +212,7 @@ func TestPrintHeatWaveDbInfo_Single(t *testing.T) {
 
 	// JSON output
 	buf.Reset()
-	err = PrintHeatWaveDbInfo(&db, appCtx, true, false)
+	err = PrintHeatWaveDbInfo(&db, appCtx, printer.JSONOutput, false)
 	assert.NoError(t, err)
 	jsonOut := buf.String()
 	assert.Contains(t, jsonOut, `"hw-single-db"`)
@@ -362,7 +363,7 @@ func TestPrintHeatWaveDbInfo_ECPUShapeAndStorage(t *testing.T) {
 	appCtx := &app.ApplicationContext{Logger: logger.NewTestLogger(), Stdout: &buf}
 
 	// Summary view
-	err := PrintHeatWaveDbInfo(&db, appCtx, false, false)
+	err := PrintHeatWaveDbInfo(&db, appCtx, printer.TableOutput, false)
 	assert.NoError(t, err)
 	out := buf.String()
 
@@ -381,7 +382,7 @@ func TestPrintHeatWaveDbInfo_ECPUShapeAndStorage(t *testing.T) {
 
 	// Detailed view
 	buf.Reset()
-	err = PrintHeatWaveDbInfo(&db, appCtx, false, true)
+	err = PrintHeatWaveDbInfo(&db, appCtx, printer.TableOutput, true)
 	assert.NoError(t, err)
 	detailedOut := buf.String()
 
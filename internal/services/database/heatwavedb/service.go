@@ -6,10 +6,14 @@ import (
 	"strings"
 
 	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
 	"github.com/cnopslabs/ocloud/internal/domain/database"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	ociheatwave "github.com/cnopslabs/ocloud/internal/oci/database/heatwavedb"
+	"github.com/cnopslabs/ocloud/internal/region"
 	"github.com/cnopslabs/ocloud/internal/services/search"
 	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/subtree"
 	"github.com/go-logr/logr"
 )
 
@@ -18,6 +22,7 @@ type Service struct {
 	repo          database.HeatWaveDatabaseRepository
 	logger        logr.Logger
 	compartmentID string
+	indexStore    *cache.Store
 }
 
 // NewService initializes a new Service instance with the provided application context.
@@ -26,9 +31,32 @@ func NewService(repo database.HeatWaveDatabaseRepository, appCtx *app.Applicatio
 		repo:          repo,
 		logger:        appCtx.Logger,
 		compartmentID: appCtx.CompartmentID,
+		indexStore:    appCtx.Cache,
 	}
 }
 
+// NewServiceFromAppContext creates a Service backed by the OCI adapter and the
+// resource cache of the application context.
+func NewServiceFromAppContext(appCtx *app.ApplicationContext) (*Service, error) {
+	repo, err := newRepository(appCtx)
+	if err != nil {
+		return nil, err
+	}
+	return NewService(repo, appCtx), nil
+}
+
+// newRepository builds the HeatWave database repository of appCtx.
+func newRepository(appCtx *app.ApplicationContext) (database.HeatWaveDatabaseRepository, error) {
+	return region.NewHeatWaveDatabaseRepository(appCtx.Regions, func(name string) (database.HeatWaveDatabaseRepository, error) {
+		regional := appCtx.ForRegion(name)
+		adapter, err := ociheatwave.NewAdapter(regional.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("creating HeatWave database adapter: %w", err)
+		}
+		return subtree.NewHeatWaveDatabaseRepository(cache.NewHeatWaveDatabaseRepository(adapter, regional.Cache), regional.Subtree), nil
+	})
+}
+
 // ListHeatWaveDb retrieves and returns all HeatWave databases from the given compartment in the OCI account.
 func (s *Service) ListHeatWaveDb(ctx context.Context) ([]HeatWaveDatabase, error) {
 	s.logger.V(logger.Debug).Info("listing HeatWave databases")
@@ -39,6 +67,16 @@ func (s *Service) ListHeatWaveDb(ctx context.Context) ([]HeatWaveDatabase, error
 	return databases, nil
 }
 
+// ListEnrichedHeatWaveDb retrieves all HeatWave databases of the compartment with their enriched details.
+func (s *Service) ListEnrichedHeatWaveDb(ctx context.Context) ([]HeatWaveDatabase, error) {
+	s.logger.V(logger.Debug).Info("listing enriched HeatWave databases")
+	databases, err := s.repo.ListEnrichedHeatWaveDatabases(ctx, s.compartmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list enriched HeatWave databases: %w", err)
+	}
+	return databases, nil
+}
+
 // FetchPaginatedHeatWaveDb retrieves a paginated list of HeatWave databases with given limit and page number parameters.
 // It returns the slice of databases, total count, next page token, and an error if encountered.
 func (s *Service) FetchPaginatedHeatWaveDb(ctx context.Context, limit, pageNum int) ([]HeatWaveDatabase, int, string, error) {
@@ -69,6 +107,16 @@ func (s *Service) FetchPaginatedHeatWaveDb(ctx context.Context, limit, pageNum i
 // FuzzySearch performs a fuzzy search across HeatWave databases using a given search pattern.
 // It indexes all searchable database fields and returns matching databases.
 func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]HeatWaveDatabase, error) {
+	matches, err := s.ScoredSearch(ctx, searchPattern)
+	if err != nil {
+		return nil, err
+	}
+	return search.Items(matches), nil
+}
+
+// ScoredSearch performs a fuzzy search for HeatWave databases and returns the matches,
+// best first, with their relevance scores.
+func (s *Service) ScoredSearch(ctx context.Context, searchPattern string) ([]search.Match[HeatWaveDatabase], error) {
 	logger.LogWithLevel(s.logger, logger.Trace, "finding databases with search", "pattern", searchPattern)
 	allDatabases, err := s.repo.ListEnrichedHeatWaveDatabases(ctx, s.compartmentID)
 	if err != nil {
@@ -76,29 +124,40 @@ func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]Heat
 	}
 	p := strings.TrimSpace(searchPattern)
 	if p == "" {
-		return allDatabases, nil
+		return search.AllMatches(allDatabases), nil
 	}
 
 	// Build index using SearchableHeatWaveDatabase
 	indexables := ToSearchableHeatWaveDbs(allDatabases)
 	idxMapping := search.NewIndexMapping(GetSearchableFields())
-	idx, err := search.BuildIndex(indexables, idxMapping)
+	idx, err := search.OpenIndex(s.indexStore, s.compartmentID, cache.ResourceEnrichedHeatWaveDatabases, indexables, idxMapping)
 	if err != nil {
 		return nil, fmt.Errorf("building search index: %w", err)
 	}
+	defer func() { _ = idx.Close() }()
 
-	hits, err := search.FuzzySearch(idx, strings.ToLower(p), GetSearchableFields(), GetBoostedFields())
+	hits, err := search.ScoredFuzzySearch(idx, strings.ToLower(p), GetSearchableFields(), GetBoostedFields())
 	if err != nil {
 		return nil, fmt.Errorf("executing search: %w", err)
 	}
 
-	results := make([]HeatWaveDatabase, 0, len(hits))
-	for _, i := range hits {
-		if i >= 0 && i < len(allDatabases) {
-			results = append(results, allDatabases[i])
-		}
-	}
+	results := search.Rank(allDatabases, hits)
 
 	logger.LogWithLevel(s.logger, logger.Debug, "completed search", "pattern", searchPattern, "totalDatabases", len(allDatabases), "matchedDatabases", len(results))
 	return results, nil
 }
+
+// Reindex lists HeatWave databases and rebuilds their persisted search index.
+// It returns the number of indexed HeatWave databases.
+func (s *Service) Reindex(ctx context.Context) (int, error) {
+	all, err := s.repo.ListEnrichedHeatWaveDatabases(ctx, s.compartmentID)
+	if err != nil {
+		return 0, fmt.Errorf("fetching HeatWave databases: %w", err)
+	}
+
+	idx, err := search.OpenIndex(s.indexStore, s.compartmentID, cache.ResourceEnrichedHeatWaveDatabases, ToSearchableHeatWaveDbs(all), search.NewIndexMapping(GetSearchableFields()))
+	if err != nil {
+		return 0, fmt.Errorf("building search index: %w", err)
+	}
+	return len(all), idx.Close()
+}
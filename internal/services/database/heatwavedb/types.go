@@ -2,7 +2,13 @@ package heatwavedb
 
 import (
 	"github.com/cnopslabs/ocloud/internal/domain/database"
+	"github.com/cnopslabs/ocloud/internal/watch"
 )
 
 // HeatWaveDatabase is an alias for the domain model
 type HeatWaveDatabase = database.HeatWaveDatabase
+
+// watchState is the part of a HeatWave database that --watch compares between polls.
+func watchState(db HeatWaveDatabase) watch.State {
+	return watch.State{ID: db.ID, Name: db.DisplayName, State: db.LifecycleState}
+}
@@ -6,10 +6,11 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
 )
 
 // GetBastions retrieves a list of bastion hosts and displays their information, optionally in JSON format.
-func GetBastions(ctx context.Context, appCtx *app.ApplicationContext, useJSON bool) error {
+func GetBastions(ctx context.Context, appCtx *app.ApplicationContext, format printer.OutputFormat) error {
 
 	logger.LogWithLevel(appCtx.Logger, logger.Debug, "Listing bastions")
 
@@ -23,5 +24,5 @@ func GetBastions(ctx context.Context, appCtx *app.ApplicationContext, useJSON bo
 		return fmt.Errorf("listing bastions: %w", err)
 	}
 
-	return PrintBastionInfo(bastions, appCtx, useJSON)
+	return PrintBastionInfo(bastions, appCtx, format)
 }
@@ -10,14 +10,14 @@ import (
 )
 
 // PrintBastionInfo displays bastion instances in a formatted table or JSON format.
-func PrintBastionInfo(bastions []Bastion, appCtx *app.ApplicationContext, useJSON bool) error {
+func PrintBastionInfo(bastions []Bastion, appCtx *app.ApplicationContext, format printer.OutputFormat) error {
 
 	p := printer.New(appCtx.Stdout)
-	if useJSON {
+	if !format.IsTable() {
 		if len(bastions) == 0 {
-			return p.MarshalToJSON(struct{}{})
+			return p.Marshal(format, struct{}{})
 		}
-		return p.MarshalToJSON(bastions)
+		return p.Marshal(format, bastions)
 	}
 
 	for _, b := range bastions {
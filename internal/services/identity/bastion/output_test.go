@@ -8,6 +8,7 @@ import (
 	"github.com/cnopslabs/ocloud/internal/app"
 	domain "github.com/cnopslabs/ocloud/internal/domain/identity"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -73,7 +74,7 @@ func TestPrintBastionInfo_JSON(t *testing.T) {
 				Logger: logger.NewTestLogger(),
 			}
 
-			err := PrintBastionInfo(tt.bastions, appCtx, true)
+			err := PrintBastionInfo(tt.bastions, appCtx, printer.JSONOutput)
 			assert.NoError(t, err)
 
 			// Verify JSON output
@@ -158,7 +159,7 @@ func TestPrintBastionInfo_Table(t *testing.T) {
 				TenancyName:     "TestTenancy",
 			}
 
-			err := PrintBastionInfo(tt.bastions, appCtx, false)
+			err := PrintBastionInfo(tt.bastions, appCtx, printer.TableOutput)
 			assert.NoError(t, err)
 
 			output := buf.String()
@@ -217,7 +218,7 @@ func TestPrintBastionInfo_MaxSessionTTLFormatting(t *testing.T) {
 				},
 			}
 
-			err := PrintBastionInfo(bastions, appCtx, false)
+			err := PrintBastionInfo(bastions, appCtx, printer.TableOutput)
 			assert.NoError(t, err)
 
 			output := buf.String()
@@ -367,17 +367,50 @@ func (s *Service) EnsureManagedSSHSession(ctx context.Context, bastionID, target
 	return sessionID, nil
 }
 
+// SessionTarget returns the instance OCID and private IP that a managed SSH or
+// port forwarding session reaches. Dynamic port forwarding sessions have no
+// single target and yield an error.
+func (s *Service) SessionTarget(ctx context.Context, sessionID string) (targetID, targetIP string, err error) {
+	resp, err := s.bastionClient.GetSession(ctx, bastion.GetSessionRequest{SessionId: &sessionID})
+	if err != nil {
+		return "", "", fmt.Errorf("getting session: %w", err)
+	}
+	var id, ip *string
+	switch trd := resp.Session.TargetResourceDetails.(type) {
+	case bastion.ManagedSshSessionTargetResourceDetails:
+		id, ip = trd.TargetResourceId, trd.TargetResourcePrivateIpAddress
+	case bastion.PortForwardingSessionTargetResourceDetails:
+		id, ip = trd.TargetResourceId, trd.TargetResourcePrivateIpAddress
+	}
+	if id != nil {
+		targetID = *id
+	}
+	if ip != nil {
+		targetIP = *ip
+	}
+	if targetID == "" && targetIP == "" {
+		return "", "", fmt.Errorf("session %s does not target a single instance", sessionID)
+	}
+	return targetID, targetIP, nil
+}
+
 // BuildManagedSSHCommand constructs the SSH command that uses ProxyCommand with the bastion Managed SSH session.
 // It opens only a direct-tcpip channel on the bastion (accepted), while authenticating to bastion with the session OCID.
 // The outer SSH connects to the target instance as targetUser@targetIP.
 func BuildManagedSSHCommand(privateKeyPath, sessionID, region, targetIP, targetUser string) string {
+	proxy := BuildProxyCommand(privateKeyPath, sessionID, region)
+	return fmt.Sprintf("ssh -i %s -o ProxyCommand=\"%s\" -p 22 %s@%s", privateKeyPath, proxy, targetUser, targetIP)
+}
+
+// BuildProxyCommand returns the ProxyCommand that tunnels an SSH connection to %h:%p
+// through the bastion session, for use in ssh_config files or with ssh -o.
+func BuildProxyCommand(privateKeyPath, sessionID, region string) string {
 	realm := "oraclecloud"
 	parts := strings.Split(sessionID, ".")
 	if len(parts) > 2 && strings.Contains(parts[2], "2") {
 		realm = "oraclegovcloud"
 	}
-	proxy := fmt.Sprintf("ssh -i %s -W %%h:%%p -p 22 %s@host.bastion.%s.oci.%s.com", privateKeyPath, sessionID, region, realm)
-	return fmt.Sprintf("ssh -i %s -o ProxyCommand=\"%s\" -p 22 %s@%s", privateKeyPath, proxy, targetUser, targetIP)
+	return fmt.Sprintf("ssh -i %s -W %%h:%%p -p 22 %s@host.bastion.%s.oci.%s.com", privateKeyPath, sessionID, region, realm)
 }
 
 // BuildPortForwardArgs constructs SSH command arguments for establishing a secure port-forwarding tunnel.
@@ -5,15 +5,18 @@ import (
 	"fmt"
 
 	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
 	"github.com/cnopslabs/ocloud/internal/oci/identity/compartment"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/subtree"
 )
 
 // GetCompartments retrieves and displays a paginated list of compartments.
-func GetCompartments(appCtx *app.ApplicationContext, useJSON bool, limit, page int, ocid string) error {
+func GetCompartments(appCtx *app.ApplicationContext, format printer.OutputFormat, limit, page int, ocid string) error {
 	ctx := context.Background()
 	compartmentAdapter := compartment.NewCompartmentAdapter(appCtx.IdentityClient, ocid)
-	service := NewService(compartmentAdapter, appCtx.Logger, ocid)
+	service := NewService(subtree.NewCompartmentRepository(cache.NewCompartmentRepository(compartmentAdapter, appCtx.Cache), appCtx.Subtree), appCtx.Logger, ocid)
 
 	compartments, totalCount, nextPageToken, err := service.FetchPaginateCompartments(ctx, limit, page)
 	if err != nil {
@@ -25,5 +28,5 @@ func GetCompartments(appCtx *app.ApplicationContext, useJSON bool, limit, page i
 		TotalCount:    totalCount,
 		Limit:         limit,
 		NextPageToken: nextPageToken,
-	}, useJSON)
+	}, format)
 }
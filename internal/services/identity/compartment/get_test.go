@@ -6,6 +6,7 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -28,7 +29,7 @@ func TestGetCompartmentsSimple(t *testing.T) {
 		Stdout:      io.Discard, // Discard output to avoid cluttering the test output
 	}
 
-	err := GetCompartments(appCtx, false, 20, 1, appCtx.TenancyID)
+	err := GetCompartments(appCtx, printer.TableOutput, 20, 1, appCtx.TenancyID)
 
 	// but if we did, we would expect no error
 	assert.NoError(t, err)
@@ -53,7 +54,7 @@ func TestListCompartmentsOutput(t *testing.T) {
 		Stdout:      io.Discard, // In a real test, we would use a buffer to capture output
 	}
 
-	err := GetCompartments(appCtxJSON, true, 20, 1, appCtxJSON.TenancyID)
+	err := GetCompartments(appCtxJSON, printer.JSONOutput, 20, 1, appCtxJSON.TenancyID)
 	assert.NoError(t, err)
 
 	// Test with table output
@@ -64,7 +65,7 @@ func TestListCompartmentsOutput(t *testing.T) {
 		Stdout:      io.Discard, // In a real test, we would use a buffer to capture output
 	}
 
-	err = GetCompartments(appCtxTable, false, 20, 1, appCtxTable.TenancyID)
+	err = GetCompartments(appCtxTable, printer.TableOutput, 20, 1, appCtxTable.TenancyID)
 	assert.NoError(t, err)
 }
 
@@ -87,15 +88,15 @@ func TestListCompartmentsPagination(t *testing.T) {
 	}
 
 	// Test page 1
-	err := GetCompartments(appCtx, false, 10, 1, appCtx.TenancyID)
+	err := GetCompartments(appCtx, printer.TableOutput, 10, 1, appCtx.TenancyID)
 	assert.NoError(t, err)
 
 	// Test page 2
-	err = GetCompartments(appCtx, false, 10, 2, appCtx.TenancyID)
+	err = GetCompartments(appCtx, printer.TableOutput, 10, 2, appCtx.TenancyID)
 	assert.NoError(t, err)
 
 	// Test with a large page number (beyond available data)
-	err = GetCompartments(appCtx, false, 10, 100, appCtx.TenancyID)
+	err = GetCompartments(appCtx, printer.TableOutput, 10, 100, appCtx.TenancyID)
 	assert.NoError(t, err)
 }
 
@@ -117,7 +118,7 @@ func TestListCompartmentsError(t *testing.T) {
 		Stdout:      io.Discard,
 	}
 
-	err := GetCompartments(appCtx, false, 20, 1, appCtx.TenancyID)
+	err := GetCompartments(appCtx, printer.TableOutput, 20, 1, appCtx.TenancyID)
 
 	// In a real test with a mock that returns an error, we would expect an error
 	// assert.Error(t, err)
@@ -6,14 +6,17 @@ import (
 	"fmt"
 
 	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
 	"github.com/cnopslabs/ocloud/internal/oci/identity/compartment"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/subtree"
 	"github.com/cnopslabs/ocloud/internal/tui"
 )
 
-func ListCompartments(appCtx *app.ApplicationContext, ocid string, useJSON bool) error {
+func ListCompartments(appCtx *app.ApplicationContext, ocid string, format printer.OutputFormat) error {
 	ctx := context.Background()
 	compartmentAdapter := compartment.NewCompartmentAdapter(appCtx.IdentityClient, ocid)
-	service := NewService(compartmentAdapter, appCtx.Logger, ocid)
+	service := NewService(subtree.NewCompartmentRepository(cache.NewCompartmentRepository(compartmentAdapter, appCtx.Cache), appCtx.Subtree), appCtx.Logger, ocid)
 
 	compartments, err := service.compartmentRepo.ListCompartments(ctx, ocid)
 	if err != nil {
@@ -21,7 +24,8 @@ func ListCompartments(appCtx *app.ApplicationContext, ocid string, useJSON bool)
 	}
 
 	//TUI
-	model := compartment.NewPoliciesListModel(compartments)
+	model := compartment.NewPoliciesListModel(compartments).
+		WithQueryFilter(ToSearchableCompartments(compartments), GetSearchableFields(), GetBoostedFields())
 	id, err := tui.Run(model)
 	if err != nil {
 		if errors.Is(err, tui.ErrCancelled) {
@@ -34,5 +38,5 @@ func ListCompartments(appCtx *app.ApplicationContext, ocid string, useJSON bool)
 		return fmt.Errorf("getting compartment: %w", err)
 	}
 
-	return PrintCompartmentInfo(c, appCtx, useJSON)
+	return PrintCompartmentInfo(c, appCtx, format)
 }
@@ -8,18 +8,18 @@ import (
 
 // PrintCompartmentsTable displays a table or JSON representation of compartments based on the provided configuration.
 // It optionally includes pagination details and writes to the application's standard output or as structured JSON.
-func PrintCompartmentsTable(compartments []Compartment, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, useJSON bool) error {
+func PrintCompartmentsTable(compartments []Compartment, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, format printer.OutputFormat) error {
 	p := printer.New(appCtx.Stdout)
 
 	if pagination != nil {
 		util.AdjustPaginationInfo(pagination)
 	}
 
-	if useJSON {
+	if !format.IsTable() {
 		if len(compartments) == 0 && pagination == nil {
-			return p.MarshalToJSON(struct{}{})
+			return p.Marshal(format, struct{}{})
 		}
-		return util.MarshalDataToJSONResponse[Compartment](p, compartments, pagination)
+		return util.MarshalScopedResponse(p, format, appCtx, compartments, compartmentID, pagination)
 	}
 
 	if util.ValidateAndReportEmpty(compartments, pagination, appCtx.Stdout) {
@@ -28,6 +28,9 @@ func PrintCompartmentsTable(compartments []Compartment, appCtx *app.ApplicationC
 
 	// Define table headers
 	headers := []string{"Name", "ID"}
+	if appCtx.Subtree != nil {
+		headers = append(headers, "Parent")
+	}
 
 	rows := make([][]string, len(compartments))
 	for i, c := range compartments {
@@ -35,6 +38,9 @@ func PrintCompartmentsTable(compartments []Compartment, appCtx *app.ApplicationC
 			c.DisplayName,
 			c.OCID,
 		}
+		if appCtx.Subtree != nil {
+			rows[i] = append(rows[i], util.CompartmentPath(appCtx, c.OCID))
+		}
 	}
 
 	// Print the table
@@ -48,16 +54,16 @@ func PrintCompartmentsTable(compartments []Compartment, appCtx *app.ApplicationC
 // PrintCompartmentsInfo displays information about a list of compartments in either JSON or formatted table output.
 // It accepts a slice of Compartment, application context, pagination info, and a boolean to indicate JSON output.
 // It adjusts pagination details, validates empty compartments, and logs pagination info post-output.
-func PrintCompartmentsInfo(compartments []Compartment, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, useJSON bool) error {
+func PrintCompartmentsInfo(compartments []Compartment, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, format printer.OutputFormat) error {
 	p := printer.New(appCtx.Stdout)
 	if pagination != nil {
 		util.AdjustPaginationInfo(pagination)
 	}
-	if useJSON {
+	if !format.IsTable() {
 		if len(compartments) == 0 && pagination == nil {
-			return p.MarshalToJSON(struct{}{})
+			return p.Marshal(format, struct{}{})
 		}
-		return util.MarshalDataToJSONResponse[Compartment](p, compartments, pagination)
+		return util.MarshalScopedResponse(p, format, appCtx, compartments, compartmentID, pagination)
 	}
 
 	if util.ValidateAndReportEmpty(compartments, pagination, appCtx.Stdout) {
@@ -75,7 +81,7 @@ func PrintCompartmentsInfo(compartments []Compartment, appCtx *app.ApplicationCo
 			"Name", "ID", "Description",
 		}
 
-		title := util.FormatColoredTitle(appCtx, compartment.DisplayName)
+		title := util.FormatColoredResourceTitle(appCtx, compartment.OCID, compartment.DisplayName)
 
 		p.PrintKeyValues(title, compartmentData, orderedKeys)
 	}
@@ -85,11 +91,11 @@ func PrintCompartmentsInfo(compartments []Compartment, appCtx *app.ApplicationCo
 }
 
 // PrintCompartmentInfo displays a detailed view of a compartment.
-func PrintCompartmentInfo(compartment *Compartment, appCtx *app.ApplicationContext, useJSON bool) error {
+func PrintCompartmentInfo(compartment *Compartment, appCtx *app.ApplicationContext, format printer.OutputFormat) error {
 	p := printer.New(appCtx.Stdout)
 
-	if useJSON {
-		return p.MarshalToJSON(compartment)
+	if !format.IsTable() {
+		return p.Marshal(format, compartment)
 	}
 	compartmentData := map[string]string{
 		"Name":        compartment.DisplayName,
@@ -100,9 +106,12 @@ func PrintCompartmentInfo(compartment *Compartment, appCtx *app.ApplicationConte
 		"Name", "ID", "Description",
 	}
 
-	title := util.FormatColoredTitle(appCtx, compartment.DisplayName)
+	title := util.FormatColoredResourceTitle(appCtx, compartment.OCID, compartment.DisplayName)
 
 	p.PrintKeyValues(title, compartmentData, orderedKeys)
 
 	return nil
 }
+
+// compartmentID keys compartments for the path of their parent.
+func compartmentID(c Compartment) string { return c.OCID }
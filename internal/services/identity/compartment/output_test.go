@@ -6,6 +6,7 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/cnopslabs/ocloud/internal/services/util"
 	"github.com/stretchr/testify/assert"
 )
@@ -38,7 +39,7 @@ func TestPrintCompartmentsInfo(t *testing.T) {
 	}
 
 	// Test with table output (useJSON = false)
-	err := PrintCompartmentsInfo(compartments, appCtx, nil, false)
+	err := PrintCompartmentsInfo(compartments, appCtx, nil, printer.TableOutput)
 	assert.NoError(t, err)
 
 	// Verify that the output contains the expected information
@@ -54,7 +55,7 @@ func TestPrintCompartmentsInfo(t *testing.T) {
 	buf.Reset()
 
 	// Test with JSON output (useJSON = true)
-	err = PrintCompartmentsInfo(compartments, appCtx, nil, true)
+	err = PrintCompartmentsInfo(compartments, appCtx, nil, printer.JSONOutput)
 	assert.NoError(t, err)
 
 	// Verify that the output is valid JSON and contains the expected information
@@ -84,7 +85,7 @@ func TestPrintCompartmentsInfoEmpty(t *testing.T) {
 	}
 
 	// Test with table output (useJSON = false)
-	err := PrintCompartmentsInfo(compartments, appCtx, nil, false)
+	err := PrintCompartmentsInfo(compartments, appCtx, nil, printer.TableOutput)
 	assert.NoError(t, err)
 
 	// Verify that the output indicates no items found
@@ -95,7 +96,7 @@ func TestPrintCompartmentsInfoEmpty(t *testing.T) {
 	buf.Reset()
 
 	// Test with JSON output (useJSON = true)
-	err = PrintCompartmentsInfo(compartments, appCtx, nil, true)
+	err = PrintCompartmentsInfo(compartments, appCtx, nil, printer.JSONOutput)
 	assert.NoError(t, err)
 
 	// Verify that the output is valid JSON and indicates an empty object
@@ -135,7 +136,7 @@ func TestPrintCompartmentsInfoWithPagination(t *testing.T) {
 
 	// Test with table output (useJSON = false)
 	var err error
-	err = PrintCompartmentsInfo(compartments, appCtx, pagination, false)
+	err = PrintCompartmentsInfo(compartments, appCtx, pagination, printer.TableOutput)
 	assert.NoError(t, err)
 
 	// Verify that the output contains the expected information
@@ -148,7 +149,7 @@ func TestPrintCompartmentsInfoWithPagination(t *testing.T) {
 	buf.Reset()
 
 	// Test with JSON output (useJSON = true)
-	err = PrintCompartmentsInfo(compartments, appCtx, pagination, true)
+	err = PrintCompartmentsInfo(compartments, appCtx, pagination, printer.JSONOutput)
 	assert.NoError(t, err)
 
 	// Verify that the output is valid JSON and contains the expected information
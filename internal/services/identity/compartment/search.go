@@ -5,23 +5,27 @@ import (
 	"fmt"
 
 	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
 	"github.com/cnopslabs/ocloud/internal/logger"
 	"github.com/cnopslabs/ocloud/internal/oci/identity/compartment"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/subtree"
 )
 
 // SearchCompartments searches and displays compartments matching a given name pattern.
-func SearchCompartments(appCtx *app.ApplicationContext, namePattern string, useJSON bool, ocid string) error {
+func SearchCompartments(appCtx *app.ApplicationContext, namePattern string, format printer.OutputFormat, ocid string) error {
 	ctx := context.Background()
 	compartmentAdapter := compartment.NewCompartmentAdapter(appCtx.IdentityClient, ocid)
 
 	// Create the application service, injecting the adapter.
-	service := NewService(compartmentAdapter, appCtx.Logger, ocid)
+	service := NewService(subtree.NewCompartmentRepository(cache.NewCompartmentRepository(compartmentAdapter, appCtx.Cache), appCtx.Subtree), appCtx.Logger, ocid)
+	service.indexStore = appCtx.Cache
 
 	matchedCompartments, err := service.FuzzySearch(ctx, namePattern)
 	if err != nil {
 		return fmt.Errorf("finding matched compartments: %w", err)
 	}
-	err = PrintCompartmentsInfo(matchedCompartments, appCtx, nil, useJSON)
+	err = PrintCompartmentsInfo(matchedCompartments, appCtx, nil, format)
 	if err != nil {
 		return fmt.Errorf("printing matched compartments: %w", err)
 	}
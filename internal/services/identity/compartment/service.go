@@ -4,10 +4,14 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
 	"github.com/cnopslabs/ocloud/internal/domain/identity"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/oci/identity/compartment"
 	"github.com/cnopslabs/ocloud/internal/services/search"
 	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/subtree"
 	"github.com/go-logr/logr"
 )
 
@@ -17,6 +21,7 @@ type Service struct {
 	compartmentRepo identity.CompartmentRepository
 	logger          logr.Logger
 	compartmentID   string
+	indexStore      *cache.Store
 }
 
 // NewService initializes and returns a new Service instance.
@@ -29,6 +34,15 @@ func NewService(repo identity.CompartmentRepository, logger logr.Logger, ocid st
 	}
 }
 
+// NewServiceFromAppContext creates a Service backed by the OCI adapter and the
+// resource cache of the application context.
+func NewServiceFromAppContext(appCtx *app.ApplicationContext) (*Service, error) {
+	repo := subtree.NewCompartmentRepository(cache.NewCompartmentRepository(compartment.NewCompartmentAdapter(appCtx.IdentityClient, appCtx.CompartmentID), appCtx.Cache), appCtx.Subtree)
+	service := NewService(repo, appCtx.Logger, appCtx.CompartmentID)
+	service.indexStore = appCtx.Cache
+	return service, nil
+}
+
 // FetchPaginateCompartments fetches a page of compartments from the repository.
 func (s *Service) FetchPaginateCompartments(ctx context.Context, limit, pageNum int) ([]Compartment, int, string, error) {
 	s.logger.V(logger.Debug).Info("listing compartments", "limit", limit, "pageNum", pageNum)
@@ -46,6 +60,16 @@ func (s *Service) FetchPaginateCompartments(ctx context.Context, limit, pageNum
 
 // FuzzySearch performs a fuzzy search for compartments based on the provided searchPattern.
 func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]Compartment, error) {
+	matches, err := s.ScoredSearch(ctx, searchPattern)
+	if err != nil {
+		return nil, err
+	}
+	return search.Items(matches), nil
+}
+
+// ScoredSearch performs a fuzzy search for compartments and returns the matches,
+// best first, with their relevance scores.
+func (s *Service) ScoredSearch(ctx context.Context, searchPattern string) ([]search.Match[Compartment], error) {
 	s.logger.V(logger.Debug).Info("finding compartments with fuzzy search", "pattern", searchPattern)
 
 	allCompartments, err := s.compartmentRepo.ListCompartments(ctx, s.compartmentID)
@@ -56,25 +80,36 @@ func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]Comp
 	// Build the search index using the common search package and the compartment searcher adapter.
 	indexables := ToSearchableCompartments(allCompartments)
 	idxMapping := search.NewIndexMapping(GetSearchableFields())
-	idx, err := search.BuildIndex(indexables, idxMapping)
+	idx, err := search.OpenIndex(s.indexStore, s.compartmentID, cache.ResourceCompartments, indexables, idxMapping)
 	if err != nil {
 		return nil, fmt.Errorf("building search index: %w", err)
 	}
+	defer func() { _ = idx.Close() }()
 
 	logger.Logger.V(logger.Debug).Info("Search index built successfully.", "numEntries", len(allCompartments))
 
-	matchedIdxs, err := search.FuzzySearch(idx, searchPattern, GetSearchableFields(), GetBoostedFields())
+	matchedIdxs, err := search.ScoredFuzzySearch(idx, searchPattern, GetSearchableFields(), GetBoostedFields())
 	if err != nil {
 		return nil, fmt.Errorf("performing fuzzy search: %w", err)
 	}
 	logger.Logger.V(logger.Debug).Info("Fuzzy search completed.", "numMatches", len(matchedIdxs))
 
-	results := make([]Compartment, 0, len(matchedIdxs))
-	for _, i := range matchedIdxs {
-		if i >= 0 && i < len(allCompartments) {
-			results = append(results, allCompartments[i])
-		}
-	}
+	results := search.Rank(allCompartments, matchedIdxs)
 
 	return results, nil
 }
+
+// Reindex lists compartments and rebuilds their persisted search index.
+// It returns the number of indexed compartments.
+func (s *Service) Reindex(ctx context.Context) (int, error) {
+	all, err := s.compartmentRepo.ListCompartments(ctx, s.compartmentID)
+	if err != nil {
+		return 0, fmt.Errorf("fetching compartments: %w", err)
+	}
+
+	idx, err := search.OpenIndex(s.indexStore, s.compartmentID, cache.ResourceCompartments, ToSearchableCompartments(all), search.NewIndexMapping(GetSearchableFields()))
+	if err != nil {
+		return 0, fmt.Errorf("building search index: %w", err)
+	}
+	return len(all), idx.Close()
+}
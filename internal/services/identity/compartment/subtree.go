@@ -0,0 +1,24 @@
+package compartment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/oci/identity/compartment"
+	"github.com/cnopslabs/ocloud/internal/subtree"
+)
+
+// LoadSubtree walks the active compartments below rootID and returns them as a
+// tree whose paths start with rootName.
+func LoadSubtree(ctx context.Context, appCtx *app.ApplicationContext, rootID, rootName string) (*subtree.Tree, error) {
+	repo := cache.NewCompartmentRepository(compartment.NewCompartmentAdapter(appCtx.IdentityClient, rootID), appCtx.Cache)
+	nodes, err := subtree.Walk(ctx, repo, rootID, rootName, subtree.DefaultWorkers)
+	if err != nil {
+		return nil, fmt.Errorf("walking compartment tree: %w", err)
+	}
+	logger.Logger.V(logger.Debug).Info("Compartment subtree loaded.", "root", rootName, "numCompartments", len(nodes))
+	return subtree.New(nodes, subtree.DefaultWorkers), nil
+}
@@ -5,15 +5,18 @@ import (
 	"fmt"
 
 	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
 	"github.com/cnopslabs/ocloud/internal/oci/identity/policy"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/subtree"
 )
 
 // GetPolicies retrieves and displays the policies for a given application context, supporting pagination and JSON output format.
-func GetPolicies(appCtx *app.ApplicationContext, useJSON bool, limit, page int, ocid string) error {
+func GetPolicies(appCtx *app.ApplicationContext, format printer.OutputFormat, limit, page int, ocid string) error {
 	ctx := context.Background()
 	policyAdapter := policy.NewAdapter(appCtx.IdentityClient)
-	service := NewService(policyAdapter, appCtx.Logger, ocid)
+	service := NewService(subtree.NewPolicyRepository(cache.NewPolicyRepository(policyAdapter, appCtx.Cache), appCtx.Subtree), appCtx.Logger, ocid)
 	policies, totalCount, nextPageToken, err := service.FetchPaginatedPolies(ctx, limit, page)
 	if err != nil {
 		return fmt.Errorf("getting policies: %w", err)
@@ -23,5 +26,5 @@ func GetPolicies(appCtx *app.ApplicationContext, useJSON bool, limit, page int,
 		TotalCount:    totalCount,
 		Limit:         limit,
 		NextPageToken: nextPageToken,
-	}, useJSON)
+	}, format)
 }
@@ -6,16 +6,19 @@ import (
 	"fmt"
 
 	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
 	"github.com/cnopslabs/ocloud/internal/oci/identity/policy"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/subtree"
 	"github.com/cnopslabs/ocloud/internal/tui"
 )
 
 // ListPolicies lists all policies in the specified compartment and prints their details in the specified format.
 // It utilizes the application context for service initialization and handles output formatting via JSON or plain text.
-func ListPolicies(appCtx *app.ApplicationContext, useJSON bool, ocid string) error {
+func ListPolicies(appCtx *app.ApplicationContext, format printer.OutputFormat, ocid string) error {
 	ctx := context.Background()
 	policyAdapter := policy.NewAdapter(appCtx.IdentityClient)
-	service := NewService(policyAdapter, appCtx.Logger, ocid)
+	service := NewService(subtree.NewPolicyRepository(cache.NewPolicyRepository(policyAdapter, appCtx.Cache), appCtx.Subtree), appCtx.Logger, ocid)
 	policies, err := service.ListPolicies(ctx)
 
 	if err != nil {
@@ -23,7 +26,8 @@ func ListPolicies(appCtx *app.ApplicationContext, useJSON bool, ocid string) err
 	}
 
 	//TUI
-	model := policy.NewPoliciesListModel(policies)
+	model := policy.NewPoliciesListModel(policies).
+		WithQueryFilter(ToSearchablePolicies(policies), GetSearchableFields(), GetBoostedFields())
 	id, err := tui.Run(model)
 	if err != nil {
 		if errors.Is(err, tui.ErrCancelled) {
@@ -36,5 +40,5 @@ func ListPolicies(appCtx *app.ApplicationContext, useJSON bool, ocid string) err
 		return fmt.Errorf("getting policy: %w", err)
 	}
 
-	return PrintPolicyTable(p, appCtx, useJSON)
+	return PrintPolicyTable(p, appCtx, format)
 }
@@ -9,7 +9,7 @@ import (
 
 // PrintPolicyInfo prints the details of policies to the standard output or in JSON format.
 // If pagination info is provided, it adjusts and logs it.
-func PrintPolicyInfo(policies []identity.Policy, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, useJSON bool) error {
+func PrintPolicyInfo(policies []identity.Policy, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, format printer.OutputFormat) error {
 
 	p := printer.New(appCtx.Stdout)
 
@@ -18,8 +18,8 @@ func PrintPolicyInfo(policies []identity.Policy, appCtx *app.ApplicationContext,
 	}
 
 	// If JSON output is requested, use the printer to marshal the response.
-	if useJSON {
-		return util.MarshalDataToJSONResponse[identity.Policy](p, policies, pagination)
+	if !format.IsTable() {
+		return util.MarshalScopedResponse(p, format, appCtx, policies, policyID, pagination)
 	}
 
 	if util.ValidateAndReportEmpty(policies, pagination, appCtx.Stdout) {
@@ -40,7 +40,7 @@ func PrintPolicyInfo(policies []identity.Policy, appCtx *app.ApplicationContext,
 		}
 
 		// Create the colored title using components from the app context
-		title := util.FormatColoredTitle(appCtx, policy.Name)
+		title := util.FormatColoredResourceTitle(appCtx, policy.ID, policy.Name)
 
 		// Call the printer method to render the key-value from the app context.
 		p.PrintKeyValues(title, policyData, orderedKeys)
@@ -51,11 +51,11 @@ func PrintPolicyInfo(policies []identity.Policy, appCtx *app.ApplicationContext,
 }
 
 // PrintPolicyTable prints a detailed view of a policy.
-func PrintPolicyTable(policy *identity.Policy, appCtx *app.ApplicationContext, useJSON bool) error {
+func PrintPolicyTable(policy *identity.Policy, appCtx *app.ApplicationContext, format printer.OutputFormat) error {
 	p := printer.New(appCtx.Stdout)
 	// If JSON output is requested, use the printer to marshal the response.
-	if useJSON {
-		return p.MarshalToJSON(policy)
+	if !format.IsTable() {
+		return p.Marshal(format, policy)
 	}
 
 	policyData := map[string]string{
@@ -69,9 +69,12 @@ func PrintPolicyTable(policy *identity.Policy, appCtx *app.ApplicationContext, u
 		"Name", "ID", "Description", "TimeCreated",
 	}
 
-	title := util.FormatColoredTitle(appCtx, policy.Name)
+	title := util.FormatColoredResourceTitle(appCtx, policy.ID, policy.Name)
 
 	p.PrintKeyValues(title, policyData, orderedKeys)
 
 	return nil
 }
+
+// policyID keys policies for their compartment path.
+func policyID(p identity.Policy) string { return p.ID }
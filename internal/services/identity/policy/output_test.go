@@ -7,6 +7,7 @@ import (
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/domain/identity"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/cnopslabs/ocloud/internal/services/util"
 	"github.com/stretchr/testify/assert"
 )
@@ -57,7 +58,7 @@ func TestPrintPolicyInfo(t *testing.T) {
 	}
 
 	// Test with table output (useJSON = false)
-	err := PrintPolicyInfo(policies, appCtx, nil, false)
+	err := PrintPolicyInfo(policies, appCtx, nil, printer.TableOutput)
 	assert.NoError(t, err)
 
 	// Verify that the output contains the expected information
@@ -73,7 +74,7 @@ func TestPrintPolicyInfo(t *testing.T) {
 	buf.Reset()
 
 	// Test with JSON output (useJSON = true)
-	err = PrintPolicyInfo(policies, appCtx, nil, true)
+	err = PrintPolicyInfo(policies, appCtx, nil, printer.JSONOutput)
 	assert.NoError(t, err)
 
 	// Verify that the output is valid JSON and contains the expected information
@@ -103,7 +104,7 @@ func TestPrintPolicyInfoEmpty(t *testing.T) {
 	}
 
 	// Test with table output (useJSON = false)
-	err := PrintPolicyInfo(policies, appCtx, nil, false)
+	err := PrintPolicyInfo(policies, appCtx, nil, printer.TableOutput)
 	assert.NoError(t, err)
 
 	// Verify that the output indicates no items found
@@ -114,7 +115,7 @@ func TestPrintPolicyInfoEmpty(t *testing.T) {
 	buf.Reset()
 
 	// Test with JSON output (useJSON = true)
-	err = PrintPolicyInfo(policies, appCtx, nil, true)
+	err = PrintPolicyInfo(policies, appCtx, nil, printer.JSONOutput)
 	assert.NoError(t, err)
 
 	// Verify that the output is valid JSON and indicates no items
@@ -160,7 +161,7 @@ func TestPrintPolicyInfoWithPagination(t *testing.T) {
 	}
 
 	// Test with table output (useJSON = false)
-	err := PrintPolicyInfo(policies, appCtx, pagination, false)
+	err := PrintPolicyInfo(policies, appCtx, pagination, printer.TableOutput)
 	assert.NoError(t, err)
 
 	// Verify that the output contains the expected information
@@ -178,7 +179,7 @@ func TestPrintPolicyInfoWithPagination(t *testing.T) {
 	buf.Reset()
 
 	// Test with JSON output (useJSON = true)
-	err = PrintPolicyInfo(policies, appCtx, pagination, true)
+	err = PrintPolicyInfo(policies, appCtx, pagination, printer.JSONOutput)
 	assert.NoError(t, err)
 
 	// Verify that the output is valid JSON and contains the expected information
@@ -5,22 +5,26 @@ import (
 	"fmt"
 
 	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
 	"github.com/cnopslabs/ocloud/internal/logger"
 	"github.com/cnopslabs/ocloud/internal/oci/identity/policy"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/subtree"
 )
 
-func SearchPolicies(appCtx *app.ApplicationContext, search string, useJSON bool, ocid string) error {
+func SearchPolicies(appCtx *app.ApplicationContext, search string, format printer.OutputFormat, ocid string) error {
 	ctx := context.Background()
 	policyAdapter := policy.NewAdapter(appCtx.IdentityClient)
 
 	// Create the application service, injecting the adapter.
-	service := NewService(policyAdapter, appCtx.Logger, ocid)
+	service := NewService(subtree.NewPolicyRepository(cache.NewPolicyRepository(policyAdapter, appCtx.Cache), appCtx.Subtree), appCtx.Logger, ocid)
+	service.indexStore = appCtx.Cache
 
 	matchedPolicies, err := service.FuzzySearch(ctx, search)
 	if err != nil {
 		return fmt.Errorf("finding matched policies: %w", err)
 	}
-	err = PrintPolicyInfo(matchedPolicies, appCtx, nil, useJSON)
+	err = PrintPolicyInfo(matchedPolicies, appCtx, nil, format)
 	if err != nil {
 		return fmt.Errorf("printing matched policies: %w", err)
 	}
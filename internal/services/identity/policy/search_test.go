@@ -6,6 +6,7 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -28,7 +29,7 @@ func TestFindPoliciesSimple(t *testing.T) {
 		Stdout:          io.Discard, // Discard output to avoid cluttering the test output
 	}
 
-	err := SearchPolicies(appCtx, "test", false, appCtx.CompartmentID)
+	err := SearchPolicies(appCtx, "test", printer.TableOutput, appCtx.CompartmentID)
 
 	// but if we did, we would expect no error
 	assert.NoError(t, err)
@@ -53,7 +54,7 @@ func TestFindPoliciesOutput(t *testing.T) {
 		Stdout:          io.Discard, // In a real test, we would use a buffer to capture output
 	}
 
-	err := SearchPolicies(appCtxJSON, "test", true, appCtxJSON.CompartmentID)
+	err := SearchPolicies(appCtxJSON, "test", printer.JSONOutput, appCtxJSON.CompartmentID)
 	assert.NoError(t, err)
 
 	// Test with table output
@@ -64,7 +65,7 @@ func TestFindPoliciesOutput(t *testing.T) {
 		Stdout:          io.Discard, // In a real test, we would use a buffer to capture output
 	}
 
-	err = SearchPolicies(appCtxTable, "test", false, appCtxTable.CompartmentID)
+	err = SearchPolicies(appCtxTable, "test", printer.TableOutput, appCtxTable.CompartmentID)
 	assert.NoError(t, err)
 }
 
@@ -86,7 +87,7 @@ func TestFindPoliciesError(t *testing.T) {
 		Stdout:          io.Discard,
 	}
 
-	err := SearchPolicies(appCtx, "test", false, appCtx.CompartmentID)
+	err := SearchPolicies(appCtx, "test", printer.TableOutput, appCtx.CompartmentID)
 
 	// In a real test with a mock that returns an error, we would expect an error
 	// assert.Error(t, err)
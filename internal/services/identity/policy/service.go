@@ -4,10 +4,14 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
 	"github.com/cnopslabs/ocloud/internal/domain/identity"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/oci/identity/policy"
 	"github.com/cnopslabs/ocloud/internal/services/search"
 	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/subtree"
 	"github.com/go-logr/logr"
 )
 
@@ -15,6 +19,7 @@ type Service struct {
 	policyRepo    identity.PolicyRepository
 	logger        logr.Logger
 	CompartmentID string
+	indexStore    *cache.Store
 }
 
 // NewService initializes a new Service instance with the provided application context.
@@ -26,6 +31,15 @@ func NewService(repo identity.PolicyRepository, logger logr.Logger, ocid string)
 	}
 }
 
+// NewServiceFromAppContext creates a Service backed by the OCI adapter and the
+// resource cache of the application context.
+func NewServiceFromAppContext(appCtx *app.ApplicationContext) (*Service, error) {
+	repo := subtree.NewPolicyRepository(cache.NewPolicyRepository(policy.NewAdapter(appCtx.IdentityClient), appCtx.Cache), appCtx.Subtree)
+	service := NewService(repo, appCtx.Logger, appCtx.CompartmentID)
+	service.indexStore = appCtx.Cache
+	return service, nil
+}
+
 func (s *Service) FetchPaginatedPolies(ctx context.Context, limit, pageNum int) ([]Policy, int, string, error) {
 	s.logger.V(logger.Debug).Info("listing policies", "limit", limit, "pageNum", pageNum)
 
@@ -49,6 +63,16 @@ func (s *Service) ListPolicies(ctx context.Context) ([]identity.Policy, error) {
 
 // FuzzySearch performs a fuzzy search for policies based on the provided search pattern and returns matching policies.
 func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]identity.Policy, error) {
+	matches, err := s.ScoredSearch(ctx, searchPattern)
+	if err != nil {
+		return nil, err
+	}
+	return search.Items(matches), nil
+}
+
+// ScoredSearch performs a fuzzy search for policies and returns the matches,
+// best first, with their relevance scores.
+func (s *Service) ScoredSearch(ctx context.Context, searchPattern string) ([]search.Match[identity.Policy], error) {
 	s.logger.V(logger.Debug).Info("finding policies with fuzzy search", "pattern", searchPattern)
 
 	allPolicies, err := s.policyRepo.ListPolicies(ctx, s.CompartmentID)
@@ -59,25 +83,36 @@ func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]iden
 	// Build the search index using the common search package and the policy searcher adapter.
 	indexables := ToSearchablePolicies(allPolicies)
 	idxMapping := search.NewIndexMapping(GetSearchableFields())
-	idx, err := search.BuildIndex(indexables, idxMapping)
+	idx, err := search.OpenIndex(s.indexStore, s.CompartmentID, cache.ResourcePolicies, indexables, idxMapping)
 	if err != nil {
 		return nil, fmt.Errorf("building search index: %w", err)
 	}
+	defer func() { _ = idx.Close() }()
 
 	s.logger.V(logger.Debug).Info("search index built successfully", "numEntries", len(allPolicies))
 
-	matchedIdxs, err := search.FuzzySearch(idx, searchPattern, GetSearchableFields(), GetBoostedFields())
+	matchedIdxs, err := search.ScoredFuzzySearch(idx, searchPattern, GetSearchableFields(), GetBoostedFields())
 	if err != nil {
 		return nil, fmt.Errorf("performing fuzzy search: %w", err)
 	}
 	s.logger.V(logger.Debug).Info("fuzzy search completed", "numMatches", len(matchedIdxs))
 
-	results := make([]identity.Policy, 0, len(matchedIdxs))
-	for _, i := range matchedIdxs {
-		if i >= 0 && i < len(allPolicies) {
-			results = append(results, allPolicies[i])
-		}
-	}
+	results := search.Rank(allPolicies, matchedIdxs)
 
 	return results, nil
 }
+
+// Reindex lists policies and rebuilds their persisted search index.
+// It returns the number of indexed policies.
+func (s *Service) Reindex(ctx context.Context) (int, error) {
+	all, err := s.policyRepo.ListPolicies(ctx, s.CompartmentID)
+	if err != nil {
+		return 0, fmt.Errorf("fetching policies: %w", err)
+	}
+
+	idx, err := search.OpenIndex(s.indexStore, s.CompartmentID, cache.ResourcePolicies, ToSearchablePolicies(all), search.NewIndexMapping(GetSearchableFields()))
+	if err != nil {
+		return 0, fmt.Errorf("building search index: %w", err)
+	}
+	return len(all), idx.Close()
+}
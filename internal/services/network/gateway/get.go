@@ -6,9 +6,10 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/oci"
+	"github.com/cnopslabs/ocloud/internal/printer"
 )
 
-func GetGateway(appCtx *app.ApplicationContext, vcnName string, useJSON bool) error {
+func GetGateway(appCtx *app.ApplicationContext, vcnName string, format printer.OutputFormat) error {
 	ctx := context.Background()
 	networkClient, err := oci.NewNetworkClient(appCtx.Provider)
 	if err != nil {
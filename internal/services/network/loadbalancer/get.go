@@ -7,47 +7,36 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
-	oci "github.com/cnopslabs/ocloud/internal/oci"
-	ocilb "github.com/cnopslabs/ocloud/internal/oci/network/loadbalancer"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/watch"
 )
 
 // GetLoadBalancers retrieves load balancers and displays a paginated list.
-func GetLoadBalancers(appCtx *app.ApplicationContext, useJSON bool, limit, page int, showAll bool) error {
+func GetLoadBalancers(appCtx *app.ApplicationContext, format printer.OutputFormat, limit, page int, showAll bool) error {
 	start := time.Now()
-	logger.LogWithLevel(appCtx.Logger, logger.Debug, "lb.service.get.START", "limit", limit, "page", page, "json", useJSON, "all", showAll)
+	logger.LogWithLevel(appCtx.Logger, logger.Debug, "lb.service.get.START", "limit", limit, "page", page, "output", format.String(), "all", showAll)
 
-	lbClient, err := oci.NewLoadBalancerClient(appCtx.Provider)
+	service, err := NewServiceFromAppContext(appCtx)
 	if err != nil {
-		logger.LogWithLevel(appCtx.Logger, logger.Debug, "lb.service.get.error", "stage", "client_init_lb", "error", err.Error(), "duration_ms", time.Since(start).Milliseconds())
-		return fmt.Errorf("creating load balancer client: %w", err)
+		logger.LogWithLevel(appCtx.Logger, logger.Debug, "lb.service.get.error", "stage", "service_init", "error", err.Error(), "duration_ms", time.Since(start).Milliseconds())
+		return fmt.Errorf("creating load balancer service: %w", err)
 	}
-	nwClient, err := oci.NewNetworkClient(appCtx.Provider)
-	if err != nil {
-		logger.LogWithLevel(appCtx.Logger, logger.Debug, "lb.service.get.error", "stage", "client_init_network", "error", err.Error(), "duration_ms", time.Since(start).Milliseconds())
-		return fmt.Errorf("creating network client: %w", err)
-	}
-	certsClient, err := oci.NewCertificatesManagementClient(appCtx.Provider)
-	if err != nil {
-		logger.LogWithLevel(appCtx.Logger, logger.Debug, "lb.service.get.error", "stage", "client_init_certs", "error", err.Error(), "duration_ms", time.Since(start).Milliseconds())
-		return fmt.Errorf("creating certificates management client: %w", err)
-	}
-	adapter := ocilb.NewAdapter(lbClient, nwClient, certsClient)
 
-	service := NewService(adapter, appCtx)
-
-	ctx := context.Background()
-	lbs, totalCount, nextPageToken, err := service.FetchPaginatedLoadBalancers(ctx, limit, page, showAll)
-	if err != nil {
-		logger.LogWithLevel(appCtx.Logger, logger.Debug, "lb.service.get.error", "stage", "fetch", "error", err.Error(), "duration_ms", time.Since(start).Milliseconds())
-		return fmt.Errorf("listing load balancers: %w", err)
-	}
+	return util.Watch(context.Background(), appCtx, func(ctx context.Context) ([]watch.State, error) {
+		lbs, totalCount, nextPageToken, err := service.FetchPaginatedLoadBalancers(ctx, limit, page, showAll)
+		if err != nil {
+			logger.LogWithLevel(appCtx.Logger, logger.Debug, "lb.service.get.error", "stage", "fetch", "error", err.Error(), "duration_ms", time.Since(start).Milliseconds())
+			return nil, fmt.Errorf("listing load balancers: %w", err)
+		}
 
-	logger.LogWithLevel(appCtx.Logger, logger.Debug, "lb.service.get.FINISH", "count", len(lbs), "total_count", totalCount, "next_page", nextPageToken, "duration_ms", time.Since(start).Seconds())
-	return PrintLoadBalancersInfo(lbs, appCtx, &util.PaginationInfo{
-		CurrentPage:   page,
-		TotalCount:    totalCount,
-		Limit:         limit,
-		NextPageToken: nextPageToken,
-	}, useJSON, showAll)
+		logger.LogWithLevel(appCtx.Logger, logger.Debug, "lb.service.get.FINISH", "count", len(lbs), "total_count", totalCount, "next_page", nextPageToken, "duration_ms", time.Since(start).Seconds())
+		err = PrintLoadBalancersInfo(lbs, appCtx, &util.PaginationInfo{
+			CurrentPage:   page,
+			TotalCount:    totalCount,
+			Limit:         limit,
+			NextPageToken: nextPageToken,
+		}, format, showAll)
+		return util.WatchStates(lbs, watchState), err
+	})
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -118,7 +119,7 @@ func TestPrintLoadBalancersInfo_JSONAndTable(t *testing.T) {
 	}
 
 	// Table (default) minimal
-	err := PrintLoadBalancersInfo(lbs, appCtx, nil, false, false)
+	err := PrintLoadBalancersInfo(lbs, appCtx, nil, printer.TableOutput, false)
 	assert.NoError(t, err)
 	out := buf.String()
 	assert.Contains(t, out, "prod-lb")
@@ -126,7 +127,7 @@ func TestPrintLoadBalancersInfo_JSONAndTable(t *testing.T) {
 	buf.Reset()
 
 	// JSON
-	err = PrintLoadBalancersInfo(lbs, appCtx, nil, true, true)
+	err = PrintLoadBalancersInfo(lbs, appCtx, nil, printer.JSONOutput, true)
 	assert.NoError(t, err)
 	jsonOut := buf.String()
 	assert.NotEmpty(t, jsonOut)
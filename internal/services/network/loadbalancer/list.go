@@ -8,35 +8,24 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
-	"github.com/cnopslabs/ocloud/internal/oci"
 	ocilb "github.com/cnopslabs/ocloud/internal/oci/network/loadbalancer"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/services/util"
 	"github.com/cnopslabs/ocloud/internal/tui"
+	"github.com/cnopslabs/ocloud/internal/watch"
 )
 
-func ListLoadBalancers(appCtx *app.ApplicationContext, useJSON, showAll bool) error {
+func ListLoadBalancers(appCtx *app.ApplicationContext, format printer.OutputFormat, showAll bool) error {
 	ctx := context.Background()
 
 	start := time.Now()
-	logger.LogWithLevel(appCtx.Logger, logger.Debug, "lb.service.get.START", "limit", "page", "json", useJSON, "all")
+	logger.LogWithLevel(appCtx.Logger, logger.Debug, "lb.service.get.START", "limit", "page", "output", format.String(), "all")
 
-	lbClient, err := oci.NewLoadBalancerClient(appCtx.Provider)
+	service, err := NewServiceFromAppContext(appCtx)
 	if err != nil {
-		logger.LogWithLevel(appCtx.Logger, logger.Debug, "lb.service.get.error", "stage", "client_init_lb", "error", err.Error(), "duration_ms", time.Since(start).Milliseconds())
-		return fmt.Errorf("creating load balancer client: %w", err)
+		logger.LogWithLevel(appCtx.Logger, logger.Debug, "lb.service.get.error", "stage", "service_init", "error", err.Error(), "duration_ms", time.Since(start).Milliseconds())
+		return fmt.Errorf("creating load balancer service: %w", err)
 	}
-	nwClient, err := oci.NewNetworkClient(appCtx.Provider)
-	if err != nil {
-		logger.LogWithLevel(appCtx.Logger, logger.Debug, "lb.service.get.error", "stage", "client_init_network", "error", err.Error(), "duration_ms", time.Since(start).Milliseconds())
-		return fmt.Errorf("creating network client: %w", err)
-	}
-	certsClient, err := oci.NewCertificatesManagementClient(appCtx.Provider)
-	if err != nil {
-		logger.LogWithLevel(appCtx.Logger, logger.Debug, "lb.service.get.error", "stage", "client_init_certs", "error", err.Error(), "duration_ms", time.Since(start).Milliseconds())
-		return fmt.Errorf("creating certificates management client: %w", err)
-	}
-	adapter := ocilb.NewAdapter(lbClient, nwClient, certsClient)
-
-	service := NewService(adapter, appCtx)
 
 	allLoadBalancers, err := service.ListLoadBalancers(ctx)
 
@@ -46,7 +35,8 @@ func ListLoadBalancers(appCtx *app.ApplicationContext, useJSON, showAll bool) er
 	}
 
 	//TUI
-	model := ocilb.NewLoadBalancerListModel(allLoadBalancers)
+	model := ocilb.NewLoadBalancerListModel(allLoadBalancers).
+		WithQueryFilter(ToSearchableLoadBalancers(allLoadBalancers), GetSearchableFields(), GetBoostedFields())
 	id, err := tui.Run(model)
 	if err != nil {
 		if errors.Is(err, tui.ErrCancelled) {
@@ -55,10 +45,13 @@ func ListLoadBalancers(appCtx *app.ApplicationContext, useJSON, showAll bool) er
 		return fmt.Errorf("selecting database: %w", err)
 	}
 
-	lb, err := service.GetEnrichedLoadBalancer(ctx, id)
-	if err != nil {
-		return fmt.Errorf("getting load balancer: %w", err)
-	}
+	return util.Watch(ctx, appCtx, func(ctx context.Context) ([]watch.State, error) {
+		lb, err := service.GetEnrichedLoadBalancer(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("getting load balancer: %w", err)
+		}
 
-	return PrintLoadBalancerInfo(lb, appCtx, useJSON, showAll)
+		err = PrintLoadBalancerInfo(lb, appCtx, format, showAll)
+		return []watch.State{watchState(*lb)}, err
+	})
 }
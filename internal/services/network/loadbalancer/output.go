@@ -11,13 +11,13 @@ import (
 	"github.com/cnopslabs/ocloud/internal/services/util"
 )
 
-func PrintLoadBalancerInfo(lb *network.LoadBalancer, appCtx *app.ApplicationContext, useJSON bool, showAll bool) error {
+func PrintLoadBalancerInfo(lb *network.LoadBalancer, appCtx *app.ApplicationContext, format printer.OutputFormat, showAll bool) error {
 	p := printer.New(appCtx.Stdout)
-	if useJSON {
-		return p.MarshalToJSON(lb)
+	if !format.IsTable() {
+		return p.Marshal(format, lb)
 	}
 
-	title := util.FormatColoredTitle(appCtx, lb.Name)
+	title := util.FormatColoredResourceTitle(appCtx, lb.OCID, lb.Name)
 
 	if showAll {
 		printAll(p, title, lb)
@@ -217,15 +217,15 @@ func formatBackendHealth(health map[string]string) string {
 }
 
 // PrintLoadBalancersInfo displays a list of load balancers in a table or JSON with pagination support.
-func PrintLoadBalancersInfo(lbs []network.LoadBalancer, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, useJSON bool, showAll bool) error {
+func PrintLoadBalancersInfo(lbs []network.LoadBalancer, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, format printer.OutputFormat, showAll bool) error {
 	p := printer.New(appCtx.Stdout)
 
 	if pagination != nil {
 		util.AdjustPaginationInfo(pagination)
 	}
 
-	if useJSON {
-		return util.MarshalDataToJSONResponse(p, lbs, pagination)
+	if !format.IsTable() {
+		return util.MarshalScopedResponse(p, format, appCtx, lbs, func(lb network.LoadBalancer) string { return lb.OCID }, pagination)
 	}
 
 	if util.ValidateAndReportEmpty(lbs, pagination, appCtx.Stdout) {
@@ -234,7 +234,7 @@ func PrintLoadBalancersInfo(lbs []network.LoadBalancer, appCtx *app.ApplicationC
 
 	for i := range lbs {
 		lb := lbs[i]
-		if err := PrintLoadBalancerInfo(&lb, appCtx, false, showAll); err != nil {
+		if err := PrintLoadBalancerInfo(&lb, appCtx, printer.TableOutput, showAll); err != nil {
 			return err
 		}
 	}
@@ -7,50 +7,39 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
-	"github.com/cnopslabs/ocloud/internal/oci"
-	ocilb "github.com/cnopslabs/ocloud/internal/oci/network/loadbalancer"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/watch"
 )
 
 // SearchLoadBalancer searches for matching load balancers based on a fuzzy search string and displays their details.
 // appCtx provides context and clients for API calls.
 // search specifies the fuzzy search string to filter load balancers.
-// useJSON determines if the output should be in JSON format.
+// format selects the output format (table, JSON, YAML, CSV, TSV or template).
 // showAll includes all details about load balancers in the output if set to true.
 // Returns an error if there is a failure in the process.
-func SearchLoadBalancer(appCtx *app.ApplicationContext, search string, useJSON, showAll bool) error {
+func SearchLoadBalancer(appCtx *app.ApplicationContext, search string, format printer.OutputFormat, showAll bool) error {
 	ctx := context.Background()
 	start := time.Now()
 
-	lbClient, err := oci.NewLoadBalancerClient(appCtx.Provider)
+	service, err := NewServiceFromAppContext(appCtx)
 	if err != nil {
-		logger.LogWithLevel(appCtx.Logger, logger.Debug, "lb.service.get.error", "stage", "client_init_lb", "error", err.Error(), "duration_ms", time.Since(start).Milliseconds())
-		return fmt.Errorf("creating load balancer client: %w", err)
+		logger.LogWithLevel(appCtx.Logger, logger.Debug, "lb.service.get.error", "stage", "service_init", "error", err.Error(), "duration_ms", time.Since(start).Milliseconds())
+		return fmt.Errorf("creating load balancer service: %w", err)
 	}
-	nwClient, err := oci.NewNetworkClient(appCtx.Provider)
-	if err != nil {
-		logger.LogWithLevel(appCtx.Logger, logger.Debug, "lb.service.get.error", "stage", "client_init_network", "error", err.Error(), "duration_ms", time.Since(start).Milliseconds())
-		return fmt.Errorf("creating network client: %w", err)
-	}
-	certsClient, err := oci.NewCertificatesManagementClient(appCtx.Provider)
-	if err != nil {
-		logger.LogWithLevel(appCtx.Logger, logger.Debug, "lb.service.get.error", "stage", "client_init_certs", "error", err.Error(), "duration_ms", time.Since(start).Milliseconds())
-		return fmt.Errorf("creating certificates management client: %w", err)
-	}
-	adapter := ocilb.NewAdapter(lbClient, nwClient, certsClient)
-
-	service := NewService(adapter, appCtx)
-
-	matchedLoadBalancers, err := service.FuzzySearch(ctx, search)
 
-	if err != nil {
-		logger.LogWithLevel(appCtx.Logger, logger.Debug, "lb.service.get.error", "stage", "list", "error", err.Error(), "duration_ms", time.Since(start).Milliseconds())
-		return fmt.Errorf("listing load balancers: %w", err)
-	}
-
-	err = PrintLoadBalancersInfo(matchedLoadBalancers, appCtx, nil, useJSON, showAll)
-	if err != nil {
-		return fmt.Errorf("printing load balancers: %w", err)
-	}
-	logger.LogWithLevel(logger.CmdLogger, logger.Info, "Found matching load balancers", "search", search, "matched", len(matchedLoadBalancers))
-	return nil
+	return util.Watch(ctx, appCtx, func(ctx context.Context) ([]watch.State, error) {
+		matchedLoadBalancers, err := service.FuzzySearch(ctx, search)
+		if err != nil {
+			logger.LogWithLevel(appCtx.Logger, logger.Debug, "lb.service.get.error", "stage", "list", "error", err.Error(), "duration_ms", time.Since(start).Milliseconds())
+			return nil, fmt.Errorf("listing load balancers: %w", err)
+		}
+
+		err = PrintLoadBalancersInfo(matchedLoadBalancers, appCtx, nil, format, showAll)
+		if err != nil {
+			return nil, fmt.Errorf("printing load balancers: %w", err)
+		}
+		logger.LogWithLevel(logger.CmdLogger, logger.Info, "Found matching load balancers", "search", search, "matched", len(matchedLoadBalancers))
+		return util.WatchStates(matchedLoadBalancers, watchState), nil
+	})
 }
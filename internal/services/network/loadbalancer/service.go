@@ -5,9 +5,14 @@ import (
 	"fmt"
 
 	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
 	domain "github.com/cnopslabs/ocloud/internal/domain/network/loadbalancer"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/oci"
+	ocilb "github.com/cnopslabs/ocloud/internal/oci/network/loadbalancer"
+	"github.com/cnopslabs/ocloud/internal/region"
 	"github.com/cnopslabs/ocloud/internal/services/search"
+	"github.com/cnopslabs/ocloud/internal/subtree"
 	"github.com/go-logr/logr"
 )
 
@@ -16,6 +21,7 @@ type Service struct {
 	repo          domain.LoadBalancerRepository
 	logger        logr.Logger
 	compartmentID string
+	indexStore    *cache.Store
 }
 
 // NewService creates a new load balancer service.
@@ -24,9 +30,41 @@ func NewService(repo domain.LoadBalancerRepository, appCtx *app.ApplicationConte
 		repo:          repo,
 		logger:        appCtx.Logger,
 		compartmentID: appCtx.CompartmentID,
+		indexStore:    appCtx.Cache,
 	}
 }
 
+// NewServiceFromAppContext creates a Service backed by the OCI adapter and the
+// resource cache of the application context.
+func NewServiceFromAppContext(appCtx *app.ApplicationContext) (*Service, error) {
+	repo, err := newRepository(appCtx)
+	if err != nil {
+		return nil, err
+	}
+	return NewService(repo, appCtx), nil
+}
+
+// newRepository builds the load balancer repository of appCtx.
+func newRepository(appCtx *app.ApplicationContext) (domain.LoadBalancerRepository, error) {
+	return region.NewLoadBalancerRepository(appCtx.Regions, func(name string) (domain.LoadBalancerRepository, error) {
+		regional := appCtx.ForRegion(name)
+		lbClient, err := oci.NewLoadBalancerClient(regional.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("creating load balancer client: %w", err)
+		}
+		nwClient, err := oci.NewNetworkClient(regional.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("creating network client: %w", err)
+		}
+		certsClient, err := oci.NewCertificatesManagementClient(regional.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("creating certificates management client: %w", err)
+		}
+		adapter := ocilb.NewAdapter(lbClient, nwClient, certsClient)
+		return subtree.NewLoadBalancerRepository(cache.NewLoadBalancerRepository(adapter, regional.Cache), regional.Subtree), nil
+	})
+}
+
 // GetLoadBalancer retrieves a load balancer by its OCID.
 func (s *Service) GetLoadBalancer(ctx context.Context, ocid string) (*LoadBalancer, error) {
 	s.logger.V(logger.Debug).Info("getting load balancer", "ocid", ocid)
@@ -47,6 +85,17 @@ func (s *Service) ListLoadBalancers(ctx context.Context) ([]LoadBalancer, error)
 	return lbs, nil
 }
 
+// ListEnrichedLoadBalancers lists all load balancers in the configured compartment
+// with their listeners, backends and certificates.
+func (s *Service) ListEnrichedLoadBalancers(ctx context.Context) ([]LoadBalancer, error) {
+	s.logger.V(logger.Debug).Info("listing enriched load balancers", "compartmentID", s.compartmentID)
+	lbs, err := s.repo.ListEnrichedLoadBalancers(ctx, s.compartmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list enriched load balancers: %w", err)
+	}
+	return lbs, nil
+}
+
 // FetchPaginatedLoadBalancers returns a page of load balancers and pagination metadata.
 // If showAll is true, it uses the enriched model; otherwise, it uses the basic model for performance.
 func (s *Service) FetchPaginatedLoadBalancers(ctx context.Context, limit, pageNum int, showAll bool) ([]LoadBalancer, int, string, error) {
@@ -95,6 +144,16 @@ func (s *Service) GetEnrichedLoadBalancer(ctx context.Context, ocid string) (*Lo
 
 // FuzzySearch performs a fuzzy search for load balancers based on the provided search pattern.
 func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]LoadBalancer, error) {
+	matches, err := s.ScoredSearch(ctx, searchPattern)
+	if err != nil {
+		return nil, err
+	}
+	return search.Items(matches), nil
+}
+
+// ScoredSearch performs a fuzzy search for load balancers and returns the matches,
+// best first, with their relevance scores.
+func (s *Service) ScoredSearch(ctx context.Context, searchPattern string) ([]search.Match[LoadBalancer], error) {
 	all, err := s.repo.ListEnrichedLoadBalancers(ctx, s.compartmentID)
 	if err != nil {
 		return nil, fmt.Errorf("fetching all load balancers for search: %w", err)
@@ -103,21 +162,32 @@ func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]Load
 	// Build the search index using the common search package and the load balancer searcher adapter.
 	indexables := ToSearchableLoadBalancers(all)
 	idxMapping := search.NewIndexMapping(GetSearchableFields())
-	idx, err := search.BuildIndex(indexables, idxMapping)
+	idx, err := search.OpenIndex(s.indexStore, s.compartmentID, cache.ResourceEnrichedLoadBalancers, indexables, idxMapping)
 	if err != nil {
 		return nil, fmt.Errorf("building search index: %w", err)
 	}
+	defer func() { _ = idx.Close() }()
 
-	matchedIdxs, err := search.FuzzySearch(idx, searchPattern, GetSearchableFields(), GetBoostedFields())
+	matchedIdxs, err := search.ScoredFuzzySearch(idx, searchPattern, GetSearchableFields(), GetBoostedFields())
 	if err != nil {
 		return nil, fmt.Errorf("performing fuzzy search: %w", err)
 	}
 
-	results := make([]LoadBalancer, 0, len(matchedIdxs))
-	for _, i := range matchedIdxs {
-		if i >= 0 && i < len(all) {
-			results = append(results, all[i])
-		}
-	}
+	results := search.Rank(all, matchedIdxs)
 	return results, nil
 }
+
+// Reindex lists load balancers and rebuilds their persisted search index.
+// It returns the number of indexed load balancers.
+func (s *Service) Reindex(ctx context.Context) (int, error) {
+	all, err := s.repo.ListEnrichedLoadBalancers(ctx, s.compartmentID)
+	if err != nil {
+		return 0, fmt.Errorf("fetching load balancers: %w", err)
+	}
+
+	idx, err := search.OpenIndex(s.indexStore, s.compartmentID, cache.ResourceEnrichedLoadBalancers, ToSearchableLoadBalancers(all), search.NewIndexMapping(GetSearchableFields()))
+	if err != nil {
+		return 0, fmt.Errorf("building search index: %w", err)
+	}
+	return len(all), idx.Close()
+}
@@ -1,5 +1,13 @@
 package loadbalancer
 
-import domain "github.com/cnopslabs/ocloud/internal/domain/network/loadbalancer"
+import (
+	domain "github.com/cnopslabs/ocloud/internal/domain/network/loadbalancer"
+	"github.com/cnopslabs/ocloud/internal/watch"
+)
 
 type LoadBalancer = domain.LoadBalancer
+
+// watchState is the part of a load balancer that --watch compares between polls.
+func watchState(lb LoadBalancer) watch.State {
+	return watch.State{ID: lb.ID, Name: lb.Name, State: lb.State}
+}
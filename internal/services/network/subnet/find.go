@@ -5,24 +5,21 @@ import (
 	"fmt"
 
 	"github.com/cnopslabs/ocloud/internal/app"
-	"github.com/cnopslabs/ocloud/internal/oci"
-	ocisubnet "github.com/cnopslabs/ocloud/internal/oci/network/subnet"
+	"github.com/cnopslabs/ocloud/internal/printer"
 )
 
 // FindSubnets finds and displays subnets matching a name pattern.
-func FindSubnets(appCtx *app.ApplicationContext, namePattern string, useJSON bool) error {
-	networkClient, err := oci.NewNetworkClient(appCtx.Provider)
+func FindSubnets(appCtx *app.ApplicationContext, namePattern string, format printer.OutputFormat) error {
+	service, err := NewServiceFromAppContext(appCtx)
 	if err != nil {
-		return fmt.Errorf("creating network client: %w", err)
+		return fmt.Errorf("creating subnet service: %w", err)
 	}
-
-	subnetAdapter := ocisubnet.NewAdapter(networkClient)
-	service := NewService(subnetAdapter, appCtx.Logger, appCtx.CompartmentID)
+	service.indexStore = appCtx.Cache
 
 	matchedSubnets, err := service.Find(context.Background(), namePattern)
 	if err != nil {
 		return fmt.Errorf("finding subnets: %w", err)
 	}
 
-	return PrintSubnetInfo(matchedSubnets, appCtx, useJSON)
+	return PrintSubnetInfo(matchedSubnets, appCtx, format)
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -32,7 +33,7 @@ func TestFindSubnets(t *testing.T) {
 	}
 
 	// Call FindSubnets with a search pattern
-	err := FindSubnets(appCtx, "test", false)
+	err := FindSubnets(appCtx, "test", printer.TableOutput)
 
 	// but if we did, we would expect no error
 	assert.NoError(t, err)
@@ -64,7 +65,7 @@ func TestFindSubnetsWithJSON(t *testing.T) {
 	}
 
 	// Call FindSubnets with a search pattern and useJSON=true
-	err := FindSubnets(appCtx, "test", true)
+	err := FindSubnets(appCtx, "test", printer.JSONOutput)
 
 	// but if we did, we would expect no error
 	assert.NoError(t, err)
@@ -96,7 +97,7 @@ func TestFindSubnetsError(t *testing.T) {
 	}
 
 	// Call FindSubnets with a search pattern
-	err := FindSubnets(appCtx, "test", false)
+	err := FindSubnets(appCtx, "test", printer.TableOutput)
 
 	// but if we did, we would expect an error
 	assert.Error(t, err)
@@ -5,21 +5,17 @@ import (
 	"fmt"
 
 	"github.com/cnopslabs/ocloud/internal/app"
-	"github.com/cnopslabs/ocloud/internal/oci"
-	ocisubnet "github.com/cnopslabs/ocloud/internal/oci/network/subnet"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/cnopslabs/ocloud/internal/services/util"
 )
 
 // ListSubnets retrieves and displays a paginated list of subnets.
-func ListSubnets(appCtx *app.ApplicationContext, useJSON bool, limit, page int, sortBy string) error {
-	networkClient, err := oci.NewNetworkClient(appCtx.Provider)
+func ListSubnets(appCtx *app.ApplicationContext, format printer.OutputFormat, limit, page int, sortBy string) error {
+	service, err := NewServiceFromAppContext(appCtx)
 	if err != nil {
-		return fmt.Errorf("creating network client: %w", err)
+		return fmt.Errorf("creating subnet service: %w", err)
 	}
 
-	subnetAdapter := ocisubnet.NewAdapter(networkClient)
-	service := NewService(subnetAdapter, appCtx.Logger, appCtx.CompartmentID)
-
 	subnets, totalCount, nextPageToken, err := service.List(context.Background(), limit, page)
 	if err != nil {
 		return fmt.Errorf("listing subnets: %w", err)
@@ -30,5 +26,5 @@ func ListSubnets(appCtx *app.ApplicationContext, useJSON bool, limit, page int,
 		TotalCount:    totalCount,
 		Limit:         limit,
 		NextPageToken: nextPageToken,
-	}, useJSON, sortBy)
+	}, format, sortBy)
 }
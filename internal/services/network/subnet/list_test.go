@@ -6,6 +6,7 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -32,7 +33,7 @@ func TestListSubnets(t *testing.T) {
 	}
 
 	// Call ListSubnets with default parameters
-	err := ListSubnets(appCtx, false, 10, 1, "")
+	err := ListSubnets(appCtx, printer.TableOutput, 10, 1, "")
 
 	// but if we did, we would expect no error
 	assert.NoError(t, err)
@@ -64,7 +65,7 @@ func TestListSubnetsWithJSON(t *testing.T) {
 	}
 
 	// Call ListSubnets with default parameters and useJSON=true
-	err := ListSubnets(appCtx, true, 10, 1, "")
+	err := ListSubnets(appCtx, printer.JSONOutput, 10, 1, "")
 
 	// but if we did, we would expect no error
 	assert.NoError(t, err)
@@ -96,7 +97,7 @@ func TestListSubnetsWithPagination(t *testing.T) {
 	}
 
 	// Call ListSubnets with pagination parameters
-	err := ListSubnets(appCtx, false, 5, 2, "")
+	err := ListSubnets(appCtx, printer.TableOutput, 5, 2, "")
 
 	// but if we did, we would expect no error
 	assert.NoError(t, err)
@@ -129,7 +130,7 @@ func TestListSubnetsWithSorting(t *testing.T) {
 	}
 
 	// Call ListSubnets with sorting by name
-	err := ListSubnets(appCtx, false, 10, 1, "name")
+	err := ListSubnets(appCtx, printer.TableOutput, 10, 1, "name")
 
 	// but if we did, we would expect no error
 	assert.NoError(t, err)
@@ -161,7 +162,7 @@ func TestListSubnetsError(t *testing.T) {
 	}
 
 	// Call ListSubnets with default parameters
-	err := ListSubnets(appCtx, false, 10, 1, "")
+	err := ListSubnets(appCtx, printer.TableOutput, 10, 1, "")
 
 	// but if we did, we would expect an error
 	assert.Error(t, err)
@@ -11,15 +11,15 @@ import (
 )
 
 // PrintSubnetTable displays a table of subnets with details such as name, CIDR, and DNS info.
-func PrintSubnetTable(subnets []subnet.Subnet, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, useJSON bool, sortBy string) error {
+func PrintSubnetTable(subnets []subnet.Subnet, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, format printer.OutputFormat, sortBy string) error {
 	p := printer.New(appCtx.Stdout)
 
 	if pagination != nil {
 		util.AdjustPaginationInfo(pagination)
 	}
 
-	if useJSON {
-		return util.MarshalDataToJSONResponse[subnet.Subnet](p, subnets, pagination)
+	if !format.IsTable() {
+		return util.MarshalScopedResponse(p, format, appCtx, subnets, subnetID, pagination)
 	}
 
 	if util.ValidateAndReportEmpty(subnets, pagination, appCtx.Stdout) {
@@ -43,6 +43,12 @@ func PrintSubnetTable(subnets []subnet.Subnet, appCtx *app.ApplicationContext, p
 
 	// Define table headers
 	headers := []string{"Name", "CIDR", "Public"}
+	if appCtx.Subtree != nil {
+		headers = append(headers, "Compartment")
+	}
+	if appCtx.Regions != nil {
+		headers = append(headers, "Region")
+	}
 
 	// Create rows for the table
 	rows := make([][]string, len(subnets))
@@ -53,6 +59,12 @@ func PrintSubnetTable(subnets []subnet.Subnet, appCtx *app.ApplicationContext, p
 			s.CidrBlock,
 			util.FormatBool(s.Public),
 		}
+		if appCtx.Subtree != nil {
+			rows[i] = append(rows[i], util.CompartmentPath(appCtx, s.OCID))
+		}
+		if appCtx.Regions != nil {
+			rows[i] = append(rows[i], util.Region(appCtx, s.OCID))
+		}
 	}
 
 	// Print the table without truncation so fully qualified domains are visible
@@ -64,17 +76,17 @@ func PrintSubnetTable(subnets []subnet.Subnet, appCtx *app.ApplicationContext, p
 }
 
 // PrintSubnetInfo displays information about a list of subnets in either JSON format or a formatted table view.
-func PrintSubnetInfo(subnets []subnet.Subnet, appCtx *app.ApplicationContext, useJSON bool) error {
+func PrintSubnetInfo(subnets []subnet.Subnet, appCtx *app.ApplicationContext, format printer.OutputFormat) error {
 	// Create a new printer that writes to the application's standard output.
 	p := printer.New(appCtx.Stdout)
 
 	// If JSON output is requested, special-case empty for compact format expected by tests.
-	if useJSON {
+	if !format.IsTable() {
 		if len(subnets) == 0 {
 			_, err := appCtx.Stdout.Write([]byte("{\"items\": []}\n"))
 			return err
 		}
-		return util.MarshalDataToJSONResponse[subnet.Subnet](p, subnets, nil)
+		return util.MarshalScopedResponse(p, format, appCtx, subnets, subnetID, nil)
 	}
 
 	if util.ValidateAndReportEmpty(subnets, nil, appCtx.Stdout) {
@@ -100,7 +112,7 @@ func PrintSubnetInfo(subnets []subnet.Subnet, appCtx *app.ApplicationContext, us
 		}
 
 		// Create the colored title using components from the app context
-		title := util.FormatColoredTitle(appCtx, s.DisplayName)
+		title := util.FormatColoredResourceTitle(appCtx, s.OCID, s.DisplayName)
 
 		p.PrintKeyValues(title, subnetData, orderedKeys)
 	}
@@ -149,3 +161,6 @@ func deriveDNSLabel(name string) string {
 	}
 	return string(b)
 }
+
+// subnetID keys subnets for their compartment path.
+func subnetID(s subnet.Subnet) string { return s.OCID }
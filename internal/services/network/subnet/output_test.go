@@ -6,6 +6,7 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -37,7 +38,7 @@ func TestPrintSubnetTable(t *testing.T) {
 	}
 
 	// Test with table output (useJSON = false)
-	err := PrintSubnetTable(subnets, appCtx, nil, false, "")
+	err := PrintSubnetTable(subnets, appCtx, nil, printer.TableOutput, "")
 	assert.NoError(t, err)
 
 	// Verify that the output contains the expected information
@@ -51,7 +52,7 @@ func TestPrintSubnetTable(t *testing.T) {
 
 	// Test sorting by name
 	buf.Reset()
-	err = PrintSubnetTable(subnets, appCtx, nil, false, "name")
+	err = PrintSubnetTable(subnets, appCtx, nil, printer.TableOutput, "name")
 	assert.NoError(t, err)
 	output = buf.String()
 	assert.Contains(t, output, "TestSubnet1")
@@ -59,7 +60,7 @@ func TestPrintSubnetTable(t *testing.T) {
 
 	// Test sorting by CIDR
 	buf.Reset()
-	err = PrintSubnetTable(subnets, appCtx, nil, false, "cidr")
+	err = PrintSubnetTable(subnets, appCtx, nil, printer.TableOutput, "cidr")
 	assert.NoError(t, err)
 	output = buf.String()
 	assert.Contains(t, output, "10.0.0.0/24")
@@ -67,7 +68,7 @@ func TestPrintSubnetTable(t *testing.T) {
 
 	// Test with JSON output (useJSON = true)
 	buf.Reset()
-	err = PrintSubnetTable(subnets, appCtx, nil, true, "")
+	err = PrintSubnetTable(subnets, appCtx, nil, printer.JSONOutput, "")
 	assert.NoError(t, err)
 	jsonOutput := buf.String()
 	assert.Contains(t, jsonOutput, "TestSubnet1")
@@ -97,7 +98,7 @@ func TestPrintSubnetInfo(t *testing.T) {
 	}
 
 	// Test with table output (useJSON = false)
-	err := PrintSubnetInfo(subnets, appCtx, false)
+	err := PrintSubnetInfo(subnets, appCtx, printer.TableOutput)
 	assert.NoError(t, err)
 
 	// Verify that the output contains the expected information
@@ -110,7 +111,7 @@ func TestPrintSubnetInfo(t *testing.T) {
 
 	// Test with JSON output (useJSON = true)
 	buf.Reset()
-	err = PrintSubnetInfo(subnets, appCtx, true)
+	err = PrintSubnetInfo(subnets, appCtx, printer.JSONOutput)
 	assert.NoError(t, err)
 
 	// Verify that the output is valid JSON and contains the expected information
@@ -135,7 +136,7 @@ func TestPrintSubnetInfoEmpty(t *testing.T) {
 	}
 
 	// Test with table output (useJSON = false)
-	err := PrintSubnetInfo(subnets, appCtx, false)
+	err := PrintSubnetInfo(subnets, appCtx, printer.TableOutput)
 	assert.NoError(t, err)
 
 	// Verify that the output indicates no items found
@@ -146,7 +147,7 @@ func TestPrintSubnetInfoEmpty(t *testing.T) {
 	buf.Reset()
 
 	// Test with JSON output (useJSON = true)
-	err = PrintSubnetInfo(subnets, appCtx, true)
+	err = PrintSubnetInfo(subnets, appCtx, printer.JSONOutput)
 	assert.NoError(t, err)
 
 	// Verify that the output is valid JSON and indicates an empty items array
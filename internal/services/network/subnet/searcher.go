@@ -0,0 +1,40 @@
+package subnet
+
+import (
+	"strings"
+
+	"github.com/cnopslabs/ocloud/internal/services/search"
+)
+
+// SearchableSubnet adapts Subnet to the search.Indexable interface.
+type SearchableSubnet struct {
+	Subnet
+}
+
+// ToIndexable converts a Subnet to a map of searchable fields. Subnets are
+// matched by name and CIDR block only, as subnet find always has.
+func (s SearchableSubnet) ToIndexable() map[string]any {
+	return map[string]any{
+		"Name": strings.ToLower(s.DisplayName),
+		"CIDR": strings.ToLower(s.CidrBlock),
+	}
+}
+
+// GetSearchableFields returns the fields to index for subnets.
+func GetSearchableFields() []string {
+	return []string{"Name", "CIDR"}
+}
+
+// GetBoostedFields returns fields to boost during the search for better relevance.
+func GetBoostedFields() []string {
+	return []string{"Name", "CIDR"}
+}
+
+// ToSearchableSubnets converts a slice of Subnet to a slice of search.Indexable.
+func ToSearchableSubnets(items []Subnet) []search.Indexable {
+	out := make([]search.Indexable, len(items))
+	for i, it := range items {
+		out[i] = SearchableSubnet{it}
+	}
+	return out
+}
@@ -0,0 +1,23 @@
+package subnet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchableSubnet_ToIndexable(t *testing.T) {
+	doc := SearchableSubnet{Subnet{
+		OCID:           "ocid1.subnet.oc1..ABC",
+		DisplayName:    "Prod-App",
+		LifecycleState: "AVAILABLE",
+		CidrBlock:      "10.0.1.0/24",
+		Public:         true,
+	}}.ToIndexable()
+
+	// subnet find matches names and CIDR blocks only
+	assert.Equal(t, map[string]any{"Name": "prod-app", "CIDR": "10.0.1.0/24"}, doc)
+	assert.Equal(t, []string{"Name", "CIDR"}, GetSearchableFields())
+
+	assert.Len(t, ToSearchableSubnets([]Subnet{{}, {}}), 2)
+}
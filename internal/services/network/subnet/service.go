@@ -5,9 +5,15 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
 	"github.com/cnopslabs/ocloud/internal/domain/network/subnet"
 	"github.com/cnopslabs/ocloud/internal/logger"
-	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/oci"
+	ocisubnet "github.com/cnopslabs/ocloud/internal/oci/network/subnet"
+	"github.com/cnopslabs/ocloud/internal/region"
+	"github.com/cnopslabs/ocloud/internal/services/search"
+	"github.com/cnopslabs/ocloud/internal/subtree"
 	"github.com/go-logr/logr"
 )
 
@@ -16,6 +22,7 @@ type Service struct {
 	subnetRepo    subnet.SubnetRepository
 	logger        logr.Logger
 	compartmentID string
+	indexStore    *cache.Store
 }
 
 // NewService creates and initializes a new Service instance.
@@ -27,6 +34,30 @@ func NewService(repo subnet.SubnetRepository, logger logr.Logger, compartmentID
 	}
 }
 
+// NewServiceFromAppContext creates a subnet service backed by the OCI network
+// client, the resource cache and the persisted search index of appCtx.
+func NewServiceFromAppContext(appCtx *app.ApplicationContext) (*Service, error) {
+	repo, err := newRepository(appCtx)
+	if err != nil {
+		return nil, err
+	}
+	service := NewService(repo, appCtx.Logger, appCtx.CompartmentID)
+	service.indexStore = appCtx.Cache
+	return service, nil
+}
+
+// newRepository builds the subnet repository of appCtx.
+func newRepository(appCtx *app.ApplicationContext) (subnet.SubnetRepository, error) {
+	return region.NewSubnetRepository(appCtx.Regions, func(name string) (subnet.SubnetRepository, error) {
+		regional := appCtx.ForRegion(name)
+		networkClient, err := oci.NewNetworkClient(regional.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("creating network client: %w", err)
+		}
+		return subtree.NewSubnetRepository(cache.NewSubnetRepository(ocisubnet.NewAdapter(networkClient), regional.Cache), regional.Subtree), nil
+	})
+}
+
 // List retrieves a paginated list of subnets.
 func (s *Service) List(ctx context.Context, limit int, pageNum int) ([]subnet.Subnet, int, string, error) {
 	s.logger.V(logger.Debug).Info("listing subnets", "limit", limit, "pageNum", pageNum)
@@ -62,6 +93,16 @@ func (s *Service) List(ctx context.Context, limit int, pageNum int) ([]subnet.Su
 
 // Find retrieves a slice of subnets whose attributes match the provided name pattern using fuzzy search.
 func (s *Service) Find(ctx context.Context, namePattern string) ([]subnet.Subnet, error) {
+	matches, err := s.ScoredSearch(ctx, namePattern)
+	if err != nil {
+		return nil, err
+	}
+	return search.Items(matches), nil
+}
+
+// ScoredSearch performs a fuzzy search for subnets and returns the matches,
+// best first, with their relevance scores.
+func (s *Service) ScoredSearch(ctx context.Context, namePattern string) ([]search.Match[subnet.Subnet], error) {
 	s.logger.V(logger.Debug).Info("finding subnet with fuzzy search", "pattern", namePattern)
 
 	allSubnets, err := s.subnetRepo.ListSubnets(ctx, s.compartmentID)
@@ -69,38 +110,34 @@ func (s *Service) Find(ctx context.Context, namePattern string) ([]subnet.Subnet
 		return nil, fmt.Errorf("fetching all subnets for search: %w", err)
 	}
 
-	index, err := util.BuildIndex(allSubnets, func(s subnet.Subnet) any {
-		return mapToIndexableSubnets(s)
-	})
+	idx, err := search.OpenIndex(s.indexStore, s.compartmentID, cache.ResourceSubnets, ToSearchableSubnets(allSubnets), search.NewIndexMapping(GetSearchableFields()))
 	if err != nil {
 		return nil, fmt.Errorf("building search index: %w", err)
 	}
-	logger.Logger.V(logger.Debug).Info("Search index built successfully.", "numEntries", len(allSubnets))
+	defer func() { _ = idx.Close() }()
+	s.logger.V(logger.Debug).Info("search index built successfully", "numEntries", len(allSubnets))
 
-	fields := []string{"Name", "CIDR"}
-	matchedIdxs, err := util.FuzzySearchIndex(index, strings.ToLower(namePattern), fields)
+	hits, err := search.ScoredFuzzySearch(idx, strings.ToLower(namePattern), GetSearchableFields(), GetBoostedFields())
 	if err != nil {
 		return nil, fmt.Errorf("performing fuzzy search: %w", err)
 	}
-	logger.Logger.V(logger.Debug).Info("Fuzzy search completed.", "numMatches", len(matchedIdxs))
-	var matchedSubnets []subnet.Subnet
-	for _, idx := range matchedIdxs {
-		if idx >= 0 && idx < len(allSubnets) {
-			matchedSubnets = append(matchedSubnets, allSubnets[idx])
-		}
-	}
+	matched := search.Rank(allSubnets, hits)
 
-	s.logger.Info("found subnet", "count", len(matchedSubnets))
-	return matchedSubnets, nil
+	s.logger.Info("found subnet", "count", len(matched))
+	return matched, nil
 }
 
-// mapToIndexableSubnets converts a domain.Subnet to a struct suitable for indexing.
-func mapToIndexableSubnets(s subnet.Subnet) any {
-	return struct {
-		Name string
-		CIDR string
-	}{
-		Name: strings.ToLower(s.DisplayName),
-		CIDR: s.CidrBlock,
+// Reindex lists subnets and rebuilds their persisted search index.
+// It returns the number of indexed subnets.
+func (s *Service) Reindex(ctx context.Context) (int, error) {
+	all, err := s.subnetRepo.ListSubnets(ctx, s.compartmentID)
+	if err != nil {
+		return 0, fmt.Errorf("fetching subnets: %w", err)
+	}
+
+	idx, err := search.OpenIndex(s.indexStore, s.compartmentID, cache.ResourceSubnets, ToSearchableSubnets(all), search.NewIndexMapping(GetSearchableFields()))
+	if err != nil {
+		return 0, fmt.Errorf("building search index: %w", err)
 	}
+	return len(all), idx.Close()
 }
@@ -5,22 +5,18 @@ import (
 	"fmt"
 
 	"github.com/cnopslabs/ocloud/internal/app"
-	"github.com/cnopslabs/ocloud/internal/oci"
-	ocivcn "github.com/cnopslabs/ocloud/internal/oci/network/vcn"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/cnopslabs/ocloud/internal/services/util"
 )
 
 // GetVCNs retrieves a VCN by OCID and prints its summary or JSON.
-func GetVCNs(appCtx *app.ApplicationContext, limit, page int, useJSON, gateways, subnets, nsgs, routes, securityLists bool) error {
+func GetVCNs(appCtx *app.ApplicationContext, limit, page int, format printer.OutputFormat, gateways, subnets, nsgs, routes, securityLists bool) error {
 	ctx := context.Background()
-	networkClient, err := oci.NewNetworkClient(appCtx.Provider)
+	service, err := NewServiceFromAppContext(appCtx)
 	if err != nil {
-		return fmt.Errorf("creating network client: %w", err)
+		return fmt.Errorf("creating VCN service: %w", err)
 	}
 
-	adapter := ocivcn.NewAdapter(networkClient)
-	service := NewService(adapter, appCtx.Logger, appCtx.CompartmentID)
-
 	vcns, totalCount, nextPageToken, err := service.FetchPaginatedVCNs(ctx, limit, page)
 	if err != nil {
 		return fmt.Errorf("getting vcn: %w", err)
@@ -31,5 +27,5 @@ func GetVCNs(appCtx *app.ApplicationContext, limit, page int, useJSON, gateways,
 		TotalCount:    totalCount,
 		Limit:         limit,
 		NextPageToken: nextPageToken,
-	}, useJSON, gateways, subnets, nsgs, routes, securityLists)
+	}, format, gateways, subnets, nsgs, routes, securityLists)
 }
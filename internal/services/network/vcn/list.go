@@ -6,27 +6,25 @@ import (
 	"fmt"
 
 	"github.com/cnopslabs/ocloud/internal/app"
-	"github.com/cnopslabs/ocloud/internal/oci"
 	ocivcn "github.com/cnopslabs/ocloud/internal/oci/network/vcn"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/cnopslabs/ocloud/internal/tui"
 )
 
-func ListVCNs(appCtx *app.ApplicationContext, useJSON, gateways, subnets, nsgs, routes, securityLists bool) error {
+func ListVCNs(appCtx *app.ApplicationContext, format printer.OutputFormat, gateways, subnets, nsgs, routes, securityLists bool) error {
 	ctx := context.Background()
-	networkClient, err := oci.NewNetworkClient(appCtx.Provider)
+	service, err := NewServiceFromAppContext(appCtx)
 	if err != nil {
-		return fmt.Errorf("creating network client: %w", err)
+		return fmt.Errorf("creating VCN service: %w", err)
 	}
 
-	adapter := ocivcn.NewAdapter(networkClient)
-	service := NewService(adapter, appCtx.Logger, appCtx.CompartmentID)
-
 	vcns, err := service.ListVcns(ctx)
 	if err != nil {
 		return fmt.Errorf("getting vcn: %w", err)
 	}
 
-	model := ocivcn.NewVCNListModel(vcns)
+	model := ocivcn.NewVCNListModel(vcns).
+		WithQueryFilter(ToSearchableVCNs(vcns), GetSearchableFields(), GetBoostedFields())
 	id, err := tui.Run(model)
 	if err != nil {
 		if errors.Is(err, tui.ErrCancelled) {
@@ -40,5 +38,5 @@ func ListVCNs(appCtx *app.ApplicationContext, useJSON, gateways, subnets, nsgs,
 		return fmt.Errorf("getting vcn: %w", err)
 	}
 
-	return PrintVCNInfo(vcn, appCtx, useJSON, gateways, subnets, nsgs, routes, securityLists)
+	return PrintVCNInfo(vcn, appCtx, format, gateways, subnets, nsgs, routes, securityLists)
 }
@@ -10,19 +10,19 @@ import (
 )
 
 // PrintVCNsInfo prints the VCN summary view or JSON if requested.
-func PrintVCNsInfo(vcns []domain.VCN, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, useJSON, gateways, subnets, nsgs, routes, securityLists bool) error {
+func PrintVCNsInfo(vcns []domain.VCN, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, format printer.OutputFormat, gateways, subnets, nsgs, routes, securityLists bool) error {
 	p := printer.New(appCtx.Stdout)
 
 	if pagination != nil {
 		util.AdjustPaginationInfo(pagination)
 	}
 
-	if useJSON {
-		return util.MarshalDataToJSONResponse[domain.VCN](p, vcns, pagination)
+	if !format.IsTable() {
+		return util.MarshalScopedResponse(p, format, appCtx, vcns, func(v domain.VCN) string { return v.OCID }, pagination)
 	}
 
 	for _, v := range vcns {
-		title := util.FormatColoredTitle(appCtx, v.DisplayName)
+		title := util.FormatColoredResourceTitle(appCtx, v.OCID, v.DisplayName)
 		cidrs := strings.Join(v.CidrBlocks, ", ")
 		ipv6 := "Disabled"
 		if v.Ipv6Enabled {
@@ -74,14 +74,14 @@ func PrintVCNsInfo(vcns []domain.VCN, appCtx *app.ApplicationContext, pagination
 //---------------------------------------------------------------------------------------------------------------------
 
 // PrintVCNInfo prints the VCN summary view or JSON if requested.
-func PrintVCNInfo(v domain.VCN, appCtx *app.ApplicationContext, useJSON, gateways, subnets, nsgs, routes, securityLists bool) error {
+func PrintVCNInfo(v domain.VCN, appCtx *app.ApplicationContext, format printer.OutputFormat, gateways, subnets, nsgs, routes, securityLists bool) error {
 	p := printer.New(appCtx.Stdout)
 
-	if useJSON {
-		return p.MarshalToJSON(v)
+	if !format.IsTable() {
+		return p.Marshal(format, v)
 	}
 
-	title := util.FormatColoredTitle(appCtx, v.DisplayName)
+	title := util.FormatColoredResourceTitle(appCtx, v.OCID, v.DisplayName)
 	cidrs := strings.Join(v.CidrBlocks, ", ")
 	ipv6 := "Disabled"
 	if v.Ipv6Enabled {
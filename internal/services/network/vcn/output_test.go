@@ -6,6 +6,7 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -16,7 +17,7 @@ func TestPrintVCNInfo_JSONAndTable(t *testing.T) {
 	v := makeVCN(0)
 
 	// Table output
-	err := PrintVCNInfo(v, appCtx, false, false, false, false, false, false)
+	err := PrintVCNInfo(v, appCtx, printer.TableOutput, false, false, false, false, false)
 	assert.NoError(t, err)
 	out := buf.String()
 	assert.Contains(t, out, v.DisplayName)
@@ -24,7 +25,7 @@ func TestPrintVCNInfo_JSONAndTable(t *testing.T) {
 	buf.Reset()
 
 	// JSON output
-	err = PrintVCNInfo(v, appCtx, true, true, true, true, true, true)
+	err = PrintVCNInfo(v, appCtx, printer.JSONOutput, true, true, true, true, true)
 	assert.NoError(t, err)
 	jsonOut := buf.String()
 	if assert.NotEmpty(t, jsonOut) {
@@ -42,7 +43,7 @@ func TestPrintVCNsInfo_List_JSONAndTable(t *testing.T) {
 	vcns := []VCN{makeVCN(0), makeVCN(1)}
 
 	// Table
-	err := PrintVCNsInfo(vcns, appCtx, nil, false, false, false, false, false, false)
+	err := PrintVCNsInfo(vcns, appCtx, nil, printer.TableOutput, false, false, false, false, false)
 	assert.NoError(t, err)
 	out := buf.String()
 	assert.Contains(t, out, vcns[0].DisplayName)
@@ -50,7 +51,7 @@ func TestPrintVCNsInfo_List_JSONAndTable(t *testing.T) {
 	buf.Reset()
 
 	// JSON
-	err = PrintVCNsInfo(vcns, appCtx, nil, true, true, true, true, true, true)
+	err = PrintVCNsInfo(vcns, appCtx, nil, printer.JSONOutput, true, true, true, true, true)
 	assert.NoError(t, err)
 	jsonOut := buf.String()
 	if assert.NotEmpty(t, jsonOut) {
@@ -6,25 +6,22 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
-	"github.com/cnopslabs/ocloud/internal/oci"
-	ocivcn "github.com/cnopslabs/ocloud/internal/oci/network/vcn"
+	"github.com/cnopslabs/ocloud/internal/printer"
 )
 
-func SearchVCNs(appCtx *app.ApplicationContext, search string, useJSON, gateways, subnets, nsgs, routes, securityLists bool) error {
+func SearchVCNs(appCtx *app.ApplicationContext, search string, format printer.OutputFormat, gateways, subnets, nsgs, routes, securityLists bool) error {
 	ctx := context.Background()
-	networkClient, err := oci.NewNetworkClient(appCtx.Provider)
+	service, err := NewServiceFromAppContext(appCtx)
 	if err != nil {
-		return fmt.Errorf("creating network client: %w", err)
+		return fmt.Errorf("creating VCN service: %w", err)
 	}
-
-	adapter := ocivcn.NewAdapter(networkClient)
-	service := NewService(adapter, appCtx.Logger, appCtx.CompartmentID)
+	service.indexStore = appCtx.Cache
 
 	vcns, err := service.FuzzySearch(ctx, search)
 	if err != nil {
 		return fmt.Errorf("finding vcn: %w", err)
 	}
-	err = PrintVCNsInfo(vcns, appCtx, nil, useJSON, gateways, subnets, nsgs, routes, securityLists)
+	err = PrintVCNsInfo(vcns, appCtx, nil, format, gateways, subnets, nsgs, routes, securityLists)
 	if err != nil {
 		return fmt.Errorf("printing vcn: %w", err)
 	}
@@ -4,10 +4,16 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
 	domain "github.com/cnopslabs/ocloud/internal/domain/network/vcn"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/oci"
+	ocivcn "github.com/cnopslabs/ocloud/internal/oci/network/vcn"
+	"github.com/cnopslabs/ocloud/internal/region"
 	"github.com/cnopslabs/ocloud/internal/services/search"
 	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/subtree"
 	"github.com/go-logr/logr"
 )
 
@@ -16,6 +22,7 @@ type Service struct {
 	vcnRepo       domain.VCNRepository
 	logger        logr.Logger
 	compartmentID string
+	indexStore    *cache.Store
 }
 
 // NewService initializes a new Service instance.
@@ -27,6 +34,30 @@ func NewService(repo domain.VCNRepository, logger logr.Logger, compartmentID str
 	}
 }
 
+// NewServiceFromAppContext creates a Service backed by the OCI adapter and the
+// resource cache of the application context.
+func NewServiceFromAppContext(appCtx *app.ApplicationContext) (*Service, error) {
+	repo, err := newRepository(appCtx)
+	if err != nil {
+		return nil, err
+	}
+	service := NewService(repo, appCtx.Logger, appCtx.CompartmentID)
+	service.indexStore = appCtx.Cache
+	return service, nil
+}
+
+// newRepository builds the VCN repository of appCtx.
+func newRepository(appCtx *app.ApplicationContext) (domain.VCNRepository, error) {
+	return region.NewVCNRepository(appCtx.Regions, func(name string) (domain.VCNRepository, error) {
+		regional := appCtx.ForRegion(name)
+		networkClient, err := oci.NewNetworkClient(regional.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("creating network client: %w", err)
+		}
+		return subtree.NewVCNRepository(cache.NewVCNRepository(ocivcn.NewAdapter(networkClient), regional.Cache), regional.Subtree), nil
+	})
+}
+
 // FetchPaginatedVCNs retrieves a paginated list of vcns.
 func (s *Service) FetchPaginatedVCNs(ctx context.Context, limit, pageNum int) ([]VCN, int, string, error) {
 	s.logger.V(logger.Debug).Info("listing vcns", "limit", limit, "pageNum", pageNum)
@@ -52,6 +83,16 @@ func (s *Service) ListVcns(ctx context.Context) ([]VCN, error) {
 
 // FuzzySearch performs a fuzzy search for vcns.
 func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]VCN, error) {
+	matches, err := s.ScoredSearch(ctx, searchPattern)
+	if err != nil {
+		return nil, err
+	}
+	return search.Items(matches), nil
+}
+
+// ScoredSearch performs a fuzzy search for VCNs and returns the matches,
+// best first, with their relevance scores.
+func (s *Service) ScoredSearch(ctx context.Context, searchPattern string) ([]search.Match[VCN], error) {
 	all, err := s.vcnRepo.ListEnrichedVcns(ctx, s.compartmentID)
 	if err != nil {
 		return nil, fmt.Errorf("fetching all VCNs for search: %w", err)
@@ -60,21 +101,32 @@ func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]VCN,
 	// Build the search index using the common search package and the VCN searcher adapter.
 	indexables := ToSearchableVCNs(all)
 	idxMapping := search.NewIndexMapping(GetSearchableFields())
-	idx, err := search.BuildIndex(indexables, idxMapping)
+	idx, err := search.OpenIndex(s.indexStore, s.compartmentID, cache.ResourceEnrichedVCNs, indexables, idxMapping)
 	if err != nil {
 		return nil, fmt.Errorf("building search index: %w", err)
 	}
+	defer func() { _ = idx.Close() }()
 
-	matchedIdxs, err := search.FuzzySearch(idx, searchPattern, GetSearchableFields(), GetBoostedFields())
+	matchedIdxs, err := search.ScoredFuzzySearch(idx, searchPattern, GetSearchableFields(), GetBoostedFields())
 	if err != nil {
 		return nil, fmt.Errorf("performing fuzzy search: %w", err)
 	}
 
-	results := make([]VCN, 0, len(matchedIdxs))
-	for _, i := range matchedIdxs {
-		if i >= 0 && i < len(all) {
-			results = append(results, all[i])
-		}
-	}
+	results := search.Rank(all, matchedIdxs)
 	return results, nil
 }
+
+// Reindex lists VCNs and rebuilds their persisted search index.
+// It returns the number of indexed VCNs.
+func (s *Service) Reindex(ctx context.Context) (int, error) {
+	all, err := s.vcnRepo.ListEnrichedVcns(ctx, s.compartmentID)
+	if err != nil {
+		return 0, fmt.Errorf("fetching VCNs: %w", err)
+	}
+
+	idx, err := search.OpenIndex(s.indexStore, s.compartmentID, cache.ResourceEnrichedVCNs, ToSearchableVCNs(all), search.NewIndexMapping(GetSearchableFields()))
+	if err != nil {
+		return 0, fmt.Errorf("building search index: %w", err)
+	}
+	return len(all), idx.Close()
+}
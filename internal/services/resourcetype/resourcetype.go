@@ -0,0 +1,70 @@
+// Package resourcetype names the resource types that commands spanning several
+// of them, such as search and snapshot, work on, and selects among them. Each
+// type is named after its command.
+package resourcetype
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Names of the resource types.
+const (
+	Instance     = "instance"
+	Image        = "image"
+	OKE          = "oke"
+	Autonomous   = "autonomous"
+	HeatWave     = "heatwave"
+	CacheCluster = "cache-cluster"
+	VCN          = "vcn"
+	Subnet       = "subnet"
+	LoadBalancer = "load-balancer"
+	Policy       = "policy"
+	Compartment  = "compartment"
+	Bucket       = "bucket"
+)
+
+// Named is implemented by the resource type descriptions of a command.
+type Named interface {
+	TypeName() string
+}
+
+// Names returns the names of types in order.
+func Names[T Named](types []T) []string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = t.TypeName()
+	}
+	return names
+}
+
+// Select returns the types matching names (case-insensitively, once each, in
+// the order given), or all types when names is empty.
+func Select[T Named](types []T, names []string) ([]T, error) {
+	if len(names) == 0 {
+		return types, nil
+	}
+
+	byName := make(map[string]T, len(types))
+	for _, t := range types {
+		byName[t.TypeName()] = t
+	}
+
+	var selected []T
+	seen := map[string]bool{}
+	for _, n := range names {
+		n = strings.ToLower(strings.TrimSpace(n))
+		t, ok := byName[n]
+		if !ok {
+			valid := Names(types)
+			sort.Strings(valid)
+			return nil, fmt.Errorf("unknown resource type %q (valid: %s)", n, strings.Join(valid, ", "))
+		}
+		if !seen[n] {
+			seen[n] = true
+			selected = append(selected, t)
+		}
+	}
+	return selected, nil
+}
@@ -0,0 +1,28 @@
+package resourcetype
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type named string
+
+func (n named) TypeName() string { return string(n) }
+
+func TestSelect(t *testing.T) {
+	types := []named{Instance, VCN, Bucket}
+	assert.Equal(t, []string{"instance", "vcn", "bucket"}, Names(types))
+
+	all, err := Select(types, nil)
+	require.NoError(t, err)
+	assert.Equal(t, types, all)
+
+	selected, err := Select(types, []string{" VCN", "instance", "vcn"})
+	require.NoError(t, err)
+	assert.Equal(t, []named{VCN, Instance}, selected)
+
+	_, err = Select(types, []string{"nope"})
+	assert.EqualError(t, err, `unknown resource type "nope" (valid: bucket, instance, vcn)`)
+}
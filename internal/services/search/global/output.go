@@ -0,0 +1,71 @@
+package global
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// PrintReindexResults displays the outcome of a reindex in a table or the requested structured format.
+func PrintReindexResults(out io.Writer, results []ReindexResult, format printer.OutputFormat) error {
+	p := printer.New(out)
+	if !format.IsTable() {
+		return util.MarshalDataResponse(p, format, results, nil)
+	}
+
+	headers := []string{"RESOURCE", "ITEMS", "DURATION", "STATUS"}
+	rows := make([][]string, 0, len(results))
+	for _, r := range results {
+		status := "ok"
+		if r.Error != "" {
+			status = r.Error
+		}
+		rows = append(rows, []string{r.Type, strconv.Itoa(r.Items), r.Duration.String(), status})
+	}
+
+	p.PrintTableNoTruncate(text.Colors{text.FgMagenta}.Sprint("Search Index Rebuild"), headers, rows)
+	return nil
+}
+
+// PrintSearchResults displays the hits of a global search in a table or the requested structured format.
+func PrintSearchResults(out io.Writer, results []Result, format printer.OutputFormat) error {
+	p := printer.New(out)
+	if !format.IsTable() {
+		return util.MarshalDataResponse(p, format, results, nil)
+	}
+
+	if util.ValidateAndReportEmpty(results, nil, out) {
+		return nil
+	}
+
+	recursive, regional := false, false
+	for _, r := range results {
+		recursive = recursive || r.Compartment != ""
+		regional = regional || r.Region != ""
+	}
+
+	headers := []string{"TYPE", "NAME", "STATE", "OCID", "MATCH", "SCORE"}
+	if recursive {
+		headers = append(headers, "COMPARTMENT")
+	}
+	if regional {
+		headers = append(headers, "REGION")
+	}
+	rows := make([][]string, 0, len(results))
+	for _, r := range results {
+		row := []string{r.Type, r.Name, r.State, r.OCID, r.Match, strconv.FormatFloat(r.Score, 'f', 3, 64)}
+		if recursive {
+			row = append(row, r.Compartment)
+		}
+		if regional {
+			row = append(row, r.Region)
+		}
+		rows = append(rows, row)
+	}
+
+	p.PrintTableNoTruncate(text.Colors{text.FgMagenta}.Sprint("Search Results"), headers, rows)
+	return nil
+}
@@ -0,0 +1,74 @@
+package global
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
+)
+
+// ReindexResult reports the outcome of rebuilding one resource type's index.
+type ReindexResult struct {
+	Type     string        `json:"Type"`
+	Items    int           `json:"Items"`
+	Duration time.Duration `json:"Duration"`
+	Error    string        `json:"Error,omitempty"`
+}
+
+// Reindex refreshes the cached listings of the given resource types (all when
+// empty) in the current compartment and rebuilds their persisted search indexes.
+// Resource types are processed concurrently; a failure of one type does not stop
+// the others, but an error is returned when none could be reindexed.
+func Reindex(appCtx *app.ApplicationContext, types []string, format printer.OutputFormat) error {
+	if appCtx.Cache == nil {
+		return errors.New("the resource cache is disabled (cache TTL is 0), there is no search index to rebuild")
+	}
+
+	selected, err := selectTypes(types)
+	if err != nil {
+		return err
+	}
+
+	// Bypass cached listings and force the indexes to be rebuilt from scratch.
+	refreshCtx := *appCtx
+	refreshCtx.Cache = appCtx.Cache.WithRefresh()
+
+	ctx := context.Background()
+	results := make([]ReindexResult, len(selected))
+	var wg sync.WaitGroup
+	for i, t := range selected {
+		wg.Add(1)
+		go func(i int, t resourceType) {
+			defer wg.Done()
+			start := time.Now()
+			n, err := t.reindex(ctx, &refreshCtx)
+			results[i] = ReindexResult{Type: t.Name, Items: n, Duration: time.Since(start).Round(time.Millisecond)}
+			if err != nil {
+				results[i].Error = err.Error()
+				logger.LogWithLevel(appCtx.Logger, logger.Debug, "reindex failed", "type", t.Name, "error", err)
+			}
+		}(i, t)
+	}
+	wg.Wait()
+
+	if err := PrintReindexResults(appCtx.Stdout, results, format); err != nil {
+		return fmt.Errorf("printing reindex results: %w", err)
+	}
+
+	failed := 0
+	for _, r := range results {
+		if r.Error != "" {
+			failed++
+		}
+	}
+	if failed == len(results) {
+		return fmt.Errorf("reindexing failed for all %d resource types", failed)
+	}
+	logger.LogWithLevel(appCtx.Logger, logger.Info, "Search indexes rebuilt", "types", len(results)-failed, "failed", failed)
+	return nil
+}
@@ -0,0 +1,146 @@
+package global
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/region"
+	"github.com/cnopslabs/ocloud/internal/services/search"
+	"github.com/cnopslabs/ocloud/internal/subtree"
+)
+
+// Result is one resource matched by a global search.
+type Result struct {
+	Type  string `json:"Type"`
+	Name  string `json:"Name"`
+	State string `json:"State,omitempty"`
+	OCID  string `json:"OCID"`
+	// Match is how the hit matched the pattern: exact, term, substring or fuzzy.
+	Match string `json:"Match"`
+	// Score is the relevance of the hit relative to the best hit of its Type, in (0, 1].
+	Score float64 `json:"Score"`
+	// Compartment is the path of the compartment the hit was found in under --recursive.
+	Compartment string `json:"Compartment,omitempty"`
+	// Region is the region the hit was found in under --regions.
+	Region string `json:"Region,omitempty"`
+
+	tier search.Tier
+}
+
+// Search runs pattern against every searchable resource type of the current
+// compartment and prints the hits as one list ranked by how closely they match.
+// Resource types are searched concurrently; a resource type that cannot be
+// searched (for example for lack of permissions) is skipped, and an error is
+// returned only when every resource type failed.
+func Search(ctx context.Context, appCtx *app.ApplicationContext, pattern string, format printer.OutputFormat) error {
+	pattern = strings.TrimSpace(pattern)
+	if pattern == "" {
+		return errors.New("a search pattern is required")
+	}
+
+	types := resourceTypes()
+	perType := make([][]Result, len(types))
+	errs := make([]error, len(types))
+	var wg sync.WaitGroup
+	for i, t := range types {
+		wg.Add(1)
+		go func(i int, t resourceType) {
+			defer wg.Done()
+			perType[i], errs[i] = t.search(ctx, appCtx, pattern)
+			if errs[i] != nil {
+				logger.LogWithLevel(appCtx.Logger, logger.Debug, "search failed", "type", t.Name, "error", errs[i])
+			}
+		}(i, t)
+	}
+	wg.Wait()
+
+	failed := 0
+	for i, err := range errs {
+		if err != nil {
+			failed++
+			logger.LogWithLevel(logger.CmdLogger, logger.Info, "Skipping resource type", "type", types[i].Name, "error", err)
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if failed == len(types) {
+		return fmt.Errorf("searching failed for all %d resource types: %w", failed, errors.Join(errs...))
+	}
+
+	results := mergeResults(types, perType)
+	annotateCompartments(appCtx.Subtree, results)
+	annotateRegions(appCtx.Regions, results)
+	if err := PrintSearchResults(appCtx.Stdout, results, format); err != nil {
+		return fmt.Errorf("printing search results: %w", err)
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Info, "Search complete", "pattern", pattern, "matches", len(results), "failedTypes", failed)
+	return nil
+}
+
+// mergeResults tags the hits of each resource type with its name and merges them
+// into one list, closest match tier first. Each resource type is searched in its
+// own index, whose raw scores are not comparable with the others, so within a
+// tier hits are ranked by their score divided by the best score of their type.
+// Ties keep the resource type order and the order each service ranked its own
+// hits in.
+func mergeResults(types []resourceType, perType [][]Result) []Result {
+	var merged []Result
+	for i, results := range perType {
+		best := 0.0
+		for _, r := range results {
+			best = max(best, r.Score)
+		}
+		for _, r := range results {
+			r.Type = types[i].Name
+			if best > 0 {
+				r.Score /= best
+			}
+			merged = append(merged, r)
+		}
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		if merged[i].tier != merged[j].tier {
+			return merged[i].tier < merged[j].tier
+		}
+		return merged[i].Score > merged[j].Score
+	})
+	return merged
+}
+
+// annotateCompartments sets the compartment path of every hit listed through
+// tree. Buckets are recorded by name, so the name is tried when the OCID is not.
+func annotateCompartments(tree *subtree.Tree, results []Result) {
+	if tree == nil {
+		return
+	}
+	for i, r := range results {
+		path := tree.PathOf(r.OCID)
+		if path == "" {
+			path = tree.PathOf(r.Name)
+		}
+		results[i].Compartment = path
+	}
+}
+
+// annotateRegions sets the region of every hit listed through set, trying the
+// name like annotateCompartments does.
+func annotateRegions(set *region.Set, results []Result) {
+	if set == nil {
+		return
+	}
+	for i, r := range results {
+		name := set.RegionOf(r.OCID)
+		if name == "" {
+			name = set.RegionOf(r.Name)
+		}
+		results[i].Region = name
+	}
+}
@@ -0,0 +1,88 @@
+package global
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/services/search"
+)
+
+func TestMergeResults_RanksAcrossTypes(t *testing.T) {
+	types := []resourceType{{Name: "instance"}, {Name: "vcn"}}
+	perType := [][]Result{
+		{{Name: "prod-api", Score: 1.5}, {Name: "prod-db", Score: 0.75}},
+		{{Name: "prod-vcn", Score: 8}, {Name: "prod-net", Score: 2}},
+	}
+
+	merged := mergeResults(types, perType)
+
+	require.Len(t, merged, 4)
+	// Scores are relative to the best hit of each type.
+	assert.Equal(t, Result{Type: "instance", Name: "prod-api", Score: 1}, merged[0])
+	assert.Equal(t, Result{Type: "vcn", Name: "prod-vcn", Score: 1}, merged[1], "equal scores keep the resource type order")
+	assert.Equal(t, Result{Type: "instance", Name: "prod-db", Score: 0.5}, merged[2])
+	assert.Equal(t, Result{Type: "vcn", Name: "prod-net", Score: 0.25}, merged[3])
+}
+
+func TestMergeResults_RanksByTierFirst(t *testing.T) {
+	types := []resourceType{{Name: "instance"}, {Name: "vcn"}}
+	perType := [][]Result{
+		{{Name: "prod-ap", Score: 3, tier: search.TierFuzzy}},
+		{{Name: "prod", Score: 2, tier: search.TierExact}, {Name: "prod-net", Score: 1, tier: search.TierTerm}},
+	}
+
+	merged := mergeResults(types, perType)
+
+	require.Len(t, merged, 3)
+	assert.Equal(t, "prod", merged[0].Name, "an exact match outranks the top fuzzy hit of another type")
+	assert.Equal(t, "prod-net", merged[1].Name)
+	assert.Equal(t, "prod-ap", merged[2].Name)
+	assert.Equal(t, 1.0, merged[2].Score)
+}
+
+func TestMergeResults_Empty(t *testing.T) {
+	assert.Empty(t, mergeResults([]resourceType{{Name: "vcn"}}, [][]Result{nil}))
+}
+
+func TestResourceTypes_CanSearchAndReindex(t *testing.T) {
+	for _, rt := range resourceTypes() {
+		assert.NotNil(t, rt.search, rt.Name)
+		assert.NotNil(t, rt.reindex, rt.Name)
+	}
+	assert.Contains(t, TypeNames(), "subnet")
+}
+
+func TestPrintSearchResults(t *testing.T) {
+	results := []Result{{Type: "vcn", Name: "prod-vcn", State: "AVAILABLE", OCID: "ocid1.vcn", Match: "exact", Score: 2}}
+
+	var buf bytes.Buffer
+	require.NoError(t, PrintSearchResults(&buf, results, printer.TableOutput))
+	out := buf.String()
+	assert.Contains(t, out, "TYPE")
+	assert.Contains(t, out, "prod-vcn")
+	assert.Contains(t, out, "exact")
+	assert.Contains(t, out, "2.000")
+
+	buf.Reset()
+	require.NoError(t, PrintSearchResults(&buf, results, printer.JSONOutput))
+	var decoded struct {
+		Items []Result `json:"items"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, results, decoded.Items)
+
+	buf.Reset()
+	results[0].Region = "eu-frankfurt-1"
+	require.NoError(t, PrintSearchResults(&buf, results, printer.TableOutput))
+	assert.Contains(t, buf.String(), "REGION")
+	assert.Contains(t, buf.String(), "eu-frankfurt-1")
+
+	buf.Reset()
+	require.NoError(t, PrintSearchResults(&buf, nil, printer.TableOutput))
+	assert.Contains(t, buf.String(), "No Items found.")
+}
@@ -0,0 +1,180 @@
+// Package global provides operations that span every searchable resource type,
+// such as searching all of them at once and rebuilding the persisted search indexes.
+package global
+
+import (
+	"context"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/domain/identity"
+	"github.com/cnopslabs/ocloud/internal/services/compute/image"
+	"github.com/cnopslabs/ocloud/internal/services/compute/instance"
+	"github.com/cnopslabs/ocloud/internal/services/compute/oke"
+	"github.com/cnopslabs/ocloud/internal/services/database/autonomousdb"
+	"github.com/cnopslabs/ocloud/internal/services/database/cacheclusterdb"
+	"github.com/cnopslabs/ocloud/internal/services/database/heatwavedb"
+	"github.com/cnopslabs/ocloud/internal/services/identity/compartment"
+	"github.com/cnopslabs/ocloud/internal/services/identity/policy"
+	"github.com/cnopslabs/ocloud/internal/services/network/loadbalancer"
+	"github.com/cnopslabs/ocloud/internal/services/network/subnet"
+	"github.com/cnopslabs/ocloud/internal/services/network/vcn"
+	"github.com/cnopslabs/ocloud/internal/services/resourcetype"
+	"github.com/cnopslabs/ocloud/internal/services/search"
+	"github.com/cnopslabs/ocloud/internal/services/storage/objectstorage"
+)
+
+// reindexer is implemented by every service that persists a search index.
+type reindexer interface {
+	Reindex(ctx context.Context) (int, error)
+}
+
+// scoredSearcher is implemented by every service that ranks its resources against a pattern.
+type scoredSearcher[T any] interface {
+	ScoredSearch(ctx context.Context, pattern string) ([]search.Match[T], error)
+}
+
+// resourceType describes one searchable resource type.
+type resourceType struct {
+	Name    string
+	reindex func(ctx context.Context, appCtx *app.ApplicationContext) (int, error)
+	search  func(ctx context.Context, appCtx *app.ApplicationContext, pattern string) ([]Result, error)
+}
+
+// reindexWith adapts a service constructor to a reindex function.
+func reindexWith[S reindexer](newService func(*app.ApplicationContext) (S, error)) func(context.Context, *app.ApplicationContext) (int, error) {
+	return func(ctx context.Context, appCtx *app.ApplicationContext) (int, error) {
+		service, err := newService(appCtx)
+		if err != nil {
+			return 0, err
+		}
+		return service.Reindex(ctx)
+	}
+}
+
+// searchWith adapts a service constructor and a summary of its resource to a search function.
+func searchWith[T any, S scoredSearcher[T]](newService func(*app.ApplicationContext) (S, error), summarize func(T) Result) func(context.Context, *app.ApplicationContext, string) ([]Result, error) {
+	return func(ctx context.Context, appCtx *app.ApplicationContext, pattern string) ([]Result, error) {
+		service, err := newService(appCtx)
+		if err != nil {
+			return nil, err
+		}
+		matches, err := service.ScoredSearch(ctx, pattern)
+		if err != nil {
+			return nil, err
+		}
+		results := make([]Result, 0, len(matches))
+		for _, m := range matches {
+			r := summarize(m.Item)
+			r.Score = m.Score
+			r.Match = m.Tier.String()
+			r.tier = m.Tier
+			results = append(results, r)
+		}
+		return results, nil
+	}
+}
+
+// resourceTypes lists every searchable resource type, named after its command.
+func resourceTypes() []resourceType {
+	return []resourceType{
+		{
+			Name:    resourcetype.Instance,
+			reindex: reindexWith(instance.NewServiceFromAppContext),
+			search: searchWith(instance.NewServiceFromAppContext, func(i instance.Instance) Result {
+				return Result{Name: i.DisplayName, State: i.State, OCID: i.OCID}
+			}),
+		},
+		{
+			Name:    resourcetype.Image,
+			reindex: reindexWith(image.NewServiceFromAppContext),
+			search: searchWith(image.NewServiceFromAppContext, func(i image.Image) Result {
+				return Result{Name: i.DisplayName, OCID: i.OCID}
+			}),
+		},
+		{
+			Name:    resourcetype.OKE,
+			reindex: reindexWith(oke.NewServiceFromAppContext),
+			search: searchWith(oke.NewServiceFromAppContext, func(c oke.Cluster) Result {
+				return Result{Name: c.DisplayName, State: c.State, OCID: c.OCID}
+			}),
+		},
+		{
+			Name:    resourcetype.Autonomous,
+			reindex: reindexWith(autonomousdb.NewServiceFromAppContext),
+			search: searchWith(autonomousdb.NewServiceFromAppContext, func(d autonomousdb.AutonomousDatabase) Result {
+				return Result{Name: d.Name, State: d.LifecycleState, OCID: d.ID}
+			}),
+		},
+		{
+			Name:    resourcetype.HeatWave,
+			reindex: reindexWith(heatwavedb.NewServiceFromAppContext),
+			search: searchWith(heatwavedb.NewServiceFromAppContext, func(d heatwavedb.HeatWaveDatabase) Result {
+				return Result{Name: d.DisplayName, State: d.LifecycleState, OCID: d.ID}
+			}),
+		},
+		{
+			Name:    resourcetype.CacheCluster,
+			reindex: reindexWith(cacheclusterdb.NewServiceFromAppContext),
+			search: searchWith(cacheclusterdb.NewServiceFromAppContext, func(c cacheclusterdb.CacheCluster) Result {
+				return Result{Name: c.DisplayName, State: c.LifecycleState, OCID: c.ID}
+			}),
+		},
+		{
+			Name:    resourcetype.VCN,
+			reindex: reindexWith(vcn.NewServiceFromAppContext),
+			search: searchWith(vcn.NewServiceFromAppContext, func(v vcn.VCN) Result {
+				return Result{Name: v.DisplayName, State: v.LifecycleState, OCID: v.OCID}
+			}),
+		},
+		{
+			Name:    resourcetype.Subnet,
+			reindex: reindexWith(subnet.NewServiceFromAppContext),
+			search: searchWith(subnet.NewServiceFromAppContext, func(s subnet.Subnet) Result {
+				return Result{Name: s.DisplayName, State: s.LifecycleState, OCID: s.OCID}
+			}),
+		},
+		{
+			Name:    resourcetype.LoadBalancer,
+			reindex: reindexWith(loadbalancer.NewServiceFromAppContext),
+			search: searchWith(loadbalancer.NewServiceFromAppContext, func(lb loadbalancer.LoadBalancer) Result {
+				return Result{Name: lb.Name, State: lb.State, OCID: lb.OCID}
+			}),
+		},
+		{
+			Name:    resourcetype.Policy,
+			reindex: reindexWith(policy.NewServiceFromAppContext),
+			search: searchWith(policy.NewServiceFromAppContext, func(p identity.Policy) Result {
+				return Result{Name: p.Name, OCID: p.ID}
+			}),
+		},
+		{
+			Name:    resourcetype.Compartment,
+			reindex: reindexWith(compartment.NewServiceFromAppContext),
+			search: searchWith(compartment.NewServiceFromAppContext, func(c compartment.Compartment) Result {
+				return Result{Name: c.DisplayName, State: c.LifecycleState, OCID: c.OCID}
+			}),
+		},
+		{
+			Name:    resourcetype.Bucket,
+			reindex: reindexWith(objectstorage.NewServiceFromAppContext),
+			search: searchWith(objectstorage.NewServiceFromAppContext, func(b objectstorage.Bucket) Result {
+				return Result{Name: b.Name, OCID: b.OCID}
+			}),
+		},
+	}
+}
+
+// TypeName returns the name of the resource type.
+func (t resourceType) TypeName() string {
+	return t.Name
+}
+
+// TypeNames returns the names of all searchable resource types.
+func TypeNames() []string {
+	return resourcetype.Names(resourceTypes())
+}
+
+// selectTypes returns the resource types matching names, or all types when names is empty.
+func selectTypes(names []string) ([]resourceType, error) {
+	return resourcetype.Select(resourceTypes(), names)
+}
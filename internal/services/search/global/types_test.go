@@ -0,0 +1,23 @@
+package global
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectTypes(t *testing.T) {
+	all, err := selectTypes(nil)
+	require.NoError(t, err)
+	assert.Len(t, all, len(TypeNames()))
+
+	selected, err := selectTypes([]string{"VCN", "instance", "vcn"})
+	require.NoError(t, err)
+	require.Len(t, selected, 2)
+	assert.Equal(t, "vcn", selected[0].Name)
+	assert.Equal(t, "instance", selected[1].Name)
+
+	_, err = selectTypes([]string{"nope"})
+	assert.ErrorContains(t, err, `unknown resource type "nope"`)
+}
@@ -0,0 +1,157 @@
+package search
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/mapping"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/cnopslabs/ocloud/internal/cache"
+)
+
+// Internal keys stored alongside the documents of a persisted index.
+var (
+	internalKeyMapping = []byte("ocloud:mapping")
+	internalKeyDocs    = []byte("ocloud:docs")
+)
+
+// indexConfig bounds how long opening an index waits for another ocloud process holding it.
+var indexConfig = map[string]interface{}{"bolt_timeout": "1s"}
+
+// OpenIndex returns a Bleve index for items that is persisted under the cache
+// directory of the given compartment and resource. The persisted index is
+// synchronized incrementally: only documents whose content changed are
+// re-indexed and documents beyond the end of items are removed.
+//
+// The index is rebuilt from scratch when the mapping changed or the store is
+// refreshing. With a nil store, or when the index cannot be opened, for
+// example because another ocloud process holds it, an in-memory index is
+// built instead. Callers must Close the returned index.
+func OpenIndex[T Indexable](store *cache.Store, compartmentID, resource string, items []T, indexMapping mapping.IndexMapping) (bleve.Index, error) {
+	path := store.IndexPath(compartmentID, resource)
+	if path == "" {
+		return BuildIndex(items, indexMapping)
+	}
+
+	docs := make([]map[string]any, len(items))
+	hashes := make([]string, len(items))
+	for i, item := range items {
+		docs[i] = toDocument(item)
+		hashes[i] = hashDocument(docs[i])
+	}
+
+	idx, err := openPersistentIndex(path, indexMapping, store.Refreshing())
+	if err != nil {
+		return BuildIndex(items, indexMapping)
+	}
+	if err := syncIndex(idx, docs, hashes); err != nil {
+		_ = idx.Close()
+		return nil, fmt.Errorf("updating index: %w", err)
+	}
+	return idx, nil
+}
+
+// openPersistentIndex opens the index at path, recreating it when rebuild is
+// set, the index is unreadable, or it was built with a different mapping. An
+// index another ocloud process holds open is never removed; opening it fails
+// with the lock timeout instead.
+func openPersistentIndex(path string, indexMapping mapping.IndexMapping, rebuild bool) (bleve.Index, error) {
+	signature, err := json.Marshal(indexMapping)
+	if err != nil {
+		return nil, fmt.Errorf("encoding index mapping: %w", err)
+	}
+
+	idx, err := bleve.OpenUsing(path, indexConfig)
+	if errors.Is(err, bolt.ErrTimeout) {
+		return nil, fmt.Errorf("opening index in use by another process: %w", err)
+	}
+	if err == nil {
+		if !rebuild {
+			stored, err := idx.GetInternal(internalKeyMapping)
+			if err == nil && string(stored) == string(signature) {
+				return idx, nil
+			}
+		}
+		_ = idx.Close()
+	}
+
+	if err := os.RemoveAll(path); err != nil {
+		return nil, fmt.Errorf("removing index: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("creating index directory: %w", err)
+	}
+	idx, err = bleve.NewUsing(path, indexMapping, bleve.Config.DefaultIndexType, bleve.Config.DefaultKVStore, indexConfig)
+	if err != nil {
+		return nil, fmt.Errorf("creating index: %w", err)
+	}
+	if err := idx.SetInternal(internalKeyMapping, signature); err != nil {
+		_ = idx.Close()
+		return nil, fmt.Errorf("storing index mapping: %w", err)
+	}
+	return idx, nil
+}
+
+// syncIndex brings the documents of idx in line with docs, keyed by position.
+func syncIndex(idx bleve.Index, docs []map[string]any, hashes []string) error {
+	var previous []string
+	if raw, err := idx.GetInternal(internalKeyDocs); err == nil && len(raw) > 0 {
+		_ = json.Unmarshal(raw, &previous)
+	}
+
+	batch := idx.NewBatch()
+	for i, doc := range docs {
+		if i < len(previous) && previous[i] == hashes[i] {
+			continue
+		}
+		if err := batch.Index(strconv.Itoa(i), doc); err != nil {
+			return fmt.Errorf("indexing %d: %w", i, err)
+		}
+	}
+	for i := len(docs); i < len(previous); i++ {
+		batch.Delete(strconv.Itoa(i))
+	}
+	if batch.Size() == 0 && len(previous) == len(hashes) {
+		return nil
+	}
+
+	encoded, err := json.Marshal(hashes)
+	if err != nil {
+		return fmt.Errorf("encoding document hashes: %w", err)
+	}
+	batch.SetInternal(internalKeyDocs, encoded)
+	return idx.Batch(batch)
+}
+
+// toDocument converts an item into the document stored in the index, adding the
+// raw and n-gram variants of every string field used by FuzzySearch.
+func toDocument(item Indexable) map[string]any {
+	doc := item.ToIndexable()
+	keys := make([]string, 0, len(doc))
+	for k := range doc {
+		keys = append(keys, k)
+	}
+	for _, k := range keys {
+		if v, ok := doc[k].(string); ok && v != "" {
+			doc[k+".raw"] = v
+			doc[k+".ng"] = v
+		}
+	}
+	return doc
+}
+
+// hashDocument returns a stable digest of a document's content.
+func hashDocument(doc map[string]any) string {
+	// encoding/json sorts map keys, so equal documents produce equal digests.
+	data, _ := json.Marshal(doc)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
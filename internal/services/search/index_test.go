@@ -0,0 +1,121 @@
+package search
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cnopslabs/ocloud/internal/cache"
+)
+
+type doc struct {
+	Name string
+}
+
+func (d doc) ToIndexable() map[string]any {
+	return map[string]any{"Name": d.Name}
+}
+
+var testFields = []string{"Name"}
+
+func searchNames(t *testing.T, idx bleve.Index, items []doc, pattern string) []string {
+	t.Helper()
+	hits, err := FuzzySearch(idx, pattern, testFields, testFields)
+	require.NoError(t, err)
+	var names []string
+	for _, i := range hits {
+		if i < len(items) {
+			names = append(names, items[i].Name)
+		}
+	}
+	return names
+}
+
+func docCount(t *testing.T, idx bleve.Index) uint64 {
+	t.Helper()
+	n, err := idx.DocCount()
+	require.NoError(t, err)
+	return n
+}
+
+func TestOpenIndex_NilStoreBuildsInMemory(t *testing.T) {
+	items := []doc{{Name: "prod-api"}, {Name: "dev-web"}}
+	idx, err := OpenIndex[doc](nil, "comp", "things", items, NewIndexMapping(testFields))
+	require.NoError(t, err)
+	defer func() { _ = idx.Close() }()
+
+	assert.Equal(t, []string{"prod-api"}, searchNames(t, idx, items, "prod"))
+}
+
+func TestOpenIndex_PersistsAndSyncsIncrementally(t *testing.T) {
+	store := cache.NewStore(t.TempDir(), "tenancy", time.Hour, false)
+	m := NewIndexMapping(testFields)
+
+	items := []doc{{Name: "prod-api"}, {Name: "dev-web"}, {Name: "prod-db"}}
+	idx, err := OpenIndex(store, "comp", "things", items, m)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), docCount(t, idx))
+	require.NoError(t, idx.Close())
+
+	_, err = os.Stat(store.IndexPath("comp", "things"))
+	require.NoError(t, err, "index should be persisted")
+
+	// One document changed and the listing shrank.
+	items = []doc{{Name: "prod-api"}, {Name: "stage-web"}}
+	idx, err = OpenIndex(store, "comp", "things", items, m)
+	require.NoError(t, err)
+	defer func() { _ = idx.Close() }()
+
+	assert.Equal(t, uint64(2), docCount(t, idx))
+	assert.Equal(t, []string{"stage-web"}, searchNames(t, idx, items, "stage"))
+	stale, err := idx.Document("2")
+	require.NoError(t, err)
+	assert.Nil(t, stale, "documents beyond the listing should be removed")
+}
+
+func TestOpenIndex_RebuildsOnMappingChangeAndRefresh(t *testing.T) {
+	dir := t.TempDir()
+	store := cache.NewStore(dir, "tenancy", time.Hour, false)
+	items := []doc{{Name: "prod-api"}}
+
+	idx, err := OpenIndex(store, "comp", "things", items, NewIndexMapping(testFields))
+	require.NoError(t, err)
+	require.NoError(t, idx.Close())
+
+	idx, err = OpenIndex(store, "comp", "things", items, NewIndexMapping([]string{"Name", "Other"}))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), docCount(t, idx))
+	require.NoError(t, idx.Close())
+
+	idx, err = OpenIndex(store.WithRefresh(), "comp", "things", items, NewIndexMapping(testFields))
+	require.NoError(t, err)
+	defer func() { _ = idx.Close() }()
+	assert.Equal(t, uint64(1), docCount(t, idx))
+	assert.Equal(t, []string{"prod-api"}, searchNames(t, idx, items, "prod"))
+}
+
+func TestOpenIndex_KeepsIndexHeldByAnotherProcess(t *testing.T) {
+	store := cache.NewStore(t.TempDir(), "tenancy", time.Hour, false)
+	items := []doc{{Name: "prod-api"}, {Name: "dev-web"}}
+
+	held, err := OpenIndex(store, "comp", "things", items, NewIndexMapping(testFields))
+	require.NoError(t, err)
+
+	for _, s := range []*cache.Store{store, store.WithRefresh()} {
+		idx, err := OpenIndex(s, "comp", "things", items[:1], NewIndexMapping(testFields))
+		require.NoError(t, err, "a locked index falls back to an in-memory one")
+		assert.Equal(t, uint64(1), docCount(t, idx))
+		require.NoError(t, idx.Close())
+	}
+
+	require.NoError(t, held.Close())
+
+	persisted, err := bleve.Open(store.IndexPath("comp", "things"))
+	require.NoError(t, err)
+	defer func() { _ = persisted.Close() }()
+	assert.Equal(t, uint64(2), docCount(t, persisted), "the held index is neither removed nor rebuilt")
+}
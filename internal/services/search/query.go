@@ -0,0 +1,242 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/blevesearch/bleve/v2"
+	bleveQuery "github.com/blevesearch/bleve/v2/search/query"
+)
+
+// fieldAliases maps query field shorthands to the indexed fields they may refer
+// to. A shorthand that matches none of a resource's fields matches nothing.
+var fieldAliases = map[string][]string{
+	"name":  {"Name", "DisplayName"},
+	"id":    {"OCID", "ID"},
+	"ocid":  {"OCID", "ID"},
+	"state": {"State"},
+	"shape": {"Shape", "ShapeName", "NodeShapes"},
+	"tag":   {"TagsKV"},
+	"tags":  {"TagsKV"},
+}
+
+// QuerySyntaxHelp describes the query language in command help texts.
+const QuerySyntaxHelp = `
+Field filters can be combined with free text:
+  state:RUNNING            field equals the value (case-insensitive)
+  shape:VM.Standard.E4*    field matches a wildcard pattern (* and ?)
+  tag:env=prod             a tag has the given key and value
+  -name:test               exclude resources matching the filter
+Filters accept any indexed field name or the shorthands name, id, ocid, state, shape and tag.
+Quote a term ("fe80::1") to search it as free text.
+`
+
+// tagsField is the indexed field holding "key:value" pairs of flattened tags.
+const tagsField = "TagsKV"
+
+// Query is a parsed search query: free text plus field filters.
+//
+// The query language is a whitespace-separated list of terms:
+//
+//	prod                    free text, matched like a FuzzySearch pattern
+//	state:RUNNING           field equals value (case-insensitive)
+//	shape:VM.Standard.E4*   field matches a wildcard pattern (* and ?)
+//	tag:env=prod            a freeform or defined tag has the given key and value
+//	-name:test              negation: exclude resources matching the filter
+//	name:"my server"        quotes group text containing spaces
+//	"fe80::1"               a quoted term is always free text
+//
+// Filters match whole words of multi-valued fields, so cidrs:10.0.0.0/16 matches a
+// VCN with several CIDR blocks. A field is either one of the resource's indexed
+// fields (case-insensitive) or a shorthand: name, id, ocid, state, shape, tag.
+// Terms whose field is unknown are treated as free text.
+type Query struct {
+	Text    string
+	Filters []FieldFilter
+}
+
+// FieldFilter restricts a query to documents whose field matches Value.
+type FieldFilter struct {
+	Name   string
+	Fields []string
+	Value  string
+	Negate bool
+}
+
+// ParseQuery parses input against the indexed fields of a resource type.
+// Parsing is lenient so that partially typed queries stay valid: an unterminated
+// quote runs to the end of the input and a filter without a value is ignored.
+func ParseQuery(input string, fields []string) Query {
+	var q Query
+	var text []string
+	for _, tok := range tokenize(input) {
+		if tok.quoted {
+			text = append(text, tok.text)
+			continue
+		}
+		f, ok := parseFilter(tok.text, fields)
+		switch {
+		case !ok:
+			text = append(text, tok.text)
+		case f.Value != "":
+			q.Filters = append(q.Filters, f)
+		}
+	}
+	q.Text = strings.Join(text, " ")
+	return q
+}
+
+// HasFilters reports whether the query restricts any field.
+func (q Query) HasFilters() bool {
+	return len(q.Filters) > 0
+}
+
+// token is a whitespace-separated term; quoted is set when the whole term was quoted.
+type token struct {
+	text   string
+	quoted bool
+}
+
+// tokenize splits input on whitespace, keeping quoted text together.
+func tokenize(input string) []token {
+	var (
+		out     []token
+		b       strings.Builder
+		inQuote bool
+		quoted  bool
+		started bool
+	)
+	flush := func() {
+		if started {
+			out = append(out, token{text: b.String(), quoted: quoted})
+		}
+		b.Reset()
+		quoted, started = false, false
+	}
+	for _, r := range input {
+		switch {
+		case r == '"':
+			if !started {
+				quoted = true
+			}
+			inQuote = !inQuote
+			started = true
+		case unicode.IsSpace(r) && !inQuote:
+			flush()
+		default:
+			b.WriteRune(r)
+			started = true
+		}
+	}
+	flush()
+	return out
+}
+
+// parseFilter parses a "[-]field:value" term. It reports false when the term is
+// not a filter on a known field or shorthand.
+func parseFilter(term string, fields []string) (FieldFilter, bool) {
+	negate := strings.HasPrefix(term, "-")
+	name, value, found := strings.Cut(strings.TrimPrefix(term, "-"), ":")
+	if !found || name == "" {
+		return FieldFilter{}, false
+	}
+
+	resolved, ok := resolveField(name, fields)
+	if !ok {
+		return FieldFilter{}, false
+	}
+	return FieldFilter{Name: strings.ToLower(name), Fields: resolved, Value: strings.ToLower(value), Negate: negate}, true
+}
+
+// resolveField maps a query field name to indexed fields.
+func resolveField(name string, fields []string) ([]string, bool) {
+	for _, f := range fields {
+		if strings.EqualFold(f, name) {
+			return []string{f}, true
+		}
+	}
+	aliases, ok := fieldAliases[strings.ToLower(name)]
+	if !ok {
+		return nil, false
+	}
+	var resolved []string
+	for _, a := range aliases {
+		for _, f := range fields {
+			if f == a {
+				resolved = append(resolved, f)
+			}
+		}
+	}
+	return resolved, true
+}
+
+// filterQuery builds the Bleve query all filters of q must satisfy, or nil when
+// q has no filters.
+func (q Query) filterQuery() bleveQuery.Query {
+	if !q.HasFilters() {
+		return nil
+	}
+
+	bq := bleve.NewBooleanQuery()
+	hasMust := false
+	for _, f := range q.Filters {
+		fq := f.query()
+		if f.Negate {
+			bq.AddMustNot(fq)
+		} else {
+			bq.AddMust(fq)
+			hasMust = true
+		}
+	}
+	if !hasMust {
+		bq.AddMust(bleve.NewMatchAllQuery())
+	}
+	return bq
+}
+
+// query returns the Bleve query matching documents that satisfy the filter,
+// ignoring negation.
+func (f FieldFilter) query() bleveQuery.Query {
+	if len(f.Fields) == 0 {
+		return bleve.NewMatchNoneQuery()
+	}
+
+	patterns := []string{f.Value}
+	if f.Fields[0] == tagsField && len(f.Fields) == 1 {
+		patterns = tagPatterns(f.Value)
+	}
+
+	var qs []bleveQuery.Query
+	for _, field := range f.Fields {
+		for _, p := range patterns {
+			qs = append(qs, wordQueries(field, p)...)
+		}
+	}
+	return bleve.NewDisjunctionQuery(qs...)
+}
+
+// tagPatterns turns "key=value", "key:value" or "key" into patterns over the
+// flattened "key:value" and "namespace.key:value" tag words.
+func tagPatterns(value string) []string {
+	key, val, found := strings.Cut(value, "=")
+	if !found {
+		key, val, found = strings.Cut(value, ":")
+	}
+	if !found || val == "" {
+		val = "*"
+	}
+	kv := key + ":" + val
+	return []string{kv, "*." + kv}
+}
+
+// wordQueries matches pattern against any whole space-separated word of the
+// keyword-analysed copy of field, as well as against the entire value.
+func wordQueries(field, pattern string) []bleveQuery.Query {
+	var qs []bleveQuery.Query
+	for _, p := range []string{pattern, pattern + " *", "* " + pattern, "* " + pattern + " *"} {
+		wq := bleve.NewWildcardQuery(p)
+		wq.SetField(field + ".raw")
+		qs = append(qs, wq)
+	}
+	return qs
+}
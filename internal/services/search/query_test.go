@@ -0,0 +1,76 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var queryFields = []string{"Name", "State", "Shape", "CIDRs", "TagsKV"}
+
+func TestParseQuery(t *testing.T) {
+	q := ParseQuery(`web state:RUNNING shape:VM.Standard.E4* tag:env=prod -name:test "fe80::1" owner:me`, queryFields)
+
+	assert.Equal(t, "web fe80::1 owner:me", q.Text)
+	require.Len(t, q.Filters, 4)
+	assert.Equal(t, FieldFilter{Name: "state", Fields: []string{"State"}, Value: "running"}, q.Filters[0])
+	assert.Equal(t, FieldFilter{Name: "shape", Fields: []string{"Shape"}, Value: "vm.standard.e4*"}, q.Filters[1])
+	assert.Equal(t, FieldFilter{Name: "tag", Fields: []string{"TagsKV"}, Value: "env=prod"}, q.Filters[2])
+	assert.Equal(t, FieldFilter{Name: "name", Fields: []string{"Name"}, Value: "test", Negate: true}, q.Filters[3])
+}
+
+func TestParseQuery_Lenient(t *testing.T) {
+	q := ParseQuery(`name:"my server" state: "unterminated text`, queryFields)
+
+	assert.Equal(t, "unterminated text", q.Text)
+	require.Len(t, q.Filters, 1)
+	assert.Equal(t, "my server", q.Filters[0].Value)
+	assert.False(t, ParseQuery("plain text", queryFields).HasFilters())
+}
+
+func TestParseQuery_AliasWithoutField(t *testing.T) {
+	q := ParseQuery("shape:vm*", []string{"Name"})
+
+	require.Len(t, q.Filters, 1)
+	assert.Empty(t, q.Filters[0].Fields)
+}
+
+type resource struct {
+	Name, State, Shape, CIDRs, Tags string
+}
+
+func (r resource) ToIndexable() map[string]any {
+	return map[string]any{"Name": r.Name, "State": r.State, "Shape": r.Shape, "CIDRs": r.CIDRs, "TagsKV": r.Tags}
+}
+
+func TestScoredFuzzySearch_StructuredQuery(t *testing.T) {
+	items := []resource{
+		{Name: "web-prod", State: "running", Shape: "vm.standard.e4.flex", CIDRs: "10.0.0.0/16", Tags: "env:prod team:web"},
+		{Name: "web-test", State: "running", Shape: "vm.standard.e4.flex", CIDRs: "10.1.0.0/16", Tags: "env:test"},
+		{Name: "db-prod", State: "stopped", Shape: "vm.standard.e3.flex", CIDRs: "10.2.0.0/16 10.3.0.0/16", Tags: "ops.env:prod"},
+	}
+	idx, err := BuildIndex(items, NewIndexMapping(queryFields))
+	require.NoError(t, err)
+	defer func() { _ = idx.Close() }()
+
+	search := func(pattern string) []string {
+		hits, err := ScoredFuzzySearch(idx, pattern, queryFields, []string{"Name"})
+		require.NoError(t, err)
+		var names []string
+		for _, h := range hits {
+			names = append(names, items[h.Index].Name)
+		}
+		return names
+	}
+
+	assert.ElementsMatch(t, []string{"web-prod", "web-test"}, search("state:RUNNING"))
+	assert.ElementsMatch(t, []string{"web-prod", "web-test"}, search("shape:VM.Standard.E4*"))
+	assert.ElementsMatch(t, []string{"web-prod", "db-prod"}, search("tag:env=prod"))
+	assert.ElementsMatch(t, []string{"web-prod", "web-test", "db-prod"}, search("tag:env"))
+	assert.Equal(t, []string{"web-prod"}, search("state:RUNNING shape:VM.Standard.E4* tag:env=prod -name:web-test"))
+	assert.Equal(t, []string{"db-prod"}, search("cidrs:10.3.0.0/16"))
+	assert.Equal(t, []string{"db-prod"}, search("-state:running"))
+	assert.Equal(t, []string{"web-test"}, search("web state:running -tag:env=prod"))
+	assert.Empty(t, search("state:terminated"))
+}
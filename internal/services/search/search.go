@@ -49,17 +49,7 @@ func BuildIndex[T Indexable](items []T, indexMapping mapping.IndexMapping) (blev
 	}
 
 	for i, item := range items {
-		doc := item.ToIndexable()
-		dup := func(k string) {
-			if v, ok := doc[k].(string); ok && v != "" {
-				doc[k+".raw"] = v
-				doc[k+".ng"] = v
-			}
-		}
-		for k := range doc {
-			dup(k)
-		}
-
+		doc := toDocument(item)
 		if err := idx.Index(strconv.Itoa(i), doc); err != nil {
 			return nil, fmt.Errorf("indexing %d: %w", i, err)
 		}
@@ -67,10 +57,73 @@ func BuildIndex[T Indexable](items []T, indexMapping mapping.IndexMapping) (blev
 	return idx, nil
 }
 
-// FuzzySearch performs a fuzzy search on the given index.
+// Hit is a search result: the position of the matched item, its relevance
+// score and how it matched.
+type Hit struct {
+	Index int
+	Score float64
+	Tier  Tier
+}
+
+// Match pairs a matched item with its relevance score and match tier.
+type Match[T any] struct {
+	Item  T
+	Score float64
+	Tier  Tier
+}
+
+// Tier tells how closely a hit matched the pattern, closest first. Unlike
+// scores, which depend on the other documents of an index, tiers compare
+// across indexes.
+type Tier int
+
+const (
+	// TierExact is a hit where a whole field equals the pattern.
+	TierExact Tier = iota
+	// TierTerm is a hit where every word of the pattern is a word of a field.
+	TierTerm
+	// TierSubstring is a hit where a field contains the pattern.
+	TierSubstring
+	// TierFuzzy is any other hit: prefix, n-gram and fuzzy matches.
+	TierFuzzy
+)
+
+// String returns the lowercase name of the tier.
+func (t Tier) String() string {
+	switch t {
+	case TierExact:
+		return "exact"
+	case TierTerm:
+		return "term"
+	case TierSubstring:
+		return "substring"
+	default:
+		return "fuzzy"
+	}
+}
+
+// FuzzySearch performs a fuzzy search on the given index and returns the
+// positions of the matched items, best match first. The pattern may use the
+// structured query language described by Query to filter on fields.
 func FuzzySearch(index bleve.Index, pattern string, fields, boostedFields []string) ([]int, error) {
-	pattern = strings.ToLower(strings.TrimSpace(pattern))
-	if pattern == "" {
+	hits, err := ScoredFuzzySearch(index, pattern, fields, boostedFields)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]int, 0, len(hits))
+	for _, h := range hits {
+		out = append(out, h.Index)
+	}
+	return out, nil
+}
+
+// ScoredFuzzySearch is FuzzySearch that also returns the relevance score and
+// the match tier of every hit.
+func ScoredFuzzySearch(index bleve.Index, pattern string, fields, boostedFields []string) ([]Hit, error) {
+	query := ParseQuery(pattern, fields)
+	filter := query.filterQuery()
+	pattern = strings.ToLower(strings.TrimSpace(query.Text))
+	if pattern == "" && filter == nil {
 		return nil, nil
 	}
 
@@ -87,21 +140,67 @@ func FuzzySearch(index bleve.Index, pattern string, fields, boostedFields []stri
 		return false
 	}
 
-	collect := func(q bleveQuery.Query, size int) ([]int, error) {
+	collect := func(q bleveQuery.Query, size int) ([]Hit, error) {
+		if filter != nil {
+			q = bleve.NewConjunctionQuery(q, filter)
+		}
 		req := bleve.NewSearchRequestOptions(q, size, 0, false)
 		res, err := index.Search(req)
 		if err != nil {
 			return nil, err
 		}
-		out := make([]int, 0, len(res.Hits))
+		out := make([]Hit, 0, len(res.Hits))
 		for _, h := range res.Hits {
 			if n, err := strconv.Atoi(h.ID); err == nil {
-				out = append(out, n)
+				out = append(out, Hit{Index: n, Score: h.Score})
 			}
 		}
 		return out, nil
 	}
 
+	if pattern == "" {
+		return collect(bleve.NewMatchAllQuery(), 1000)
+	}
+
+	// classify sets the tier of every hit by running one query per tier,
+	// closest first; a hit none of them returns stays TierFuzzy.
+	classify := func(hits []Hit) ([]Hit, error) {
+		size, err := index.DocCount()
+		if err != nil {
+			return nil, err
+		}
+		tierQueries := make([][]bleveQuery.Query, TierFuzzy)
+		for _, f := range fields {
+			tq := bleve.NewTermQuery(pattern)
+			tq.SetField(f + ".raw")
+			mq := bleve.NewMatchQuery(pattern)
+			mq.SetField(f)
+			mq.SetOperator(bleveQuery.MatchQueryOperatorAnd)
+			wq := bleve.NewWildcardQuery("*" + pattern + "*")
+			wq.SetField(f + ".raw")
+			tierQueries[TierExact] = append(tierQueries[TierExact], tq)
+			tierQueries[TierTerm] = append(tierQueries[TierTerm], mq)
+			tierQueries[TierSubstring] = append(tierQueries[TierSubstring], wq)
+		}
+		tiers := make(map[int]Tier, len(hits))
+		for tier := TierSubstring; tier >= TierExact; tier-- {
+			matched, err := collect(bleve.NewDisjunctionQuery(tierQueries[tier]...), int(size))
+			if err != nil {
+				return nil, err
+			}
+			for _, h := range matched {
+				tiers[h.Index] = tier
+			}
+		}
+		for i := range hits {
+			hits[i].Tier = TierFuzzy
+			if tier, ok := tiers[hits[i].Index]; ok {
+				hits[i].Tier = tier
+			}
+		}
+		return hits, nil
+	}
+
 	if looksSpecific(pattern) {
 		var eqQs []bleveQuery.Query
 		for _, f := range fields {
@@ -112,7 +211,7 @@ func FuzzySearch(index bleve.Index, pattern string, fields, boostedFields []stri
 		if hits, err := collect(bleve.NewDisjunctionQuery(eqQs...), 200); err != nil {
 			return nil, err
 		} else if len(hits) > 0 {
-			return hits, nil
+			return classify(hits)
 		}
 
 		var subQs []bleveQuery.Query
@@ -125,7 +224,7 @@ func FuzzySearch(index bleve.Index, pattern string, fields, boostedFields []stri
 		if hits, err := collect(bleve.NewDisjunctionQuery(subQs...), 500); err != nil {
 			return nil, err
 		} else if len(hits) > 0 {
-			return hits, nil
+			return classify(hits)
 		}
 	}
 
@@ -158,5 +257,43 @@ func FuzzySearch(index bleve.Index, pattern string, fields, boostedFields []stri
 		qs = append(qs, bq)
 	}
 
-	return collect(bleve.NewDisjunctionQuery(qs...), 1000)
+	hits, err := collect(bleve.NewDisjunctionQuery(qs...), 1000)
+	if err != nil {
+		return nil, err
+	}
+	return classify(hits)
+}
+
+// Rank returns the items referenced by hits, in hit order, with their scores and tiers.
+// Hits that fall outside items are ignored.
+func Rank[T any](items []T, hits []Hit) []Match[T] {
+	out := make([]Match[T], 0, len(hits))
+	for _, h := range hits {
+		if h.Index >= 0 && h.Index < len(items) {
+			out = append(out, Match[T]{Item: items[h.Index], Score: h.Score, Tier: h.Tier})
+		}
+	}
+	return out
+}
+
+// AllMatches wraps every item as a match with a zero score, for searches that
+// return everything (such as an empty pattern).
+func AllMatches[T any](items []T) []Match[T] {
+	out := make([]Match[T], len(items))
+	for i, item := range items {
+		out[i] = Match[T]{Item: item}
+	}
+	return out
+}
+
+// Items returns the items of matches in order.
+func Items[T any](matches []Match[T]) []T {
+	if matches == nil {
+		return nil
+	}
+	out := make([]T, len(matches))
+	for i, m := range matches {
+		out[i] = m.Item
+	}
+	return out
 }
@@ -0,0 +1,57 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScoredFuzzySearch_RanksHits(t *testing.T) {
+	items := []doc{{Name: "dev-web"}, {Name: "prod-api"}, {Name: "prod"}}
+	idx, err := BuildIndex(items, NewIndexMapping(testFields))
+	require.NoError(t, err)
+	defer func() { _ = idx.Close() }()
+
+	hits, err := ScoredFuzzySearch(idx, "prod", testFields, testFields)
+	require.NoError(t, err)
+	require.NotEmpty(t, hits)
+
+	assert.Equal(t, 2, hits[0].Index, "exact name match ranks first")
+	for i := 1; i < len(hits); i++ {
+		assert.GreaterOrEqual(t, hits[i-1].Score, hits[i].Score)
+		assert.Greater(t, hits[i].Score, 0.0)
+	}
+
+	indices, err := FuzzySearch(idx, "prod", testFields, testFields)
+	require.NoError(t, err)
+	for i, h := range hits {
+		assert.Equal(t, h.Index, indices[i])
+	}
+}
+
+func TestScoredFuzzySearch_Tiers(t *testing.T) {
+	items := []doc{{Name: "prod"}, {Name: "prod-api"}, {Name: "preprod"}, {Name: "prid"}}
+	idx, err := BuildIndex(items, NewIndexMapping(testFields))
+	require.NoError(t, err)
+	defer func() { _ = idx.Close() }()
+
+	hits, err := ScoredFuzzySearch(idx, "prod", testFields, testFields)
+	require.NoError(t, err)
+	tiers := map[string]Tier{}
+	for _, h := range hits {
+		tiers[items[h.Index].Name] = h.Tier
+	}
+	assert.Equal(t, map[string]Tier{"prod": TierExact, "prod-api": TierTerm, "preprod": TierSubstring, "prid": TierFuzzy}, tiers)
+	assert.Equal(t, "substring", TierSubstring.String())
+}
+
+func TestRankAndItems(t *testing.T) {
+	items := []string{"a", "b", "c"}
+	matches := Rank(items, []Hit{{Index: 2, Score: 3}, {Index: 9, Score: 2}, {Index: 0, Score: 1, Tier: TierFuzzy}})
+
+	assert.Equal(t, []Match[string]{{Item: "c", Score: 3}, {Item: "a", Score: 1, Tier: TierFuzzy}}, matches)
+	assert.Equal(t, []string{"c", "a"}, Items(matches))
+	assert.Nil(t, Items[string](nil))
+	assert.Equal(t, []Match[string]{{Item: "a"}, {Item: "b"}, {Item: "c"}}, AllMatches(items))
+}
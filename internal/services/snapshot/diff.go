@@ -0,0 +1,38 @@
+package snapshot
+
+import (
+	"fmt"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/snapshot"
+)
+
+// Diff loads the snapshots a and b, given by name or path, and prints the
+// resources added, removed and modified from a to b.
+func Diff(appCtx *app.ApplicationContext, a, b string, format printer.OutputFormat) error {
+	dir, err := snapshot.DefaultDir()
+	if err != nil {
+		return fmt.Errorf("resolving snapshot directory: %w", err)
+	}
+	before, err := snapshot.Load(dir, a)
+	if err != nil {
+		return err
+	}
+	after, err := snapshot.Load(dir, b)
+	if err != nil {
+		return err
+	}
+
+	changes, err := snapshot.Diff(before, after)
+	if err != nil {
+		return fmt.Errorf("comparing snapshots: %w", err)
+	}
+	logger.LogWithLevel(logger.CmdLogger, logger.Debug, "Snapshot diff", "from", before.Name, "to", after.Name, "changes", len(changes))
+
+	if err := PrintDiff(appCtx.Stdout, before, after, changes, format); err != nil {
+		return fmt.Errorf("printing snapshot diff: %w", err)
+	}
+	return nil
+}
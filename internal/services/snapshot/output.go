@@ -0,0 +1,72 @@
+package snapshot
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/snapshot"
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// PrintSaveResults displays what a snapshot captured in a table or the requested
+// structured format. path is the file written, or "" when nothing was saved.
+func PrintSaveResults(out io.Writer, path string, results []SaveResult, format printer.OutputFormat) error {
+	p := printer.New(out)
+	if !format.IsTable() {
+		return util.MarshalDataResponse(p, format, results, nil)
+	}
+
+	headers := []string{"RESOURCE", "ITEMS", "DURATION", "STATUS"}
+	rows := make([][]string, 0, len(results))
+	for _, r := range results {
+		status := "ok"
+		if r.Error != "" {
+			status = "skipped: " + r.Error
+		}
+		rows = append(rows, []string{r.Type, strconv.Itoa(r.Items), r.Duration.String(), status})
+	}
+
+	p.PrintTableNoTruncate(text.Colors{text.FgMagenta}.Sprint("Snapshot"), headers, rows)
+	if path != "" {
+		_, _ = fmt.Fprintf(out, "Saved snapshot to %s\n", path)
+	}
+	return nil
+}
+
+// PrintDiff displays the changes between two snapshots in a table with one row
+// per added or removed resource and per modified field, or in the requested
+// structured format.
+func PrintDiff(out io.Writer, before, after *snapshot.Snapshot, changes []snapshot.Change, format printer.OutputFormat) error {
+	p := printer.New(out)
+	if !format.IsTable() {
+		return util.MarshalDataResponse(p, format, changes, nil)
+	}
+
+	if len(changes) == 0 {
+		_, err := fmt.Fprintf(out, "No differences between %s and %s\n", before.Name, after.Name)
+		return err
+	}
+
+	headers := []string{"CHANGE", "TYPE", "NAME", "FIELD", "OLD", "NEW"}
+	var rows [][]string
+	counts := map[string]int{}
+	for _, c := range changes {
+		counts[c.Kind]++
+		if c.Kind != snapshot.Modified {
+			rows = append(rows, []string{c.Kind, c.Type, c.Name, "", "", ""})
+			continue
+		}
+		for _, f := range c.Fields {
+			rows = append(rows, []string{c.Kind, c.Type, c.Name, f.Field, f.Old, f.New})
+		}
+	}
+
+	title := fmt.Sprintf("%s (%s) → %s (%s)", before.Name, before.CreatedAt.Local().Format(time.DateTime), after.Name, after.CreatedAt.Local().Format(time.DateTime))
+	p.PrintTableNoTruncate(text.Colors{text.FgMagenta}.Sprint(title), headers, rows)
+	_, err := fmt.Fprintf(out, "%d added, %d removed, %d modified\n", counts[snapshot.Added], counts[snapshot.Removed], counts[snapshot.Modified])
+	return err
+}
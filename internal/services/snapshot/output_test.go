@@ -0,0 +1,84 @@
+package snapshot
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/snapshot"
+)
+
+func TestPrintDiff(t *testing.T) {
+	before := &snapshot.Snapshot{Name: "monday", CreatedAt: time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)}
+	after := &snapshot.Snapshot{Name: "tuesday", CreatedAt: time.Date(2026, 10, 13, 9, 0, 0, 0, time.UTC)}
+	changes := []snapshot.Change{
+		{Kind: snapshot.Added, Type: "instance", ID: "i2", Name: "worker"},
+		{Kind: snapshot.Modified, Type: "vcn", ID: "v1", Name: "prod-vcn", Fields: []snapshot.FieldChange{
+			{Field: "CidrBlock", Old: "10.0.0.0/16", New: "10.1.0.0/16"},
+		}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, PrintDiff(&buf, before, after, changes, printer.TableOutput))
+	out := buf.String()
+	assert.Contains(t, out, "worker")
+	assert.Contains(t, out, "CidrBlock")
+	assert.Contains(t, out, "10.1.0.0/16")
+	assert.Contains(t, out, "1 added, 0 removed, 1 modified")
+
+	buf.Reset()
+	require.NoError(t, PrintDiff(&buf, before, after, changes, printer.JSONOutput))
+	var decoded struct {
+		Items []snapshot.Change `json:"items"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, changes, decoded.Items)
+
+	buf.Reset()
+	require.NoError(t, PrintDiff(&buf, before, after, nil, printer.TableOutput))
+	assert.Equal(t, "No differences between monday and tuesday\n", buf.String())
+}
+
+func TestDiff_WritesToApplicationStdout(t *testing.T) {
+	dir := t.TempDir()
+	vcn, err := snapshot.NewResource("vcn", "v1", "prod-vcn", map[string]string{"CidrBlock": "10.0.0.0/16"})
+	require.NoError(t, err)
+	before, err := snapshot.Save(dir, &snapshot.Snapshot{Name: "monday", Types: []string{"vcn"}})
+	require.NoError(t, err)
+	after, err := snapshot.Save(dir, &snapshot.Snapshot{Name: "tuesday", Types: []string{"vcn"}, Resources: []snapshot.Resource{vcn}})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, Diff(&app.ApplicationContext{Stdout: &buf}, before, after, printer.TableOutput))
+	assert.Contains(t, buf.String(), "prod-vcn")
+	assert.Contains(t, buf.String(), "1 added, 0 removed, 0 modified")
+}
+
+func TestPrintSaveResults(t *testing.T) {
+	results := []SaveResult{
+		{Type: "instance", Items: 3},
+		{Type: "heatwave", Error: "not authorized"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, PrintSaveResults(&buf, "/tmp/prod.json", results, printer.TableOutput))
+	out := buf.String()
+	assert.Contains(t, out, "skipped: not authorized")
+	assert.Contains(t, out, "Saved snapshot to /tmp/prod.json")
+}
+
+func TestSelectTypes(t *testing.T) {
+	selected, err := selectTypes([]string{"VCN", "instance", "vcn"})
+	require.NoError(t, err)
+	assert.Len(t, selected, 2)
+	assert.Equal(t, "vcn", selected[0].Name)
+
+	_, err = selectTypes([]string{"nope"})
+	assert.ErrorContains(t, err, "unknown resource type")
+}
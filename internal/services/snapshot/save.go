@@ -0,0 +1,93 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/snapshot"
+)
+
+// SaveResult reports how many resources of one type were captured.
+type SaveResult struct {
+	Type     string        `json:"Type"`
+	Items    int           `json:"Items"`
+	Duration time.Duration `json:"Duration"`
+	Error    string        `json:"Error,omitempty"`
+}
+
+// Save captures the given resource types (all when empty) of the current
+// compartment and writes them to the snapshot called name. Listings bypass the
+// resource cache so that the snapshot reflects OCI at this moment. A type that
+// cannot be listed is left out of the snapshot; an error is returned only when
+// no type could be captured.
+func Save(appCtx *app.ApplicationContext, name string, types []string, format printer.OutputFormat) error {
+	if err := snapshot.ValidateName(name); err != nil {
+		return err
+	}
+	selected, err := selectTypes(types)
+	if err != nil {
+		return err
+	}
+	dir, err := snapshot.DefaultDir()
+	if err != nil {
+		return fmt.Errorf("resolving snapshot directory: %w", err)
+	}
+
+	freshCtx := *appCtx
+	freshCtx.Cache = appCtx.Cache.WithRefresh()
+
+	ctx := context.Background()
+	captured := make([][]snapshot.Resource, len(selected))
+	results := make([]SaveResult, len(selected))
+	var wg sync.WaitGroup
+	for i, t := range selected {
+		wg.Add(1)
+		go func(i int, t resourceType) {
+			defer wg.Done()
+			start := time.Now()
+			resources, err := t.capture(ctx, &freshCtx, t.Name)
+			results[i] = SaveResult{Type: t.Name, Items: len(resources), Duration: time.Since(start).Round(time.Millisecond)}
+			if err != nil {
+				results[i].Error = err.Error()
+				logger.LogWithLevel(appCtx.Logger, logger.Debug, "snapshot capture failed", "type", t.Name, "error", err)
+				return
+			}
+			captured[i] = resources
+		}(i, t)
+	}
+	wg.Wait()
+
+	s := &snapshot.Snapshot{
+		Name:          name,
+		CreatedAt:     time.Now().UTC(),
+		TenancyName:   appCtx.TenancyName,
+		TenancyID:     appCtx.TenancyID,
+		Compartment:   appCtx.CompartmentName,
+		CompartmentID: appCtx.CompartmentID,
+	}
+	for i, r := range results {
+		if r.Error == "" {
+			s.Types = append(s.Types, r.Type)
+			s.Resources = append(s.Resources, captured[i]...)
+		}
+	}
+	if len(s.Types) == 0 {
+		_ = PrintSaveResults(appCtx.Stdout, "", results, format)
+		return fmt.Errorf("capturing failed for all %d resource types", len(results))
+	}
+
+	path, err := snapshot.Save(dir, s)
+	if err != nil {
+		return fmt.Errorf("saving snapshot: %w", err)
+	}
+	if err := PrintSaveResults(appCtx.Stdout, path, results, format); err != nil {
+		return fmt.Errorf("printing snapshot results: %w", err)
+	}
+	logger.LogWithLevel(appCtx.Logger, logger.Info, "Snapshot saved", "name", name, "path", path, "resources", len(s.Resources))
+	return nil
+}
@@ -0,0 +1,136 @@
+// Package snapshot saves the resources of a compartment to snapshot files and
+// reports the differences between two snapshots.
+package snapshot
+
+import (
+	"context"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/domain/identity"
+	"github.com/cnopslabs/ocloud/internal/services/compute/instance"
+	"github.com/cnopslabs/ocloud/internal/services/database/autonomousdb"
+	"github.com/cnopslabs/ocloud/internal/services/database/cacheclusterdb"
+	"github.com/cnopslabs/ocloud/internal/services/database/heatwavedb"
+	"github.com/cnopslabs/ocloud/internal/services/identity/policy"
+	"github.com/cnopslabs/ocloud/internal/services/network/loadbalancer"
+	"github.com/cnopslabs/ocloud/internal/services/network/vcn"
+	"github.com/cnopslabs/ocloud/internal/services/resourcetype"
+	"github.com/cnopslabs/ocloud/internal/services/storage/objectstorage"
+	"github.com/cnopslabs/ocloud/internal/snapshot"
+)
+
+// resourceType describes one resource type captured by a snapshot.
+type resourceType struct {
+	Name    string
+	capture captureFunc
+}
+
+// captureFunc lists the resources of one type as snapshot resources of typeName.
+type captureFunc func(ctx context.Context, appCtx *app.ApplicationContext, typeName string) ([]snapshot.Resource, error)
+
+// captureWith adapts a service constructor, its enriched listing and the
+// identity of its resource to a capture function. Resources listed across
+// regions or a compartment subtree keep the region and path they came from.
+func captureWith[T, S any](newService func(*app.ApplicationContext) (S, error), list func(S, context.Context) ([]T, error), identify func(T) (id, name string)) captureFunc {
+	return func(ctx context.Context, appCtx *app.ApplicationContext, typeName string) ([]snapshot.Resource, error) {
+		service, err := newService(appCtx)
+		if err != nil {
+			return nil, err
+		}
+		items, err := list(service, ctx)
+		if err != nil {
+			return nil, err
+		}
+		resources := make([]snapshot.Resource, 0, len(items))
+		for _, item := range items {
+			id, name := identify(item)
+			r, err := snapshot.NewResource(typeName, id, name, item)
+			if err != nil {
+				return nil, err
+			}
+			r.Region = lookup(appCtx.Regions.RegionOf, id, name)
+			r.CompartmentPath = lookup(appCtx.Subtree.PathOf, id, name)
+			resources = append(resources, r)
+		}
+		return resources, nil
+	}
+}
+
+// lookup returns of(id), falling back to of(name) for resources recorded by name.
+func lookup(of func(string) string, id, name string) string {
+	if v := of(id); v != "" {
+		return v
+	}
+	return of(name)
+}
+
+// resourceTypes lists every resource type a snapshot captures, named after its command.
+func resourceTypes() []resourceType {
+	return []resourceType{
+		{
+			Name: resourcetype.Instance,
+			capture: captureWith(instance.NewServiceFromAppContext, (*instance.Service).ListEnrichedInstances, func(i instance.Instance) (string, string) {
+				return i.OCID, i.DisplayName
+			}),
+		},
+		{
+			Name: resourcetype.VCN,
+			capture: captureWith(vcn.NewServiceFromAppContext, (*vcn.Service).ListVcns, func(v vcn.VCN) (string, string) {
+				return v.OCID, v.DisplayName
+			}),
+		},
+		{
+			Name: resourcetype.LoadBalancer,
+			capture: captureWith(loadbalancer.NewServiceFromAppContext, (*loadbalancer.Service).ListEnrichedLoadBalancers, func(lb loadbalancer.LoadBalancer) (string, string) {
+				return lb.OCID, lb.Name
+			}),
+		},
+		{
+			Name: resourcetype.Policy,
+			capture: captureWith(policy.NewServiceFromAppContext, (*policy.Service).ListPolicies, func(p identity.Policy) (string, string) {
+				return p.ID, p.Name
+			}),
+		},
+		{
+			Name: resourcetype.Bucket,
+			// Buckets are keyed by namespace and name: the OCID is only known
+			// when the bucket details could be read.
+			capture: captureWith(objectstorage.NewServiceFromAppContext, (*objectstorage.Service).ListBuckets, func(b objectstorage.Bucket) (string, string) {
+				return b.Namespace + "/" + b.Name, b.Name
+			}),
+		},
+		{
+			Name: resourcetype.Autonomous,
+			capture: captureWith(autonomousdb.NewServiceFromAppContext, (*autonomousdb.Service).ListEnrichedAutonomousDb, func(d autonomousdb.AutonomousDatabase) (string, string) {
+				return d.ID, d.Name
+			}),
+		},
+		{
+			Name: resourcetype.HeatWave,
+			capture: captureWith(heatwavedb.NewServiceFromAppContext, (*heatwavedb.Service).ListEnrichedHeatWaveDb, func(d heatwavedb.HeatWaveDatabase) (string, string) {
+				return d.ID, d.DisplayName
+			}),
+		},
+		{
+			Name: resourcetype.CacheCluster,
+			capture: captureWith(cacheclusterdb.NewServiceFromAppContext, (*cacheclusterdb.Service).ListEnrichedCacheClusters, func(c cacheclusterdb.CacheCluster) (string, string) {
+				return c.ID, c.DisplayName
+			}),
+		},
+	}
+}
+
+// TypeName returns the name of the resource type.
+func (t resourceType) TypeName() string {
+	return t.Name
+}
+
+// TypeNames returns the names of all resource types a snapshot captures.
+func TypeNames() []string {
+	return resourcetype.Names(resourceTypes())
+}
+
+// selectTypes returns the resource types matching names, or all types when names is empty.
+func selectTypes(names []string) ([]resourceType, error) {
+	return resourcetype.Select(resourceTypes(), names)
+}
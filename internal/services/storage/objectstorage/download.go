@@ -44,7 +44,8 @@ func DownloadFile(appCtx *app.ApplicationContext) error {
 		return nil
 	}
 
-	bucketModel := osadapter.NewBucketListModel(buckets)
+	bucketModel := osadapter.NewBucketListModel(buckets).
+		WithQueryFilter(ToSearchableBuckets(buckets), GetSearchableFields(), GetBoostedFields())
 	bucketID, err := tui.Run(bucketModel)
 	if err != nil {
 		if errors.Is(err, tui.ErrCancelled) {
@@ -7,23 +7,20 @@ import (
 	"os"
 
 	"github.com/cnopslabs/ocloud/internal/app"
-	"github.com/cnopslabs/ocloud/internal/oci"
 	osadapter "github.com/cnopslabs/ocloud/internal/oci/storage/objectstorage"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/cnopslabs/ocloud/internal/services/util"
 	"github.com/cnopslabs/ocloud/internal/tui"
 )
 
 // ListBuckets retrieves and lists all buckets, allows browsing objects within them,
 // and performs actions (view details or download) on selected objects.
-func ListBuckets(appCtx *app.ApplicationContext, useJSON bool) error {
+func ListBuckets(appCtx *app.ApplicationContext, format printer.OutputFormat) error {
 	ctx := context.Background()
-	client, err := oci.NewObjectStorageClient(appCtx.Provider)
+	service, err := NewServiceFromAppContext(appCtx)
 	if err != nil {
-		return fmt.Errorf("creating object storage client: %w", err)
+		return fmt.Errorf("creating object storage service: %w", err)
 	}
-
-	bucketAdapter := osadapter.NewAdapter(client)
-	service := NewService(bucketAdapter, appCtx.Logger, appCtx.CompartmentID) // Get namespace once for all operations
 	namespace, err := service.GetNamespace(ctx)
 	if err != nil {
 		return fmt.Errorf("getting namespace: %w", err)
@@ -43,7 +40,8 @@ func ListBuckets(appCtx *app.ApplicationContext, useJSON bool) error {
 		}
 
 		// Show bucket list TUI
-		bucketModel := osadapter.NewBucketListModel(buckets)
+		bucketModel := osadapter.NewBucketListModel(buckets).
+			WithQueryFilter(ToSearchableBuckets(buckets), GetSearchableFields(), GetBoostedFields())
 		bucketID, err := tui.Run(bucketModel)
 		if err != nil {
 			if errors.Is(err, tui.ErrCancelled) {
@@ -97,7 +95,7 @@ func ListBuckets(appCtx *app.ApplicationContext, useJSON bool) error {
 				if err != nil {
 					return fmt.Errorf("getting object details: %w", err)
 				}
-				return PrintObjectInfo(obj, appCtx, region, useJSON)
+				return PrintObjectInfo(obj, appCtx, region, format)
 
 			case "download":
 				// Download to current working directory with progress TUI
@@ -11,15 +11,15 @@ import (
 
 // PrintBucketsInfo displays buckets in a formatted table or JSON format.
 // If pagination info is provided, it adjusts and logs it.
-func PrintBucketsInfo(buckets []Bucket, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, useJSON bool) error {
+func PrintBucketsInfo(buckets []Bucket, appCtx *app.ApplicationContext, pagination *util.PaginationInfo, format printer.OutputFormat) error {
 	p := printer.New(appCtx.Stdout)
 
 	if pagination != nil {
 		util.AdjustPaginationInfo(pagination)
 	}
 
-	if useJSON {
-		return util.MarshalDataToJSONResponse[objectstorage.Bucket](p, buckets, pagination)
+	if !format.IsTable() {
+		return util.MarshalScopedResponse(p, format, appCtx, buckets, func(b objectstorage.Bucket) string { return b.Name }, pagination)
 	}
 
 	if util.ValidateAndReportEmpty(buckets, pagination, appCtx.Stdout) {
@@ -47,7 +47,7 @@ func PrintBucketsInfo(buckets []Bucket, appCtx *app.ApplicationContext, paginati
 			"Name", "OCID", "Namespace", "Created", "StorageTier", "Visibility", "Encryption", "Versioning", "ReplicationEnabled", "ReadOnly", "ApproximateCount", "ApproximateSize", "ApproximateSizeBytes",
 		}
 
-		title := util.FormatColoredTitle(appCtx, bucket.Name)
+		title := util.FormatColoredResourceTitle(appCtx, bucket.Name, bucket.Name)
 		p.PrintKeyValues(title, bucketData, orderedKeys)
 	}
 
@@ -55,11 +55,11 @@ func PrintBucketsInfo(buckets []Bucket, appCtx *app.ApplicationContext, paginati
 	return nil
 }
 
-func PrintBucketInfo(bucket *Bucket, appCtx *app.ApplicationContext, useJSON bool) error {
+func PrintBucketInfo(bucket *Bucket, appCtx *app.ApplicationContext, format printer.OutputFormat) error {
 	p := printer.New(appCtx.Stdout)
 
-	if useJSON {
-		return p.MarshalToJSON(bucket)
+	if !format.IsTable() {
+		return p.Marshal(format, bucket)
 	}
 
 	bucketData := map[string]string{
@@ -82,18 +82,18 @@ func PrintBucketInfo(bucket *Bucket, appCtx *app.ApplicationContext, useJSON boo
 		"Name", "OCID", "Namespace", "Created", "StorageTier", "Visibility", "Encryption", "Versioning", "ReplicationEnabled", "ReadOnly", "ApproximateCount", "ApproximateSize", "ApproximateSizeBytes",
 	}
 
-	title := util.FormatColoredTitle(appCtx, bucket.Name)
+	title := util.FormatColoredResourceTitle(appCtx, bucket.Name, bucket.Name)
 	p.PrintKeyValues(title, bucketData, orderedKeys)
 
 	return nil
 }
 
 // PrintObjectInfo displays object details in a formatted table or JSON format.
-func PrintObjectInfo(obj *Object, appCtx *app.ApplicationContext, region string, useJSON bool) error {
+func PrintObjectInfo(obj *Object, appCtx *app.ApplicationContext, region string, format printer.OutputFormat) error {
 	p := printer.New(appCtx.Stdout)
 
-	if useJSON {
-		return p.MarshalToJSON(obj)
+	if !format.IsTable() {
+		return p.Marshal(format, obj)
 	}
 
 	// Generate URLs
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/printer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -17,7 +18,7 @@ func TestPrintBucketsInfo_TableAndJSON(t *testing.T) {
 	b2 := makeBucket(1)
 
 	// Table output
-	err := PrintBucketsInfo([]Bucket{b1, b2}, appCtx, nil, false)
+	err := PrintBucketsInfo([]Bucket{b1, b2}, appCtx, nil, printer.TableOutput)
 	assert.NoError(t, err)
 	out := buf.String()
 	assert.Contains(t, out, b1.Name)
@@ -25,7 +26,7 @@ func TestPrintBucketsInfo_TableAndJSON(t *testing.T) {
 	buf.Reset()
 
 	// JSON output
-	err = PrintBucketsInfo([]Bucket{b1, b2}, appCtx, nil, true)
+	err = PrintBucketsInfo([]Bucket{b1, b2}, appCtx, nil, printer.JSONOutput)
 	assert.NoError(t, err)
 	jsonOut := buf.String()
 	if assert.NotEmpty(t, jsonOut) {
@@ -42,14 +43,14 @@ func TestPrintBucketInfo_TableAndJSON(t *testing.T) {
 	b := makeBucket(0)
 
 	// Table output
-	err := PrintBucketInfo(&b, appCtx, false)
+	err := PrintBucketInfo(&b, appCtx, printer.TableOutput)
 	assert.NoError(t, err)
 	out := buf.String()
 	assert.Contains(t, out, b.Name)
 	buf.Reset()
 
 	// JSON output
-	err = PrintBucketInfo(&b, appCtx, true)
+	err = PrintBucketInfo(&b, appCtx, printer.JSONOutput)
 	assert.NoError(t, err)
 	jsonOut := buf.String()
 	if assert.NotEmpty(t, jsonOut) {
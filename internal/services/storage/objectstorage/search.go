@@ -6,25 +6,22 @@ import (
 
 	"github.com/cnopslabs/ocloud/internal/app"
 	"github.com/cnopslabs/ocloud/internal/logger"
-	"github.com/cnopslabs/ocloud/internal/oci"
-	ociobj "github.com/cnopslabs/ocloud/internal/oci/storage/objectstorage"
+	"github.com/cnopslabs/ocloud/internal/printer"
 )
 
-func SearchBuckets(appCtx *app.ApplicationContext, pattern string, useJSON bool) error {
+func SearchBuckets(appCtx *app.ApplicationContext, pattern string, format printer.OutputFormat) error {
 	ctx := context.Background()
-	client, err := oci.NewObjectStorageClient(appCtx.Provider)
+	svc, err := NewServiceFromAppContext(appCtx)
 	if err != nil {
-		return fmt.Errorf("creating object storage client: %w", err)
+		return fmt.Errorf("creating object storage service: %w", err)
 	}
-
-	adapter := ociobj.NewAdapter(client)
-	svc := NewService(adapter, appCtx.Logger, appCtx.CompartmentID)
+	svc.indexStore = appCtx.Cache
 
 	buckets, err := svc.FuzzySearch(ctx, pattern)
 	if err != nil {
 		return fmt.Errorf("searching buckets: %w", err)
 	}
-	if err := PrintBucketsInfo(buckets, appCtx, nil, useJSON); err != nil {
+	if err := PrintBucketsInfo(buckets, appCtx, nil, format); err != nil {
 		return fmt.Errorf("printing buckets: %w", err)
 	}
 	logger.LogWithLevel(logger.CmdLogger, logger.Info, "Found matching buckets", "search", pattern, "matched", len(buckets))
@@ -4,10 +4,16 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/cache"
 	storage "github.com/cnopslabs/ocloud/internal/domain/storage/objectstorage"
 	"github.com/cnopslabs/ocloud/internal/logger"
+	"github.com/cnopslabs/ocloud/internal/oci"
+	ociobj "github.com/cnopslabs/ocloud/internal/oci/storage/objectstorage"
+	"github.com/cnopslabs/ocloud/internal/region"
 	"github.com/cnopslabs/ocloud/internal/services/search"
 	"github.com/cnopslabs/ocloud/internal/services/util"
+	"github.com/cnopslabs/ocloud/internal/subtree"
 	"github.com/go-logr/logr"
 )
 
@@ -15,6 +21,7 @@ type Service struct {
 	osRepo        storage.ObjectStorageRepository
 	logger        logr.Logger
 	CompartmentID string
+	indexStore    *cache.Store
 }
 
 func NewService(repo storage.ObjectStorageRepository, logger logr.Logger, compartmentID string) *Service {
@@ -25,6 +32,30 @@ func NewService(repo storage.ObjectStorageRepository, logger logr.Logger, compar
 	}
 }
 
+// NewServiceFromAppContext creates a Service backed by the OCI adapter and the
+// resource cache of the application context.
+func NewServiceFromAppContext(appCtx *app.ApplicationContext) (*Service, error) {
+	repo, err := newRepository(appCtx)
+	if err != nil {
+		return nil, err
+	}
+	service := NewService(repo, appCtx.Logger, appCtx.CompartmentID)
+	service.indexStore = appCtx.Cache
+	return service, nil
+}
+
+// newRepository builds the object storage repository of appCtx.
+func newRepository(appCtx *app.ApplicationContext) (storage.ObjectStorageRepository, error) {
+	return region.NewObjectStorageRepository(appCtx.Regions, func(name string) (storage.ObjectStorageRepository, error) {
+		regional := appCtx.ForRegion(name)
+		client, err := oci.NewObjectStorageClient(regional.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("creating object storage client: %w", err)
+		}
+		return subtree.NewObjectStorageRepository(ociobj.NewAdapter(client), regional.Subtree), nil
+	})
+}
+
 func (s *Service) ListBuckets(ctx context.Context) ([]Bucket, error) {
 	s.logger.V(logger.Debug).Info("listing object storage buckets")
 	buckets, err := s.osRepo.ListBuckets(ctx, s.CompartmentID)
@@ -69,41 +100,38 @@ func (s *Service) FetchPaginatedBuckets(ctx context.Context, limit, pageNum int)
 }
 
 func (s *Service) FuzzySearch(ctx context.Context, searchPattern string) ([]Bucket, error) {
+	matches, err := s.ScoredSearch(ctx, searchPattern)
+	if err != nil {
+		return nil, err
+	}
+	return search.Items(matches), nil
+}
+
+// ScoredSearch performs a fuzzy search for buckets and returns the matches,
+// best first, with their relevance scores.
+func (s *Service) ScoredSearch(ctx context.Context, searchPattern string) ([]search.Match[Bucket], error) {
 	s.logger.V(logger.Debug).Info("searching object storage buckets", "pattern", searchPattern)
 	// List and enrich buckets similar to ListBuckets behavior
-	all, err := s.osRepo.ListBuckets(ctx, s.CompartmentID)
+	all, err := s.listEnrichedBuckets(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("fetching all buckets for search: %w", err)
 	}
-	for i := range all {
-		name := all[i].Name
-		if name == "" {
-			continue
-		}
-		if full, e := s.osRepo.GetBucketByName(ctx, s.CompartmentID, name); e == nil && full != nil {
-			all[i] = *full
-		}
-	}
 
 	// Build the search index using the common search package and the bucket searcher adapter.
 	indexables := ToSearchableBuckets(all)
 	idxMapping := search.NewIndexMapping(GetSearchableFields())
-	idx, err := search.BuildIndex(indexables, idxMapping)
+	idx, err := search.OpenIndex(s.indexStore, s.CompartmentID, cache.ResourceBuckets, indexables, idxMapping)
 	if err != nil {
 		return nil, fmt.Errorf("building search index: %w", err)
 	}
+	defer func() { _ = idx.Close() }()
 
-	matchedIdxs, err := search.FuzzySearch(idx, searchPattern, GetSearchableFields(), GetBoostedFields())
+	matchedIdxs, err := search.ScoredFuzzySearch(idx, searchPattern, GetSearchableFields(), GetBoostedFields())
 	if err != nil {
 		return nil, fmt.Errorf("performing fuzzy search: %w", err)
 	}
 
-	results := make([]Bucket, 0, len(matchedIdxs))
-	for _, i := range matchedIdxs {
-		if i >= 0 && i < len(all) {
-			results = append(results, all[i])
-		}
-	}
+	results := search.Rank(all, matchedIdxs)
 	return results, nil
 }
 
@@ -136,3 +164,36 @@ func (s *Service) UploadObject(ctx context.Context, namespace, bucketName, objec
 	s.logger.V(logger.Debug).Info("uploading object", "bucket", bucketName, "object", objectName, "file", filePath)
 	return s.osRepo.UploadObject(ctx, namespace, bucketName, objectName, filePath, progressFn)
 }
+
+// listEnrichedBuckets lists buckets and replaces each summary with its full details when available.
+func (s *Service) listEnrichedBuckets(ctx context.Context) ([]Bucket, error) {
+	all, err := s.osRepo.ListBuckets(ctx, s.CompartmentID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range all {
+		name := all[i].Name
+		if name == "" {
+			continue
+		}
+		if full, e := s.osRepo.GetBucketByName(ctx, s.CompartmentID, name); e == nil && full != nil {
+			all[i] = *full
+		}
+	}
+	return all, nil
+}
+
+// Reindex lists buckets and rebuilds their persisted search index.
+// It returns the number of indexed buckets.
+func (s *Service) Reindex(ctx context.Context) (int, error) {
+	all, err := s.listEnrichedBuckets(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fetching buckets: %w", err)
+	}
+
+	idx, err := search.OpenIndex(s.indexStore, s.CompartmentID, cache.ResourceBuckets, ToSearchableBuckets(all), search.NewIndexMapping(GetSearchableFields()))
+	if err != nil {
+		return 0, fmt.Errorf("building search index: %w", err)
+	}
+	return len(all), idx.Close()
+}
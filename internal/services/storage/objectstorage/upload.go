@@ -43,7 +43,8 @@ func UploadFile(appCtx *app.ApplicationContext) error {
 		return nil
 	}
 
-	bucketModel := osadapter.NewBucketListModel(buckets)
+	bucketModel := osadapter.NewBucketListModel(buckets).
+		WithQueryFilter(ToSearchableBuckets(buckets), GetSearchableFields(), GetBoostedFields())
 	bucketID, err := tui.Run(bucketModel)
 	if err != nil {
 		if errors.Is(err, tui.ErrCancelled) {
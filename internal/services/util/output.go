@@ -1,6 +1,8 @@
 package util
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -11,17 +13,125 @@ import (
 
 // MarshalDataToJSONResponse now accepts a printer and returns an error.
 func MarshalDataToJSONResponse[T any](p *printer.Printer, items []T, pagination *PaginationInfo) error {
+	return MarshalDataResponse(p, printer.JSONOutput, items, pagination)
+}
+
+// MarshalDataResponse writes items in the requested structured format.
+// JSON, YAML and template outputs wrap the items in a JSONResponse with pagination;
+// CSV and TSV outputs emit one row per item. A --query/--fields projection is
+// applied to the items themselves, so "[].Name" selects the name of every item.
+func MarshalDataResponse[T any](p *printer.Printer, format printer.OutputFormat, items []T, pagination *PaginationInfo) error {
+	if format.IsDelimited() || format.HasProjection() {
+		return p.Marshal(format, items)
+	}
 	response := JSONResponse[T]{
 		Items:      items,
 		Pagination: pagination,
 	}
-	return p.MarshalToJSON(response)
+	return p.Marshal(format, response)
+}
+
+// MarshalScopedResponse writes items like MarshalDataResponse. When the listing
+// spans a compartment subtree (--recursive) or several regions (--regions), each
+// item is prefixed with the CompartmentPath and Region it was listed from; idOf
+// returns the key the item was recorded under.
+func MarshalScopedResponse[T any](p *printer.Printer, format printer.OutputFormat, appCtx *app.ApplicationContext, items []T, idOf func(T) string, pagination *PaginationInfo) error {
+	if appCtx.Subtree == nil && appCtx.Regions == nil {
+		return MarshalDataResponse(p, format, items, pagination)
+	}
+	scoped := make([]ScopedItem[T], len(items))
+	for i, item := range items {
+		id := idOf(item)
+		scoped[i] = ScopedItem[T]{
+			Region:          appCtx.Regions.RegionOf(id),
+			CompartmentPath: appCtx.Subtree.PathOf(id),
+			Item:            item,
+		}
+	}
+	return MarshalDataResponse(p, format, scoped, pagination)
+}
+
+// MarshalJSON flattens the item's fields next to Region and CompartmentPath so
+// that projections see the same field names as without them. Delimited output
+// does not go through JSON; it inlines Item through its struct tag instead.
+func (s ScopedItem[T]) MarshalJSON() ([]byte, error) {
+	item, err := json.Marshal(s.Item)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for _, field := range []struct{ key, value string }{
+		{"Region", s.Region},
+		{"CompartmentPath", s.CompartmentPath},
+	} {
+		if field.value == "" {
+			continue
+		}
+		value, err := json.Marshal(field.value)
+		if err != nil {
+			return nil, err
+		}
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		fmt.Fprintf(&buf, "%q:", field.key)
+		buf.Write(value)
+	}
+	switch trimmed := bytes.TrimSpace(item); {
+	case bytes.Equal(trimmed, []byte("{}")):
+		buf.WriteByte('}')
+	case len(trimmed) > 0 && trimmed[0] == '{':
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		buf.Write(trimmed[1:])
+	default:
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(`"Item":`)
+		buf.Write(trimmed)
+		buf.WriteByte('}')
+	}
+	return buf.Bytes(), nil
+}
+
+// CompartmentPath returns the path of the compartment the resource with the
+// given ID was listed from under --recursive, or "" otherwise.
+func CompartmentPath(appCtx *app.ApplicationContext, id string) string {
+	return appCtx.Subtree.PathOf(id)
+}
+
+// Region returns the region the resource with the given ID was listed from
+// under --regions, or "" otherwise.
+func Region(appCtx *app.ApplicationContext, id string) string {
+	return appCtx.Regions.RegionOf(id)
 }
 
 // FormatColoredTitle builds a colorized title string with tenancy, compartment, and cluster.
 func FormatColoredTitle(appCtx *app.ApplicationContext, name string) string {
-	coloredTenancy := text.Colors{text.FgMagenta}.Sprint(appCtx.TenancyName)
-	coloredCompartment := text.Colors{text.FgCyan}.Sprint(appCtx.CompartmentName)
+	return formatColoredTitle(appCtx.TenancyName, appCtx.CompartmentName, name)
+}
+
+// FormatColoredResourceTitle builds the title of a single resource. Under
+// --recursive the compartment part is the path the resource was listed from,
+// and under --regions it names the region as well.
+func FormatColoredResourceTitle(appCtx *app.ApplicationContext, id, name string) string {
+	compartment := CompartmentPath(appCtx, id)
+	if compartment == "" {
+		compartment = appCtx.CompartmentName
+	}
+	if region := Region(appCtx, id); region != "" {
+		compartment += " (" + region + ")"
+	}
+	return formatColoredTitle(appCtx.TenancyName, compartment, name)
+}
+
+func formatColoredTitle(tenancy, compartment, name string) string {
+	coloredTenancy := text.Colors{text.FgMagenta}.Sprint(tenancy)
+	coloredCompartment := text.Colors{text.FgCyan}.Sprint(compartment)
 	coloredName := text.Colors{text.FgBlue}.Sprint(name)
 	title := fmt.Sprintf("%s: %s: %s", coloredTenancy, coloredCompartment, coloredName)
 
@@ -2,11 +2,15 @@ package util
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"testing"
 
 	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
 	"github.com/cnopslabs/ocloud/internal/printer"
+	"github.com/cnopslabs/ocloud/internal/region"
+	"github.com/cnopslabs/ocloud/internal/subtree"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -64,6 +68,127 @@ func TestMarshalDataToJSON(t *testing.T) {
 	assert.Equal(t, pagination, response.Pagination, "Pagination should match")
 }
 
+// TestMarshalDataResponse tests structured formats and projections of MarshalDataResponse
+func TestMarshalDataResponse(t *testing.T) {
+	type item struct {
+		Name  string `json:"Name"`
+		State string `json:"State"`
+	}
+	items := []item{{Name: "a", State: "RUNNING"}, {Name: "b", State: "STOPPED"}}
+	pagination := &PaginationInfo{CurrentPage: 1, TotalCount: 2, Limit: 20}
+
+	var buf bytes.Buffer
+	p := printer.New(&buf)
+
+	// CSV emits rows only, without the pagination envelope
+	err := MarshalDataResponse(p, printer.OutputFormat{Format: printer.FormatCSV}, items, pagination)
+	assert.NoError(t, err)
+	assert.Equal(t, "Name,State\na,RUNNING\nb,STOPPED\n", buf.String())
+
+	// YAML keeps the items/pagination envelope
+	buf.Reset()
+	err = MarshalDataResponse(p, printer.OutputFormat{Format: printer.FormatYAML}, items, pagination)
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), "items:\n")
+	assert.Contains(t, buf.String(), "pagination:\n")
+
+	// Queries are evaluated against the items
+	buf.Reset()
+	format := printer.OutputFormat{Format: printer.FormatJSON, Query: "[?State=='RUNNING'].Name"}
+	err = MarshalDataResponse(p, format, items, pagination)
+	assert.NoError(t, err)
+	var names []string
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &names))
+	assert.Equal(t, []string{"a"}, names)
+}
+
+// TestMarshalScopedResponse tests that items listed through a subtree carry their compartment path
+func TestMarshalScopedResponse(t *testing.T) {
+	type item struct {
+		ID   string `json:"ID"`
+		Name string `json:"Name"`
+	}
+	tree := subtree.New([]subtree.Node{{ID: "root", Path: "tenancy"}, {ID: "child", Path: "tenancy/child"}}, 1)
+	items, err := subtree.List(context.Background(), tree, "root", func(ctx context.Context, id string) ([]item, error) {
+		return []item{{ID: "i-" + id, Name: id}}, nil
+	}, func(i item) string { return i.ID })
+	assert.NoError(t, err)
+
+	var buf bytes.Buffer
+	p := printer.New(&buf)
+	idOf := func(i item) string { return i.ID }
+
+	// Without a subtree the output is unchanged
+	err = MarshalScopedResponse(p, printer.OutputFormat{Format: printer.FormatCSV}, &app.ApplicationContext{}, items, idOf, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "ID,Name\ni-root,root\ni-child,child\n", buf.String())
+
+	// With a subtree every row starts with its compartment path
+	buf.Reset()
+	appCtx := &app.ApplicationContext{Subtree: tree}
+	err = MarshalScopedResponse(p, printer.OutputFormat{Format: printer.FormatJSON}, appCtx, items, idOf, nil)
+	assert.NoError(t, err)
+	var response JSONResponse[map[string]string]
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &response))
+	assert.Equal(t, []map[string]string{
+		{"CompartmentPath": "tenancy", "ID": "i-root", "Name": "root"},
+		{"CompartmentPath": "tenancy/child", "ID": "i-child", "Name": "child"},
+	}, response.Items)
+
+	title := FormatColoredResourceTitle(appCtx, "i-child", "child")
+	assert.Contains(t, title, "tenancy/child")
+}
+
+// TestMarshalScopedResponse_Regions tests that items listed across regions carry their region
+func TestMarshalScopedResponse_Regions(t *testing.T) {
+	type item struct {
+		ID string `json:"ID"`
+	}
+	set := region.NewSet([]string{"us-ashburn-1", "eu-frankfurt-1"})
+	repo, err := region.NewImageRepository(set, func(name string) (compute.ImageRepository, error) {
+		return fakeImageRepository{region: name}, nil
+	})
+	assert.NoError(t, err)
+	images, err := repo.ListImages(context.Background(), "c")
+	assert.NoError(t, err)
+
+	items := make([]item, len(images))
+	for i, image := range images {
+		items[i] = item{ID: image.OCID}
+	}
+
+	var buf bytes.Buffer
+	p := printer.New(&buf)
+	appCtx := &app.ApplicationContext{Regions: set}
+	err = MarshalScopedResponse(p, printer.OutputFormat{Format: printer.FormatJSON}, appCtx, items, func(i item) string { return i.ID }, nil)
+	assert.NoError(t, err)
+	var response JSONResponse[map[string]string]
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &response))
+	assert.Equal(t, []map[string]string{
+		{"Region": "us-ashburn-1", "ID": "img-us-ashburn-1"},
+		{"Region": "eu-frankfurt-1", "ID": "img-eu-frankfurt-1"},
+	}, response.Items, "results keep region order and omit the empty compartment path")
+
+	// CSV columns use the item's field names too
+	buf.Reset()
+	err = MarshalScopedResponse(p, printer.OutputFormat{Format: printer.FormatCSV}, appCtx, items, func(i item) string { return i.ID }, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Region,CompartmentPath,ID\nus-ashburn-1,,img-us-ashburn-1\neu-frankfurt-1,,img-eu-frankfurt-1\n", buf.String())
+
+	title := FormatColoredResourceTitle(appCtx, "img-eu-frankfurt-1", "image")
+	assert.Contains(t, title, "eu-frankfurt-1")
+}
+
+// fakeImageRepository returns one image named after its region.
+type fakeImageRepository struct {
+	compute.ImageRepository
+	region string
+}
+
+func (f fakeImageRepository) ListImages(ctx context.Context, compartmentID string) ([]compute.Image, error) {
+	return []compute.Image{{OCID: "img-" + f.region}}, nil
+}
+
 // TestFormatColoredTitle tests the FormatColoredTitle function
 func TestFormatColoredTitle(t *testing.T) {
 	// Create a test application context
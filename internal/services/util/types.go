@@ -21,3 +21,11 @@ type JSONResponse[T any] struct {
 	Items      []T             `json:"items"`
 	Pagination *PaginationInfo `json:"pagination,omitempty"`
 }
+
+// ScopedItem pairs an item with the region and the path of the compartment it
+// was listed from when a listing spans several regions or a compartment subtree.
+type ScopedItem[T any] struct {
+	Region          string
+	CompartmentPath string
+	Item            T `json:",inline"`
+}
@@ -0,0 +1,27 @@
+package util
+
+import (
+	"context"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/watch"
+)
+
+// Watch runs poll once, or, when --watch set appCtx.Watch, re-runs it at the
+// requested interval and reports the state transitions between runs.
+func Watch(ctx context.Context, appCtx *app.ApplicationContext, poll watch.Poll) error {
+	if appCtx.Watch == nil {
+		_, err := poll(ctx)
+		return err
+	}
+	return watch.Run(ctx, *appCtx.Watch, poll)
+}
+
+// WatchStates maps the items printed by a poll to the states compared between polls.
+func WatchStates[T any](items []T, state func(T) watch.State) []watch.State {
+	states := make([]watch.State, 0, len(items))
+	for _, item := range items {
+		states = append(states, state(item))
+	}
+	return states
+}
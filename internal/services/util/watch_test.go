@@ -0,0 +1,38 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cnopslabs/ocloud/internal/app"
+	"github.com/cnopslabs/ocloud/internal/watch"
+)
+
+func TestWatch(t *testing.T) {
+	calls := 0
+	poll := func(context.Context) ([]watch.State, error) {
+		calls++
+		return []watch.State{{ID: "1", Name: "db", State: "AVAILABLE"}}, nil
+	}
+
+	require.NoError(t, Watch(context.Background(), &app.ApplicationContext{}, poll))
+	assert.Equal(t, 1, calls, "without --watch the poll runs once")
+
+	calls = 0
+	appCtx := &app.ApplicationContext{Watch: &watch.Options{Interval: time.Millisecond, UntilState: "available", Out: &bytes.Buffer{}}}
+	require.NoError(t, Watch(context.Background(), appCtx, poll))
+	assert.Equal(t, 1, calls, "the watch ends as soon as the target state is reached")
+}
+
+func TestWatchStates(t *testing.T) {
+	type vm struct{ id, name, state string }
+	states := WatchStates([]vm{{"1", "web", "RUNNING"}}, func(v vm) watch.State {
+		return watch.State{ID: v.id, Name: v.name, State: v.state}
+	})
+	assert.Equal(t, []watch.State{{ID: "1", Name: "web", State: "RUNNING"}}, states)
+}
@@ -0,0 +1,165 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// Kinds of change reported by Diff.
+const (
+	Added    = "added"
+	Removed  = "removed"
+	Modified = "modified"
+)
+
+// Change describes how one resource differs between two snapshots.
+type Change struct {
+	Kind   string        `json:"Kind"`
+	Type   string        `json:"Type"`
+	ID     string        `json:"ID"`
+	Name   string        `json:"Name"`
+	Fields []FieldChange `json:"Fields,omitempty"`
+}
+
+// FieldChange is one field whose value differs. Nested fields are named by
+// their path, e.g. "FreeformTags.env" or "PrivateIPs[0]".
+type FieldChange struct {
+	Field string `json:"Field"`
+	Old   string `json:"Old"`
+	New   string `json:"New"`
+}
+
+// Diff reports the resources added, removed and modified from a to b, sorted
+// by type, name and ID. Only resource types captured by both snapshots are
+// compared.
+func Diff(a, b *Snapshot) ([]Change, error) {
+	types := commonTypes(a.Types, b.Types)
+	before := index(a.Resources, types)
+	after := index(b.Resources, types)
+
+	var changes []Change
+	for key, old := range before {
+		cur, ok := after[key]
+		if !ok {
+			changes = append(changes, Change{Kind: Removed, Type: old.Type, ID: old.ID, Name: old.Name})
+			continue
+		}
+		fields, err := diffFields(old.Data, cur.Data)
+		if err != nil {
+			return nil, fmt.Errorf("comparing %s %s: %w", old.Type, old.Name, err)
+		}
+		if len(fields) > 0 {
+			changes = append(changes, Change{Kind: Modified, Type: cur.Type, ID: cur.ID, Name: cur.Name, Fields: fields})
+		}
+	}
+	for key, cur := range after {
+		if _, ok := before[key]; !ok {
+			changes = append(changes, Change{Kind: Added, Type: cur.Type, ID: cur.ID, Name: cur.Name})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		x, y := changes[i], changes[j]
+		if x.Type != y.Type {
+			return x.Type < y.Type
+		}
+		if x.Name != y.Name {
+			return x.Name < y.Name
+		}
+		return x.ID < y.ID
+	})
+	return changes, nil
+}
+
+// commonTypes returns the resource types present in both lists.
+func commonTypes(a, b []string) map[string]bool {
+	inA := make(map[string]bool, len(a))
+	for _, t := range a {
+		inA[t] = true
+	}
+	common := map[string]bool{}
+	for _, t := range b {
+		if inA[t] {
+			common[t] = true
+		}
+	}
+	return common
+}
+
+// index keys the resources of the given types by type and ID.
+func index(resources []Resource, types map[string]bool) map[string]Resource {
+	byKey := make(map[string]Resource, len(resources))
+	for _, r := range resources {
+		if types[r.Type] {
+			byKey[r.Type+"/"+r.ID] = r
+		}
+	}
+	return byKey
+}
+
+// diffFields compares two JSON documents leaf by leaf.
+func diffFields(a, b json.RawMessage) ([]FieldChange, error) {
+	before, err := flattenJSON(a)
+	if err != nil {
+		return nil, err
+	}
+	after, err := flattenJSON(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []FieldChange
+	for path, old := range before {
+		if cur := after[path]; cur != old {
+			fields = append(fields, FieldChange{Field: path, Old: old, New: cur})
+		}
+	}
+	for path, cur := range after {
+		if _, ok := before[path]; !ok {
+			fields = append(fields, FieldChange{Field: path, New: cur})
+		}
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Field < fields[j].Field })
+	return fields, nil
+}
+
+// flattenJSON maps every leaf of a JSON document to its string value. Null
+// values and empty objects or arrays produce no leaf, so they compare equal
+// to a missing field.
+func flattenJSON(data json.RawMessage) (map[string]string, error) {
+	leaves := map[string]string{}
+	if len(data) == 0 {
+		return leaves, nil
+	}
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	flatten("", v, leaves)
+	return leaves, nil
+}
+
+func flatten(path string, v any, leaves map[string]string) {
+	switch v := v.(type) {
+	case nil:
+	case map[string]any:
+		for k, child := range v {
+			if path != "" {
+				k = path + "." + k
+			}
+			flatten(k, child, leaves)
+		}
+	case []any:
+		for i, child := range v {
+			flatten(path+"["+strconv.Itoa(i)+"]", child, leaves)
+		}
+	case string:
+		leaves[path] = v
+	case float64:
+		leaves[path] = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		leaves[path] = fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,150 @@
+// Package snapshot stores point-in-time copies of OCI resources and compares them.
+//
+// Snapshots live under ~/.ocloud/snapshots/<name>.json. Each resource keeps its
+// enriched domain model as raw JSON so that snapshots taken by different
+// versions of ocloud can still be compared field by field.
+package snapshot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/cnopslabs/ocloud/internal/config/flags"
+)
+
+// FormatVersion is the version of the snapshot file layout written by Save.
+// Load rejects files written with a newer layout.
+const FormatVersion = 1
+
+// fileExt is the suffix of snapshot files.
+const fileExt = ".json"
+
+// validName restricts snapshot names to characters that are safe in file names.
+var validName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// Snapshot is the on-disk representation of the resources of a scope at one point in time.
+type Snapshot struct {
+	Version       int       `json:"version"`
+	Name          string    `json:"name"`
+	CreatedAt     time.Time `json:"createdAt"`
+	TenancyName   string    `json:"tenancyName"`
+	TenancyID     string    `json:"tenancyId"`
+	Compartment   string    `json:"compartment"`
+	CompartmentID string    `json:"compartmentId"`
+	// Types lists the resource types captured by the snapshot. A type that
+	// could not be listed is left out so that it is not reported as removed.
+	Types     []string   `json:"types"`
+	Resources []Resource `json:"resources"`
+}
+
+// Resource is one captured resource. ID identifies it within its type: its OCID,
+// or for buckets their namespace and name. Data holds its enriched domain model.
+type Resource struct {
+	Type            string          `json:"type"`
+	ID              string          `json:"id"`
+	Name            string          `json:"name"`
+	Region          string          `json:"region,omitempty"`
+	CompartmentPath string          `json:"compartmentPath,omitempty"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// NewResource captures item as a resource of the given type.
+func NewResource(resourceType, id, name string, item any) (Resource, error) {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return Resource{}, fmt.Errorf("marshalling %s %q: %w", resourceType, name, err)
+	}
+	return Resource{Type: resourceType, ID: id, Name: name, Data: data}, nil
+}
+
+// DefaultDir returns ~/.ocloud/snapshots.
+func DefaultDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("getting user home directory: %w", err)
+	}
+	return filepath.Join(home, flags.OCloudDefaultDirName, flags.OCloudSnapshotsDirName), nil
+}
+
+// ValidateName reports whether name can be used as a snapshot name.
+func ValidateName(name string) error {
+	if !validName.MatchString(name) {
+		return fmt.Errorf("invalid snapshot name %q: use letters, digits, '.', '_' and '-'", name)
+	}
+	return nil
+}
+
+// Path returns the file of the snapshot called name in dir.
+func Path(dir, name string) string {
+	return filepath.Join(dir, name+fileExt)
+}
+
+// Save writes s to dir as <name>.json, replacing an existing snapshot of the
+// same name, and returns the path written.
+func Save(dir string, s *Snapshot) (string, error) {
+	if err := ValidateName(s.Name); err != nil {
+		return "", err
+	}
+	s.Version = FormatVersion
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshalling snapshot: %w", err)
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("creating snapshot directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, ".tmp-*"+fileExt)
+	if err != nil {
+		return "", fmt.Errorf("creating temp snapshot file: %w", err)
+	}
+	tmpName := tmp.Name()
+	defer func() { _ = os.Remove(tmpName) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return "", fmt.Errorf("writing temp snapshot file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("closing temp snapshot file: %w", err)
+	}
+	if err := os.Chmod(tmpName, 0o600); err != nil {
+		return "", fmt.Errorf("setting snapshot file permissions: %w", err)
+	}
+	path := Path(dir, s.Name)
+	if err := os.Rename(tmpName, path); err != nil {
+		return "", fmt.Errorf("renaming snapshot file: %w", err)
+	}
+	return path, nil
+}
+
+// Load reads a snapshot. ref is either the name of a snapshot in dir or the
+// path of a snapshot file.
+func Load(dir, ref string) (*Snapshot, error) {
+	path := ref
+	if !strings.ContainsRune(ref, os.PathSeparator) && !strings.HasSuffix(ref, fileExt) {
+		path = Path(dir, ref)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("snapshot %q not found in %s", ref, dir)
+		}
+		return nil, fmt.Errorf("reading snapshot %q: %w", ref, err)
+	}
+	var s Snapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("parsing snapshot %q: %w", ref, err)
+	}
+	if s.Version < 1 || s.Version > FormatVersion {
+		return nil, fmt.Errorf("snapshot %q has unsupported format version %d (supported: up to %d)", ref, s.Version, FormatVersion)
+	}
+	return &s, nil
+}
@@ -0,0 +1,96 @@
+package snapshot
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type vm struct {
+	Name  string            `json:"Name"`
+	Shape string            `json:"Shape"`
+	IPs   []string          `json:"IPs,omitempty"`
+	Tags  map[string]string `json:"Tags,omitempty"`
+	OCPUs float64           `json:"OCPUs"`
+}
+
+func mustResource(t *testing.T, id string, item vm) Resource {
+	t.Helper()
+	r, err := NewResource("instance", id, item.Name, item)
+	require.NoError(t, err)
+	return r
+}
+
+func TestSaveLoad_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	s := &Snapshot{
+		Name:      "prod-2026-10-16",
+		CreatedAt: time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC),
+		Types:     []string{"instance"},
+		Resources: []Resource{mustResource(t, "i1", vm{Name: "web", Shape: "VM.Standard.E4.Flex"})},
+	}
+
+	path, err := Save(dir, s)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "prod-2026-10-16.json"), path)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	byName, err := Load(dir, "prod-2026-10-16")
+	require.NoError(t, err)
+	assert.Equal(t, FormatVersion, byName.Version)
+	assert.Equal(t, s.Resources[0].ID, byName.Resources[0].ID)
+	assert.JSONEq(t, string(s.Resources[0].Data), string(byName.Resources[0].Data))
+
+	byPath, err := Load(dir, path)
+	require.NoError(t, err)
+	assert.Equal(t, byName.Name, byPath.Name)
+}
+
+func TestSave_RejectsInvalidName(t *testing.T) {
+	_, err := Save(t.TempDir(), &Snapshot{Name: "../escape"})
+	assert.Error(t, err)
+}
+
+func TestLoad_Errors(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := Load(dir, "missing")
+	assert.ErrorContains(t, err, "not found")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "future.json"), []byte(`{"version": 99}`), 0o600))
+	_, err = Load(dir, "future")
+	assert.ErrorContains(t, err, "unsupported format version 99")
+}
+
+func TestDiff(t *testing.T) {
+	a := &Snapshot{Types: []string{"instance", "bucket"}, Resources: []Resource{
+		mustResource(t, "i1", vm{Name: "web", Shape: "VM.Standard.E4.Flex", IPs: []string{"10.0.0.2"}, OCPUs: 2}),
+		mustResource(t, "i2", vm{Name: "old", Shape: "VM.Standard2.1"}),
+		mustResource(t, "i3", vm{Name: "same", Shape: "VM.Standard2.1"}),
+		{Type: "bucket", ID: "logs", Name: "logs"},
+	}}
+	b := &Snapshot{Types: []string{"instance"}, Resources: []Resource{
+		mustResource(t, "i1", vm{Name: "web", Shape: "VM.Standard.E5.Flex", IPs: []string{"10.0.0.2"}, Tags: map[string]string{"env": "prod"}, OCPUs: 2.5}),
+		mustResource(t, "i3", vm{Name: "same", Shape: "VM.Standard2.1"}),
+		mustResource(t, "i4", vm{Name: "new", Shape: "VM.Standard2.1"}),
+	}}
+
+	changes, err := Diff(a, b)
+	require.NoError(t, err)
+	assert.Equal(t, []Change{
+		{Kind: Added, Type: "instance", ID: "i4", Name: "new"},
+		{Kind: Removed, Type: "instance", ID: "i2", Name: "old"},
+		{Kind: Modified, Type: "instance", ID: "i1", Name: "web", Fields: []FieldChange{
+			{Field: "OCPUs", Old: "2", New: "2.5"},
+			{Field: "Shape", Old: "VM.Standard.E4.Flex", New: "VM.Standard.E5.Flex"},
+			{Field: "Tags.env", New: "prod"},
+		}},
+	}, changes, "buckets are not compared because the second snapshot did not capture them")
+}
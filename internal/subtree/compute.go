@@ -0,0 +1,91 @@
+package subtree
+
+import (
+	"context"
+
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+)
+
+// instanceRepository lists instances across the tree; lookups by OCID pass through.
+type instanceRepository struct {
+	compute.InstanceRepository
+	tree *Tree
+}
+
+// NewInstanceRepository wraps repo with the tree. A nil tree returns repo unchanged.
+func NewInstanceRepository(repo compute.InstanceRepository, tree *Tree) compute.InstanceRepository {
+	if tree == nil {
+		return repo
+	}
+	return &instanceRepository{InstanceRepository: repo, tree: tree}
+}
+
+func (r *instanceRepository) ListInstances(ctx context.Context, compartmentID string) ([]compute.Instance, error) {
+	return List(ctx, r.tree, compartmentID, r.InstanceRepository.ListInstances, instanceID)
+}
+
+func (r *instanceRepository) ListEnrichedInstances(ctx context.Context, compartmentID string) ([]compute.Instance, error) {
+	return List(ctx, r.tree, compartmentID, r.InstanceRepository.ListEnrichedInstances, instanceID)
+}
+
+func instanceID(i compute.Instance) string { return i.OCID }
+
+// imageRepository lists images across the tree; lookups by OCID pass through.
+type imageRepository struct {
+	compute.ImageRepository
+	tree *Tree
+}
+
+// NewImageRepository wraps repo with the tree. A nil tree returns repo unchanged.
+func NewImageRepository(repo compute.ImageRepository, tree *Tree) compute.ImageRepository {
+	if tree == nil {
+		return repo
+	}
+	return &imageRepository{ImageRepository: repo, tree: tree}
+}
+
+func (r *imageRepository) ListImages(ctx context.Context, compartmentID string) ([]compute.Image, error) {
+	return List(ctx, r.tree, compartmentID, r.ImageRepository.ListImages, func(i compute.Image) string { return i.OCID })
+}
+
+// clusterRepository lists OKE clusters across the tree; lookups by OCID pass through.
+type clusterRepository struct {
+	compute.ClusterRepository
+	tree *Tree
+}
+
+// NewClusterRepository wraps repo with the tree. A nil tree returns repo unchanged.
+func NewClusterRepository(repo compute.ClusterRepository, tree *Tree) compute.ClusterRepository {
+	if tree == nil {
+		return repo
+	}
+	return &clusterRepository{ClusterRepository: repo, tree: tree}
+}
+
+func (r *clusterRepository) ListClusters(ctx context.Context, compartmentID string) ([]compute.Cluster, error) {
+	return List(ctx, r.tree, compartmentID, r.ClusterRepository.ListClusters, func(c compute.Cluster) string { return c.OCID })
+}
+
+// instancePoolRepository lists instance pools across the tree; lookups by OCID pass through.
+type instancePoolRepository struct {
+	compute.InstancePoolRepository
+	tree *Tree
+}
+
+// NewInstancePoolRepository wraps repo with the tree. A nil tree returns repo unchanged.
+func NewInstancePoolRepository(repo compute.InstancePoolRepository, tree *Tree) compute.InstancePoolRepository {
+	if tree == nil {
+		return repo
+	}
+	return &instancePoolRepository{InstancePoolRepository: repo, tree: tree}
+}
+
+func (r *instancePoolRepository) ListInstancePools(ctx context.Context, compartmentID string) ([]compute.InstancePool, error) {
+	return List(ctx, r.tree, compartmentID, r.InstancePoolRepository.ListInstancePools, instancePoolID)
+}
+
+func (r *instancePoolRepository) ListEnrichedInstancePools(ctx context.Context, compartmentID string) ([]compute.InstancePool, error) {
+	return List(ctx, r.tree, compartmentID, r.InstancePoolRepository.ListEnrichedInstancePools, instancePoolID)
+}
+
+func instancePoolID(p compute.InstancePool) string { return p.OCID }
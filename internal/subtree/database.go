@@ -0,0 +1,79 @@
+package subtree
+
+import (
+	"context"
+
+	"github.com/cnopslabs/ocloud/internal/domain/database"
+)
+
+// autonomousDatabaseRepository lists Autonomous Databases across the tree; lookups by OCID pass through.
+type autonomousDatabaseRepository struct {
+	database.AutonomousDatabaseRepository
+	tree *Tree
+}
+
+// NewAutonomousDatabaseRepository wraps repo with the tree. A nil tree returns repo unchanged.
+func NewAutonomousDatabaseRepository(repo database.AutonomousDatabaseRepository, tree *Tree) database.AutonomousDatabaseRepository {
+	if tree == nil {
+		return repo
+	}
+	return &autonomousDatabaseRepository{AutonomousDatabaseRepository: repo, tree: tree}
+}
+
+func (r *autonomousDatabaseRepository) ListAutonomousDatabases(ctx context.Context, compartmentID string) ([]database.AutonomousDatabase, error) {
+	return List(ctx, r.tree, compartmentID, r.AutonomousDatabaseRepository.ListAutonomousDatabases, autonomousDatabaseID)
+}
+
+func (r *autonomousDatabaseRepository) ListEnrichedAutonomousDatabase(ctx context.Context, compartmentID string) ([]database.AutonomousDatabase, error) {
+	return List(ctx, r.tree, compartmentID, r.AutonomousDatabaseRepository.ListEnrichedAutonomousDatabase, autonomousDatabaseID)
+}
+
+func autonomousDatabaseID(d database.AutonomousDatabase) string { return d.ID }
+
+// heatWaveDatabaseRepository lists HeatWave databases across the tree; lookups by OCID pass through.
+type heatWaveDatabaseRepository struct {
+	database.HeatWaveDatabaseRepository
+	tree *Tree
+}
+
+// NewHeatWaveDatabaseRepository wraps repo with the tree. A nil tree returns repo unchanged.
+func NewHeatWaveDatabaseRepository(repo database.HeatWaveDatabaseRepository, tree *Tree) database.HeatWaveDatabaseRepository {
+	if tree == nil {
+		return repo
+	}
+	return &heatWaveDatabaseRepository{HeatWaveDatabaseRepository: repo, tree: tree}
+}
+
+func (r *heatWaveDatabaseRepository) ListHeatWaveDatabases(ctx context.Context, compartmentID string) ([]database.HeatWaveDatabase, error) {
+	return List(ctx, r.tree, compartmentID, r.HeatWaveDatabaseRepository.ListHeatWaveDatabases, heatWaveDatabaseID)
+}
+
+func (r *heatWaveDatabaseRepository) ListEnrichedHeatWaveDatabases(ctx context.Context, compartmentID string) ([]database.HeatWaveDatabase, error) {
+	return List(ctx, r.tree, compartmentID, r.HeatWaveDatabaseRepository.ListEnrichedHeatWaveDatabases, heatWaveDatabaseID)
+}
+
+func heatWaveDatabaseID(d database.HeatWaveDatabase) string { return d.ID }
+
+// cacheClusterRepository lists OCI Cache clusters across the tree; lookups by OCID pass through.
+type cacheClusterRepository struct {
+	database.CacheClusterRepository
+	tree *Tree
+}
+
+// NewCacheClusterRepository wraps repo with the tree. A nil tree returns repo unchanged.
+func NewCacheClusterRepository(repo database.CacheClusterRepository, tree *Tree) database.CacheClusterRepository {
+	if tree == nil {
+		return repo
+	}
+	return &cacheClusterRepository{CacheClusterRepository: repo, tree: tree}
+}
+
+func (r *cacheClusterRepository) ListCacheClusters(ctx context.Context, compartmentID string) ([]database.CacheCluster, error) {
+	return List(ctx, r.tree, compartmentID, r.CacheClusterRepository.ListCacheClusters, cacheClusterID)
+}
+
+func (r *cacheClusterRepository) ListEnrichedCacheClusters(ctx context.Context, compartmentID string) ([]database.CacheCluster, error) {
+	return List(ctx, r.tree, compartmentID, r.CacheClusterRepository.ListEnrichedCacheClusters, cacheClusterID)
+}
+
+func cacheClusterID(c database.CacheCluster) string { return c.ID }
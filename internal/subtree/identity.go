@@ -0,0 +1,60 @@
+package subtree
+
+import (
+	"context"
+
+	"github.com/cnopslabs/ocloud/internal/domain/identity"
+)
+
+// policyRepository lists policies across the tree; lookups by OCID pass through.
+type policyRepository struct {
+	identity.PolicyRepository
+	tree *Tree
+}
+
+// NewPolicyRepository wraps repo with the tree. A nil tree returns repo unchanged.
+func NewPolicyRepository(repo identity.PolicyRepository, tree *Tree) identity.PolicyRepository {
+	if tree == nil {
+		return repo
+	}
+	return &policyRepository{PolicyRepository: repo, tree: tree}
+}
+
+func (r *policyRepository) ListPolicies(ctx context.Context, compartmentID string) ([]identity.Policy, error) {
+	return List(ctx, r.tree, compartmentID, r.PolicyRepository.ListPolicies, func(p identity.Policy) string { return p.ID })
+}
+
+// compartmentRepository lists the compartments of the tree, each one annotated
+// with the path of its parent; lookups by OCID pass through.
+type compartmentRepository struct {
+	identity.CompartmentRepository
+	tree *Tree
+}
+
+// NewCompartmentRepository wraps repo with the tree. A nil tree returns repo unchanged.
+func NewCompartmentRepository(repo identity.CompartmentRepository, tree *Tree) identity.CompartmentRepository {
+	if tree == nil {
+		return repo
+	}
+	return &compartmentRepository{CompartmentRepository: repo, tree: tree}
+}
+
+func (r *compartmentRepository) ListCompartments(ctx context.Context, ocid string) ([]identity.Compartment, error) {
+	return List(ctx, r.tree, ocid, r.listChildren, func(c identity.Compartment) string { return c.OCID })
+}
+
+// listChildren returns the direct children of ocid. Listing a tenancy returns
+// its whole subtree, so deeper compartments are dropped to list each one once.
+func (r *compartmentRepository) listChildren(ctx context.Context, ocid string) ([]identity.Compartment, error) {
+	items, err := r.CompartmentRepository.ListCompartments(ctx, ocid)
+	if err != nil {
+		return nil, err
+	}
+	var children []identity.Compartment
+	for _, c := range items {
+		if c.ParentID == "" || c.ParentID == ocid {
+			children = append(children, c)
+		}
+	}
+	return children, nil
+}
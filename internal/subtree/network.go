@@ -0,0 +1,75 @@
+package subtree
+
+import (
+	"context"
+
+	"github.com/cnopslabs/ocloud/internal/domain/network/loadbalancer"
+	"github.com/cnopslabs/ocloud/internal/domain/network/subnet"
+	"github.com/cnopslabs/ocloud/internal/domain/network/vcn"
+)
+
+// vcnRepository lists VCNs across the tree; lookups by OCID pass through.
+type vcnRepository struct {
+	vcn.VCNRepository
+	tree *Tree
+}
+
+// NewVCNRepository wraps repo with the tree. A nil tree returns repo unchanged.
+func NewVCNRepository(repo vcn.VCNRepository, tree *Tree) vcn.VCNRepository {
+	if tree == nil {
+		return repo
+	}
+	return &vcnRepository{VCNRepository: repo, tree: tree}
+}
+
+func (r *vcnRepository) ListVcns(ctx context.Context, compartmentID string) ([]vcn.VCN, error) {
+	return List(ctx, r.tree, compartmentID, r.VCNRepository.ListVcns, vcnID)
+}
+
+func (r *vcnRepository) ListEnrichedVcns(ctx context.Context, compartmentID string) ([]vcn.VCN, error) {
+	return List(ctx, r.tree, compartmentID, r.VCNRepository.ListEnrichedVcns, vcnID)
+}
+
+func vcnID(v vcn.VCN) string { return v.OCID }
+
+// subnetRepository lists subnets across the tree; lookups by OCID pass through.
+type subnetRepository struct {
+	subnet.SubnetRepository
+	tree *Tree
+}
+
+// NewSubnetRepository wraps repo with the tree. A nil tree returns repo unchanged.
+func NewSubnetRepository(repo subnet.SubnetRepository, tree *Tree) subnet.SubnetRepository {
+	if tree == nil {
+		return repo
+	}
+	return &subnetRepository{SubnetRepository: repo, tree: tree}
+}
+
+func (r *subnetRepository) ListSubnets(ctx context.Context, compartmentID string) ([]subnet.Subnet, error) {
+	return List(ctx, r.tree, compartmentID, r.SubnetRepository.ListSubnets, func(s subnet.Subnet) string { return s.OCID })
+}
+
+// loadBalancerRepository lists load balancers across the tree; lookups by OCID pass through.
+type loadBalancerRepository struct {
+	loadbalancer.LoadBalancerRepository
+	tree *Tree
+}
+
+// NewLoadBalancerRepository wraps repo with the tree. A nil tree returns repo unchanged.
+func NewLoadBalancerRepository(repo loadbalancer.LoadBalancerRepository, tree *Tree) loadbalancer.LoadBalancerRepository {
+	if tree == nil {
+		return repo
+	}
+	return &loadBalancerRepository{LoadBalancerRepository: repo, tree: tree}
+}
+
+func (r *loadBalancerRepository) ListLoadBalancers(ctx context.Context, compartmentID string) ([]loadbalancer.LoadBalancer, error) {
+	return List(ctx, r.tree, compartmentID, r.LoadBalancerRepository.ListLoadBalancers, loadBalancerID)
+}
+
+func (r *loadBalancerRepository) ListEnrichedLoadBalancers(ctx context.Context, compartmentID string) ([]loadbalancer.LoadBalancer, error) {
+	return List(ctx, r.tree, compartmentID, r.LoadBalancerRepository.ListEnrichedLoadBalancers, loadBalancerID)
+}
+
+func loadBalancerID(lb loadbalancer.LoadBalancer) string { return lb.OCID }
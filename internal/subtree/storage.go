@@ -0,0 +1,26 @@
+package subtree
+
+import (
+	"context"
+
+	"github.com/cnopslabs/ocloud/internal/domain/storage/objectstorage"
+)
+
+// bucketRepository lists buckets across the tree. Buckets are keyed by name,
+// which is unique within the namespace; object operations pass through.
+type bucketRepository struct {
+	objectstorage.ObjectStorageRepository
+	tree *Tree
+}
+
+// NewObjectStorageRepository wraps repo with the tree. A nil tree returns repo unchanged.
+func NewObjectStorageRepository(repo objectstorage.ObjectStorageRepository, tree *Tree) objectstorage.ObjectStorageRepository {
+	if tree == nil {
+		return repo
+	}
+	return &bucketRepository{ObjectStorageRepository: repo, tree: tree}
+}
+
+func (r *bucketRepository) ListBuckets(ctx context.Context, compartmentID string) ([]objectstorage.Bucket, error) {
+	return List(ctx, r.tree, compartmentID, r.ObjectStorageRepository.ListBuckets, func(b objectstorage.Bucket) string { return b.Name })
+}
@@ -0,0 +1,220 @@
+// Package subtree fans resource listings out over a compartment subtree.
+//
+// A Tree holds the compartments below a root compartment together with their
+// paths. Repository decorators in this package wrap the domain repository
+// interfaces so that listing the root compartment lists every compartment of
+// the tree concurrently and remembers the compartment each resource came from.
+package subtree
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/cnopslabs/ocloud/internal/domain/identity"
+)
+
+// DefaultWorkers bounds how many compartments are queried at the same time.
+const DefaultWorkers = 8
+
+// Node is a compartment of a tree with its path from the tree root,
+// e.g. "root/apps/prod".
+type Node struct {
+	ID   string
+	Path string
+}
+
+// Tree is a compartment subtree. A nil *Tree is valid and disables fan-out.
+type Tree struct {
+	nodes   []Node
+	workers int
+
+	mu    sync.RWMutex
+	paths map[string]string
+}
+
+// New creates a Tree from nodes, the first of which is the root.
+// Listings run on at most workers goroutines (DefaultWorkers when not positive).
+func New(nodes []Node, workers int) *Tree {
+	if workers <= 0 {
+		workers = DefaultWorkers
+	}
+	return &Tree{nodes: nodes, workers: workers, paths: map[string]string{}}
+}
+
+// Root returns the OCID of the root compartment.
+func (t *Tree) Root() string {
+	if t == nil || len(t.nodes) == 0 {
+		return ""
+	}
+	return t.nodes[0].ID
+}
+
+// Nodes returns the compartments of the tree, root first.
+func (t *Tree) Nodes() []Node {
+	if t == nil {
+		return nil
+	}
+	return t.nodes
+}
+
+// PathOf returns the path of the compartment the resource with the given ID was
+// listed from, or "" when the resource was not listed through the tree.
+func (t *Tree) PathOf(id string) string {
+	if t == nil {
+		return ""
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.paths[id]
+}
+
+// record remembers the compartment path of the given resources.
+func (t *Tree) record(path string, ids []string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, id := range ids {
+		if id != "" {
+			t.paths[id] = path
+		}
+	}
+}
+
+// List calls list for compartmentID. When compartmentID is the root of t, every
+// compartment of t is listed concurrently instead and the results are
+// concatenated in tree order; id identifies each resource for PathOf.
+func List[T any](ctx context.Context, t *Tree, compartmentID string, list func(context.Context, string) ([]T, error), id func(T) string) ([]T, error) {
+	if t == nil || compartmentID != t.Root() {
+		return list(ctx, compartmentID)
+	}
+
+	results := make([][]T, len(t.nodes))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(t.workers)
+	for i, n := range t.nodes {
+		g.Go(func() error {
+			items, err := list(gctx, n.ID)
+			if err != nil {
+				return fmt.Errorf("listing compartment %s: %w", n.Path, err)
+			}
+			ids := make([]string, len(items))
+			for j, item := range items {
+				ids[j] = id(item)
+			}
+			t.record(n.Path, ids)
+			results[i] = items
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	var all []T
+	for _, items := range results {
+		all = append(all, items...)
+	}
+	return all, nil
+}
+
+// Walk returns the active compartments of the subtree rooted at rootID, root
+// first and breadth first. rootName is the path of the root node.
+func Walk(ctx context.Context, repo identity.CompartmentRepository, rootID, rootName string, workers int) ([]Node, error) {
+	if workers <= 0 {
+		workers = DefaultWorkers
+	}
+	root := Node{ID: rootID, Path: rootName}
+
+	children, err := repo.ListCompartments(ctx, rootID)
+	if err != nil {
+		return nil, fmt.Errorf("listing compartments of %s: %w", rootName, err)
+	}
+	// Listing a tenancy returns its whole subtree at once.
+	if hasDescendants(children, rootID) {
+		return fromFlat(root, children), nil
+	}
+
+	nodes := []Node{root}
+	level := childNodes(root, children)
+	for len(level) > 0 {
+		nodes = append(nodes, level...)
+
+		next := make([][]Node, len(level))
+		g, gctx := errgroup.WithContext(ctx)
+		g.SetLimit(workers)
+		for i, n := range level {
+			g.Go(func() error {
+				items, err := repo.ListCompartments(gctx, n.ID)
+				if err != nil {
+					return fmt.Errorf("listing compartments of %s: %w", n.Path, err)
+				}
+				next[i] = childNodes(n, items)
+				return nil
+			})
+		}
+		if err := g.Wait(); err != nil {
+			return nil, err
+		}
+
+		level = nil
+		for _, ns := range next {
+			level = append(level, ns...)
+		}
+	}
+	return nodes, nil
+}
+
+// hasDescendants reports whether items hold compartments below the direct children of parentID.
+func hasDescendants(items []identity.Compartment, parentID string) bool {
+	for _, c := range items {
+		if c.ParentID != "" && c.ParentID != parentID {
+			return true
+		}
+	}
+	return false
+}
+
+// childNodes returns the direct children of parent among items.
+func childNodes(parent Node, items []identity.Compartment) []Node {
+	var out []Node
+	for _, c := range items {
+		if c.ParentID != "" && c.ParentID != parent.ID {
+			continue
+		}
+		out = append(out, Node{ID: c.OCID, Path: joinPath(parent.Path, c.DisplayName)})
+	}
+	return out
+}
+
+// fromFlat builds the tree below root from a flat list of descendants.
+func fromFlat(root Node, items []identity.Compartment) []Node {
+	byParent := map[string][]identity.Compartment{}
+	for _, c := range items {
+		byParent[c.ParentID] = append(byParent[c.ParentID], c)
+	}
+
+	nodes := []Node{root}
+	level := []Node{root}
+	for len(level) > 0 {
+		var next []Node
+		for _, n := range level {
+			for _, c := range byParent[n.ID] {
+				next = append(next, Node{ID: c.OCID, Path: joinPath(n.Path, c.DisplayName)})
+			}
+		}
+		nodes = append(nodes, next...)
+		level = next
+	}
+	return nodes
+}
+
+// joinPath appends a compartment name to a path.
+func joinPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return strings.TrimSuffix(parent, "/") + "/" + name
+}
@@ -0,0 +1,148 @@
+package subtree
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/cnopslabs/ocloud/internal/domain/compute"
+	"github.com/cnopslabs/ocloud/internal/domain/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCompartmentRepository serves compartments from a parent → children map.
+// When flat is set, listing the root returns every descendant like a tenancy does.
+type fakeCompartmentRepository struct {
+	children map[string][]identity.Compartment
+	flat     string
+
+	mu     sync.Mutex
+	listed []string
+}
+
+func (f *fakeCompartmentRepository) GetCompartment(ctx context.Context, ocid string) (*identity.Compartment, error) {
+	return &identity.Compartment{OCID: ocid}, nil
+}
+
+func (f *fakeCompartmentRepository) ListCompartments(ctx context.Context, ocid string) ([]identity.Compartment, error) {
+	f.mu.Lock()
+	f.listed = append(f.listed, ocid)
+	f.mu.Unlock()
+
+	if ocid != f.flat {
+		return f.children[ocid], nil
+	}
+	var all []identity.Compartment
+	queue := []string{ocid}
+	for len(queue) > 0 {
+		for _, c := range f.children[queue[0]] {
+			all = append(all, c)
+			queue = append(queue, c.OCID)
+		}
+		queue = queue[1:]
+	}
+	return all, nil
+}
+
+// newFakeTree returns root → {apps → {prod}, shared}.
+func newFakeTree() *fakeCompartmentRepository {
+	return &fakeCompartmentRepository{children: map[string][]identity.Compartment{
+		"root": {
+			{OCID: "apps", ParentID: "root", DisplayName: "apps"},
+			{OCID: "shared", ParentID: "root", DisplayName: "shared"},
+		},
+		"apps": {
+			{OCID: "prod", ParentID: "apps", DisplayName: "prod"},
+		},
+	}}
+}
+
+var wantNodes = []Node{
+	{ID: "root", Path: "tenancy"},
+	{ID: "apps", Path: "tenancy/apps"},
+	{ID: "shared", Path: "tenancy/shared"},
+	{ID: "prod", Path: "tenancy/apps/prod"},
+}
+
+func TestWalk_BreadthFirst(t *testing.T) {
+	repo := newFakeTree()
+
+	nodes, err := Walk(context.Background(), repo, "root", "tenancy", 2)
+	require.NoError(t, err)
+	assert.Equal(t, wantNodes, nodes)
+	assert.ElementsMatch(t, []string{"root", "apps", "shared", "prod"}, repo.listed)
+}
+
+func TestWalk_FlatTenancyListing(t *testing.T) {
+	repo := newFakeTree()
+	repo.flat = "root"
+
+	nodes, err := Walk(context.Background(), repo, "root", "tenancy", 2)
+	require.NoError(t, err)
+	assert.Equal(t, wantNodes, nodes)
+	assert.Equal(t, []string{"root"}, repo.listed, "a tenancy listing already holds the whole subtree")
+}
+
+func TestList_FansOutFromRoot(t *testing.T) {
+	tree := New(wantNodes, 2)
+	list := func(ctx context.Context, compartmentID string) ([]compute.Instance, error) {
+		return []compute.Instance{{OCID: "i-" + compartmentID}}, nil
+	}
+	id := func(i compute.Instance) string { return i.OCID }
+
+	items, err := List(context.Background(), tree, "root", list, id)
+	require.NoError(t, err)
+
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.OCID
+	}
+	assert.Equal(t, []string{"i-root", "i-apps", "i-shared", "i-prod"}, ids, "results keep tree order")
+	assert.Equal(t, "tenancy/apps/prod", tree.PathOf("i-prod"))
+	assert.Equal(t, "tenancy", tree.PathOf("i-root"))
+
+	items, err = List(context.Background(), tree, "apps", list, id)
+	require.NoError(t, err)
+	assert.Len(t, items, 1, "only the root compartment fans out")
+}
+
+func TestList_ReportsFailingCompartment(t *testing.T) {
+	tree := New(wantNodes, 2)
+	list := func(ctx context.Context, compartmentID string) ([]compute.Instance, error) {
+		if compartmentID == "shared" {
+			return nil, errors.New("not authorized")
+		}
+		return nil, nil
+	}
+
+	_, err := List(context.Background(), tree, "root", list, func(i compute.Instance) string { return i.OCID })
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "tenancy/shared")
+}
+
+func TestNilTree(t *testing.T) {
+	var tree *Tree
+	assert.Equal(t, "", tree.Root())
+	assert.Equal(t, "", tree.PathOf("x"))
+
+	repo := newFakeTree()
+	assert.Same(t, identity.CompartmentRepository(repo), NewCompartmentRepository(repo, nil))
+}
+
+func TestCompartmentRepository_ListsEachCompartmentOnce(t *testing.T) {
+	repo := newFakeTree()
+	repo.flat = "root"
+	tree := New(wantNodes, 2)
+
+	items, err := NewCompartmentRepository(repo, tree).ListCompartments(context.Background(), "root")
+	require.NoError(t, err)
+
+	ids := make([]string, len(items))
+	for i, c := range items {
+		ids[i] = c.OCID
+	}
+	assert.Equal(t, []string{"apps", "shared", "prod"}, ids)
+	assert.Equal(t, "tenancy/apps", tree.PathOf("prod"), "compartments carry the path of their parent")
+}
@@ -0,0 +1,41 @@
+package tui
+
+import (
+	"github.com/blevesearch/bleve/v2"
+	"github.com/charmbracelet/bubbles/list"
+
+	"github.com/cnopslabs/ocloud/internal/services/search"
+)
+
+// WithQueryFilter lets the list filter accept the structured search query
+// language (see search.Query), e.g. "state:running -name:test". docs must hold
+// the searchable form of every list item, in list order. Filter terms without
+// field filters keep the default fuzzy list filtering.
+func (m Model) WithQueryFilter(docs []search.Indexable, fields, boostedFields []string) Model {
+	idx, err := search.BuildIndex(docs, search.NewIndexMapping(fields))
+	if err != nil {
+		return m
+	}
+	m.list.Filter = queryFilter(idx, fields, boostedFields)
+	return m
+}
+
+// queryFilter returns a list.FilterFunc that evaluates structured queries against idx.
+func queryFilter(idx bleve.Index, fields, boostedFields []string) list.FilterFunc {
+	return func(term string, targets []string) []list.Rank {
+		if !search.ParseQuery(term, fields).HasFilters() {
+			return list.DefaultFilter(term, targets)
+		}
+		hits, err := search.ScoredFuzzySearch(idx, term, fields, boostedFields)
+		if err != nil {
+			return nil
+		}
+		ranks := make([]list.Rank, 0, len(hits))
+		for _, h := range hits {
+			if h.Index >= 0 && h.Index < len(targets) {
+				ranks = append(ranks, list.Rank{Index: h.Index})
+			}
+		}
+		return ranks
+	}
+}
@@ -0,0 +1,154 @@
+// Package watch re-runs a query at a fixed interval and reports the lifecycle
+// state transitions of the resources it returns.
+package watch
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// State is the lifecycle state of one resource observed by a poll.
+type State struct {
+	ID    string
+	Name  string
+	State string
+}
+
+// Transition is a change of state between two polls. From is empty for a
+// resource that appeared and To is empty for one that disappeared.
+type Transition struct {
+	Name string
+	From string
+	To   string
+}
+
+// Options configures a watch. A nil *Options disables watching.
+type Options struct {
+	// Interval is the time between the end of one poll and the start of the next.
+	Interval time.Duration
+	// UntilState stops the watch once every observed resource is in this state.
+	UntilState string
+	// Out receives the poll headers and transitions, so that structured
+	// output written by the poll itself stays parseable.
+	Out io.Writer
+	// Done stops the watch when closed, e.g. on Ctrl-C.
+	Done <-chan struct{}
+}
+
+// Poll queries and prints the resources once and returns their states.
+type Poll func(ctx context.Context) ([]State, error)
+
+// Run calls poll every opts.Interval until ctx or opts.Done ends the watch or
+// every resource reached opts.UntilState. Transitions are printed after each
+// poll. A failing poll ends the watch with its error.
+func Run(ctx context.Context, opts Options, poll Poll) error {
+	var prev map[string]State
+	for i := 0; ; i++ {
+		if i > 0 {
+			_, _ = fmt.Fprintf(opts.Out, "\n%s\n", text.Colors{text.FgHiBlack}.Sprintf("── %s (every %s, Ctrl-C to stop) ──", time.Now().Format(time.TimeOnly), opts.Interval))
+		}
+		states, err := poll(ctx)
+		if err != nil {
+			return err
+		}
+
+		cur := byID(states)
+		if prev != nil {
+			for _, t := range Transitions(prev, cur) {
+				printTransition(opts.Out, t)
+			}
+		}
+		prev = cur
+
+		if Reached(states, opts.UntilState) {
+			_, _ = fmt.Fprintln(opts.Out, text.Colors{text.FgGreen}.Sprintf("All %d resources are %s", len(states), strings.ToUpper(opts.UntilState)))
+			return nil
+		}
+
+		timer := time.NewTimer(opts.Interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-opts.Done:
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+	}
+}
+
+// Transitions returns the state changes from prev to cur ordered by name, with
+// the resources that disappeared last.
+func Transitions(prev, cur map[string]State) []Transition {
+	var out []Transition
+	for _, id := range sortedIDs(cur) {
+		c := cur[id]
+		p, ok := prev[id]
+		switch {
+		case !ok:
+			out = append(out, Transition{Name: c.Name, To: c.State})
+		case !strings.EqualFold(p.State, c.State):
+			out = append(out, Transition{Name: c.Name, From: p.State, To: c.State})
+		}
+	}
+	for _, id := range sortedIDs(prev) {
+		if _, ok := cur[id]; !ok {
+			out = append(out, Transition{Name: prev[id].Name, From: prev[id].State})
+		}
+	}
+	return out
+}
+
+// Reached reports whether there is at least one resource and every resource is
+// in target. An empty target is never reached.
+func Reached(states []State, target string) bool {
+	if target == "" || len(states) == 0 {
+		return false
+	}
+	for _, s := range states {
+		if !strings.EqualFold(s.State, target) {
+			return false
+		}
+	}
+	return true
+}
+
+func byID(states []State) map[string]State {
+	m := make(map[string]State, len(states))
+	for _, s := range states {
+		m[s.ID] = s
+	}
+	return m
+}
+
+func sortedIDs(m map[string]State) []string {
+	ids := make([]string, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if m[ids[i]].Name != m[ids[j]].Name {
+			return m[ids[i]].Name < m[ids[j]].Name
+		}
+		return ids[i] < ids[j]
+	})
+	return ids
+}
+
+func printTransition(out io.Writer, t Transition) {
+	switch {
+	case t.From == "":
+		_, _ = fmt.Fprintln(out, text.Colors{text.FgCyan}.Sprintf("+ %s appeared (%s)", t.Name, t.To))
+	case t.To == "":
+		_, _ = fmt.Fprintln(out, text.Colors{text.FgRed}.Sprintf("- %s disappeared (was %s)", t.Name, t.From))
+	default:
+		_, _ = fmt.Fprintln(out, text.Colors{text.FgYellow}.Sprintf("~ %s: %s → %s", t.Name, t.From, t.To))
+	}
+}
@@ -0,0 +1,80 @@
+package watch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransitions(t *testing.T) {
+	prev := byID([]State{
+		{ID: "1", Name: "adb", State: "PROVISIONING"},
+		{ID: "2", Name: "web", State: "RUNNING"},
+		{ID: "3", Name: "old", State: "TERMINATING"},
+	})
+	cur := byID([]State{
+		{ID: "1", Name: "adb", State: "AVAILABLE"},
+		{ID: "2", Name: "web", State: "running"},
+		{ID: "4", Name: "new", State: "PROVISIONING"},
+	})
+
+	assert.Equal(t, []Transition{
+		{Name: "adb", From: "PROVISIONING", To: "AVAILABLE"},
+		{Name: "new", To: "PROVISIONING"},
+		{Name: "old", From: "TERMINATING"},
+	}, Transitions(prev, cur))
+}
+
+func TestReached(t *testing.T) {
+	states := []State{{ID: "1", State: "STOPPED"}, {ID: "2", State: "stopped"}}
+	assert.True(t, Reached(states, "Stopped"))
+	assert.False(t, Reached(states, ""))
+	assert.False(t, Reached(nil, "STOPPED"))
+	assert.False(t, Reached(append(states, State{ID: "3", State: "STOPPING"}), "STOPPED"))
+}
+
+func TestRun(t *testing.T) {
+	t.Run("stops at the target state", func(t *testing.T) {
+		polls := [][]State{
+			{{ID: "1", Name: "vm", State: "STOPPING"}},
+			{{ID: "1", Name: "vm", State: "STOPPED"}},
+		}
+		var out bytes.Buffer
+		calls := 0
+		err := Run(context.Background(), Options{Interval: time.Millisecond, UntilState: "stopped", Out: &out}, func(context.Context) ([]State, error) {
+			calls++
+			return polls[calls-1], nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls)
+		assert.Contains(t, out.String(), "vm: STOPPING → STOPPED")
+		assert.Contains(t, out.String(), "All 1 resources are STOPPED")
+	})
+
+	t.Run("returns poll errors", func(t *testing.T) {
+		boom := errors.New("boom")
+		err := Run(context.Background(), Options{Interval: time.Millisecond, Out: &bytes.Buffer{}}, func(context.Context) ([]State, error) {
+			return nil, boom
+		})
+		assert.ErrorIs(t, err, boom)
+	})
+
+	t.Run("stops when done is closed", func(t *testing.T) {
+		done := make(chan struct{})
+		calls := 0
+		err := Run(context.Background(), Options{Interval: time.Millisecond, Out: &bytes.Buffer{}, Done: done}, func(context.Context) ([]State, error) {
+			calls++
+			if calls == 3 {
+				close(done)
+			}
+			return nil, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+}
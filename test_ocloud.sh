@@ -78,6 +78,7 @@ print_header "Testing compute instance list command"
 run_command ./bin/ocloud compute instance get
 run_command ./bin/ocloud compute instance get --limit 10 --page 1 --json
 run_command ./bin/ocloud compute instance get -m 10 -p 1 -j
+run_command ./bin/ocloud compute instance get --metadata --limit 5
 run_command ./bin/ocloud comp inst get
 
 # Test compute instance search command
@@ -87,6 +88,17 @@ run_command ./bin/ocloud compute instance search "roster" --all --json
 run_command ./bin/ocloud compute instance search "roster" -A -j
 run_command ./bin/ocloud comp inst s "roster"
 
+# Test compute instance export command
+print_header "Testing compute instance export command"
+run_command ./bin/ocloud compute instance export
+run_command ./bin/ocloud compute instance export --format ansible-ini
+run_command ./bin/ocloud compute instance export --format ansible-yaml
+
+# Test compute instance console commands
+print_header "Testing compute instance console commands"
+run_command ./bin/ocloud compute instance console-history --help
+run_command ./bin/ocloud compute instance console connect --help
+
 # Test compute image command
 print_header "Testing compute image command"
 run_command ./bin/ocloud compute image --help
@@ -106,6 +118,33 @@ run_command ./bin/ocloud compute image search "Oracle-Linux" --json
 run_command ./bin/ocloud compute image search "Oracle-Linux" -j
 run_command ./bin/ocloud comp img s "Oracle-Linux"
 
+# Test compute image latest command
+print_header "Testing compute image latest command"
+run_command ./bin/ocloud compute image latest --os "Oracle Linux" --os-version 9
+run_command ./bin/ocloud compute image latest --os "Oracle Linux" --os-version 9 --shape VM.Standard.E5.Flex
+run_command ./bin/ocloud compute image latest --os "Oracle Linux" --os-version 9 --shape VM.Standard.E5.Flex --json
+
+# Test compute image lifecycle commands (help only, as they create resources)
+print_header "Testing compute image create, export and import commands"
+run_command ./bin/ocloud compute image create --help
+run_command ./bin/ocloud compute image export --help
+run_command ./bin/ocloud compute image import --help
+
+# Test compute instance-pool command
+print_header "Testing compute instance-pool command"
+run_command ./bin/ocloud compute instance-pool --help
+run_command ./bin/ocloud compute instance-pool get
+run_command ./bin/ocloud compute instance-pool get --limit 10 --page 1 --json
+run_command ./bin/ocloud compute pool search "web"
+
+# Test compute shape command
+print_header "Testing compute shape command"
+run_command ./bin/ocloud compute shape --help
+run_command ./bin/ocloud compute shape get
+run_command ./bin/ocloud compute shape get --limit 10 --page 1 --json
+run_command ./bin/ocloud compute shape get VM.Standard.E5.Flex
+run_command ./bin/ocloud compute shape search "flexible"
+
 # Test compute oke command
 print_header "Testing compute oke command"
 run_command ./bin/ocloud compute oke --help
@@ -117,12 +156,28 @@ run_command ./bin/ocloud compute oke get
 run_command ./bin/ocloud compute oke get --limit 10 --page 1 --json
 run_command ./bin/ocloud compute oke get -m 10 -p 1 -j
 run_command ./bin/ocloud comp oke get
+run_command ./bin/ocloud compute oke get "orion" --nodes
+run_command ./bin/ocloud compute oke get "orion" --nodes --json
+run_command ./bin/ocloud compute oke get --all
+run_command ./bin/ocloud compute oke get "orion" -A
+
+# Test compute oke upgrades command
+print_header "Testing compute oke upgrades command"
+run_command ./bin/ocloud compute oke upgrades "orion"
+run_command ./bin/ocloud compute oke upgrades "orion" --json
+
+# Test compute oke kubeconfig command
+print_header "Testing compute oke kubeconfig command"
+run_command ./bin/ocloud compute oke kubeconfig list
+run_command ./bin/ocloud compute oke kubeconfig list --json
 
 # Test compute oke search command
 print_header "Testing compute oke search command"
 run_command ./bin/ocloud compute oke search "orion"
 run_command ./bin/ocloud compute oke search "orion" --json
+run_command ./bin/ocloud compute oke search "orion" --all
 run_command ./bin/ocloud compute oke search "orion" -j
+run_command ./bin/ocloud compute oke search "Pod.Standard"
 run_command ./bin/ocloud comp oke s "orion"
 
 # Test with debug flag